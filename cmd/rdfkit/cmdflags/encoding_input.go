@@ -2,9 +2,12 @@ package cmdflags
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"os"
 
 	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
 	"github.com/spf13/pflag"
@@ -42,6 +45,9 @@ type EncodingInputOpenOptions struct {
 	ReaderTee           io.Writer
 	DecoderPatcher      rdfiotypes.GenericOptionsPatcherFunc
 	DecoderFallbackType encoding.ContentTypeIdentifier
+
+	// MessageWriter receives diagnostic messages of the decoder. By default, invalid literals and IRIs are written to stderr.
+	MessageWriter encoding.DecoderMessageWriter
 }
 
 func (f EncodingInput) Open(ctx context.Context, r rdfiotypes.Registry, opts *EncodingInputOpenOptions) (*rdfiotypes.DecoderHandle, error) {
@@ -49,6 +55,11 @@ func (f EncodingInput) Open(ctx context.Context, r rdfiotypes.Registry, opts *En
 		opts = &EncodingInputOpenOptions{}
 	}
 
+	messageWriter := opts.MessageWriter
+	if messageWriter == nil {
+		messageWriter = encoding.DecoderMessageWriterFunc(writeDecoderMessage)
+	}
+
 	return r.OpenDecoder(
 		ctx,
 		rdfiotypes.ReaderOptions{
@@ -61,6 +72,8 @@ func (f EncodingInput) Open(ctx context.Context, r rdfiotypes.Registry, opts *En
 			BaseIRI: rdf.IRI(f.EncodingBaseIRI),
 			Params:  f.EncodingParams,
 			Patcher: opts.DecoderPatcher,
+
+			MessageWriter: messageWriter,
 		},
 		rdfiotypes.DecoderOptionsBuilderFunc(func(r rdfiotypes.Registry, rr rdfiotypes.Reader, ropts *rdfiotypes.DecoderOptions) error {
 			cti, ok := r.ResolveDecoderType(rr, ropts.Type)
@@ -76,3 +89,12 @@ func (f EncodingInput) Open(ctx context.Context, r rdfiotypes.Registry, opts *En
 		}),
 	)
 }
+
+func writeDecoderMessage(msg encoding.DecoderMessage) {
+	switch msg := msg.(type) {
+	case encodingutil.DecoderMessage_InvalidLiteral:
+		fmt.Fprintf(os.Stderr, "WARN: %v\n", msg.Err)
+	case encodingutil.DecoderMessage_InvalidIRI:
+		fmt.Fprintf(os.Stderr, "WARN: %v\n", msg.Err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/nquads/nquadscontent"
 	"github.com/dpb587/rdfkit-go/encoding/trig/trigcontent"
 	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads/quadssort"
 	"github.com/spf13/cobra"
 )
 
@@ -18,6 +19,8 @@ func New(app *cmdutil.App) *cobra.Command {
 	fIn := &cmdflags.EncodingInput{}
 	fOut := &cmdflags.EncodingOutput{}
 
+	var fSort, fUnique bool
+
 	cmd := &cobra.Command{
 		Use:   "pipe",
 		Short: "Decode and re-encode using supported encoding formats",
@@ -47,6 +50,30 @@ func New(app *cmdutil.App) *cobra.Command {
 			decoderQuads := bfIn.GetQuadsDecoder()
 			encoderQuads := bfOut.GetQuadsEncoder()
 
+			if fSort || fUnique {
+				sorted, err := quadssort.Sort(ctx, decoderQuads, quadssort.SortConfig{}.
+					SetUnique(fUnique),
+				)
+				if err != nil {
+					return fmt.Errorf("sort[%s]: %v", bfIn.Decoder.GetContentTypeIdentifier(), err)
+				}
+
+				defer sorted.Close()
+
+				for sorted.Next() {
+					err := encoderQuads.AddQuad(ctx, sorted.Quad())
+					if err != nil {
+						return fmt.Errorf("write: %v", err)
+					}
+				}
+
+				if err := sorted.Err(); err != nil {
+					return fmt.Errorf("sort: %v", err)
+				}
+
+				return nil
+			}
+
 			writeStatementFunc := func(ctx context.Context, iter rdf.QuadIterator) error {
 				return encoderQuads.AddQuad(ctx, iter.Quad())
 			}
@@ -82,6 +109,8 @@ func New(app *cmdutil.App) *cobra.Command {
 	f := cmd.Flags()
 	fIn.Bind(f, "in", "i")
 	fOut.Bind(f, "out", "o")
+	f.BoolVar(&fSort, "sort", fSort, "sort statements, using temporary files for large inputs")
+	f.BoolVar(&fUnique, "unique", fUnique, "sort statements and drop duplicates (implies sort)")
 
 	cmd.SetHelpFunc(cmdutil.RegistryHelpFunc(app))
 
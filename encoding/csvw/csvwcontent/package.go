@@ -0,0 +1,24 @@
+package csvwcontent
+
+import (
+	"github.com/dpb587/rdfkit-go/encoding"
+)
+
+const TypeIdentifier encoding.ContentTypeIdentifier = "org.w3.csvw"
+
+var DefaultMetadata = encoding.ContentMetadata{
+	FileExt: ".csv",
+	MediaType: encoding.ContentMediaType{
+		Type:    "text",
+		Subtype: "csv",
+	},
+}
+
+// TabSeparatedMetadata describes the tab-separated variant, which is decoded with a tab delimiter.
+var TabSeparatedMetadata = encoding.ContentMetadata{
+	FileExt: ".tsv",
+	MediaType: encoding.ContentMediaType{
+		Type:    "text",
+		Subtype: "tab-separated-values",
+	},
+}
@@ -0,0 +1,150 @@
+package csvwrdfio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/csvw"
+	"github.com/dpb587/rdfkit-go/encoding/csvw/csvwcontent"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type decoder struct{}
+
+var _ rdfiotypes.DecoderManager = decoder{}
+
+func NewDecoder() rdfiotypes.DecoderManager {
+	return decoder{}
+}
+
+func (decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return csvwcontent.TypeIdentifier
+}
+
+func (e decoder) NewDecoderParams() rdfiotypes.Params {
+	return newDecoderParams()
+}
+
+func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions) (*rdfiotypes.DecoderHandle, error) {
+	params := newDecoderParams()
+
+	err := rdfiotypes.LoadAndApplyParams(params, opts.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	defaultBase, err := resolveFileURL(string(opts.BaseIRI))
+	if err != nil {
+		return nil, fmt.Errorf("base: %v", err)
+	}
+
+	networkLoader, err := params.DocumentLoader.NewDocumentLoader()
+	if err != nil {
+		return nil, fmt.Errorf("params: documentLoader: %v", err)
+	} else if networkLoader == nil {
+		networkLoader = jsonldtype.NewDefaultDocumentLoader(nil)
+	}
+
+	httpClient, err := params.DocumentLoader.NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("params: documentLoader: %v", err)
+	}
+
+	// local files may only be referenced by local tabular data
+
+	allowFiles := strings.HasPrefix(defaultBase, "file:")
+
+	documentLoader := jsonldtype.NewChainDocumentLoader(fileDocumentLoader{allowed: allowFiles}, networkLoader)
+
+	bnFactory := blanknodes.NewStringFactory()
+
+	options := csvw.DecoderConfig{}.
+		SetBlankNodeStringFactory(bnFactory).
+		SetDefaultBase(defaultBase).
+		SetDocumentLoader(documentLoader).
+		SetTableLoader(newTableLoader(httpClient, allowFiles))
+
+	if params.CaptureTextOffsets != nil {
+		options = options.SetCaptureTextOffsets(*params.CaptureTextOffsets)
+	}
+
+	if params.Mode != nil {
+		options = options.SetMode(csvw.Mode(*params.Mode))
+	}
+
+	if params.Delimiter != nil {
+		dialect := csvw.DefaultDialect
+		dialect.Delimiter = *params.Delimiter
+
+		options = options.SetDialect(dialect)
+	} else if strings.HasSuffix(strings.ToLower(defaultBase), ".tsv") {
+		dialect := csvw.DefaultDialect
+		dialect.Delimiter = "\t"
+
+		options = options.SetDialect(dialect)
+	}
+
+	if params.MetadataDiscovery != nil {
+		options = options.SetMetadataDiscovery(*params.MetadataDiscovery)
+	}
+
+	if params.Metadata != nil {
+		metadataURL := *params.Metadata
+		metadataLoader := jsonldtype.DocumentLoader(documentLoader)
+
+		if !strings.Contains(metadataURL, "://") {
+			metadataURL, err = resolveFileURL("file://" + metadataURL)
+			if err != nil {
+				return nil, fmt.Errorf("params: metadata: %v", err)
+			}
+
+			// a file path is given explicitly, unlike the references of metadata
+			metadataLoader = fileDocumentLoader{allowed: true}
+		}
+
+		remoteDocument, err := metadataLoader.LoadDocument(context.Background(), metadataURL, jsonldtype.DocumentLoaderOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("params: metadata: %v", err)
+		}
+
+		options = options.SetMetadataDocument(remoteDocument)
+	}
+
+	allOptions, err := rdfiotypes.PatchGenericOptions([]csvw.DecoderOption{options}, opts.Patcher)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := csvw.NewDecoder(rr, allOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &rdfiotypes.DecoderHandle{
+		Reader:            rr,
+		Decoder:           decoder,
+		DecoderBlankNodes: bnFactory,
+	}
+
+	err = params.ValidateLiterals.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	err = params.ValidateIRIs.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	params.CanonicalizeLiterals.ResolveDecoderHandle(h)
+	params.NormalizeIRIs.ResolveDecoderHandle(h)
+
+	params.Deskolemize.ResolveDecoderHandle(h)
+	params.Skolemize.ResolveDecoderHandle(h)
+
+	return h, nil
+}
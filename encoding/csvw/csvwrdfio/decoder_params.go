@@ -0,0 +1,81 @@
+package csvwrdfio
+
+import (
+	"maps"
+
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldrdfio"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfioutil"
+)
+
+type decoderParams struct {
+	CaptureTextOffsets *bool
+	Mode               *string
+	Delimiter          *string
+	Metadata           *string
+	MetadataDiscovery  *bool
+
+	DocumentLoader       *jsonldrdfio.DocumentLoaderParams
+	ValidateLiterals     *rdfioutil.LiteralValidation
+	CanonicalizeLiterals *rdfioutil.LiteralCanonicalization
+	ValidateIRIs         *rdfioutil.IRIValidation
+	NormalizeIRIs        *rdfioutil.IRINormalization
+	Skolemize            *rdfioutil.Skolemization
+	Deskolemize          *rdfioutil.Deskolemization
+}
+
+var _ rdfiotypes.Params = &decoderParams{}
+
+func newDecoderParams() *decoderParams {
+	return &decoderParams{
+		DocumentLoader:       &jsonldrdfio.DocumentLoaderParams{},
+		ValidateLiterals:     &rdfioutil.LiteralValidation{},
+		CanonicalizeLiterals: &rdfioutil.LiteralCanonicalization{},
+		ValidateIRIs:         &rdfioutil.IRIValidation{},
+		NormalizeIRIs:        &rdfioutil.IRINormalization{},
+		Skolemize:            &rdfioutil.Skolemization{},
+		Deskolemize:          &rdfioutil.Deskolemization{},
+	}
+}
+
+func (f *decoderParams) NewParamsCollection() rdfiotypes.ParamsCollection {
+	c := rdfiotypes.ParamsCollection{
+		"captureTextOffsets": kvref.BoolPtr(&f.CaptureTextOffsets, rdfiotypes.ParamMeta{
+			Usage: "Capture the line+column offsets for statement properties",
+		}),
+		"mode": kvref.StringPtr(&f.Mode, rdfiotypes.ParamMeta{
+			Usage:      "Describe the table group, tables, and rows, or only the values of cells (default standard)",
+			ValueEnums: []string{"standard", "minimal"},
+		}),
+		"delimiter": kvref.StringPtr(&f.Delimiter, rdfiotypes.ParamMeta{
+			Usage: "Separator of cells when metadata does not describe a dialect (default tab for .tsv files, otherwise comma)",
+		}),
+		"metadata": kvref.StringPtr(&f.Metadata, rdfiotypes.ParamMeta{
+			Usage: "File path or URL of the metadata describing the tabular data",
+		}),
+		"metadataDiscovery": kvref.BoolPtr(&f.MetadataDiscovery, rdfiotypes.ParamMeta{
+			Usage: "Locate metadata next to the tabular data, such as data.csv-metadata.json (default true)",
+		}),
+	}
+
+	maps.Copy(c, f.DocumentLoader.NewParamsCollection("documentLoader"))
+	maps.Copy(c, f.ValidateLiterals.NewParamsCollection("validateLiterals"))
+	maps.Copy(c, f.CanonicalizeLiterals.NewParamsCollection("canonicalizeLiterals"))
+	maps.Copy(c, f.ValidateIRIs.NewParamsCollection("validateIRIs"))
+	maps.Copy(c, f.NormalizeIRIs.NewParamsCollection("normalizeIRIs"))
+	maps.Copy(c, f.Skolemize.NewParamsCollection("skolemize"))
+	maps.Copy(c, f.Deskolemize.NewParamsCollection("deskolemize"))
+
+	return c
+}
+
+func (f *decoderParams) ApplyDefaults() {
+	f.DocumentLoader.ApplyDefaults()
+	f.ValidateLiterals.ApplyDefaults()
+	f.CanonicalizeLiterals.ApplyDefaults()
+	f.ValidateIRIs.ApplyDefaults()
+	f.NormalizeIRIs.ApplyDefaults()
+	f.Skolemize.ApplyDefaults()
+	f.Deskolemize.ApplyDefaults()
+}
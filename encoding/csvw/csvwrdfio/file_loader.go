@@ -0,0 +1,132 @@
+package csvwrdfio
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/csvw"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+)
+
+// fileDocumentLoader loads metadata documents with a file URL from the local file system. Unless allowed, which is
+// only the case for tabular data from the local file system, file URLs are refused by policy so that metadata of
+// remote data cannot refer to local files.
+type fileDocumentLoader struct {
+	allowed bool
+}
+
+var _ jsonldtype.DocumentLoader = fileDocumentLoader{}
+
+func (f fileDocumentLoader) LoadDocument(ctx context.Context, u string, opts jsonldtype.DocumentLoaderOptions) (jsonldtype.RemoteDocument, error) {
+	documentURL, err := url.Parse(u)
+	if err != nil {
+		return jsonldtype.RemoteDocument{}, jsonldtype.Error{
+			Code: jsonldtype.LoadingDocumentFailed,
+			Err:  fmt.Errorf("parse url: %v", err),
+		}
+	} else if documentURL.Scheme != "file" {
+		return jsonldtype.RemoteDocument{}, jsonldtype.Error{
+			Code: jsonldtype.LoadingDocumentFailed,
+			Err:  fmt.Errorf("unsupported scheme: %s", documentURL.Scheme),
+		}
+	} else if !f.allowed {
+		return jsonldtype.RemoteDocument{}, jsonldtype.RemoteDocumentPolicyError{
+			URL:    u,
+			Reason: jsonldtype.RemoteDocumentPolicyReasonSchemeNotAllowed,
+		}
+	}
+
+	documentURL.Fragment = ""
+
+	fh, err := os.Open(documentURL.Path)
+	if err != nil {
+		return jsonldtype.RemoteDocument{}, jsonldtype.Error{
+			Code: jsonldtype.LoadingDocumentFailed,
+			Err:  fmt.Errorf("open: %w", err),
+		}
+	}
+
+	defer fh.Close()
+
+	parsed, err := inspectjson.Parse(fh)
+	if err != nil {
+		return jsonldtype.RemoteDocument{}, jsonldtype.Error{
+			Code: jsonldtype.LoadingDocumentFailed,
+			Err:  fmt.Errorf("parse (%s): %v", documentURL.Path, err),
+		}
+	}
+
+	return jsonldtype.RemoteDocument{
+		ContentType: "application/csvm+json",
+		Document:    parsed,
+		DocumentURL: documentURL,
+	}, nil
+}
+
+// newTableLoader loads other tables of a table group from the local file system, if allowed, or the network.
+func newTableLoader(client *http.Client, allowFiles bool) csvw.TableLoader {
+	return csvw.TableLoaderFunc(func(ctx context.Context, u string) (io.ReadCloser, error) {
+		tableURL, err := url.Parse(u)
+		if err != nil {
+			return nil, fmt.Errorf("parse url: %v", err)
+		}
+
+		switch tableURL.Scheme {
+		case "file":
+			if !allowFiles {
+				return nil, jsonldtype.RemoteDocumentPolicyError{
+					URL:    u,
+					Reason: jsonldtype.RemoteDocumentPolicyReasonSchemeNotAllowed,
+				}
+			}
+
+			return os.Open(tableURL.Path)
+		case "http", "https":
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Accept", "text/csv, text/tab-separated-values;q=0.9, */*;q=0.1")
+
+			resp, err := client.Do(req)
+			if err != nil {
+				return nil, err
+			} else if resp.StatusCode != http.StatusOK {
+				resp.Body.Close()
+
+				return nil, fmt.Errorf("unexpected status: %s", resp.Status)
+			}
+
+			return resp.Body, nil
+		}
+
+		return nil, fmt.Errorf("unsupported scheme: %s", tableURL.Scheme)
+	})
+}
+
+// resolveFileURL converts the relative file paths of file resources, such as file://data.csv, to an absolute URL so
+// that metadata and other tables may be resolved relative to it.
+func resolveFileURL(v string) (string, error) {
+	fp, ok := strings.CutPrefix(v, "file://")
+	if !ok || strings.HasPrefix(fp, "/") {
+		return v, nil
+	}
+
+	fp, err := filepath.Abs(fp)
+	if err != nil {
+		return "", err
+	}
+
+	return (&url.URL{
+		Scheme: "file",
+		Path:   filepath.ToSlash(fp),
+	}).String(), nil
+}
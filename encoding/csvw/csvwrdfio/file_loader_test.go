@@ -0,0 +1,120 @@
+package csvwrdfio
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+)
+
+type testingRoundTripper func(req *http.Request) (*http.Response, error)
+
+func (f testingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestFileDocumentLoader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "csv-metadata.json")
+
+	err := os.WriteFile(path, []byte(`{"url": "data.csv"}`), 0o600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	t.Run("Allowed", func(t *testing.T) {
+		remoteDocument, err := fileDocumentLoader{allowed: true}.LoadDocument(context.Background(), "file://"+path, jsonldtype.DocumentLoaderOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _a, _e := remoteDocument.DocumentURL.Path, path; _a != _e {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		}
+	})
+
+	t.Run("NotAllowed", func(t *testing.T) {
+		_, err := fileDocumentLoader{}.LoadDocument(context.Background(), "file://"+path, jsonldtype.DocumentLoaderOptions{})
+
+		var policyErr jsonldtype.RemoteDocumentPolicyError
+
+		if !errors.As(err, &policyErr) {
+			t.Fatalf("expected policy error, got %v", err)
+		} else if _a, _e := policyErr.Reason, jsonldtype.RemoteDocumentPolicyReasonSchemeNotAllowed; _a != _e {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		}
+	})
+}
+
+func TestTableLoader(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data.csv")
+
+	err := os.WriteFile(path, []byte("id\n1\n"), 0o600)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var requested []string
+
+	client := &http.Client{
+		Transport: testingRoundTripper(func(req *http.Request) (*http.Response, error) {
+			requested = append(requested, req.URL.String())
+
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Body:       io.NopCloser(strings.NewReader("id\n2\n")),
+				Request:    req,
+			}, nil
+		}),
+	}
+
+	t.Run("File", func(t *testing.T) {
+		rc, err := newTableLoader(client, true).LoadTable(context.Background(), "file://"+path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		defer rc.Close()
+
+		buf, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _a, _e := string(buf), "id\n1\n"; _a != _e {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		}
+	})
+
+	t.Run("FileNotAllowed", func(t *testing.T) {
+		_, err := newTableLoader(client, false).LoadTable(context.Background(), "file://"+path)
+
+		var policyErr jsonldtype.RemoteDocumentPolicyError
+
+		if !errors.As(err, &policyErr) {
+			t.Fatalf("expected policy error, got %v", err)
+		} else if _a, _e := policyErr.Reason, jsonldtype.RemoteDocumentPolicyReasonSchemeNotAllowed; _a != _e {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		}
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		rc, err := newTableLoader(client, false).LoadTable(context.Background(), "https://example.com/data.csv")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		defer rc.Close()
+
+		buf, err := io.ReadAll(rc)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _a, _e := string(buf), "id\n2\n"; _a != _e {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		} else if _a, _e := requested, []string{"https://example.com/data.csv"}; len(_a) != 1 || _a[0] != _e[0] {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		}
+	})
+}
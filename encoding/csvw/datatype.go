@@ -0,0 +1,501 @@
+package csvw
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/ontology/csvw/csvwiri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+type datatypeFamily int
+
+const (
+	datatypeFamilyOther datatypeFamily = iota
+	datatypeFamilyString
+	datatypeFamilyNumeric
+	datatypeFamilyBoolean
+	datatypeFamilyDate
+	datatypeFamilyDateTime
+	datatypeFamilyTime
+)
+
+type builtinDatatype struct {
+	iri    rdf.IRI
+	family datatypeFamily
+
+	// raw values are never normalized for whitespace
+	raw bool
+}
+
+// [spec // 5.11.1] The built-in datatypes of the metadata vocabulary along with their aliases.
+var builtinDatatypes = map[string]builtinDatatype{
+	"any":                {iri: xsdiri.AnyAtomicType_Datatype, raw: true},
+	"anyAtomicType":      {iri: xsdiri.AnyAtomicType_Datatype, raw: true},
+	"anyURI":             {iri: xsdiri.AnyURI_Datatype},
+	"base64Binary":       {iri: xsdiri.Base64Binary_Datatype},
+	"binary":             {iri: xsdiri.Base64Binary_Datatype},
+	"boolean":            {iri: xsdiri.Boolean_Datatype, family: datatypeFamilyBoolean},
+	"byte":               {iri: xsdiri.Byte_Datatype, family: datatypeFamilyNumeric},
+	"date":               {iri: xsdiri.Date_Datatype, family: datatypeFamilyDate},
+	"datetime":           {iri: xsdiri.DateTime_Datatype, family: datatypeFamilyDateTime},
+	"dateTime":           {iri: xsdiri.DateTime_Datatype, family: datatypeFamilyDateTime},
+	"dateTimeStamp":      {iri: xsdiri.DateTimeStamp_Datatype, family: datatypeFamilyDateTime},
+	"dayTimeDuration":    {iri: xsdiri.DayTimeDuration_Datatype},
+	"decimal":            {iri: xsdiri.Decimal_Datatype, family: datatypeFamilyNumeric},
+	"double":             {iri: xsdiri.Double_Datatype, family: datatypeFamilyNumeric},
+	"duration":           {iri: xsdiri.Duration_Datatype},
+	"float":              {iri: xsdiri.Float_Datatype, family: datatypeFamilyNumeric},
+	"gDay":               {iri: xsdiri.GDay_Datatype},
+	"gMonth":             {iri: xsdiri.GMonth_Datatype},
+	"gMonthDay":          {iri: xsdiri.GMonthDay_Datatype},
+	"gYear":              {iri: xsdiri.GYear_Datatype},
+	"gYearMonth":         {iri: xsdiri.GYearMonth_Datatype},
+	"hexBinary":          {iri: xsdiri.HexBinary_Datatype},
+	"html":               {iri: rdfiri.HTML_Datatype, raw: true},
+	"int":                {iri: xsdiri.Int_Datatype, family: datatypeFamilyNumeric},
+	"integer":            {iri: xsdiri.Integer_Datatype, family: datatypeFamilyNumeric},
+	"json":               {iri: csvwiri.JSON_Datatype, raw: true},
+	"language":           {iri: xsdiri.Language_Datatype, family: datatypeFamilyString},
+	"long":               {iri: xsdiri.Long_Datatype, family: datatypeFamilyNumeric},
+	"Name":               {iri: xsdiri.Name_Datatype, family: datatypeFamilyString},
+	"NMTOKEN":            {iri: xsdiri.NMTOKEN_Datatype, family: datatypeFamilyString},
+	"negativeInteger":    {iri: xsdiri.NegativeInteger_Datatype, family: datatypeFamilyNumeric},
+	"nonNegativeInteger": {iri: xsdiri.NonNegativeInteger_Datatype, family: datatypeFamilyNumeric},
+	"nonPositiveInteger": {iri: xsdiri.NonPositiveInteger_Datatype, family: datatypeFamilyNumeric},
+	"normalizedString":   {iri: xsdiri.NormalizedString_Datatype, family: datatypeFamilyString},
+	"number":             {iri: xsdiri.Double_Datatype, family: datatypeFamilyNumeric},
+	"positiveInteger":    {iri: xsdiri.PositiveInteger_Datatype, family: datatypeFamilyNumeric},
+	"QName":              {iri: xsdiri.QName_Datatype},
+	"short":              {iri: xsdiri.Short_Datatype, family: datatypeFamilyNumeric},
+	"string":             {iri: xsdiri.String_Datatype, family: datatypeFamilyString, raw: true},
+	"time":               {iri: xsdiri.Time_Datatype, family: datatypeFamilyTime},
+	"token":              {iri: xsdiri.Token_Datatype, family: datatypeFamilyString},
+	"unsignedByte":       {iri: xsdiri.UnsignedByte_Datatype, family: datatypeFamilyNumeric},
+	"unsignedInt":        {iri: xsdiri.UnsignedInt_Datatype, family: datatypeFamilyNumeric},
+	"unsignedLong":       {iri: xsdiri.UnsignedLong_Datatype, family: datatypeFamilyNumeric},
+	"unsignedShort":      {iri: xsdiri.UnsignedShort_Datatype, family: datatypeFamilyNumeric},
+	"xml":                {iri: rdfiri.XMLLiteral_Datatype, raw: true},
+	"yearMonthDuration":  {iri: xsdiri.YearMonthDuration_Datatype},
+}
+
+type datatype struct {
+	base builtinDatatype
+	iri  rdf.IRI
+
+	format datatypeFormat
+
+	length    *int
+	minLength *int
+	maxLength *int
+
+	minInclusive *big.Rat
+	maxInclusive *big.Rat
+	minExclusive *big.Rat
+	maxExclusive *big.Rat
+}
+
+var stringDatatype = &datatype{
+	base: builtinDatatypes["string"],
+	iri:  xsdiri.String_Datatype,
+}
+
+func (mp *metadataParser) parseDatatype(v inspectjson.Value) (*datatype, error) {
+	switch vT := v.(type) {
+	case inspectjson.StringValue:
+		base, ok := builtinDatatypes[vT.Value]
+		if !ok {
+			return nil, fmt.Errorf("unsupported datatype: %s", vT.Value)
+		}
+
+		return &datatype{
+			base: base,
+			iri:  base.iri,
+		}, nil
+	case inspectjson.ObjectValue:
+		// handled below
+	default:
+		return nil, fmt.Errorf("expected string or object")
+	}
+
+	object := v.(inspectjson.ObjectValue)
+
+	dt := &datatype{
+		base: builtinDatatypes["string"],
+	}
+
+	if baseValue, ok := object.Members["base"]; ok {
+		baseString, ok := baseValue.Value.(inspectjson.StringValue)
+		if !ok {
+			return nil, fmt.Errorf("base: expected string")
+		}
+
+		dt.base, ok = builtinDatatypes[baseString.Value]
+		if !ok {
+			return nil, fmt.Errorf("base: unsupported datatype: %s", baseString.Value)
+		}
+	}
+
+	dt.iri = dt.base.iri
+
+	for _, key := range sortedMemberNames(object) {
+		value := object.Members[key].Value
+
+		var err error
+
+		switch key {
+		case "base", "@type":
+			// handled above, or ignored
+		case "@id":
+			var id string
+
+			id, err = parseDialectString(value)
+			if err == nil {
+				var expanded string
+
+				expanded, err = mp.expandIRI(id)
+				if err == nil {
+					dt.iri = rdf.IRI(expanded)
+				}
+			}
+		case "format":
+			dt.format, err = parseDatatypeFormat(dt.base, value)
+		case "length":
+			dt.length, err = parseNonNegativeIntegerPtr(value)
+		case "minLength":
+			dt.minLength, err = parseNonNegativeIntegerPtr(value)
+		case "maxLength":
+			dt.maxLength, err = parseNonNegativeIntegerPtr(value)
+		case "minimum", "minInclusive":
+			dt.minInclusive, err = parseDatatypeBound(value)
+		case "maximum", "maxInclusive":
+			dt.maxInclusive, err = parseDatatypeBound(value)
+		case "minExclusive":
+			dt.minExclusive, err = parseDatatypeBound(value)
+		case "maxExclusive":
+			dt.maxExclusive, err = parseDatatypeBound(value)
+		default:
+			if !isCommonPropertyName(key) {
+				err = fmt.Errorf("unsupported property")
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	return dt, nil
+}
+
+// parseDatatypeBound only supports numeric bounds; any others are ignored.
+func parseDatatypeBound(v inspectjson.Value) (*big.Rat, error) {
+	var s string
+
+	switch vT := v.(type) {
+	case inspectjson.NumberValue:
+		s = fmt.Sprint(vT.Value)
+	case inspectjson.StringValue:
+		s = vT.Value
+	default:
+		return nil, fmt.Errorf("expected number or string")
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, nil
+	}
+
+	return r, nil
+}
+
+// normalize applies the whitespace normalization of [spec // 6.4.1] and [spec // 6.4.2].
+func (dt *datatype) normalize(v string) string {
+	if dt.base.raw {
+		return v
+	}
+
+	v = strings.Map(
+		func(r rune) rune {
+			switch r {
+			case '\r', '\n', '\t':
+				return ' '
+			}
+
+			return r
+		},
+		v,
+	)
+
+	if dt.base.iri == xsdiri.NormalizedString_Datatype {
+		return v
+	}
+
+	return strings.Join(strings.Fields(v), " ")
+}
+
+// parse returns the literal of a non-null value along with whether it is valid for the datatype. Invalid values are
+// returned as xsd:string literals.
+func (dt *datatype) parse(v string, lang string) (rdf.Literal, bool) {
+	var format datatypeFormat = datatypeFormatNone{}
+
+	if dt.format != nil {
+		format = dt.format
+	}
+
+	lexicalForm, ok := format.parse(dt.base, v)
+	if ok {
+		ok = dt.validate(lexicalForm)
+	}
+
+	if !ok {
+		return rdf.Literal{
+			Datatype:    xsdiri.String_Datatype,
+			LexicalForm: v,
+		}, false
+	}
+
+	if dt.iri == xsdiri.String_Datatype && len(lang) > 0 && lang != "und" {
+		return rdf.Literal{
+			Datatype:    rdfiri.LangString_Datatype,
+			LexicalForm: lexicalForm,
+			Tag: rdf.LanguageLiteralTag{
+				Language: lang,
+			},
+		}, true
+	}
+
+	return rdf.Literal{
+		Datatype:    dt.iri,
+		LexicalForm: lexicalForm,
+	}, true
+}
+
+func (dt *datatype) validate(lexicalForm string) bool {
+	if encodingutil.ValidateLiteral(rdf.Literal{Datatype: dt.base.iri, LexicalForm: lexicalForm}) != nil {
+		return false
+	}
+
+	if dt.length != nil || dt.minLength != nil || dt.maxLength != nil {
+		length := utf8.RuneCountInString(lexicalForm)
+
+		if dt.length != nil && length != *dt.length {
+			return false
+		} else if dt.minLength != nil && length < *dt.minLength {
+			return false
+		} else if dt.maxLength != nil && length > *dt.maxLength {
+			return false
+		}
+	}
+
+	if dt.minInclusive == nil && dt.maxInclusive == nil && dt.minExclusive == nil && dt.maxExclusive == nil {
+		return true
+	} else if dt.base.family != datatypeFamilyNumeric {
+		return true
+	}
+
+	r, ok := new(big.Rat).SetString(lexicalForm)
+	if !ok {
+		// special values, such as INF, are not compared
+		return true
+	}
+
+	if dt.minInclusive != nil && r.Cmp(dt.minInclusive) < 0 {
+		return false
+	} else if dt.maxInclusive != nil && r.Cmp(dt.maxInclusive) > 0 {
+		return false
+	} else if dt.minExclusive != nil && r.Cmp(dt.minExclusive) <= 0 {
+		return false
+	} else if dt.maxExclusive != nil && r.Cmp(dt.maxExclusive) >= 0 {
+		return false
+	}
+
+	return true
+}
+
+//
+
+type datatypeFormat interface {
+	parse(base builtinDatatype, v string) (string, bool)
+}
+
+type datatypeFormatNone struct{}
+
+func (datatypeFormatNone) parse(base builtinDatatype, v string) (string, bool) {
+	if base.family == datatypeFamilyBoolean {
+		switch v {
+		case "true", "1":
+			return "true", true
+		case "false", "0":
+			return "false", true
+		}
+
+		return v, false
+	}
+
+	return v, true
+}
+
+type datatypeFormatRegexp struct {
+	re *regexp.Regexp
+}
+
+func (f datatypeFormatRegexp) parse(base builtinDatatype, v string) (string, bool) {
+	return v, f.re.MatchString(v)
+}
+
+type datatypeFormatBoolean struct {
+	trueValue  string
+	falseValue string
+}
+
+func (f datatypeFormatBoolean) parse(base builtinDatatype, v string) (string, bool) {
+	switch v {
+	case f.trueValue:
+		return "true", true
+	case f.falseValue:
+		return "false", true
+	}
+
+	return v, false
+}
+
+type datatypeFormatNumeric struct {
+	decimalChar string
+	groupChar   string
+}
+
+// parse supports the decimal and group characters along with percent and per-mille suffixes. The pattern of the
+// number format is not currently enforced.
+func (f datatypeFormatNumeric) parse(base builtinDatatype, v string) (string, bool) {
+	switch v {
+	case "NaN", "INF", "-INF":
+		return v, true
+	}
+
+	if len(f.groupChar) > 0 {
+		v = strings.ReplaceAll(v, f.groupChar, "")
+	}
+
+	if f.decimalChar != "." {
+		if strings.Contains(v, ".") {
+			return v, false
+		}
+
+		v = strings.ReplaceAll(v, f.decimalChar, ".")
+	}
+
+	var divisor int64
+
+	if trimmed, ok := strings.CutSuffix(v, "%"); ok {
+		v, divisor = trimmed, 100
+	} else if trimmed, ok := strings.CutSuffix(v, "‰"); ok {
+		v, divisor = trimmed, 1000
+	}
+
+	if divisor == 0 {
+		return v, true
+	}
+
+	r, ok := new(big.Rat).SetString(v)
+	if !ok {
+		return v, false
+	}
+
+	r.Quo(r, new(big.Rat).SetInt64(divisor))
+
+	if r.IsInt() {
+		return r.Num().String(), true
+	}
+
+	return r.FloatString(decimalPlaces(r)), true
+}
+
+// decimalPlaces returns the number of decimal places for an exact representation, if one exists within a reasonable
+// precision.
+func decimalPlaces(r *big.Rat) int {
+	for places := 1; places < 32; places++ {
+		scaled := new(big.Rat).Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)))
+		if scaled.IsInt() {
+			return places
+		}
+	}
+
+	return 32
+}
+
+func parseDatatypeFormat(base builtinDatatype, v inspectjson.Value) (datatypeFormat, error) {
+	switch base.family {
+	case datatypeFamilyNumeric:
+		f := datatypeFormatNumeric{
+			decimalChar: ".",
+		}
+
+		switch vT := v.(type) {
+		case inspectjson.StringValue:
+			// pattern only
+		case inspectjson.ObjectValue:
+			for _, key := range sortedMemberNames(vT) {
+				value, err := parseDialectString(vT.Members[key].Value)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %v", key, err)
+				}
+
+				switch key {
+				case "decimalChar":
+					f.decimalChar = value
+				case "groupChar":
+					f.groupChar = value
+				case "pattern":
+					// not enforced
+				default:
+					return nil, fmt.Errorf("%s: unsupported property", key)
+				}
+			}
+
+			if len(f.decimalChar) == 0 {
+				return nil, fmt.Errorf("decimalChar: expected non-empty string")
+			}
+		default:
+			return nil, fmt.Errorf("expected string or object")
+		}
+
+		return f, nil
+	}
+
+	s, err := parseDialectString(v)
+	if err != nil {
+		return nil, err
+	}
+
+	switch base.family {
+	case datatypeFamilyBoolean:
+		trueValue, falseValue, ok := strings.Cut(s, "|")
+		if !ok {
+			return nil, fmt.Errorf("expected true and false values separated by |")
+		}
+
+		return datatypeFormatBoolean{
+			trueValue:  trueValue,
+			falseValue: falseValue,
+		}, nil
+	case datatypeFamilyDate, datatypeFamilyDateTime, datatypeFamilyTime:
+		return parseDatatypeFormatDateTime(base.family, s)
+	}
+
+	re, err := regexp.Compile(`^(?:` + s + `)$`)
+	if err != nil {
+		return nil, fmt.Errorf("parse regexp: %v", err)
+	}
+
+	return datatypeFormatRegexp{
+		re: re,
+	}, nil
+}
@@ -0,0 +1,201 @@
+package csvw
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type datatypeFormatDateTimeField int
+
+const (
+	datatypeFormatDateTimeFieldYear datatypeFormatDateTimeField = iota
+	datatypeFormatDateTimeFieldMonth
+	datatypeFormatDateTimeFieldDay
+	datatypeFormatDateTimeFieldHour
+	datatypeFormatDateTimeFieldMinute
+	datatypeFormatDateTimeFieldSecond
+	datatypeFormatDateTimeFieldFraction
+	datatypeFormatDateTimeFieldZone
+)
+
+// datatypeFormatDateTime supports the subset of date and time patterns of [UAX35] which are listed by the metadata
+// vocabulary, such as dd.MM.yyyy or yyyy-MM-ddTHH:mm:ssXXX. Parsed values are converted to the XSD lexical form.
+//
+// [UAX35]: https://www.unicode.org/reports/tr35/tr35-dates.html#Date_Format_Patterns
+type datatypeFormatDateTime struct {
+	family datatypeFamily
+	re     *regexp.Regexp
+	fields []datatypeFormatDateTimeField
+}
+
+func parseDatatypeFormatDateTime(family datatypeFamily, pattern string) (datatypeFormatDateTime, error) {
+	f := datatypeFormatDateTime{
+		family: family,
+	}
+
+	var expr strings.Builder
+
+	expr.WriteString("^")
+
+	seen := map[datatypeFormatDateTimeField]bool{}
+
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+
+		count := 1
+		for i+count < len(pattern) && pattern[i+count] == c {
+			count++
+		}
+
+		var field datatypeFormatDateTimeField
+		var fieldExpr string
+
+		switch {
+		case c == 'y' && count == 4:
+			field, fieldExpr = datatypeFormatDateTimeFieldYear, `(\d{4})`
+		case c == 'M' && count <= 2:
+			field, fieldExpr = datatypeFormatDateTimeFieldMonth, fmt.Sprintf(`(\d{%d,2})`, count)
+		case c == 'd' && count <= 2:
+			field, fieldExpr = datatypeFormatDateTimeFieldDay, fmt.Sprintf(`(\d{%d,2})`, count)
+		case c == 'H' && count == 2:
+			field, fieldExpr = datatypeFormatDateTimeFieldHour, `(\d{2})`
+		case c == 'm' && count == 2:
+			field, fieldExpr = datatypeFormatDateTimeFieldMinute, `(\d{2})`
+		case c == 's' && count == 2:
+			field, fieldExpr = datatypeFormatDateTimeFieldSecond, `(\d{2})`
+		case c == 'S':
+			field, fieldExpr = datatypeFormatDateTimeFieldFraction, fmt.Sprintf(`(\d{1,%d})`, count)
+		case c == 'X' && count <= 3:
+			field, fieldExpr = datatypeFormatDateTimeFieldZone, []string{
+				`(Z|[+-]\d{2}(?:\d{2})?)`,
+				`(Z|[+-]\d{4})`,
+				`(Z|[+-]\d{2}:\d{2})`,
+			}[count-1]
+		case c == 'x' && count <= 3:
+			field, fieldExpr = datatypeFormatDateTimeFieldZone, []string{
+				`([+-]\d{2}(?:\d{2})?)`,
+				`([+-]\d{4})`,
+				`([+-]\d{2}:\d{2})`,
+			}[count-1]
+		case c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+			if c != 'T' || count != 1 {
+				return f, fmt.Errorf("unsupported pattern: %s", pattern[i:i+count])
+			}
+
+			expr.WriteString("T")
+			i++
+
+			continue
+		default:
+			expr.WriteString(regexp.QuoteMeta(pattern[i : i+count]))
+			i += count
+
+			continue
+		}
+
+		if seen[field] {
+			return f, fmt.Errorf("unsupported pattern: repeated field: %s", pattern[i:i+count])
+		}
+
+		seen[field] = true
+
+		if field == datatypeFormatDateTimeFieldFraction {
+			// fractional seconds immediately follow the separator of seconds
+			exprString := expr.String()
+			if !strings.HasSuffix(exprString, `\.`) {
+				return f, fmt.Errorf("unsupported pattern: expected fractional seconds after a period")
+			}
+		}
+
+		expr.WriteString(fieldExpr)
+		f.fields = append(f.fields, field)
+
+		i += count
+	}
+
+	expr.WriteString("$")
+
+	hasDate := seen[datatypeFormatDateTimeFieldYear] && seen[datatypeFormatDateTimeFieldMonth] && seen[datatypeFormatDateTimeFieldDay]
+	hasTime := seen[datatypeFormatDateTimeFieldHour] && seen[datatypeFormatDateTimeFieldMinute]
+
+	switch family {
+	case datatypeFamilyDate:
+		if !hasDate || hasTime {
+			return f, fmt.Errorf("unsupported pattern: expected date fields: %s", pattern)
+		}
+	case datatypeFamilyTime:
+		if hasDate || !hasTime {
+			return f, fmt.Errorf("unsupported pattern: expected time fields: %s", pattern)
+		}
+	case datatypeFamilyDateTime:
+		if !hasDate || !hasTime {
+			return f, fmt.Errorf("unsupported pattern: expected date and time fields: %s", pattern)
+		}
+	}
+
+	re, err := regexp.Compile(expr.String())
+	if err != nil {
+		return f, fmt.Errorf("unsupported pattern: %s", pattern)
+	}
+
+	f.re = re
+
+	return f, nil
+}
+
+func (f datatypeFormatDateTime) parse(base builtinDatatype, v string) (string, bool) {
+	match := f.re.FindStringSubmatch(v)
+	if match == nil {
+		return v, false
+	}
+
+	values := map[datatypeFormatDateTimeField]string{}
+
+	for fieldIdx, field := range f.fields {
+		values[field] = match[fieldIdx+1]
+	}
+
+	var sb strings.Builder
+
+	if f.family != datatypeFamilyTime {
+		month, _ := strconv.Atoi(values[datatypeFormatDateTimeFieldMonth])
+		day, _ := strconv.Atoi(values[datatypeFormatDateTimeFieldDay])
+
+		fmt.Fprintf(&sb, "%s-%02d-%02d", values[datatypeFormatDateTimeFieldYear], month, day)
+
+		if f.family == datatypeFamilyDateTime {
+			sb.WriteString("T")
+		}
+	}
+
+	if f.family != datatypeFamilyDate {
+		second := values[datatypeFormatDateTimeFieldSecond]
+		if len(second) == 0 {
+			second = "00"
+		}
+
+		fmt.Fprintf(&sb, "%s:%s:%s", values[datatypeFormatDateTimeFieldHour], values[datatypeFormatDateTimeFieldMinute], second)
+
+		if fraction := values[datatypeFormatDateTimeFieldFraction]; len(fraction) > 0 {
+			sb.WriteString(".")
+			sb.WriteString(fraction)
+		}
+	}
+
+	switch zone := values[datatypeFormatDateTimeFieldZone]; {
+	case len(zone) == 0:
+		// none
+	case zone == "Z":
+		sb.WriteString("Z")
+	case len(zone) == 3:
+		sb.WriteString(zone + ":00")
+	case len(zone) == 5:
+		sb.WriteString(zone[0:3] + ":" + zone[3:5])
+	default:
+		sb.WriteString(zone)
+	}
+
+	return sb.String(), true
+}
@@ -0,0 +1,352 @@
+package csvw
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/csvw/csvwcontent"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+type DecoderOption interface {
+	apply(s *DecoderConfig)
+	newDecoder(r io.Reader) (*Decoder, error)
+}
+
+type statement struct {
+	quad        rdf.Quad
+	textOffsets encoding.StatementTextOffsets
+}
+
+// Decoder converts tabular data to RDF according to its CSVW metadata. Without metadata, the embedded metadata of the
+// header row is used, where each row describes a blank node with a property for each column.
+//
+// Text offsets are only captured for the tabular data read by the decoder, not any other tables of a table group.
+// Rows are read and converted as statements are requested.
+type Decoder struct {
+	r io.Reader
+
+	defaultBase       string
+	mode              Mode
+	dialect           Dialect
+	metadataDocument  *jsonldtype.RemoteDocument
+	metadataDiscovery bool
+	documentLoader    jsonldtype.DocumentLoader
+	tableLoader       TableLoader
+
+	initialTextOffset *cursorio.TextOffset
+	buildTextOffsets  encodingutil.TextOffsetsBuilderFunc
+	bnStringFactory   blanknodes.StringFactory
+
+	err error
+
+	csv2rdf      *csv2rdf
+	primaryTable *metadataTable
+	tables       []*metadataTable
+	table        *csv2rdfTable
+	tableCloser  io.Closer
+
+	statements    []statement
+	statementsIdx int
+}
+
+var _ encoding.QuadsDecoder = &Decoder{}
+var _ encoding.StatementTextOffsetsProvider = &Decoder{}
+
+func NewDecoder(r io.Reader, opts ...DecoderOption) (*Decoder, error) {
+	compiledOpts := DecoderConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&compiledOpts)
+	}
+
+	return compiledOpts.newDecoder(r)
+}
+
+func (d *Decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return csvwcontent.TypeIdentifier
+}
+
+func (d *Decoder) Close() error {
+	return d.closeTable()
+}
+
+func (d *Decoder) Err() error {
+	return d.err
+}
+
+func (d *Decoder) Next() bool {
+	if d.err != nil {
+		return false
+	} else if d.statementsIdx == -1 {
+		d.err = d.parseRoot()
+		if d.err != nil {
+			return false
+		}
+	}
+
+	d.statementsIdx++
+
+	for d.statementsIdx >= len(d.statements) && (d.table != nil || len(d.tables) > 0) {
+		d.statements = d.statements[:0]
+		d.statementsIdx = 0
+
+		d.err = d.decodeNext(context.Background())
+		if d.err != nil {
+			return false
+		}
+	}
+
+	return d.statementsIdx < len(d.statements)
+}
+
+func (d *Decoder) Quad() rdf.Quad {
+	return d.statements[d.statementsIdx].quad
+}
+
+func (d *Decoder) Statement() rdf.Statement {
+	return d.Quad()
+}
+
+func (d *Decoder) StatementTextOffsets() encoding.StatementTextOffsets {
+	return d.statements[d.statementsIdx].textOffsets
+}
+
+func (d *Decoder) parseRoot() error {
+	ctx := context.Background()
+
+	tg, err := d.loadMetadata(ctx)
+	if err != nil {
+		return err
+	} else if tg == nil {
+		tg = &metadataTableGroup{
+			tables: []*metadataTable{
+				{
+					url: d.defaultBase,
+				},
+			},
+		}
+	}
+
+	d.primaryTable, err = d.findPrimaryTable(tg)
+	if err != nil {
+		return err
+	}
+
+	d.csv2rdf = &csv2rdf{
+		d:  d,
+		tg: tg,
+	}
+
+	err = d.csv2rdf.emitTableGroup()
+	if err != nil {
+		return err
+	}
+
+	d.tables = tg.tables
+
+	return nil
+}
+
+// decodeNext opens the next table or emits the next row of the current one.
+func (d *Decoder) decodeNext(ctx context.Context) error {
+	if d.table != nil {
+		table := d.table.table
+
+		ok, err := d.csv2rdf.emitNextRow(d.table)
+		if err != nil {
+			return fmt.Errorf("table (%s): %v", table.url, err)
+		} else if !ok {
+			return d.closeTable()
+		}
+
+		return nil
+	}
+
+	table := d.tables[0]
+	d.tables = d.tables[1:]
+
+	var r io.Reader
+	var initialTextOffset *cursorio.TextOffset
+
+	if table == d.primaryTable {
+		r = d.r
+		initialTextOffset = d.initialTextOffset
+	} else {
+		rc, err := d.loadTable(ctx, table.url)
+		if err != nil {
+			return fmt.Errorf("table (%s): %v", table.url, err)
+		}
+
+		r = rc
+		d.tableCloser = rc
+	}
+
+	var err error
+
+	d.table, err = d.csv2rdf.openTable(table, r, initialTextOffset)
+	if err != nil {
+		d.closeTable()
+
+		return fmt.Errorf("table (%s): %v", table.url, err)
+	}
+
+	return nil
+}
+
+func (d *Decoder) closeTable() error {
+	d.table = nil
+
+	if d.tableCloser == nil {
+		return nil
+	}
+
+	err := d.tableCloser.Close()
+	d.tableCloser = nil
+
+	return err
+}
+
+// loadMetadata returns nil if no metadata was configured or located.
+func (d *Decoder) loadMetadata(ctx context.Context) (*metadataTableGroup, error) {
+	if d.metadataDocument != nil {
+		var documentURL string
+
+		if d.metadataDocument.DocumentURL != nil {
+			documentURL = d.metadataDocument.DocumentURL.String()
+		}
+
+		tg, err := d.parseMetadataDocument(ctx, documentURL, d.metadataDocument.Document)
+		if err != nil {
+			return nil, fmt.Errorf("metadata: %v", err)
+		}
+
+		return tg, nil
+	} else if !d.metadataDiscovery || d.documentLoader == nil || len(d.defaultBase) == 0 {
+		return nil, nil
+	}
+
+	tableURL := stripFragment(d.defaultBase)
+
+	// [spec // 5.3] The default locations of metadata are used since /.well-known/csvm is not requested.
+
+	for _, location := range defaultMetadataLocations {
+		documentURL, err := resolveReference(tableURL, location.Expand(uriTemplateVariables{"url": tableURL}))
+		if err != nil {
+			continue
+		}
+
+		remoteDocument, err := d.documentLoader.LoadDocument(ctx, documentURL, jsonldtype.DocumentLoaderOptions{})
+		if err != nil {
+			// missing metadata is expected
+			continue
+		}
+
+		if remoteDocument.DocumentURL != nil {
+			documentURL = remoteDocument.DocumentURL.String()
+		}
+
+		tg, err := d.parseMetadataDocument(ctx, documentURL, remoteDocument.Document)
+		if err != nil {
+			return nil, fmt.Errorf("metadata (%s): %v", documentURL, err)
+		}
+
+		// [spec // 5.1] Metadata which does not describe the table is ignored.
+
+		for _, table := range tg.tables {
+			if stripFragment(table.url) == tableURL {
+				return tg, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+var defaultMetadataLocations = []*uriTemplate{
+	mustParseURITemplate("{+url}-metadata.json"),
+	mustParseURITemplate("csv-metadata.json"),
+}
+
+func (d *Decoder) parseMetadataDocument(ctx context.Context, documentURL string, document inspectjson.Value) (*metadataTableGroup, error) {
+	object, ok := document.(inspectjson.ObjectValue)
+	if !ok {
+		return nil, fmt.Errorf("expected object")
+	}
+
+	mp, err := newMetadataParser(ctx, d.documentLoader, documentURL)
+	if err != nil {
+		return nil, err
+	}
+
+	tg, err := mp.parseMetadata(object)
+	if err != nil {
+		return nil, err
+	}
+
+	tg.parser = mp
+
+	return tg, nil
+}
+
+// findPrimaryTable returns the table of the data read by the decoder. A single table is assumed to describe the data
+// when the default base does not match it.
+func (d *Decoder) findPrimaryTable(tg *metadataTableGroup) (*metadataTable, error) {
+	if len(d.defaultBase) > 0 {
+		tableURL := stripFragment(d.defaultBase)
+
+		for _, table := range tg.tables {
+			if stripFragment(table.url) == tableURL {
+				return table, nil
+			}
+		}
+	}
+
+	if len(tg.tables) == 1 {
+		return tg.tables[0], nil
+	}
+
+	return nil, fmt.Errorf("metadata: no table matches the base: %s", d.defaultBase)
+}
+
+func (d *Decoder) loadTable(ctx context.Context, u string) (io.ReadCloser, error) {
+	if d.tableLoader == nil {
+		return nil, fmt.Errorf("load: no table loader")
+	}
+
+	rc, err := d.tableLoader.LoadTable(ctx, u)
+	if err != nil {
+		return nil, fmt.Errorf("load: %v", err)
+	}
+
+	return rc, nil
+}
+
+func resolveReference(base, ref string) (string, error) {
+	parsedBase, err := iri.ParseIRI(base)
+	if err != nil {
+		return "", err
+	}
+
+	resolved, err := parsedBase.Parse(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return resolved.String(), nil
+}
+
+func stripFragment(v string) string {
+	v, _, _ = strings.Cut(v, "#")
+
+	return v
+}
@@ -0,0 +1,202 @@
+package csvw
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+type Mode string
+
+const (
+	// ModeStandard describes the table group, tables, and rows in addition to the values of cells.
+	ModeStandard Mode = "standard"
+
+	// ModeMinimal only describes the values of cells.
+	ModeMinimal Mode = "minimal"
+)
+
+type DecoderConfig struct {
+	defaultBase       *string
+	mode              *Mode
+	dialect           *Dialect
+	metadataDocument  *jsonldtype.RemoteDocument
+	metadataDiscovery *bool
+	documentLoader    jsonldtype.DocumentLoader
+	tableLoader       TableLoader
+
+	captureTextOffsets *bool
+	initialTextOffset  *cursorio.TextOffset
+	bnStringFactory    blanknodes.StringFactory
+}
+
+// SetDefaultBase is the URL of the decoded tabular data. It is used for locating metadata and as the base of its
+// URI templates.
+func (b DecoderConfig) SetDefaultBase(v string) DecoderConfig {
+	b.defaultBase = &v
+
+	return b
+}
+
+func (b DecoderConfig) SetMode(v Mode) DecoderConfig {
+	b.mode = &v
+
+	return b
+}
+
+// SetDialect is used when metadata does not describe a dialect. By default, [DefaultDialect] is used.
+func (b DecoderConfig) SetDialect(v Dialect) DecoderConfig {
+	b.dialect = &v
+
+	return b
+}
+
+// SetMetadataDocument uses the metadata of a table group or table instead of locating it. The DocumentURL is used as
+// the base for any relative URLs of the metadata.
+func (b DecoderConfig) SetMetadataDocument(v jsonldtype.RemoteDocument) DecoderConfig {
+	b.metadataDocument = &v
+
+	return b
+}
+
+// SetMetadataDiscovery controls whether metadata is located from the default base with the document loader when no
+// metadata document is configured. It is enabled by default.
+func (b DecoderConfig) SetMetadataDiscovery(v bool) DecoderConfig {
+	b.metadataDiscovery = &v
+
+	return b
+}
+
+// SetDocumentLoader is used for locating metadata and loading any referenced schemas or dialects. Without a document
+// loader, only a configured metadata document or the embedded metadata of the tabular data is used.
+func (b DecoderConfig) SetDocumentLoader(v jsonldtype.DocumentLoader) DecoderConfig {
+	b.documentLoader = v
+
+	return b
+}
+
+func (b DecoderConfig) SetTableLoader(v TableLoader) DecoderConfig {
+	b.tableLoader = v
+
+	return b
+}
+
+func (b DecoderConfig) SetCaptureTextOffsets(v bool) DecoderConfig {
+	b.captureTextOffsets = &v
+
+	return b
+}
+
+func (b DecoderConfig) SetInitialTextOffset(v cursorio.TextOffset) DecoderConfig {
+	t := true
+
+	b.captureTextOffsets = &t
+	b.initialTextOffset = &v
+
+	return b
+}
+
+func (b DecoderConfig) SetBlankNodeStringFactory(v blanknodes.StringFactory) DecoderConfig {
+	b.bnStringFactory = v
+
+	return b
+}
+
+func (b DecoderConfig) apply(s *DecoderConfig) {
+	if b.defaultBase != nil {
+		s.defaultBase = b.defaultBase
+	}
+
+	if b.mode != nil {
+		s.mode = b.mode
+	}
+
+	if b.dialect != nil {
+		s.dialect = b.dialect
+	}
+
+	if b.metadataDocument != nil {
+		s.metadataDocument = b.metadataDocument
+	}
+
+	if b.metadataDiscovery != nil {
+		s.metadataDiscovery = b.metadataDiscovery
+	}
+
+	if b.documentLoader != nil {
+		s.documentLoader = b.documentLoader
+	}
+
+	if b.tableLoader != nil {
+		s.tableLoader = b.tableLoader
+	}
+
+	if b.captureTextOffsets != nil {
+		s.captureTextOffsets = b.captureTextOffsets
+	}
+
+	if b.initialTextOffset != nil {
+		s.initialTextOffset = b.initialTextOffset
+	}
+
+	if b.bnStringFactory != nil {
+		s.bnStringFactory = b.bnStringFactory
+	}
+}
+
+func (b DecoderConfig) newDecoder(r io.Reader) (*Decoder, error) {
+	d := &Decoder{
+		r:                 r,
+		mode:              ModeStandard,
+		dialect:           DefaultDialect,
+		metadataDocument:  b.metadataDocument,
+		metadataDiscovery: true,
+		documentLoader:    b.documentLoader,
+		tableLoader:       b.tableLoader,
+		statementsIdx:     -1,
+		buildTextOffsets:  encodingutil.BuildTextOffsetsNil,
+		bnStringFactory:   b.bnStringFactory,
+	}
+
+	if b.defaultBase != nil {
+		d.defaultBase = *b.defaultBase
+	}
+
+	if b.mode != nil {
+		switch *b.mode {
+		case ModeStandard, ModeMinimal:
+			d.mode = *b.mode
+		default:
+			return nil, fmt.Errorf("unsupported mode: %s", *b.mode)
+		}
+	}
+
+	if b.dialect != nil {
+		d.dialect = *b.dialect
+	}
+
+	if b.metadataDiscovery != nil {
+		d.metadataDiscovery = *b.metadataDiscovery
+	}
+
+	if b.captureTextOffsets != nil && *b.captureTextOffsets {
+		var initialTextOffset cursorio.TextOffset
+
+		if b.initialTextOffset != nil {
+			initialTextOffset = *b.initialTextOffset
+		}
+
+		d.initialTextOffset = &initialTextOffset
+		d.buildTextOffsets = encodingutil.BuildTextOffsetsValue
+	}
+
+	if d.bnStringFactory == nil {
+		d.bnStringFactory = blanknodes.NewStringFactory()
+	}
+
+	return d, nil
+}
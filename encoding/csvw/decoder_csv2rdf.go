@@ -0,0 +1,906 @@
+package csvw
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/ontology/csvw/csvwiri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/rdfs/rdfsiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+var defaultPropertyURLTemplate = mustParseURITemplate("{#_name}")
+
+// defaultPrefixes is used for expanding URI templates of embedded metadata.
+var defaultPrefixes = newInitialContext()
+
+// csv2rdf generates the statements of a table group according to the mode of the decoder.
+type csv2rdf struct {
+	d  *Decoder
+	tg *metadataTableGroup
+
+	tableGroupNode rdf.SubjectValue
+}
+
+func (e *csv2rdf) standard() bool {
+	return e.d.mode == ModeStandard
+}
+
+func (e *csv2rdf) addStatement(s rdf.SubjectValue, p rdf.PredicateValue, o rdf.ObjectValue, pairs ...any) {
+	e.d.statements = append(e.d.statements, statement{
+		quad: rdf.Quad{
+			Triple: rdf.Triple{
+				Subject:   s,
+				Predicate: p,
+				Object:    o,
+			},
+		},
+		textOffsets: e.d.buildTextOffsets(pairs...),
+	})
+}
+
+func (e *csv2rdf) newNode(id *string) rdf.SubjectValue {
+	if id != nil {
+		return rdf.IRI(*id)
+	}
+
+	return e.d.bnStringFactory.NewBlankNode()
+}
+
+func (e *csv2rdf) emitTableGroup() error {
+	if !e.standard() {
+		return nil
+	}
+
+	e.tableGroupNode = e.newNode(e.tg.id)
+
+	e.addStatement(e.tableGroupNode, rdfiri.Type_Property, csvwiri.TableGroup_Class)
+
+	return e.emitDescription(e.tableGroupNode, e.tg.notes, e.tg.common)
+}
+
+// emitDescription emits the notes and common properties of a table group or table.
+func (e *csv2rdf) emitDescription(subject rdf.SubjectValue, notes []inspectjson.Value, common map[string]inspectjson.Value) error {
+	if e.tg.parser == nil {
+		return nil
+	}
+
+	for _, note := range notes {
+		err := e.emitCommonValue(subject, csvwiri.Note_Property, note)
+		if err != nil {
+			return fmt.Errorf("notes: %v", err)
+		}
+	}
+
+	for _, key := range slices.Sorted(maps.Keys(common)) {
+		predicate, err := e.tg.parser.expandIRI(key)
+		if err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+
+		err = e.emitCommonValue(subject, rdf.IRI(predicate), common[key])
+		if err != nil {
+			return fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	return nil
+}
+
+// emitCommonValue follows the normalized JSON-LD syntax of common properties.
+func (e *csv2rdf) emitCommonValue(subject rdf.SubjectValue, predicate rdf.PredicateValue, v inspectjson.Value) error {
+	mp := e.tg.parser
+
+	switch vT := v.(type) {
+	case inspectjson.NullValue:
+		return nil
+	case inspectjson.ArrayValue:
+		for _, item := range vT.Values {
+			err := e.emitCommonValue(subject, predicate, item)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case inspectjson.StringValue:
+		e.addStatement(subject, predicate, newStringLiteral(vT.Value, mp.language))
+
+		return nil
+	case inspectjson.NumberValue:
+		e.addStatement(subject, predicate, newNumberLiteral(vT.Value))
+
+		return nil
+	case inspectjson.BooleanValue:
+		e.addStatement(subject, predicate, rdf.Literal{
+			Datatype:    xsdiri.Boolean_Datatype,
+			LexicalForm: strconv.FormatBool(vT.Value),
+		})
+
+		return nil
+	}
+
+	object := v.(inspectjson.ObjectValue)
+
+	if valueMember, ok := object.Members["@value"]; ok {
+		var literal rdf.Literal
+
+		switch value := valueMember.Value.(type) {
+		case inspectjson.StringValue:
+			literal = newStringLiteral(value.Value, "")
+		case inspectjson.NumberValue:
+			literal = newNumberLiteral(value.Value)
+		case inspectjson.BooleanValue:
+			literal = rdf.Literal{
+				Datatype:    xsdiri.Boolean_Datatype,
+				LexicalForm: strconv.FormatBool(value.Value),
+			}
+		default:
+			return fmt.Errorf("@value: expected string, number, or boolean")
+		}
+
+		if typeMember, ok := object.Members["@type"]; ok {
+			datatypeString, err := parseDialectString(typeMember.Value)
+			if err != nil {
+				return fmt.Errorf("@type: %v", err)
+			}
+
+			datatypeIRI, err := mp.expandIRI(datatypeString)
+			if err != nil {
+				return fmt.Errorf("@type: %v", err)
+			}
+
+			literal.Datatype = rdf.IRI(datatypeIRI)
+		} else if languageMember, ok := object.Members["@language"]; ok {
+			language, err := parseDialectString(languageMember.Value)
+			if err != nil {
+				return fmt.Errorf("@language: %v", err)
+			}
+
+			literal = newStringLiteral(literal.LexicalForm, language)
+		}
+
+		e.addStatement(subject, predicate, literal)
+
+		return nil
+	}
+
+	var node rdf.SubjectValue
+
+	if idMember, ok := object.Members["@id"]; ok {
+		id, err := mp.parseID(idMember.Value)
+		if err != nil {
+			return fmt.Errorf("@id: %v", err)
+		}
+
+		node = rdf.IRI(*id)
+	} else {
+		node = e.d.bnStringFactory.NewBlankNode()
+	}
+
+	e.addStatement(subject, predicate, node)
+
+	for _, key := range sortedMemberNames(object) {
+		value := object.Members[key].Value
+
+		switch key {
+		case "@id":
+			// handled above
+		case "@type":
+			types, err := parseStringOrStrings(value)
+			if err != nil {
+				return fmt.Errorf("@type: %v", err)
+			}
+
+			for _, t := range types {
+				typeIRI, err := mp.expandIRI(t)
+				if err != nil {
+					return fmt.Errorf("@type: %v", err)
+				}
+
+				e.addStatement(node, rdfiri.Type_Property, rdf.IRI(typeIRI))
+			}
+		default:
+			if !isCommonPropertyName(key) {
+				continue
+			}
+
+			nestedPredicate, err := mp.expandIRI(key)
+			if err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+
+			err = e.emitCommonValue(node, rdf.IRI(nestedPredicate), value)
+			if err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func newStringLiteral(v string, language string) rdf.Literal {
+	if len(language) > 0 {
+		return rdf.Literal{
+			Datatype:    rdfiri.LangString_Datatype,
+			LexicalForm: v,
+			Tag: rdf.LanguageLiteralTag{
+				Language: language,
+			},
+		}
+	}
+
+	return rdf.Literal{
+		Datatype:    xsdiri.String_Datatype,
+		LexicalForm: v,
+	}
+}
+
+func newNumberLiteral(v float64) rdf.Literal {
+	if v == math.Trunc(v) && math.Abs(v) < 1e21 {
+		return rdf.Literal{
+			Datatype:    xsdiri.Integer_Datatype,
+			LexicalForm: strconv.FormatFloat(v, 'f', -1, 64),
+		}
+	}
+
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(v, 'E', -1, 64), "E")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+
+	exponentInt, _ := strconv.Atoi(exponent)
+
+	return rdf.Literal{
+		Datatype:    xsdiri.Double_Datatype,
+		LexicalForm: mantissa + "E" + strconv.Itoa(exponentInt),
+	}
+}
+
+//
+
+type csv2rdfColumn struct {
+	number       int
+	sourceNumber int
+	name         string
+	titleOffsets *cursorio.TextOffsetRange
+
+	virtual        bool
+	suppressOutput bool
+
+	aboutURL     *uriTemplate
+	propertyURL  *uriTemplate
+	valueURL     *uriTemplate
+	datatype     *datatype
+	defaultValue string
+	lang         string
+	null         []string
+	ordered      bool
+	separator    *string
+}
+
+type csv2rdfCell struct {
+	null          bool
+	list          bool
+	values        []rdf.Literal
+	templateValue uriTemplateValue
+	offsets       *cursorio.TextOffsetRange
+}
+
+// csv2rdfTable is the state of a table while its rows are read.
+type csv2rdfTable struct {
+	table     *metadataTable
+	dialect   Dialect
+	schema    *metadataSchema
+	tableBase *iri.ParsedIRI
+	tr        *tableReader
+	columns   []*csv2rdfColumn
+	tableNode rdf.SubjectValue
+
+	comments        []string
+	sourceRowNumber int
+	rowNumber       int
+}
+
+// openTable reads any skipped and header rows of a table and emits the statements of the table itself. Its rows are
+// then emitted by emitNextRow.
+func (e *csv2rdf) openTable(table *metadataTable, r io.Reader, initialTextOffset *cursorio.TextOffset) (*csv2rdfTable, error) {
+	dialect := e.d.dialect
+
+	if table.dialect != nil {
+		dialect = *table.dialect
+	} else if e.tg.dialect != nil {
+		dialect = *e.tg.dialect
+	}
+
+	err := dialect.validate()
+	if err != nil {
+		return nil, fmt.Errorf("dialect: %v", err)
+	}
+
+	schema := table.tableSchema
+	if schema == nil {
+		schema = e.tg.tableSchema
+	}
+
+	if schema == nil {
+		schema = &metadataSchema{}
+	}
+
+	t := &csv2rdfTable{
+		table:   table,
+		dialect: dialect,
+		schema:  schema,
+		tr:      newTableReader(r, dialect, initialTextOffset),
+	}
+
+	if len(table.url) > 0 {
+		t.tableBase, err = iri.ParseIRI(table.url)
+		if err != nil {
+			return nil, fmt.Errorf("parse url: %v", err)
+		}
+	}
+
+	// [spec // 8] Skipped rows are treated as comments.
+
+	for range dialect.SkipRows {
+		row, ok := t.tr.readRow()
+		if !ok {
+			break
+		}
+
+		t.sourceRowNumber++
+
+		if comment, ok := e.rowComment(dialect, row); ok {
+			t.comments = append(t.comments, comment)
+		} else if len(row.content) > 0 {
+			t.comments = append(t.comments, row.content)
+		}
+	}
+
+	var headerTitles []string
+	var headerOffsets []*cursorio.TextOffsetRange
+
+	for headerRowCount := 0; headerRowCount < dialect.HeaderRowCount; {
+		row, ok := t.tr.readRow()
+		if !ok {
+			break
+		}
+
+		t.sourceRowNumber++
+
+		if comment, ok := e.rowComment(dialect, row); ok {
+			t.comments = append(t.comments, comment)
+
+			continue
+		}
+
+		headerRowCount++
+
+		for cellIdx, cell := range row.cells[min(dialect.SkipColumns, len(row.cells)):] {
+			for len(headerTitles) <= cellIdx {
+				headerTitles = append(headerTitles, "")
+				headerOffsets = append(headerOffsets, nil)
+			}
+
+			if len(cell.value) == 0 {
+				continue
+			} else if len(headerTitles[cellIdx]) > 0 {
+				headerTitles[cellIdx] += " "
+			}
+
+			headerTitles[cellIdx] += cell.value
+			headerOffsets[cellIdx] = cell.offsets
+		}
+	}
+
+	if err := t.tr.Err(); err != nil {
+		return nil, fmt.Errorf("read: %v", err)
+	}
+
+	t.columns = e.buildColumns(table, schema, dialect, headerTitles, headerOffsets)
+
+	if e.standard() && !table.suppressOutput {
+		t.tableNode = e.newNode(table.id)
+
+		e.addStatement(e.tableGroupNode, csvwiri.Table_Property, t.tableNode)
+		e.addStatement(t.tableNode, rdfiri.Type_Property, csvwiri.Table_Class)
+
+		if len(table.url) > 0 {
+			e.addStatement(t.tableNode, csvwiri.Url_Property, rdf.IRI(table.url))
+		}
+
+		err = e.emitDescription(t.tableNode, table.notes, table.common)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return t, nil
+}
+
+// emitNextRow reads the next row of a table and emits its statements. Once all rows have been read, the comments of
+// the table are emitted and false is returned.
+func (e *csv2rdf) emitNextRow(t *csv2rdfTable) (bool, error) {
+	dialect := t.dialect
+
+	for {
+		row, ok := t.tr.readRow()
+		if !ok {
+			break
+		}
+
+		t.sourceRowNumber++
+
+		if comment, ok := e.rowComment(dialect, row); ok {
+			t.comments = append(t.comments, comment)
+
+			continue
+		}
+
+		cells := row.cells[min(dialect.SkipColumns, len(row.cells)):]
+
+		if dialect.SkipBlankRows && !slices.ContainsFunc(cells, func(c tableReaderCell) bool { return len(c.value) > 0 }) {
+			continue
+		}
+
+		t.rowNumber++
+
+		for len(t.columns) < len(cells) {
+			t.columns = append(t.columns, e.newEmbeddedColumn(t.table, t.schema, dialect, len(t.columns)+1, "", nil))
+		}
+
+		if t.table.suppressOutput {
+			continue
+		}
+
+		err := e.emitRow(t.tableNode, t.tableBase, t.table, t.schema, t.columns, row, cells, t.rowNumber, t.sourceRowNumber)
+		if err != nil {
+			return false, fmt.Errorf("row %d: %v", t.sourceRowNumber, err)
+		}
+
+		return true, nil
+	}
+
+	if err := t.tr.Err(); err != nil {
+		return false, fmt.Errorf("read: %v", err)
+	}
+
+	if t.tableNode != nil {
+		for _, comment := range t.comments {
+			e.addStatement(t.tableNode, rdfsiri.Comment_Property, newStringLiteral(comment, ""))
+		}
+	}
+
+	return false, nil
+}
+
+func (e *csv2rdf) rowComment(dialect Dialect, row tableReaderRow) (string, bool) {
+	if row.cells != nil {
+		return "", false
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(row.content, dialect.CommentPrefix)), true
+}
+
+// buildColumns uses the columns of the schema or, without any, the embedded metadata of the header titles.
+func (e *csv2rdf) buildColumns(table *metadataTable, schema *metadataSchema, dialect Dialect, headerTitles []string, headerOffsets []*cursorio.TextOffsetRange) []*csv2rdfColumn {
+	var columns []*csv2rdfColumn
+
+	if len(schema.columns) == 0 {
+		for titleIdx, title := range headerTitles {
+			column := e.newEmbeddedColumn(table, schema, dialect, titleIdx+1, title, headerOffsets[titleIdx])
+
+			columns = append(columns, column)
+		}
+
+		return columns
+	}
+
+	for columnIdx, metadataColumn := range schema.columns {
+		column := e.newColumn(table, schema, dialect, columnIdx+1, metadataColumn)
+
+		if columnIdx < len(headerOffsets) && !metadataColumn.virtual {
+			column.titleOffsets = headerOffsets[columnIdx]
+		}
+
+		columns = append(columns, column)
+	}
+
+	return columns
+}
+
+func (e *csv2rdf) newEmbeddedColumn(table *metadataTable, schema *metadataSchema, dialect Dialect, number int, title string, titleOffsets *cursorio.TextOffsetRange) *csv2rdfColumn {
+	metadataColumn := &metadataColumn{}
+
+	if len(title) > 0 {
+		metadataColumn.titles = []metadataTitle{
+			{
+				value: title,
+				lang:  "und",
+			},
+		}
+	}
+
+	column := e.newColumn(table, schema, dialect, number, metadataColumn)
+	column.titleOffsets = titleOffsets
+
+	return column
+}
+
+func (e *csv2rdf) newColumn(table *metadataTable, schema *metadataSchema, dialect Dialect, number int, metadataColumn *metadataColumn) *csv2rdfColumn {
+	column := &csv2rdfColumn{
+		number:         number,
+		sourceNumber:   number + dialect.SkipColumns,
+		virtual:        metadataColumn.virtual,
+		suppressOutput: metadataColumn.suppressOutput,
+		datatype:       stringDatatype,
+		lang:           "und",
+		null:           []string{""},
+	}
+
+	if metadataColumn.name != nil {
+		column.name = *metadataColumn.name
+	} else if len(metadataColumn.titles) > 0 {
+		column.name = encodeColumnName(metadataColumn.titles[0].value)
+	} else {
+		column.name = fmt.Sprintf("_col.%d", number)
+	}
+
+	// [spec // 5.7] Inherited properties are resolved from the column, its schema, table, and table group.
+
+	for _, ip := range []*inheritedProperties{&e.tg.inherited, &table.inherited, &schema.inherited, &metadataColumn.inherited} {
+		if ip.aboutURL != nil {
+			column.aboutURL = ip.aboutURL
+		}
+
+		if ip.datatype != nil {
+			column.datatype = ip.datatype
+		}
+
+		if ip.defaultValue != nil {
+			column.defaultValue = *ip.defaultValue
+		}
+
+		if ip.lang != nil {
+			column.lang = *ip.lang
+		}
+
+		if ip.null != nil {
+			column.null = ip.null
+		}
+
+		if ip.ordered != nil {
+			column.ordered = *ip.ordered
+		}
+
+		if ip.propertyURL != nil {
+			column.propertyURL = ip.propertyURL
+		}
+
+		if ip.separatorSet {
+			column.separator = ip.separator
+		}
+
+		if ip.valueURL != nil {
+			column.valueURL = ip.valueURL
+		}
+	}
+
+	return column
+}
+
+// encodeColumnName percent-encodes a title for use as a variable name of URI templates.
+func encodeColumnName(v string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+
+	return sb.String()
+}
+
+func decodeColumnName(v string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(v); i++ {
+		if v[i] == '%' && i+2 < len(v) && isHex(v[i+1]) && isHex(v[i+2]) {
+			b, _ := strconv.ParseUint(v[i+1:i+3], 16, 8)
+			sb.WriteByte(byte(b))
+
+			i += 2
+
+			continue
+		}
+
+		sb.WriteByte(v[i])
+	}
+
+	return sb.String()
+}
+
+// parseCell follows [spec // 6.4] for the value of a cell.
+func (c *csv2rdfColumn) parseCell(raw string) csv2rdfCell {
+	normalized := c.datatype.normalize(raw)
+	if len(normalized) == 0 {
+		normalized = c.defaultValue
+	}
+
+	if slices.Contains(c.null, normalized) {
+		return csv2rdfCell{
+			null: true,
+		}
+	}
+
+	if c.separator == nil {
+		literal, _ := c.datatype.parse(normalized, c.lang)
+
+		return csv2rdfCell{
+			values:        []rdf.Literal{literal},
+			templateValue: literal.LexicalForm,
+		}
+	}
+
+	cell := csv2rdfCell{
+		list: true,
+	}
+
+	templateValues := []string{}
+
+	if len(normalized) > 0 {
+		for _, item := range strings.Split(normalized, *c.separator) {
+			if !c.datatype.base.raw {
+				item = strings.TrimSpace(item)
+			}
+
+			if slices.Contains(c.null, item) {
+				continue
+			}
+
+			literal, _ := c.datatype.parse(item, c.lang)
+
+			cell.values = append(cell.values, literal)
+			templateValues = append(templateValues, literal.LexicalForm)
+		}
+	}
+
+	cell.templateValue = templateValues
+
+	return cell
+}
+
+func (e *csv2rdf) emitRow(tableNode rdf.SubjectValue, tableBase *iri.ParsedIRI, table *metadataTable, schema *metadataSchema, columns []*csv2rdfColumn, row tableReaderRow, rawCells []tableReaderCell, rowNumber, sourceRowNumber int) error {
+	cells := make([]csv2rdfCell, len(columns))
+
+	vars := uriTemplateVariables{
+		"_row":       strconv.Itoa(rowNumber),
+		"_sourceRow": strconv.Itoa(sourceRowNumber),
+	}
+
+	for columnIdx, column := range columns {
+		if column.virtual {
+			cells[columnIdx] = csv2rdfCell{
+				null: true,
+			}
+		} else {
+			var raw string
+			var offsets *cursorio.TextOffsetRange
+
+			if columnIdx < len(rawCells) {
+				raw = rawCells[columnIdx].value
+				offsets = rawCells[columnIdx].offsets
+			}
+
+			cells[columnIdx] = column.parseCell(raw)
+			cells[columnIdx].offsets = offsets
+		}
+
+		if !cells[columnIdx].null {
+			vars[column.name] = cells[columnIdx].templateValue
+		}
+	}
+
+	var rowNode rdf.SubjectValue
+
+	if tableNode != nil {
+		rowNode = e.d.bnStringFactory.NewBlankNode()
+
+		e.addStatement(
+			tableNode, csvwiri.Row_Property, rowNode,
+			encoding.ObjectStatementOffsets, row.offsets,
+		)
+		e.addStatement(
+			rowNode, rdfiri.Type_Property, csvwiri.Row_Class,
+			encoding.SubjectStatementOffsets, row.offsets,
+		)
+		e.addStatement(
+			rowNode,
+			csvwiri.Rownum_Property,
+			rdf.Literal{
+				Datatype:    xsdiri.Integer_Datatype,
+				LexicalForm: strconv.Itoa(rowNumber),
+			},
+			encoding.SubjectStatementOffsets, row.offsets,
+			encoding.ObjectStatementOffsets, row.offsets,
+		)
+
+		if len(table.url) > 0 {
+			e.addStatement(
+				rowNode,
+				csvwiri.Url_Property,
+				rdf.IRI(fmt.Sprintf("%s#row=%d", stripFragment(table.url), sourceRowNumber)),
+				encoding.SubjectStatementOffsets, row.offsets,
+				encoding.ObjectStatementOffsets, row.offsets,
+			)
+		}
+
+		for _, rowTitle := range schema.rowTitles {
+			for columnIdx, column := range columns {
+				if column.name != rowTitle || cells[columnIdx].null {
+					continue
+				}
+
+				for _, value := range cells[columnIdx].values {
+					e.addStatement(
+						rowNode, csvwiri.Title_Property, value,
+						encoding.SubjectStatementOffsets, row.offsets,
+						encoding.ObjectStatementOffsets, cells[columnIdx].offsets,
+					)
+				}
+			}
+		}
+	}
+
+	defaultSubject := e.d.bnStringFactory.NewBlankNode()
+	describedSubjects := map[rdf.SubjectValue]struct{}{}
+
+	for columnIdx, column := range columns {
+		if column.suppressOutput {
+			continue
+		}
+
+		cell := cells[columnIdx]
+
+		vars["_column"] = strconv.Itoa(column.number)
+		vars["_sourceColumn"] = strconv.Itoa(column.sourceNumber)
+		vars["_name"] = decodeColumnName(column.name)
+
+		var subject rdf.SubjectValue = defaultSubject
+
+		if column.aboutURL != nil {
+			expanded, err := e.expandTemplate(column.aboutURL, tableBase, vars)
+			if err != nil {
+				return fmt.Errorf("column %d: aboutUrl: %v", column.number, err)
+			}
+
+			subject = expanded
+		}
+
+		if rowNode != nil {
+			if _, ok := describedSubjects[subject]; !ok {
+				describedSubjects[subject] = struct{}{}
+
+				e.addStatement(
+					rowNode, csvwiri.Describes_Property, subject,
+					encoding.SubjectStatementOffsets, row.offsets,
+					encoding.ObjectStatementOffsets, row.offsets,
+				)
+			}
+		}
+
+		if column.valueURL == nil && (cell.null || len(cell.values) == 0) {
+			continue
+		} else if column.valueURL != nil && cell.null && !column.virtual {
+			continue
+		}
+
+		propertyURL := column.propertyURL
+		if propertyURL == nil {
+			propertyURL = defaultPropertyURLTemplate
+		}
+
+		predicate, err := e.expandTemplate(propertyURL, tableBase, vars)
+		if err != nil {
+			return fmt.Errorf("column %d: propertyUrl: %v", column.number, err)
+		}
+
+		if column.valueURL != nil {
+			object, err := e.expandTemplate(column.valueURL, tableBase, vars)
+			if err != nil {
+				return fmt.Errorf("column %d: valueUrl: %v", column.number, err)
+			}
+
+			e.addStatement(
+				subject, predicate, object,
+				encoding.SubjectStatementOffsets, row.offsets,
+				encoding.PredicateStatementOffsets, column.titleOffsets,
+				encoding.ObjectStatementOffsets, cell.offsets,
+			)
+
+			continue
+		}
+
+		if cell.list && column.ordered {
+			e.emitList(subject, predicate, cell, row.offsets, column.titleOffsets)
+
+			continue
+		}
+
+		for _, value := range cell.values {
+			e.addStatement(
+				subject, predicate, value,
+				encoding.SubjectStatementOffsets, row.offsets,
+				encoding.PredicateStatementOffsets, column.titleOffsets,
+				encoding.ObjectStatementOffsets, cell.offsets,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (e *csv2rdf) emitList(subject rdf.SubjectValue, predicate rdf.PredicateValue, cell csv2rdfCell, subjectOffsets, predicateOffsets *cursorio.TextOffsetRange) {
+	listNode := e.d.bnStringFactory.NewBlankNode()
+
+	e.addStatement(
+		subject, predicate, listNode,
+		encoding.SubjectStatementOffsets, subjectOffsets,
+		encoding.PredicateStatementOffsets, predicateOffsets,
+		encoding.ObjectStatementOffsets, cell.offsets,
+	)
+
+	for valueIdx, value := range cell.values {
+		e.addStatement(
+			listNode, rdfiri.First_Property, value,
+			encoding.ObjectStatementOffsets, cell.offsets,
+		)
+
+		if valueIdx == len(cell.values)-1 {
+			e.addStatement(listNode, rdfiri.Rest_Property, rdfiri.Nil_List)
+
+			break
+		}
+
+		nextListNode := e.d.bnStringFactory.NewBlankNode()
+
+		e.addStatement(listNode, rdfiri.Rest_Property, nextListNode)
+
+		listNode = nextListNode
+	}
+}
+
+// expandTemplate expands a URI template of a cell and resolves it against the URL of the table. Prefixed names of the
+// initial context are expanded, such as a propertyUrl of schema:name.
+func (e *csv2rdf) expandTemplate(t *uriTemplate, tableBase *iri.ParsedIRI, vars uriTemplateVariables) (rdf.IRI, error) {
+	prefixes := defaultPrefixes
+
+	if e.tg.parser != nil {
+		prefixes = e.tg.parser.prefixes
+	}
+
+	expanded, err := expandIRI(prefixes, tableBase, t.Expand(vars))
+	if err != nil {
+		return "", err
+	}
+
+	return rdf.IRI(expanded), nil
+}
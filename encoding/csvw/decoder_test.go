@@ -0,0 +1,387 @@
+package csvw
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/url"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/encodingtest"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/ontology/csvw/csvwiri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+	"github.com/dpb587/rdfkit-go/testing/testingassert"
+)
+
+var testingBnode = blanknodes.NewStringFactory()
+
+func testingMetadataDocument(t *testing.T, documentURL, document string) DecoderConfig {
+	t.Helper()
+
+	parsedDocumentURL, err := url.Parse(documentURL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsedDocument, err := inspectjson.Parse(bytes.NewBufferString(document))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	return DecoderConfig{}.SetMetadataDocument(jsonldtype.RemoteDocument{
+		DocumentURL: parsedDocumentURL,
+		Document:    parsedDocument,
+	})
+}
+
+func TestEmbeddedMetadata(t *testing.T) {
+	// https://www.w3.org/TR/2015/REC-csv2rdf-20151217/#example-tree-ops-csv
+	out, err := quads.CollectErr(NewDecoder(
+		bytes.NewBufferString("GID,On Street,Species\n1,ADDISON AV,Celtis australis\n"),
+		DecoderConfig{}.SetDefaultBase("http://example.org/tree-ops.csv"),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testingassert.IsomorphicDatasets(t.Context(), t, rdf.QuadList{
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("tg"), Predicate: rdfiri.Type_Property, Object: csvwiri.TableGroup_Class}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("tg"), Predicate: csvwiri.Table_Property, Object: testingBnode.NewStringBlankNode("t")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("t"), Predicate: rdfiri.Type_Property, Object: csvwiri.Table_Class}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("t"), Predicate: csvwiri.Url_Property, Object: rdf.IRI("http://example.org/tree-ops.csv")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("t"), Predicate: csvwiri.Row_Property, Object: testingBnode.NewStringBlankNode("r1")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("r1"), Predicate: rdfiri.Type_Property, Object: csvwiri.Row_Class}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("r1"), Predicate: csvwiri.Rownum_Property, Object: xsdobject.Integer(1)}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("r1"), Predicate: csvwiri.Url_Property, Object: rdf.IRI("http://example.org/tree-ops.csv#row=2")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("r1"), Predicate: csvwiri.Describes_Property, Object: testingBnode.NewStringBlankNode("s1")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("s1"), Predicate: rdf.IRI("http://example.org/tree-ops.csv#GID"), Object: xsdobject.String("1")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("s1"), Predicate: rdf.IRI("http://example.org/tree-ops.csv#On%20Street"), Object: xsdobject.String("ADDISON AV")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("s1"), Predicate: rdf.IRI("http://example.org/tree-ops.csv#Species"), Object: xsdobject.String("Celtis australis")}},
+	}, out)
+}
+
+func TestMetadataMinimal(t *testing.T) {
+	out, err := quads.CollectErr(NewDecoder(
+		bytes.NewBufferString("GID,On Street,Inventory Date,Score\n1,ADDISON AV;MAIN ST,10/18/2010,\"1,024.5\"\n2,EMERSON ST,6/2/2010,-\n"),
+		DecoderConfig{}.
+			SetDefaultBase("http://example.org/tree-ops.csv").
+			SetMode(ModeMinimal),
+		testingMetadataDocument(t, "http://example.org/tree-ops.csv-metadata.json", `{
+  "@context": "http://www.w3.org/ns/csvw",
+  "url": "tree-ops.csv",
+  "dc:title": "Tree Operations",
+  "tableSchema": {
+    "columns": [{
+      "name": "GID",
+      "titles": "GID",
+      "suppressOutput": true
+    }, {
+      "name": "on_street",
+      "titles": "On Street",
+      "separator": ";",
+      "propertyUrl": "schema:streetAddress"
+    }, {
+      "name": "inventory_date",
+      "titles": "Inventory Date",
+      "datatype": {"base": "date", "format": "M/d/yyyy"}
+    }, {
+      "name": "score",
+      "titles": "Score",
+      "null": "-",
+      "datatype": {"base": "decimal", "format": {"groupChar": ","}}
+    }, {
+      "virtual": true,
+      "propertyUrl": "rdf:type",
+      "valueUrl": "schema:Thing"
+    }, {
+      "virtual": true,
+      "propertyUrl": "schema:url",
+      "valueUrl": "http://example.org/trees/{GID}"
+    }],
+    "aboutUrl": "#gid-{GID}"
+  }
+}`),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testingassert.IsomorphicDatasets(t.Context(), t, rdf.QuadList{
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-1"), Predicate: rdf.IRI("http://schema.org/streetAddress"), Object: xsdobject.String("ADDISON AV")}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-1"), Predicate: rdf.IRI("http://schema.org/streetAddress"), Object: xsdobject.String("MAIN ST")}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-1"), Predicate: rdf.IRI("http://example.org/tree-ops.csv#inventory_date"), Object: rdf.Literal{Datatype: xsdiri.Date_Datatype, LexicalForm: "2010-10-18"}}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-1"), Predicate: rdf.IRI("http://example.org/tree-ops.csv#score"), Object: rdf.Literal{Datatype: xsdiri.Decimal_Datatype, LexicalForm: "1024.5"}}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-1"), Predicate: rdfiri.Type_Property, Object: rdf.IRI("http://schema.org/Thing")}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-1"), Predicate: rdf.IRI("http://schema.org/url"), Object: rdf.IRI("http://example.org/trees/1")}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-2"), Predicate: rdf.IRI("http://schema.org/streetAddress"), Object: xsdobject.String("EMERSON ST")}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-2"), Predicate: rdf.IRI("http://example.org/tree-ops.csv#inventory_date"), Object: rdf.Literal{Datatype: xsdiri.Date_Datatype, LexicalForm: "2010-06-02"}}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-2"), Predicate: rdfiri.Type_Property, Object: rdf.IRI("http://schema.org/Thing")}},
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/tree-ops.csv#gid-2"), Predicate: rdf.IRI("http://schema.org/url"), Object: rdf.IRI("http://example.org/trees/2")}},
+	}, out)
+}
+
+func TestMetadataOrderedList(t *testing.T) {
+	out, err := quads.CollectErr(NewDecoder(
+		bytes.NewBufferString("# preamble\nid\tvalues\nx\t1 2\n"),
+		DecoderConfig{}.
+			SetDefaultBase("http://example.org/list.tsv").
+			SetMode(ModeMinimal),
+		testingMetadataDocument(t, "http://example.org/csv-metadata.json", `{
+  "@context": "http://www.w3.org/ns/csvw",
+  "url": "list.tsv",
+  "dialect": {"delimiter": "\t"},
+  "tableSchema": {
+    "columns": [{
+      "name": "id",
+      "suppressOutput": true
+    }, {
+      "name": "values",
+      "separator": " ",
+      "ordered": true,
+      "datatype": "integer"
+    }],
+    "aboutUrl": "{#id}"
+  }
+}`),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testingassert.IsomorphicDatasets(t.Context(), t, rdf.QuadList{
+		{Triple: rdf.Triple{Subject: rdf.IRI("http://example.org/list.tsv#x"), Predicate: rdf.IRI("http://example.org/list.tsv#values"), Object: testingBnode.NewStringBlankNode("l1")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("l1"), Predicate: rdfiri.First_Property, Object: xsdobject.Integer(1)}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("l1"), Predicate: rdfiri.Rest_Property, Object: testingBnode.NewStringBlankNode("l2")}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("l2"), Predicate: rdfiri.First_Property, Object: xsdobject.Integer(2)}},
+		{Triple: rdf.Triple{Subject: testingBnode.NewStringBlankNode("l2"), Predicate: rdfiri.Rest_Property, Object: rdfiri.Nil_List}},
+	}, out)
+}
+
+func TestTextOffsets(t *testing.T) {
+	out, err := encodingtest.CollectQuadStatementsErr(NewDecoder(
+		bytes.NewBufferString("name,age\n\"Alice\",42\n"),
+		DecoderConfig{}.
+			SetDefaultBase("http://example.org/people.csv").
+			SetMode(ModeMinimal).
+			SetCaptureTextOffsets(true),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(out), 2; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	for _, testcase := range []struct {
+		Expected encodingtest.QuadStatement
+		Actual   encodingtest.QuadStatement
+	}{
+		{
+			Actual: out[0],
+			Expected: encodingtest.QuadStatement{
+				Quad: rdf.Quad{Triple: rdf.Triple{
+					Subject:   out[0].Quad.Triple.Subject,
+					Predicate: rdf.IRI("http://example.org/people.csv#name"),
+					Object:    xsdobject.String("Alice"),
+				}},
+				TextOffsets: encoding.StatementTextOffsets{
+					encoding.SubjectStatementOffsets: cursorio.TextOffsetRange{
+						From:  cursorio.TextOffset{Byte: 9, LineColumn: cursorio.TextLineColumn{1, 0}},
+						Until: cursorio.TextOffset{Byte: 19, LineColumn: cursorio.TextLineColumn{1, 10}},
+					},
+					encoding.PredicateStatementOffsets: cursorio.TextOffsetRange{
+						From:  cursorio.TextOffset{Byte: 0, LineColumn: cursorio.TextLineColumn{0, 0}},
+						Until: cursorio.TextOffset{Byte: 4, LineColumn: cursorio.TextLineColumn{0, 4}},
+					},
+					encoding.ObjectStatementOffsets: cursorio.TextOffsetRange{
+						From:  cursorio.TextOffset{Byte: 9, LineColumn: cursorio.TextLineColumn{1, 0}},
+						Until: cursorio.TextOffset{Byte: 16, LineColumn: cursorio.TextLineColumn{1, 7}},
+					},
+				},
+			},
+		},
+		{
+			Actual: out[1],
+			Expected: encodingtest.QuadStatement{
+				Quad: rdf.Quad{Triple: rdf.Triple{
+					Subject:   out[0].Quad.Triple.Subject,
+					Predicate: rdf.IRI("http://example.org/people.csv#age"),
+					Object:    xsdobject.String("42"),
+				}},
+				TextOffsets: encoding.StatementTextOffsets{
+					encoding.SubjectStatementOffsets: cursorio.TextOffsetRange{
+						From:  cursorio.TextOffset{Byte: 9, LineColumn: cursorio.TextLineColumn{1, 0}},
+						Until: cursorio.TextOffset{Byte: 19, LineColumn: cursorio.TextLineColumn{1, 10}},
+					},
+					encoding.PredicateStatementOffsets: cursorio.TextOffsetRange{
+						From:  cursorio.TextOffset{Byte: 5, LineColumn: cursorio.TextLineColumn{0, 5}},
+						Until: cursorio.TextOffset{Byte: 8, LineColumn: cursorio.TextLineColumn{0, 8}},
+					},
+					encoding.ObjectStatementOffsets: cursorio.TextOffsetRange{
+						From:  cursorio.TextOffset{Byte: 17, LineColumn: cursorio.TextLineColumn{1, 8}},
+						Until: cursorio.TextOffset{Byte: 19, LineColumn: cursorio.TextLineColumn{1, 10}},
+					},
+				},
+			},
+		},
+	} {
+		if _a, _e := testcase.Actual.Quad, testcase.Expected.Quad; _a != _e {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		}
+
+		for offsetsType, expectedRange := range testcase.Expected.TextOffsets {
+			if _a, _e := testcase.Actual.TextOffsets[offsetsType], expectedRange; _a != _e {
+				t.Fatalf("%v: expected %v, got %v", offsetsType, _e, _a)
+			}
+		}
+	}
+}
+
+func TestDecoderErrors(t *testing.T) {
+	for _, testcase := range []struct {
+		Name     string
+		Document string
+		Expected string
+	}{
+		{
+			Name:     "invalid column name",
+			Document: `{"url": "data.csv", "tableSchema": {"columns": [{"name": "_id"}]}}`,
+			Expected: "metadata: tableSchema: columns: 0: name: invalid name: _id",
+		},
+		{
+			Name:     "invalid uri template",
+			Document: `{"url": "data.csv", "tableSchema": {"aboutUrl": "{id"}}`,
+			Expected: "metadata: tableSchema: aboutUrl: unterminated expression",
+		},
+	} {
+		t.Run(testcase.Name, func(t *testing.T) {
+			_, err := quads.CollectErr(NewDecoder(
+				bytes.NewBufferString("id\n1\n"),
+				DecoderConfig{}.SetDefaultBase("http://example.org/data.csv"),
+				testingMetadataDocument(t, "http://example.org/csv-metadata.json", testcase.Document),
+			))
+			if err == nil {
+				t.Fatalf("expected error")
+			} else if _a, _e := err.Error(), testcase.Expected; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestTextOffsets_ByteOrderMark(t *testing.T) {
+	out, err := encodingtest.CollectQuadStatementsErr(NewDecoder(
+		bytes.NewBufferString("\ufeffname\nAlice\n"),
+		DecoderConfig{}.
+			SetDefaultBase("http://example.org/people.csv").
+			SetMode(ModeMinimal).
+			SetCaptureTextOffsets(true),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(out), 1; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := out[0].Quad.Triple.Predicate, rdf.PredicateValue(rdf.IRI("http://example.org/people.csv#name")); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := out[0].TextOffsets[encoding.PredicateStatementOffsets].From.Byte, cursorio.ByteOffset(3); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := out[0].TextOffsets[encoding.ObjectStatementOffsets].From.Byte, cursorio.ByteOffset(8); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestDecoder_Incremental(t *testing.T) {
+	errReader := errors.New("reader error")
+
+	d, err := NewDecoder(
+		iotest.OneByteReader(io.MultiReader(
+			strings.NewReader("name\r\n\"Alice\"\r\n"),
+			iotest.ErrReader(errReader),
+		)),
+		DecoderConfig{}.
+			SetDefaultBase("http://example.org/people.csv").
+			SetMode(ModeMinimal),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	defer d.Close()
+
+	// rows are converted as they are read, before the reader fails
+	if !d.Next() {
+		t.Fatalf("expected statement, got %v", d.Err())
+	} else if _a, _e := d.Quad().Triple.Object, rdf.ObjectValue(xsdobject.String("Alice")); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if d.Next() {
+		t.Fatalf("expected no statement")
+	} else if err := d.Err(); err == nil {
+		t.Fatalf("expected error")
+	} else if _a, _e := err.Error(), "table (http://example.org/people.csv): read: reader error"; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+type testingTableReadCloser struct {
+	io.Reader
+	closed bool
+}
+
+func (rc *testingTableReadCloser) Close() error {
+	rc.closed = true
+
+	return nil
+}
+
+func TestDecoder_TableLoader(t *testing.T) {
+	var loaded []*testingTableReadCloser
+
+	out, err := quads.CollectErr(NewDecoder(
+		bytes.NewBufferString("name\nAlice\n"),
+		DecoderConfig{}.
+			SetDefaultBase("http://example.org/people.csv").
+			SetMode(ModeMinimal).
+			SetTableLoader(TableLoaderFunc(func(_ context.Context, u string) (io.ReadCloser, error) {
+				if _a, _e := u, "http://example.org/pets.csv"; _a != _e {
+					t.Fatalf("expected %v, got %v", _e, _a)
+				}
+
+				rc := &testingTableReadCloser{
+					Reader: strings.NewReader("name\nRex\n"),
+				}
+
+				loaded = append(loaded, rc)
+
+				return rc, nil
+			})),
+		testingMetadataDocument(t, "http://example.org/csv-metadata.json", `{
+  "@context": "http://www.w3.org/ns/csvw",
+  "tables": [
+    {"url": "people.csv"},
+    {"url": "pets.csv"}
+  ]
+}`),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(out), 2; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := out[1].Triple.Object, rdf.ObjectValue(xsdobject.String("Rex")); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := len(loaded), 1; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if !loaded[0].closed {
+		t.Fatalf("expected table to be closed")
+	}
+}
@@ -0,0 +1,186 @@
+package csvw
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+)
+
+// Dialect describes the syntax of tabular data. Start from [DefaultDialect] when customizing a dialect since the zero
+// value disables quoting, trimming, and the header row.
+//
+// See https://www.w3.org/TR/2015/REC-tabular-metadata-20151217/#dialect-descriptions
+type Dialect struct {
+	// CommentPrefix starts rows which are comments. An empty value disables comments.
+	CommentPrefix string
+
+	Delimiter string
+
+	// DoubleQuote uses a repeated QuoteChar for escaping a quote within a quoted cell; otherwise a backslash is used.
+	DoubleQuote bool
+
+	// Encoding must be utf-8, which is the only encoding currently supported.
+	Encoding string
+
+	// HeaderRowCount is the number of rows used for the titles of columns.
+	HeaderRowCount int
+
+	LineTerminators []string
+
+	// QuoteChar is used for quoting cells. An empty value disables quoting.
+	QuoteChar string
+
+	SkipBlankRows    bool
+	SkipColumns      int
+	SkipInitialSpace bool
+	SkipRows         int
+
+	TrimStart bool
+	TrimEnd   bool
+}
+
+// DefaultDialect is the dialect of CSV files without any dialect description.
+var DefaultDialect = Dialect{
+	CommentPrefix:   "#",
+	Delimiter:       ",",
+	DoubleQuote:     true,
+	Encoding:        "utf-8",
+	HeaderRowCount:  1,
+	LineTerminators: []string{"\r\n", "\n"},
+	QuoteChar:       `"`,
+	TrimStart:       true,
+	TrimEnd:         true,
+}
+
+func parseDialect(v inspectjson.Value) (Dialect, error) {
+	d := DefaultDialect
+	d.LineTerminators = append([]string{}, DefaultDialect.LineTerminators...)
+
+	object, ok := v.(inspectjson.ObjectValue)
+	if !ok {
+		return d, fmt.Errorf("expected object")
+	}
+
+	var headerRowCountSet bool
+
+	for _, key := range sortedMemberNames(object) {
+		value := object.Members[key].Value
+
+		var err error
+
+		switch key {
+		case "@id", "@type":
+			// ignored
+		case "commentPrefix":
+			d.CommentPrefix, err = parseDialectString(value)
+		case "delimiter":
+			d.Delimiter, err = parseDialectString(value)
+		case "doubleQuote":
+			d.DoubleQuote, err = parseBoolean(value)
+		case "encoding":
+			d.Encoding, err = parseDialectString(value)
+		case "header":
+			var header bool
+
+			header, err = parseBoolean(value)
+			if err == nil && !headerRowCountSet {
+				d.HeaderRowCount = 0
+
+				if header {
+					d.HeaderRowCount = 1
+				}
+			}
+		case "headerRowCount":
+			d.HeaderRowCount, err = parseNonNegativeInteger(value)
+			headerRowCountSet = true
+		case "lineTerminators":
+			switch valueT := value.(type) {
+			case inspectjson.StringValue:
+				d.LineTerminators = []string{valueT.Value}
+			case inspectjson.ArrayValue:
+				d.LineTerminators = nil
+
+				for _, item := range valueT.Values {
+					itemString, ok := item.(inspectjson.StringValue)
+					if !ok {
+						err = fmt.Errorf("expected string")
+
+						break
+					}
+
+					d.LineTerminators = append(d.LineTerminators, itemString.Value)
+				}
+			default:
+				err = fmt.Errorf("expected string or array")
+			}
+		case "quoteChar":
+			if _, ok := value.(inspectjson.NullValue); ok {
+				d.QuoteChar = ""
+			} else {
+				d.QuoteChar, err = parseDialectString(value)
+			}
+		case "skipBlankRows":
+			d.SkipBlankRows, err = parseBoolean(value)
+		case "skipColumns":
+			d.SkipColumns, err = parseNonNegativeInteger(value)
+		case "skipInitialSpace":
+			d.SkipInitialSpace, err = parseBoolean(value)
+		case "skipRows":
+			d.SkipRows, err = parseNonNegativeInteger(value)
+		case "trim":
+			switch valueT := value.(type) {
+			case inspectjson.BooleanValue:
+				d.TrimStart, d.TrimEnd = valueT.Value, valueT.Value
+			case inspectjson.StringValue:
+				switch valueT.Value {
+				case "true":
+					d.TrimStart, d.TrimEnd = true, true
+				case "false":
+					d.TrimStart, d.TrimEnd = false, false
+				case "start":
+					d.TrimStart, d.TrimEnd = true, false
+				case "end":
+					d.TrimStart, d.TrimEnd = false, true
+				default:
+					err = fmt.Errorf("unsupported value: %s", valueT.Value)
+				}
+			default:
+				err = fmt.Errorf("expected boolean or string")
+			}
+		default:
+			err = fmt.Errorf("unsupported property")
+		}
+
+		if err != nil {
+			return d, fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	return d, nil
+}
+
+func parseDialectString(v inspectjson.Value) (string, error) {
+	s, ok := v.(inspectjson.StringValue)
+	if !ok {
+		return "", fmt.Errorf("expected string")
+	}
+
+	return s.Value, nil
+}
+
+func (d Dialect) validate() error {
+	if len(d.Delimiter) == 0 {
+		return fmt.Errorf("delimiter: expected non-empty string")
+	} else if !strings.EqualFold(d.Encoding, "utf-8") && !strings.EqualFold(d.Encoding, "utf8") {
+		return fmt.Errorf("encoding: unsupported value: %s", d.Encoding)
+	}
+
+	for _, lineTerminator := range d.LineTerminators {
+		if len(lineTerminator) == 0 {
+			return fmt.Errorf("lineTerminators: expected non-empty string")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,691 @@
+package csvw
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/rdfacontext"
+)
+
+const metadataContextIRI = "http://www.w3.org/ns/csvw"
+
+type metadataTableGroup struct {
+	// parser is nil for embedded metadata.
+	parser *metadataParser
+
+	id          *string
+	tables      []*metadataTable
+	dialect     *Dialect
+	tableSchema *metadataSchema
+	notes       []inspectjson.Value
+	common      map[string]inspectjson.Value
+	inherited   inheritedProperties
+}
+
+type metadataTable struct {
+	url            string
+	id             *string
+	tableSchema    *metadataSchema
+	dialect        *Dialect
+	notes          []inspectjson.Value
+	common         map[string]inspectjson.Value
+	suppressOutput bool
+	inherited      inheritedProperties
+}
+
+type metadataSchema struct {
+	columns   []*metadataColumn
+	rowTitles []string
+	inherited inheritedProperties
+}
+
+type metadataColumn struct {
+	name           *string
+	titles         []metadataTitle
+	virtual        bool
+	suppressOutput bool
+	inherited      inheritedProperties
+}
+
+type metadataTitle struct {
+	value string
+	lang  string
+}
+
+// inheritedProperties are the properties which a column inherits from its schema, table, and table group. Nil values
+// have not been specified.
+type inheritedProperties struct {
+	aboutURL     *uriTemplate
+	datatype     *datatype
+	defaultValue *string
+	lang         *string
+	null         []string
+	ordered      *bool
+	propertyURL  *uriTemplate
+	required     *bool
+	separator    *string
+	separatorSet bool
+	valueURL     *uriTemplate
+}
+
+// metadataParser parses a metadata document into its descriptions. The base and language are updated by the @context
+// of the document.
+type metadataParser struct {
+	ctx      context.Context
+	loader   jsonldtype.DocumentLoader
+	prefixes *iri.PrefixManager
+
+	base     *iri.ParsedIRI
+	language string
+}
+
+func newMetadataParser(ctx context.Context, loader jsonldtype.DocumentLoader, documentURL string) (*metadataParser, error) {
+	mp := &metadataParser{
+		ctx:      ctx,
+		loader:   loader,
+		prefixes: newInitialContext(),
+	}
+
+	if len(documentURL) > 0 {
+		base, err := iri.ParseIRI(documentURL)
+		if err != nil {
+			return nil, fmt.Errorf("parse url: %v", err)
+		}
+
+		mp.base = base
+	}
+
+	return mp, nil
+}
+
+func (mp *metadataParser) fork(documentURL string) (*metadataParser, error) {
+	forked, err := newMetadataParser(mp.ctx, mp.loader, documentURL)
+	if err != nil {
+		return nil, err
+	}
+
+	forked.prefixes = mp.prefixes
+	forked.language = mp.language
+
+	return forked, nil
+}
+
+// newInitialContext includes the prefixes of the CSVW context, which are those of the RDFa initial context and its
+// widely-used prefixes.
+func newInitialContext() *iri.PrefixManager {
+	return iri.NewPrefixManager(rdfacontext.AppendWidelyUsedInitialContext(rdfacontext.AppendInitialContext(nil)))
+}
+
+// expandIRI expands prefixed names of the initial context or resolves the value against the base.
+func (mp *metadataParser) expandIRI(v string) (string, error) {
+	return expandIRI(mp.prefixes, mp.base, v)
+}
+
+func expandIRI(prefixes *iri.PrefixManager, base *iri.ParsedIRI, v string) (string, error) {
+	if prefix, reference, ok := strings.Cut(v, ":"); ok && !strings.HasPrefix(reference, "//") {
+		if expanded, ok := prefixes.ExpandPrefix(iri.PrefixReference{Prefix: prefix, Reference: reference}); ok {
+			return expanded, nil
+		}
+	}
+
+	if base == nil {
+		return v, nil
+	}
+
+	resolved, err := base.Parse(v)
+	if err != nil {
+		return "", err
+	}
+
+	return resolved.String(), nil
+}
+
+func (mp *metadataParser) load(u string) (*metadataParser, inspectjson.ObjectValue, error) {
+	resolved, err := mp.expandIRI(u)
+	if err != nil {
+		return nil, inspectjson.ObjectValue{}, fmt.Errorf("parse url: %v", err)
+	} else if mp.loader == nil {
+		return nil, inspectjson.ObjectValue{}, fmt.Errorf("load (%s): no document loader", resolved)
+	}
+
+	remoteDocument, err := mp.loader.LoadDocument(mp.ctx, resolved, jsonldtype.DocumentLoaderOptions{})
+	if err != nil {
+		return nil, inspectjson.ObjectValue{}, fmt.Errorf("load (%s): %v", resolved, err)
+	}
+
+	object, ok := remoteDocument.Document.(inspectjson.ObjectValue)
+	if !ok {
+		return nil, inspectjson.ObjectValue{}, fmt.Errorf("load (%s): expected object", resolved)
+	}
+
+	documentURL := resolved
+	if remoteDocument.DocumentURL != nil {
+		documentURL = remoteDocument.DocumentURL.String()
+	}
+
+	forked, err := mp.fork(documentURL)
+	if err != nil {
+		return nil, inspectjson.ObjectValue{}, err
+	}
+
+	return forked, object, nil
+}
+
+// parseContext applies the @base and @language of the context, if any.
+func (mp *metadataParser) parseContext(object inspectjson.ObjectValue) error {
+	member, ok := object.Members["@context"]
+	if !ok {
+		return nil
+	}
+
+	switch value := member.Value.(type) {
+	case inspectjson.StringValue:
+		if value.Value != metadataContextIRI {
+			return fmt.Errorf("unsupported context: %s", value.Value)
+		}
+	case inspectjson.ArrayValue:
+		if len(value.Values) != 2 {
+			return fmt.Errorf("expected array of two values")
+		} else if contextIRI, ok := value.Values[0].(inspectjson.StringValue); !ok || contextIRI.Value != metadataContextIRI {
+			return fmt.Errorf("expected %s as the first value", metadataContextIRI)
+		}
+
+		contextObject, ok := value.Values[1].(inspectjson.ObjectValue)
+		if !ok {
+			return fmt.Errorf("expected object as the second value")
+		}
+
+		for _, key := range sortedMemberNames(contextObject) {
+			contextValue, err := parseDialectString(contextObject.Members[key].Value)
+			if err != nil {
+				return fmt.Errorf("%s: %v", key, err)
+			}
+
+			switch key {
+			case "@base":
+				resolved, err := mp.expandIRI(contextValue)
+				if err != nil {
+					return fmt.Errorf("@base: %v", err)
+				}
+
+				mp.base, err = iri.ParseIRI(resolved)
+				if err != nil {
+					return fmt.Errorf("@base: %v", err)
+				}
+			case "@language":
+				mp.language = contextValue
+			default:
+				return fmt.Errorf("%s: unsupported property", key)
+			}
+		}
+	default:
+		return fmt.Errorf("expected string or array")
+	}
+
+	return nil
+}
+
+// parseMetadata parses the top-level description, which is either a table group or a single table. A single table is
+// always returned within a table group.
+func (mp *metadataParser) parseMetadata(object inspectjson.ObjectValue) (*metadataTableGroup, error) {
+	err := mp.parseContext(object)
+	if err != nil {
+		return nil, fmt.Errorf("@context: %v", err)
+	}
+
+	if _, ok := object.Members["tables"]; !ok {
+		table, err := mp.parseTable(object)
+		if err != nil {
+			return nil, err
+		}
+
+		return &metadataTableGroup{
+			tables: []*metadataTable{table},
+		}, nil
+	}
+
+	tg := &metadataTableGroup{
+		common: map[string]inspectjson.Value{},
+	}
+
+	for _, key := range sortedMemberNames(object) {
+		value := object.Members[key].Value
+
+		var err error
+
+		switch key {
+		case "@context", "@type", "tableDirection", "transformations":
+			// handled above, or ignored
+		case "@id":
+			tg.id, err = mp.parseID(value)
+		case "dialect":
+			tg.dialect, err = mp.parseDialect(value)
+		case "notes":
+			tg.notes = parseNotes(value)
+		case "tables":
+			tablesArray, ok := value.(inspectjson.ArrayValue)
+			if !ok {
+				err = fmt.Errorf("expected array")
+
+				break
+			} else if len(tablesArray.Values) == 0 {
+				err = fmt.Errorf("expected at least one table")
+
+				break
+			}
+
+			for tableIdx, tableValue := range tablesArray.Values {
+				tableObject, ok := tableValue.(inspectjson.ObjectValue)
+				if !ok {
+					err = fmt.Errorf("%d: expected object", tableIdx)
+
+					break
+				}
+
+				table, tableErr := mp.parseTable(tableObject)
+				if tableErr != nil {
+					err = fmt.Errorf("%d: %v", tableIdx, tableErr)
+
+					break
+				}
+
+				tg.tables = append(tg.tables, table)
+			}
+		case "tableSchema":
+			tg.tableSchema, err = mp.parseSchema(value)
+		default:
+			var ok bool
+
+			ok, err = tg.inherited.parse(mp, key, value)
+			if !ok && isCommonPropertyName(key) {
+				tg.common[key] = value
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	return tg, nil
+}
+
+func (mp *metadataParser) parseTable(object inspectjson.ObjectValue) (*metadataTable, error) {
+	t := &metadataTable{
+		common: map[string]inspectjson.Value{},
+	}
+
+	for _, key := range sortedMemberNames(object) {
+		value := object.Members[key].Value
+
+		var err error
+
+		switch key {
+		case "@context", "@type", "tableDirection", "transformations":
+			// handled by parseMetadata, or ignored
+		case "@id":
+			t.id, err = mp.parseID(value)
+		case "dialect":
+			t.dialect, err = mp.parseDialect(value)
+		case "notes":
+			t.notes = parseNotes(value)
+		case "suppressOutput":
+			t.suppressOutput, err = parseBoolean(value)
+		case "tableSchema":
+			t.tableSchema, err = mp.parseSchema(value)
+		case "url":
+			var u string
+
+			u, err = parseDialectString(value)
+			if err == nil {
+				t.url, err = mp.expandIRI(u)
+			}
+		default:
+			var ok bool
+
+			ok, err = t.inherited.parse(mp, key, value)
+			if !ok && isCommonPropertyName(key) {
+				t.common[key] = value
+			}
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	if len(t.url) == 0 {
+		return nil, fmt.Errorf("url: expected string")
+	}
+
+	return t, nil
+}
+
+func (mp *metadataParser) parseSchema(v inspectjson.Value) (*metadataSchema, error) {
+	object, ok := v.(inspectjson.ObjectValue)
+	if !ok {
+		u, ok := v.(inspectjson.StringValue)
+		if !ok {
+			return nil, fmt.Errorf("expected object or string")
+		}
+
+		loaded, loadedObject, err := mp.load(u.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		err = loaded.parseContext(loadedObject)
+		if err != nil {
+			return nil, fmt.Errorf("@context: %v", err)
+		}
+
+		return loaded.parseSchema(loadedObject)
+	}
+
+	s := &metadataSchema{}
+
+	for _, key := range sortedMemberNames(object) {
+		value := object.Members[key].Value
+
+		var err error
+
+		switch key {
+		case "@context", "@id", "@type", "foreignKeys", "primaryKey":
+			// handled by parseSchema, or ignored
+		case "columns":
+			columnsArray, ok := value.(inspectjson.ArrayValue)
+			if !ok {
+				err = fmt.Errorf("expected array")
+
+				break
+			}
+
+			for columnIdx, columnValue := range columnsArray.Values {
+				column, columnErr := mp.parseColumn(columnValue)
+				if columnErr != nil {
+					err = fmt.Errorf("%d: %v", columnIdx, columnErr)
+
+					break
+				}
+
+				s.columns = append(s.columns, column)
+			}
+		case "rowTitles":
+			s.rowTitles, err = parseStringOrStrings(value)
+		default:
+			_, err = s.inherited.parse(mp, key, value)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	return s, nil
+}
+
+func (mp *metadataParser) parseColumn(v inspectjson.Value) (*metadataColumn, error) {
+	object, ok := v.(inspectjson.ObjectValue)
+	if !ok {
+		return nil, fmt.Errorf("expected object")
+	}
+
+	c := &metadataColumn{}
+
+	for _, key := range sortedMemberNames(object) {
+		value := object.Members[key].Value
+
+		var err error
+
+		switch key {
+		case "@id", "@type":
+			// ignored
+		case "name":
+			var name string
+
+			name, err = parseDialectString(value)
+			if err == nil {
+				if !isURITemplateVarname(name) || strings.HasPrefix(name, "_") {
+					err = fmt.Errorf("invalid name: %s", name)
+				} else {
+					c.name = &name
+				}
+			}
+		case "suppressOutput":
+			c.suppressOutput, err = parseBoolean(value)
+		case "titles":
+			c.titles, err = mp.parseTitles(value)
+		case "virtual":
+			c.virtual, err = parseBoolean(value)
+		default:
+			_, err = c.inherited.parse(mp, key, value)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", key, err)
+		}
+	}
+
+	return c, nil
+}
+
+// parseTitles parses a natural language property.
+func (mp *metadataParser) parseTitles(v inspectjson.Value) ([]metadataTitle, error) {
+	lang := mp.language
+	if len(lang) == 0 {
+		lang = "und"
+	}
+
+	if object, ok := v.(inspectjson.ObjectValue); ok {
+		var titles []metadataTitle
+
+		for _, key := range sortedMemberNames(object) {
+			values, err := parseStringOrStrings(object.Members[key].Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", key, err)
+			}
+
+			for _, value := range values {
+				titles = append(titles, metadataTitle{
+					value: value,
+					lang:  key,
+				})
+			}
+		}
+
+		return titles, nil
+	}
+
+	values, err := parseStringOrStrings(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var titles []metadataTitle
+
+	for _, value := range values {
+		titles = append(titles, metadataTitle{
+			value: value,
+			lang:  lang,
+		})
+	}
+
+	return titles, nil
+}
+
+func (mp *metadataParser) parseID(v inspectjson.Value) (*string, error) {
+	s, err := parseDialectString(v)
+	if err != nil {
+		return nil, err
+	} else if strings.HasPrefix(s, "_:") {
+		return nil, fmt.Errorf("unsupported blank node identifier: %s", s)
+	}
+
+	expanded, err := mp.expandIRI(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return &expanded, nil
+}
+
+func (mp *metadataParser) parseDialect(v inspectjson.Value) (*Dialect, error) {
+	if u, ok := v.(inspectjson.StringValue); ok {
+		_, loadedObject, err := mp.load(u.Value)
+		if err != nil {
+			return nil, err
+		}
+
+		v = loadedObject
+	}
+
+	d, err := parseDialect(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// parse returns false if the key is not an inherited property.
+func (ip *inheritedProperties) parse(mp *metadataParser, key string, value inspectjson.Value) (bool, error) {
+	var err error
+
+	switch key {
+	case "aboutUrl":
+		ip.aboutURL, err = parseURITemplateValue(value)
+	case "datatype":
+		ip.datatype, err = mp.parseDatatype(value)
+	case "default":
+		var s string
+
+		s, err = parseDialectString(value)
+		ip.defaultValue = &s
+	case "lang":
+		var s string
+
+		s, err = parseDialectString(value)
+		ip.lang = &s
+	case "null":
+		ip.null, err = parseStringOrStrings(value)
+	case "ordered":
+		var b bool
+
+		b, err = parseBoolean(value)
+		ip.ordered = &b
+	case "propertyUrl":
+		ip.propertyURL, err = parseURITemplateValue(value)
+	case "required":
+		var b bool
+
+		b, err = parseBoolean(value)
+		ip.required = &b
+	case "separator":
+		ip.separatorSet = true
+
+		if _, ok := value.(inspectjson.NullValue); !ok {
+			var s string
+
+			s, err = parseDialectString(value)
+			ip.separator = &s
+		}
+	case "textDirection":
+		// ignored
+	case "valueUrl":
+		ip.valueURL, err = parseURITemplateValue(value)
+	default:
+		return false, nil
+	}
+
+	return true, err
+}
+
+func parseURITemplateValue(v inspectjson.Value) (*uriTemplate, error) {
+	s, err := parseDialectString(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseURITemplate(s)
+}
+
+func parseNotes(v inspectjson.Value) []inspectjson.Value {
+	if array, ok := v.(inspectjson.ArrayValue); ok {
+		return array.Values
+	}
+
+	return []inspectjson.Value{v}
+}
+
+//
+
+func isCommonPropertyName(v string) bool {
+	return strings.Contains(v, ":")
+}
+
+func sortedMemberNames(object inspectjson.ObjectValue) []string {
+	keys := make([]string, 0, len(object.Members))
+
+	for key := range object.Members {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+func parseBoolean(v inspectjson.Value) (bool, error) {
+	b, ok := v.(inspectjson.BooleanValue)
+	if !ok {
+		return false, fmt.Errorf("expected boolean")
+	}
+
+	return b.Value, nil
+}
+
+func parseNonNegativeInteger(v inspectjson.Value) (int, error) {
+	n, ok := v.(inspectjson.NumberValue)
+	if !ok || n.Value < 0 || n.Value != math.Trunc(n.Value) || n.Value > math.MaxInt32 {
+		return 0, fmt.Errorf("expected non-negative integer")
+	}
+
+	return int(n.Value), nil
+}
+
+func parseNonNegativeIntegerPtr(v inspectjson.Value) (*int, error) {
+	n, err := parseNonNegativeInteger(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &n, nil
+}
+
+func parseStringOrStrings(v inspectjson.Value) ([]string, error) {
+	switch vT := v.(type) {
+	case inspectjson.StringValue:
+		return []string{vT.Value}, nil
+	case inspectjson.ArrayValue:
+		values := make([]string, 0, len(vT.Values))
+
+		for _, item := range vT.Values {
+			itemString, ok := item.(inspectjson.StringValue)
+			if !ok {
+				return nil, fmt.Errorf("expected string")
+			}
+
+			values = append(values, itemString.Value)
+		}
+
+		return values, nil
+	}
+
+	return nil, fmt.Errorf("expected string or array")
+}
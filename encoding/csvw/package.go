@@ -0,0 +1,4 @@
+// see https://www.w3.org/TR/2015/REC-tabular-data-model-20151217/
+// see https://www.w3.org/TR/2015/REC-tabular-metadata-20151217/
+// see https://www.w3.org/TR/2015/REC-csv2rdf-20151217/
+package csvw
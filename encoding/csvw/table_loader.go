@@ -0,0 +1,18 @@
+package csvw
+
+import (
+	"context"
+	"io"
+)
+
+// TableLoader opens the tabular data of a table, by its URL, when it is not the data given to the decoder. It is
+// used for the other tables of a table group.
+type TableLoader interface {
+	LoadTable(ctx context.Context, url string) (io.ReadCloser, error)
+}
+
+type TableLoaderFunc func(ctx context.Context, url string) (io.ReadCloser, error)
+
+func (f TableLoaderFunc) LoadTable(ctx context.Context, url string) (io.ReadCloser, error) {
+	return f(ctx, url)
+}
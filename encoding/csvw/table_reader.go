@@ -0,0 +1,312 @@
+package csvw
+
+import (
+	"bytes"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+)
+
+type tableReaderCell struct {
+	value   string
+	offsets *cursorio.TextOffsetRange
+}
+
+type tableReaderRow struct {
+	// content is the raw text of the row, used for comments.
+	content string
+	cells   []tableReaderCell
+	offsets *cursorio.TextOffsetRange
+}
+
+// tableReader splits tabular data into rows and cells according to a dialect. It follows the steps of parsing rows
+// and cells from the tabular data model while keeping track of the source offsets of each row and cell.
+//
+// Data is read incrementally, so buf only holds the data from the current row onwards.
+type tableReader struct {
+	r       io.Reader
+	err     error
+	eof     bool
+	buf     []byte
+	pos     int
+	dialect Dialect
+
+	escapeChar string
+
+	tw    *cursorio.TextWriter
+	twPos int
+}
+
+func newTableReader(r io.Reader, dialect Dialect, initialTextOffset *cursorio.TextOffset) *tableReader {
+	tr := &tableReader{
+		r:          r,
+		dialect:    dialect,
+		escapeChar: `\`,
+	}
+
+	if dialect.DoubleQuote {
+		tr.escapeChar = dialect.QuoteChar
+	}
+
+	if initialTextOffset != nil {
+		tr.tw = cursorio.NewTextWriter(*initialTextOffset)
+	}
+
+	if tr.hasPrefixAt(0, "\ufeff") {
+		// [dpb] offsets continue to refer to the original input, including any byte order mark
+		tr.pos = len("\ufeff")
+		tr.offsetRange(tr.pos, tr.pos)
+	}
+
+	return tr
+}
+
+// Err returns the error of the underlying reader, if any.
+func (tr *tableReader) Err() error {
+	return tr.err
+}
+
+// fill reads until buf has more than n bytes, returning false if the data ends first.
+func (tr *tableReader) fill(n int) bool {
+	for len(tr.buf) <= n && !tr.eof {
+		if len(tr.buf) == cap(tr.buf) {
+			tr.buf = slices.Grow(tr.buf, max(4096, len(tr.buf)))
+		}
+
+		read, err := tr.r.Read(tr.buf[len(tr.buf):cap(tr.buf)])
+		tr.buf = tr.buf[:len(tr.buf)+read]
+
+		if err == io.EOF {
+			tr.eof = true
+		} else if err != nil {
+			tr.err = err
+			tr.eof = true
+		}
+	}
+
+	return len(tr.buf) > n
+}
+
+// discard drops the data which was already read, after writing it for any offsets.
+func (tr *tableReader) discard() {
+	if tr.tw != nil {
+		tr.tw.Write(tr.buf[tr.twPos:tr.pos])
+	}
+
+	tr.buf = tr.buf[:copy(tr.buf, tr.buf[tr.pos:])]
+	tr.pos = 0
+	tr.twPos = 0
+}
+
+func (tr *tableReader) offsetRange(from, until int) *cursorio.TextOffsetRange {
+	if tr.tw == nil {
+		return nil
+	}
+
+	tr.tw.Write(tr.buf[tr.twPos:from])
+	tr.twPos = from
+
+	r := tr.tw.WriteForOffsetRange(tr.buf[from:until])
+	tr.twPos = until
+
+	return &r
+}
+
+// readRow returns false once all data has been read or reading fails.
+func (tr *tableReader) readRow() (tableReaderRow, bool) {
+	tr.discard()
+
+	if !tr.fill(0) {
+		return tableReaderRow{}, false
+	}
+
+	rowFrom := tr.pos
+	rowUntil := -1
+	next := -1
+
+	var quoted bool
+
+	for pos := rowFrom; tr.fill(pos); {
+		if len(tr.dialect.QuoteChar) > 0 {
+			if quoted && tr.hasPrefixAt(pos, tr.escapeChar) && tr.hasPrefixAt(pos+len(tr.escapeChar), tr.dialect.QuoteChar) {
+				pos += len(tr.escapeChar) + len(tr.dialect.QuoteChar)
+
+				continue
+			} else if tr.hasPrefixAt(pos, tr.dialect.QuoteChar) {
+				quoted = !quoted
+				pos += len(tr.dialect.QuoteChar)
+
+				continue
+			}
+		}
+
+		if !quoted {
+			if lineTerminator, ok := tr.lineTerminatorAt(pos); ok {
+				rowUntil = pos
+				next = pos + len(lineTerminator)
+
+				break
+			}
+		}
+
+		pos++
+	}
+
+	if rowUntil == -1 {
+		if tr.err != nil {
+			// the row may be incomplete
+			return tableReaderRow{}, false
+		}
+
+		rowUntil = len(tr.buf)
+		next = len(tr.buf)
+	}
+
+	tr.pos = next
+
+	row := tableReaderRow{
+		content: string(tr.buf[rowFrom:rowUntil]),
+	}
+
+	if len(tr.dialect.CommentPrefix) > 0 && strings.HasPrefix(row.content, tr.dialect.CommentPrefix) {
+		// comments are not split into cells
+		return row, true
+	}
+
+	row.cells = tr.parseCells(rowFrom, rowUntil)
+
+	if tr.tw != nil {
+		// cells always span the whole row
+		row.offsets = &cursorio.TextOffsetRange{
+			From:  row.cells[0].offsets.From,
+			Until: row.cells[len(row.cells)-1].offsets.Until,
+		}
+	}
+
+	return row, true
+}
+
+func (tr *tableReader) parseCells(from, until int) []tableReaderCell {
+	var cells []tableReaderCell
+	var value strings.Builder
+	var quoted bool
+
+	// quoted content of the value is never trimmed
+	quotedFrom, quotedUntil := -1, -1
+
+	cellFrom := from
+
+	appendCell := func(cellUntil int) {
+		cells = append(cells, tableReaderCell{
+			value:   tr.trim(value.String(), quotedFrom, quotedUntil),
+			offsets: tr.offsetRange(cellFrom, cellUntil),
+		})
+
+		value.Reset()
+
+		quotedFrom, quotedUntil = -1, -1
+	}
+
+	for pos := from; pos < until; {
+		if len(tr.dialect.QuoteChar) > 0 {
+			if quoted && tr.hasPrefixAt(pos, tr.escapeChar) && tr.hasPrefixAt(pos+len(tr.escapeChar), tr.dialect.QuoteChar) {
+				value.WriteString(tr.dialect.QuoteChar)
+				pos += len(tr.escapeChar) + len(tr.dialect.QuoteChar)
+
+				continue
+			} else if quoted && tr.escapeChar != tr.dialect.QuoteChar && tr.hasPrefixAt(pos, tr.escapeChar) && pos+len(tr.escapeChar) < until {
+				pos += len(tr.escapeChar)
+				value.WriteByte(tr.buf[pos])
+				pos++
+
+				continue
+			} else if tr.hasPrefixAt(pos, tr.dialect.QuoteChar) {
+				pos += len(tr.dialect.QuoteChar)
+
+				if !quoted {
+					if value.Len() > 0 {
+						value.WriteString(tr.dialect.QuoteChar)
+					}
+
+					if quotedFrom == -1 {
+						quotedFrom = value.Len()
+					}
+
+					quoted = true
+				} else {
+					quoted = false
+					quotedUntil = value.Len()
+
+					if pos < until && !tr.hasPrefixAt(pos, tr.dialect.Delimiter) {
+						value.WriteString(tr.dialect.QuoteChar)
+					}
+				}
+
+				continue
+			}
+		}
+
+		if !quoted && tr.hasPrefixAt(pos, tr.dialect.Delimiter) {
+			appendCell(pos)
+
+			pos += len(tr.dialect.Delimiter)
+			cellFrom = pos
+
+			if tr.dialect.SkipInitialSpace {
+				for pos < until && (tr.buf[pos] == ' ' || tr.buf[pos] == '\t') {
+					pos++
+				}
+			}
+
+			continue
+		}
+
+		value.WriteByte(tr.buf[pos])
+		pos++
+	}
+
+	appendCell(until)
+
+	return cells
+}
+
+func (tr *tableReader) trim(v string, quotedFrom, quotedUntil int) string {
+	if tr.dialect.TrimEnd {
+		if quotedUntil == -1 {
+			v = strings.TrimRightFunc(v, unicode.IsSpace)
+		} else {
+			v = v[:quotedUntil] + strings.TrimRightFunc(v[quotedUntil:], unicode.IsSpace)
+		}
+	}
+
+	if tr.dialect.TrimStart {
+		if quotedFrom == -1 {
+			v = strings.TrimLeftFunc(v, unicode.IsSpace)
+		} else {
+			v = strings.TrimLeftFunc(v[:quotedFrom], unicode.IsSpace) + v[quotedFrom:]
+		}
+	}
+
+	return v
+}
+
+func (tr *tableReader) hasPrefixAt(pos int, v string) bool {
+	if len(v) == 0 || !tr.fill(pos+len(v)-1) {
+		return false
+	}
+
+	return bytes.HasPrefix(tr.buf[pos:], []byte(v))
+}
+
+func (tr *tableReader) lineTerminatorAt(pos int) (string, bool) {
+	for _, lineTerminator := range tr.dialect.LineTerminators {
+		if tr.hasPrefixAt(pos, lineTerminator) {
+			return lineTerminator, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,21 @@
+package testsuite
+
+import "github.com/dpb587/rdfkit-go/rdf"
+
+type manifestSchema struct {
+	Entries []struct {
+		ID       rdf.IRI                   `json:"id"`
+		Type     string                    `json:"type"`
+		Action   string                    `json:"action"`
+		Result   string                    `json:"result"`
+		Implicit []string                  `json:"implicit"`
+		HTTPLink string                    `json:"httpLink"`
+		Option   manifestSchemaEntryOption `json:"option"`
+	} `json:"entries"`
+}
+
+type manifestSchemaEntryOption struct {
+	Metadata string `json:"metadata"`
+	Minimal  bool   `json:"minimal"`
+	NoProv   bool   `json:"noProv"`
+}
@@ -0,0 +1,33 @@
+#!/bin/bash
+
+set -euo pipefail
+
+cd "$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+
+rm -fr testdata/
+
+mkdir testdata/
+
+curl -Lo testdata/manifest-rdf.jsonld https://w3c.github.io/csvw/tests/manifest-rdf.jsonld
+
+iter() {
+  while read -r p; do
+    mkdir -p "$( dirname "testdata/${p}" )"
+    curl -fLo "testdata/${p}" "https://w3c.github.io/csvw/tests/${p}" || true
+  done 
+}
+
+iter < <(
+  jq -r '.entries[].action | select(.)' testdata/manifest-rdf.jsonld
+  jq -r '.entries[].result | select(.)' testdata/manifest-rdf.jsonld
+  jq -r '.entries[].implicit | select(.) | .[]' testdata/manifest-rdf.jsonld
+  jq -r '.entries[].option.metadata | select(.)' testdata/manifest-rdf.jsonld
+)
+
+cd testdata/
+
+GZIP=-9 tar -czf ../testdata.tar.gz ./
+
+cd ../
+
+rm -fr testdata/
@@ -0,0 +1,255 @@
+package testsuite
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/dev/earltestingutil"
+	"github.com/dpb587/rdfkit-go/encoding/csvw"
+	"github.com/dpb587/rdfkit-go/encoding/encodingtest"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/encoding/turtle"
+	"github.com/dpb587/rdfkit-go/ontology/earl/earliri"
+	"github.com/dpb587/rdfkit-go/ontology/earl/earltesting"
+	"github.com/dpb587/rdfkit-go/ontology/foaf/foafiri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/rdfutil"
+	"github.com/dpb587/rdfkit-go/rdf/triples"
+	"github.com/dpb587/rdfkit-go/rdfdescription"
+	"github.com/dpb587/rdfkit-go/testing/testingarchive"
+	"github.com/dpb587/rdfkit-go/testing/testingassert"
+	"github.com/dpb587/rdfkit-go/testing/testingutil"
+)
+
+// manifestPrefix is the location the tests assume, rather than where they are published.
+const manifestPrefix = "http://www.w3.org/2013/csvw/tests/"
+
+func Test(t *testing.T) {
+	testdata, testdataManifest := requireTestdata(t)
+
+	earlReport := earltesting.NewReportFromEnv(t).
+		WithAssertor(
+			rdf.IRI("#assertor"),
+			rdfdescription.NewStatementsFromObjectsByPredicate(rdfutil.ObjectsByPredicate{
+				rdfiri.Type_Property: rdf.ObjectValueList{
+					earliri.Software_Class,
+				},
+				foafiri.Name_Property: rdf.ObjectValueList{
+					xsdobject.String("rdfkit-go test suite"),
+				},
+				foafiri.Homepage_Property: rdf.ObjectValueList{
+					rdf.IRI("https://github.com/dpb587/rdfkit-go/tree/main/encoding/csvw/testsuites/w3c-github-csvw-rdf"),
+				},
+			})...,
+		).
+		WithSubject(
+			rdf.IRI("#subject"),
+			rdfdescription.NewStatementsFromObjectsByPredicate(rdfutil.ObjectsByPredicate{
+				rdfiri.Type_Property: rdf.ObjectValueList{
+					earliri.Software_Class,
+					rdf.IRI("http://usefulinc.com/ns/doap#Project"),
+				},
+				foafiri.Name_Property: rdf.ObjectValueList{
+					xsdobject.String("rdfkit-go/encoding/csvw"),
+				},
+				foafiri.Homepage_Property: rdf.ObjectValueList{
+					rdf.IRI("https://pkg.go.dev/github.com/dpb587/rdfkit-go/encoding/csvw"),
+				},
+				rdf.IRI("http://usefulinc.com/ns/doap#programming-language"): rdf.ObjectValueList{
+					xsdobject.String("Go"),
+				},
+				rdf.IRI("http://usefulinc.com/ns/doap#repository"): rdf.ObjectValueList{
+					rdf.IRI("https://github.com/dpb587/rdfkit-go"),
+				},
+			})...,
+		)
+
+	earltestingutil.ReportSummaryFromEnv(t, earlReport, earltestingutil.DefaultReportSummaryOptions)
+	rdfioDebug := testingutil.NewDebugRdfioBuilderFromEnv(t)
+
+	loadDocument := func(u string) (jsonldtype.RemoteDocument, error) {
+		u, _, _ = strings.Cut(u, "#")
+
+		if !testdata.HasFile(u) {
+			return jsonldtype.RemoteDocument{}, fmt.Errorf("unknown url: %s", u)
+		}
+
+		doc, err := inspectjson.Parse(testdata.NewFileByteReader(t, u))
+		if err != nil {
+			return jsonldtype.RemoteDocument{}, fmt.Errorf("parse: %v", err)
+		}
+
+		docURL, err := url.Parse(u)
+		if err != nil {
+			return jsonldtype.RemoteDocument{}, fmt.Errorf("parse url: %v", err)
+		}
+
+		return jsonldtype.RemoteDocument{
+			ContentType: "application/csvm+json",
+			Document:    doc,
+			DocumentURL: docURL,
+		}, nil
+	}
+
+	for _, entry := range testdataManifest.Entries {
+		t.Run(string(entry.ID), func(t *testing.T) {
+			tAssertion := earlReport.NewAssertion(t, entry.ID)
+
+			if len(entry.HTTPLink) > 0 {
+				tAssertion.Skip(earliri.Untested_NotTested, "Link headers of tabular data are not supported")
+			}
+
+			decodeAction := func() (encodingtest.QuadStatementList, error) {
+				actionURL := manifestPrefix + entry.Action
+				tableURL := actionURL
+
+				dopt := csvw.DecoderConfig{}.
+					SetDocumentLoader(jsonldtype.DocumentLoaderFunc(func(ctx context.Context, u string, opts jsonldtype.DocumentLoaderOptions) (jsonldtype.RemoteDocument, error) {
+						return loadDocument(u)
+					})).
+					SetTableLoader(csvw.TableLoaderFunc(func(ctx context.Context, u string) (io.ReadCloser, error) {
+						u, _, _ = strings.Cut(u, "#")
+
+						if !testdata.HasFile(u) {
+							return nil, fmt.Errorf("unknown url: %s", u)
+						}
+
+						return io.NopCloser(testdata.NewFileByteReader(t, u)), nil
+					})).
+					SetCaptureTextOffsets(true)
+
+				if entry.Option.Minimal {
+					dopt = dopt.SetMode(csvw.ModeMinimal)
+				}
+
+				metadataURL := entry.Option.Metadata
+				if len(metadataURL) > 0 {
+					metadataURL = manifestPrefix + metadataURL
+				} else if strings.HasSuffix(entry.Action, ".json") {
+					// the action is the metadata and the decoder reads its first table
+
+					metadataURL = actionURL
+
+					var tableGroup struct {
+						URL    string `json:"url"`
+						Tables []struct {
+							URL string `json:"url"`
+						} `json:"tables"`
+					}
+
+					err := json.Unmarshal(testdata.GetFileBytes(t, actionURL), &tableGroup)
+					if err != nil {
+						return nil, fmt.Errorf("metadata: %v", err)
+					}
+
+					tableRef := tableGroup.URL
+					if len(tableGroup.Tables) > 0 {
+						tableRef = tableGroup.Tables[0].URL
+					}
+
+					parsedTableURL, err := url.Parse(actionURL)
+					if err == nil {
+						parsedTableURL, err = parsedTableURL.Parse(tableRef)
+					}
+
+					if err != nil {
+						return nil, fmt.Errorf("metadata: table url: %v", err)
+					}
+
+					tableURL = parsedTableURL.String()
+				}
+
+				if len(metadataURL) > 0 {
+					remoteDocument, err := loadDocument(metadataURL)
+					if err != nil {
+						return nil, fmt.Errorf("metadata: %v", err)
+					}
+
+					dopt = dopt.SetMetadataDocument(remoteDocument)
+				}
+
+				if strings.HasSuffix(tableURL, ".tsv") {
+					dialect := csvw.DefaultDialect
+					dialect.Delimiter = "\t"
+
+					dopt = dopt.SetDialect(dialect)
+				}
+
+				if !testdata.HasFile(tableURL) {
+					return nil, fmt.Errorf("unknown url: %s", tableURL)
+				}
+
+				return encodingtest.CollectQuadStatementsErr(csvw.NewDecoder(
+					testdata.NewFileByteReader(t, tableURL),
+					dopt.SetDefaultBase(tableURL),
+				))
+			}
+
+			switch entry.Type {
+			case "csvt:NegativeRdfTest":
+				_, err := decodeAction()
+				if err != nil {
+					tAssertion.Logf("error (expected): %v", err)
+				} else {
+					t.Fatal("expected error, but got none")
+				}
+			case "csvt:ToRdfTest", "csvt:ToRdfTestWithWarnings":
+				expectedStatements, err := triples.CollectErr(turtle.NewDecoder(
+					testdata.NewFileByteReader(t, manifestPrefix+entry.Result),
+					turtle.DecoderConfig{}.
+						SetDefaultBase(manifestPrefix+entry.Result),
+				))
+				if err != nil {
+					tAssertion.Fatalf("setup error: decode result: %v", err)
+				}
+
+				actualStatements, err := decodeAction()
+				if err != nil {
+					tAssertion.Fatalf("error: %v", err)
+				}
+
+				testingassert.IsomorphicDatasets(t.Context(), tAssertion, expectedStatements.AsQuads(nil), actualStatements.AsQuads())
+
+				rdfioDebug.PutQuadsBundle(t.Name(), actualStatements)
+			default:
+				t.Fatalf("unsupported test type: %v", entry.Type)
+			}
+		})
+	}
+}
+
+func requireTestdata(t *testing.T) (testingarchive.Archive, manifestSchema) {
+	if _, err := os.Stat("testdata.tar.gz"); err != nil {
+		t.Skipf("testdata.tar.gz is not available; see testdata.sh")
+	}
+
+	testdata := testingarchive.OpenTarGz(
+		t,
+		"testdata.tar.gz",
+		func(v string) string {
+			return manifestPrefix + strings.TrimPrefix(v, "./")
+		},
+	)
+
+	// avoiding cyclical usage of csvw for testing
+	var loadedManifest manifestSchema
+
+	if err := json.Unmarshal(testdata.GetFileBytes(t, manifestPrefix+"manifest-rdf.jsonld"), &loadedManifest); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	for entryIdx, entry := range loadedManifest.Entries {
+		loadedManifest.Entries[entryIdx].ID = rdf.IRI(manifestPrefix + string(entry.ID))
+	}
+
+	return testdata, loadedManifest
+}
@@ -0,0 +1,316 @@
+package csvw
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// uriTemplateValue is a string, []string, or nil for an undefined variable.
+type uriTemplateValue any
+
+type uriTemplateVariables map[string]uriTemplateValue
+
+// uriTemplate is a parsed URI Template of [RFC 6570], supporting all operators and modifiers of level 4. Associative
+// array values are not supported since cell values are never maps.
+//
+// [RFC 6570]: https://www.rfc-editor.org/rfc/rfc6570
+type uriTemplate struct {
+	raw   string
+	parts []uriTemplatePart
+}
+
+type uriTemplatePart struct {
+	literal string
+
+	expression *uriTemplateExpression
+}
+
+type uriTemplateExpression struct {
+	operator  byte
+	varspecs  []uriTemplateVarspec
+	first     string
+	separator string
+	named     bool
+	ifEmpty   string
+	reserved  bool
+}
+
+type uriTemplateVarspec struct {
+	name      string
+	maxLength int
+	explode   bool
+}
+
+func parseURITemplate(v string) (*uriTemplate, error) {
+	t := &uriTemplate{
+		raw: v,
+	}
+
+	for len(v) > 0 {
+		openIdx := strings.IndexByte(v, '{')
+		if openIdx == -1 {
+			t.parts = append(t.parts, uriTemplatePart{literal: v})
+
+			break
+		} else if openIdx > 0 {
+			t.parts = append(t.parts, uriTemplatePart{literal: v[:openIdx]})
+		}
+
+		closeIdx := strings.IndexByte(v[openIdx:], '}')
+		if closeIdx == -1 {
+			return nil, fmt.Errorf("unterminated expression")
+		}
+
+		expression, err := parseURITemplateExpression(v[openIdx+1 : openIdx+closeIdx])
+		if err != nil {
+			return nil, err
+		}
+
+		t.parts = append(t.parts, uriTemplatePart{expression: expression})
+
+		v = v[openIdx+closeIdx+1:]
+	}
+
+	return t, nil
+}
+
+func mustParseURITemplate(v string) *uriTemplate {
+	t, err := parseURITemplate(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return t
+}
+
+func parseURITemplateExpression(v string) (*uriTemplateExpression, error) {
+	if len(v) == 0 {
+		return nil, fmt.Errorf("empty expression")
+	}
+
+	e := &uriTemplateExpression{
+		separator: ",",
+	}
+
+	switch v[0] {
+	case '+':
+		e.reserved = true
+	case '#':
+		e.first = "#"
+		e.reserved = true
+	case '.':
+		e.first = "."
+		e.separator = "."
+	case '/':
+		e.first = "/"
+		e.separator = "/"
+	case ';':
+		e.first = ";"
+		e.separator = ";"
+		e.named = true
+	case '?':
+		e.first = "?"
+		e.separator = "&"
+		e.named = true
+		e.ifEmpty = "="
+	case '&':
+		e.first = "&"
+		e.separator = "&"
+		e.named = true
+		e.ifEmpty = "="
+	case '=', ',', '!', '@', '|':
+		return nil, fmt.Errorf("reserved operator: %c", v[0])
+	}
+
+	if e.first != "" || e.reserved {
+		e.operator = v[0]
+		v = v[1:]
+	}
+
+	for _, rawVarspec := range strings.Split(v, ",") {
+		varspec := uriTemplateVarspec{}
+
+		if name, ok := strings.CutSuffix(rawVarspec, "*"); ok {
+			varspec.explode = true
+			rawVarspec = name
+		} else if name, maxLength, ok := strings.Cut(rawVarspec, ":"); ok {
+			parsed, err := strconv.Atoi(maxLength)
+			if err != nil || parsed <= 0 || parsed >= 10000 {
+				return nil, fmt.Errorf("invalid prefix modifier: %s", maxLength)
+			}
+
+			varspec.maxLength = parsed
+			rawVarspec = name
+		}
+
+		if !isURITemplateVarname(rawVarspec) {
+			return nil, fmt.Errorf("invalid variable name: %s", rawVarspec)
+		}
+
+		varspec.name = rawVarspec
+
+		e.varspecs = append(e.varspecs, varspec)
+	}
+
+	return e, nil
+}
+
+func isURITemplateVarname(v string) bool {
+	if len(v) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9', c == '_':
+			// valid
+		case c == '.' && i > 0 && v[i-1] != '.':
+			// valid
+		case c == '%' && i+2 < len(v) && isHex(v[i+1]) && isHex(v[i+2]):
+			i += 2
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func (t *uriTemplate) String() string {
+	return t.raw
+}
+
+func (t *uriTemplate) Expand(vars uriTemplateVariables) string {
+	var sb strings.Builder
+
+	for _, part := range t.parts {
+		if part.expression == nil {
+			sb.WriteString(part.literal)
+
+			continue
+		}
+
+		part.expression.expand(&sb, vars)
+	}
+
+	return sb.String()
+}
+
+func (e *uriTemplateExpression) expand(sb *strings.Builder, vars uriTemplateVariables) {
+	var wroteFirst bool
+
+	writeSeparator := func() {
+		if !wroteFirst {
+			sb.WriteString(e.first)
+			wroteFirst = true
+		} else {
+			sb.WriteString(e.separator)
+		}
+	}
+
+	for _, varspec := range e.varspecs {
+		switch value := vars[varspec.name].(type) {
+		case string:
+			writeSeparator()
+
+			if e.named {
+				sb.WriteString(varspec.name)
+
+				if len(value) == 0 {
+					sb.WriteString(e.ifEmpty)
+
+					continue
+				}
+
+				sb.WriteString("=")
+			}
+
+			if varspec.maxLength > 0 && utf8.RuneCountInString(value) > varspec.maxLength {
+				value = string([]rune(value)[:varspec.maxLength])
+			}
+
+			sb.WriteString(e.encode(value))
+		case []string:
+			if len(value) == 0 {
+				continue
+			}
+
+			writeSeparator()
+
+			if varspec.explode {
+				for itemIdx, item := range value {
+					if itemIdx > 0 {
+						sb.WriteString(e.separator)
+					}
+
+					if e.named {
+						sb.WriteString(varspec.name)
+
+						if len(item) == 0 {
+							sb.WriteString(e.ifEmpty)
+
+							continue
+						}
+
+						sb.WriteString("=")
+					}
+
+					sb.WriteString(e.encode(item))
+				}
+
+				continue
+			}
+
+			if e.named {
+				sb.WriteString(varspec.name)
+				sb.WriteString("=")
+			}
+
+			for itemIdx, item := range value {
+				if itemIdx > 0 {
+					sb.WriteString(",")
+				}
+
+				sb.WriteString(e.encode(item))
+			}
+		}
+	}
+}
+
+func (e *uriTemplateExpression) encode(v string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+
+		if isURITemplateUnreserved(c) {
+			sb.WriteByte(c)
+		} else if e.reserved && isURITemplateReserved(c) {
+			sb.WriteByte(c)
+		} else if e.reserved && c == '%' && i+2 < len(v) && isHex(v[i+1]) && isHex(v[i+2]) {
+			sb.WriteString(v[i : i+3])
+
+			i += 2
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+
+	return sb.String()
+}
+
+func isURITemplateUnreserved(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-' || c == '.' || c == '_' || c == '~'
+}
+
+func isURITemplateReserved(c byte) bool {
+	return strings.IndexByte(":/?#[]@!$&'()*+,;=", c) != -1
+}
+
+func isHex(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
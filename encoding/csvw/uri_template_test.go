@@ -0,0 +1,48 @@
+package csvw
+
+import "testing"
+
+func TestURITemplate(t *testing.T) {
+	// https://www.rfc-editor.org/rfc/rfc6570#section-1.2
+	vars := uriTemplateVariables{
+		"var":   "value",
+		"hello": "Hello World!",
+		"path":  "/foo/bar",
+		"empty": "",
+		"list":  []string{"red", "green", "blue"},
+		"x":     "1024",
+		"y":     "768",
+	}
+
+	for _, testcase := range []struct {
+		Template string
+		Expected string
+	}{
+		{"{var}", "value"},
+		{"{hello}", "Hello%20World%21"},
+		{"{+hello}", "Hello%20World!"},
+		{"{+path}/here", "/foo/bar/here"},
+		{"{#hello}", "#Hello%20World!"},
+		{"{undef}", ""},
+		{"{var:3}", "val"},
+		{"{list}", "red,green,blue"},
+		{"{list*}", "red,green,blue"},
+		{"{.list*}", ".red.green.blue"},
+		{"{/var,x}/here", "/value/1024/here"},
+		{"{;x,y,empty}", ";x=1024;y=768;empty"},
+		{"{?x,y,empty}", "?x=1024&y=768&empty="},
+		{"{?list*}", "?list=red&list=green&list=blue"},
+		{"?fixed=yes{&x}", "?fixed=yes&x=1024"},
+	} {
+		t.Run(testcase.Template, func(t *testing.T) {
+			template, err := parseURITemplate(testcase.Template)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _a, _e := template.Expand(vars), testcase.Expected; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
@@ -133,33 +133,8 @@ func (w *QuadsEncoder) AddQuadStatement(_ context.Context, s QuadStatement) erro
 			{
 				w.w.Write([]byte("\t\t\t\tObject: "))
 
-				switch object := s.Quad.Triple.Object.(type) {
-				case rdf.BlankNode:
-					fmt.Fprintf(w.w, "%s.NewStringBlankNode(%q)", w.opts.BlankNodeStringFactoryVar, w.opts.BlankNodeStringProvider.GetBlankNodeString(object))
-				case rdf.IRI:
-					fmt.Fprintf(w.w, "rdf.IRI(%q)", object)
-				case rdf.Literal:
-					fmt.Fprintf(w.w, "rdf.Literal{\n")
-					fmt.Fprintf(w.w, "\t\t\t\t\tLexicalForm: %q,\n", object.LexicalForm)
-					fmt.Fprintf(w.w, "\t\t\t\t\tDatatype: rdf.IRI(%q),\n", object.Datatype)
-
-					if object.Tag != nil {
-						switch tag := object.Tag.(type) {
-						case rdf.LanguageLiteralTag:
-							fmt.Fprintf(w.w, "\t\t\t\t\tTag: rdf.LanguageLiteralTag{\n")
-							fmt.Fprintf(w.w, "\t\t\t\t\t\tLanguage: %q,\n", tag.Language)
-							fmt.Fprintf(w.w, "\t\t\t\t\t},\n")
-						case rdf.DirectionalLanguageLiteralTag:
-							fmt.Fprintf(w.w, "\t\t\t\t\tTag: rdf.DirectionalLanguageLiteralTag{\n")
-							fmt.Fprintf(w.w, "\t\t\t\t\t\tLanguage: %q,\n", tag.Language)
-							fmt.Fprintf(w.w, "\t\t\t\t\t\tBaseDirection: %q,\n", tag.BaseDirection)
-							fmt.Fprintf(w.w, "\t\t\t\t\t},\n")
-						}
-					}
-
-					w.w.Write([]byte("\t\t\t\t}"))
-				default:
-					return fmt.Errorf("unsupported object type: %T", object)
+				if err := w.writeObject("\t\t\t\t", s.Quad.Triple.Object); err != nil {
+					return err
 				}
 
 				w.w.Write([]byte(",\n"))
@@ -190,19 +165,23 @@ func (w *QuadsEncoder) AddQuadStatement(_ context.Context, s QuadStatement) erro
 		w.w.Write([]byte("\t\tTextOffsets: encoding.StatementTextOffsets{\n"))
 
 		if v, ok := s.TextOffsets[encoding.SubjectStatementOffsets]; ok {
-			w.writeOffsetRange("\t\t\t", "Subject", v)
+			w.writeOffsetRange("\t\t\t", "encoding.SubjectStatementOffsets", v)
 		}
 
 		if v, ok := s.TextOffsets[encoding.PredicateStatementOffsets]; ok {
-			w.writeOffsetRange("\t\t\t", "Predicate", v)
+			w.writeOffsetRange("\t\t\t", "encoding.PredicateStatementOffsets", v)
 		}
 
 		if v, ok := s.TextOffsets[encoding.ObjectStatementOffsets]; ok {
-			w.writeOffsetRange("\t\t\t", "Object", v)
+			w.writeOffsetRange("\t\t\t", "encoding.ObjectStatementOffsets", v)
 		}
 
 		if v, ok := s.TextOffsets[encoding.GraphNameStatementOffsets]; ok {
-			w.writeOffsetRange("\t\t\t", "GraphName", v)
+			w.writeOffsetRange("\t\t\t", "encoding.GraphNameStatementOffsets", v)
+		}
+
+		for _, k := range tripleTermStatementOffsetsTypes(s.TextOffsets) {
+			w.writeOffsetRange("\t\t\t", statementOffsetsTypeGoString(k), s.TextOffsets[k])
 		}
 
 		w.w.Write([]byte("\t\t},\n"))
@@ -213,9 +192,71 @@ func (w *QuadsEncoder) AddQuadStatement(_ context.Context, s QuadStatement) erro
 	return nil
 }
 
+func (w *QuadsEncoder) writeObject(indent string, object rdf.ObjectValue) error {
+	switch object := object.(type) {
+	case rdf.BlankNode:
+		fmt.Fprintf(w.w, "%s.NewStringBlankNode(%q)", w.opts.BlankNodeStringFactoryVar, w.opts.BlankNodeStringProvider.GetBlankNodeString(object))
+	case rdf.IRI:
+		fmt.Fprintf(w.w, "rdf.IRI(%q)", object)
+	case rdf.Literal:
+		fmt.Fprintf(w.w, "rdf.Literal{\n")
+		fmt.Fprintf(w.w, "%s\tLexicalForm: %q,\n", indent, object.LexicalForm)
+		fmt.Fprintf(w.w, "%s\tDatatype: rdf.IRI(%q),\n", indent, object.Datatype)
+
+		if object.Tag != nil {
+			switch tag := object.Tag.(type) {
+			case rdf.LanguageLiteralTag:
+				fmt.Fprintf(w.w, "%s\tTag: rdf.LanguageLiteralTag{\n", indent)
+				fmt.Fprintf(w.w, "%s\t\tLanguage: %q,\n", indent, tag.Language)
+				fmt.Fprintf(w.w, "%s\t},\n", indent)
+			case rdf.DirectionalLanguageLiteralTag:
+				fmt.Fprintf(w.w, "%s\tTag: rdf.DirectionalLanguageLiteralTag{\n", indent)
+				fmt.Fprintf(w.w, "%s\t\tLanguage: %q,\n", indent, tag.Language)
+				fmt.Fprintf(w.w, "%s\t\tBaseDirection: %q,\n", indent, tag.BaseDirection)
+				fmt.Fprintf(w.w, "%s\t},\n", indent)
+			}
+		}
+
+		fmt.Fprintf(w.w, "%s}", indent)
+	case rdf.TripleTerm:
+		fmt.Fprintf(w.w, "rdf.TripleTerm{\n")
+		fmt.Fprintf(w.w, "%s\tSubject: ", indent)
+
+		switch subject := object.Subject.(type) {
+		case rdf.BlankNode:
+			fmt.Fprintf(w.w, "%s.NewStringBlankNode(%q)", w.opts.BlankNodeStringFactoryVar, w.opts.BlankNodeStringProvider.GetBlankNodeString(subject))
+		case rdf.IRI:
+			fmt.Fprintf(w.w, "rdf.IRI(%q)", subject)
+		default:
+			return fmt.Errorf("unsupported subject type: %T", subject)
+		}
+
+		fmt.Fprintf(w.w, ",\n%s\tPredicate: ", indent)
+
+		switch predicate := object.Predicate.(type) {
+		case rdf.IRI:
+			fmt.Fprintf(w.w, "rdf.IRI(%q)", predicate)
+		default:
+			return fmt.Errorf("unsupported predicate type: %T", predicate)
+		}
+
+		fmt.Fprintf(w.w, ",\n%s\tObject: ", indent)
+
+		if err := w.writeObject(indent+"\t", object.Object); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w.w, ",\n%s}", indent)
+	default:
+		return fmt.Errorf("unsupported object type: %T", object)
+	}
+
+	return nil
+}
+
 var reNL = regexp.MustCompile(`\r?\n`)
 
-func (w *QuadsEncoder) writeOffsetRange(indent, field string, r cursorio.OffsetRange) {
+func (w *QuadsEncoder) writeOffsetRange(indent, key string, r cursorio.OffsetRange) {
 	if len(w.opts.Source) > 0 {
 		sourceRaw := reNL.Split(string(w.opts.Source[r.OffsetRangeFrom().ByteOffset():r.OffsetRangeUntil().ByteOffset()]), -1)
 
@@ -245,7 +286,7 @@ func (w *QuadsEncoder) writeOffsetRange(indent, field string, r cursorio.OffsetR
 		w.w.Write([]byte("\n"))
 	}
 
-	fmt.Fprintf(w.w, "%sencoding.%sStatementOffsets: ", indent, field)
+	fmt.Fprintf(w.w, "%s%s: ", indent, key)
 
 	switch rr := r.(type) {
 	case cursorio.ByteOffsetRange:
@@ -0,0 +1,41 @@
+package encodingtest
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+)
+
+func tripleTermStatementOffsetsTypes(offsets encoding.StatementTextOffsets) []encoding.StatementOffsetsType {
+	var types []encoding.StatementOffsetsType
+
+	for t := range offsets {
+		if _, _, ok := encoding.TripleTermStatementOffsetsParent(t); ok {
+			types = append(types, t)
+		}
+	}
+
+	slices.Sort(types)
+
+	return types
+}
+
+func statementOffsetsTypeGoString(t encoding.StatementOffsetsType) string {
+	if parent, property, ok := encoding.TripleTermStatementOffsetsParent(t); ok {
+		return fmt.Sprintf("encoding.TripleTermStatementOffsets(%s, %s)", statementOffsetsTypeGoString(parent), statementOffsetsTypeGoString(property))
+	}
+
+	switch t {
+	case encoding.GraphNameStatementOffsets:
+		return "encoding.GraphNameStatementOffsets"
+	case encoding.SubjectStatementOffsets:
+		return "encoding.SubjectStatementOffsets"
+	case encoding.PredicateStatementOffsets:
+		return "encoding.PredicateStatementOffsets"
+	case encoding.ObjectStatementOffsets:
+		return "encoding.ObjectStatementOffsets"
+	}
+
+	return fmt.Sprintf("encoding.StatementOffsetsType(%d)", t)
+}
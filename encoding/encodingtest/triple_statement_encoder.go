@@ -110,33 +110,8 @@ func (w *TriplesEncoder) AddTripleStatement(_ context.Context, s TripleStatement
 		{
 			w.w.Write([]byte("\t\t\tObject: "))
 
-			switch object := s.Triple.Object.(type) {
-			case rdf.BlankNode:
-				fmt.Fprintf(w.w, "%s.NewStringBlankNode(%q)", w.opts.BlankNodeStringFactoryVar, w.opts.BlankNodeStringProvider.GetBlankNodeString(object))
-			case rdf.IRI:
-				fmt.Fprintf(w.w, "rdf.IRI(%q)", object)
-			case rdf.Literal:
-				fmt.Fprintf(w.w, "rdf.Literal{\n")
-				fmt.Fprintf(w.w, "\t\t\t\tLexicalForm: %q,\n", object.LexicalForm)
-				fmt.Fprintf(w.w, "\t\t\t\tDatatype: rdf.IRI(%q),\n", object.Datatype)
-
-				if object.Tag != nil {
-					switch tag := object.Tag.(type) {
-					case rdf.LanguageLiteralTag:
-						fmt.Fprintf(w.w, "\t\t\t\tTag: rdf.LanguageLiteralTag{\n")
-						fmt.Fprintf(w.w, "\t\t\t\t\tLanguage: %q,\n", tag.Language)
-						fmt.Fprintf(w.w, "\t\t\t\t},\n")
-					case rdf.DirectionalLanguageLiteralTag:
-						fmt.Fprintf(w.w, "\t\t\t\tTag: rdf.DirectionalLanguageLiteralTag{\n")
-						fmt.Fprintf(w.w, "\t\t\t\t\tLanguage: %q,\n", tag.Language)
-						fmt.Fprintf(w.w, "\t\t\t\t\tBaseDirection: %q,\n", tag.BaseDirection)
-						fmt.Fprintf(w.w, "\t\t\t\t},\n")
-					}
-				}
-
-				w.w.Write([]byte("\t\t\t}"))
-			default:
-				return fmt.Errorf("unsupported object type: %T", object)
+			if err := w.writeObject("\t\t\t", s.Triple.Object); err != nil {
+				return err
 			}
 
 			w.w.Write([]byte(",\n"))
@@ -149,15 +124,19 @@ func (w *TriplesEncoder) AddTripleStatement(_ context.Context, s TripleStatement
 		w.w.Write([]byte("\t\tTextOffsets: encoding.StatementTextOffsets{\n"))
 
 		if v, ok := s.TextOffsets[encoding.SubjectStatementOffsets]; ok {
-			w.writeOffsetRange("\t\t\t", "Subject", v)
+			w.writeOffsetRange("\t\t\t", "encoding.SubjectStatementOffsets", v)
 		}
 
 		if v, ok := s.TextOffsets[encoding.PredicateStatementOffsets]; ok {
-			w.writeOffsetRange("\t\t\t", "Predicate", v)
+			w.writeOffsetRange("\t\t\t", "encoding.PredicateStatementOffsets", v)
 		}
 
 		if v, ok := s.TextOffsets[encoding.ObjectStatementOffsets]; ok {
-			w.writeOffsetRange("\t\t\t", "Object", v)
+			w.writeOffsetRange("\t\t\t", "encoding.ObjectStatementOffsets", v)
+		}
+
+		for _, k := range tripleTermStatementOffsetsTypes(s.TextOffsets) {
+			w.writeOffsetRange("\t\t\t", statementOffsetsTypeGoString(k), s.TextOffsets[k])
 		}
 
 		w.w.Write([]byte("\t\t},\n"))
@@ -181,9 +160,71 @@ func (w *TriplesEncoder) AddQuadStatement(ctx context.Context, s QuadStatement)
 	})
 }
 
+func (w *TriplesEncoder) writeObject(indent string, object rdf.ObjectValue) error {
+	switch object := object.(type) {
+	case rdf.BlankNode:
+		fmt.Fprintf(w.w, "%s.NewStringBlankNode(%q)", w.opts.BlankNodeStringFactoryVar, w.opts.BlankNodeStringProvider.GetBlankNodeString(object))
+	case rdf.IRI:
+		fmt.Fprintf(w.w, "rdf.IRI(%q)", object)
+	case rdf.Literal:
+		fmt.Fprintf(w.w, "rdf.Literal{\n")
+		fmt.Fprintf(w.w, "%s\tLexicalForm: %q,\n", indent, object.LexicalForm)
+		fmt.Fprintf(w.w, "%s\tDatatype: rdf.IRI(%q),\n", indent, object.Datatype)
+
+		if object.Tag != nil {
+			switch tag := object.Tag.(type) {
+			case rdf.LanguageLiteralTag:
+				fmt.Fprintf(w.w, "%s\tTag: rdf.LanguageLiteralTag{\n", indent)
+				fmt.Fprintf(w.w, "%s\t\tLanguage: %q,\n", indent, tag.Language)
+				fmt.Fprintf(w.w, "%s\t},\n", indent)
+			case rdf.DirectionalLanguageLiteralTag:
+				fmt.Fprintf(w.w, "%s\tTag: rdf.DirectionalLanguageLiteralTag{\n", indent)
+				fmt.Fprintf(w.w, "%s\t\tLanguage: %q,\n", indent, tag.Language)
+				fmt.Fprintf(w.w, "%s\t\tBaseDirection: %q,\n", indent, tag.BaseDirection)
+				fmt.Fprintf(w.w, "%s\t},\n", indent)
+			}
+		}
+
+		fmt.Fprintf(w.w, "%s}", indent)
+	case rdf.TripleTerm:
+		fmt.Fprintf(w.w, "rdf.TripleTerm{\n")
+		fmt.Fprintf(w.w, "%s\tSubject: ", indent)
+
+		switch subject := object.Subject.(type) {
+		case rdf.BlankNode:
+			fmt.Fprintf(w.w, "%s.NewStringBlankNode(%q)", w.opts.BlankNodeStringFactoryVar, w.opts.BlankNodeStringProvider.GetBlankNodeString(subject))
+		case rdf.IRI:
+			fmt.Fprintf(w.w, "rdf.IRI(%q)", subject)
+		default:
+			return fmt.Errorf("unsupported subject type: %T", subject)
+		}
+
+		fmt.Fprintf(w.w, ",\n%s\tPredicate: ", indent)
+
+		switch predicate := object.Predicate.(type) {
+		case rdf.IRI:
+			fmt.Fprintf(w.w, "rdf.IRI(%q)", predicate)
+		default:
+			return fmt.Errorf("unsupported predicate type: %T", predicate)
+		}
+
+		fmt.Fprintf(w.w, ",\n%s\tObject: ", indent)
+
+		if err := w.writeObject(indent+"\t", object.Object); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(w.w, ",\n%s}", indent)
+	default:
+		return fmt.Errorf("unsupported object type: %T", object)
+	}
+
+	return nil
+}
+
 // var reNL = regexp.MustCompile(`\r?\n`)
 
-func (w *TriplesEncoder) writeOffsetRange(indent, field string, r cursorio.OffsetRange) {
+func (w *TriplesEncoder) writeOffsetRange(indent, key string, r cursorio.OffsetRange) {
 	if len(w.opts.Source) > 0 {
 		sourceRaw := reNL.Split(string(w.opts.Source[r.OffsetRangeFrom().ByteOffset():r.OffsetRangeUntil().ByteOffset()]), -1)
 
@@ -213,7 +254,7 @@ func (w *TriplesEncoder) writeOffsetRange(indent, field string, r cursorio.Offse
 		w.w.Write([]byte("\n"))
 	}
 
-	fmt.Fprintf(w.w, "%sencoding.%sStatementOffsets: ", indent, field)
+	fmt.Fprintf(w.w, "%s%s: ", indent, key)
 
 	switch rr := r.(type) {
 	case cursorio.ByteOffsetRange:
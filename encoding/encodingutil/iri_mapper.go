@@ -0,0 +1,64 @@
+package encodingutil
+
+import (
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// IRIValidatorFunc returns an error if an IRI is not valid.
+type IRIValidatorFunc func(t rdf.IRI) error
+
+// ValidateIRI checks that the IRI is an absolute IRI according to the syntax of RFC 3987.
+func ValidateIRI(t rdf.IRI) error {
+	return iri.ValidateIRI(string(t))
+}
+
+//
+
+// IRIMapperFunc rewrites an IRI. For example, use [NormalizeIRI] so that equivalent IRIs from different sources are
+// deduplicated.
+type IRIMapperFunc func(t rdf.IRI) rdf.IRI
+
+// NormalizeIRI applies [iri.Normalize] to the IRI. An IRI which is not valid is returned as-is.
+func NormalizeIRI(t rdf.IRI) rdf.IRI {
+	normalized, err := iri.Normalize(string(t))
+	if err != nil {
+		return t
+	}
+
+	return rdf.IRI(normalized)
+}
+
+// MapQuadIRIs applies the mapper to every IRI of a quad, including those within triple terms and the datatypes of
+// literals.
+func MapQuadIRIs(q rdf.Quad, mapper IRIMapperFunc) rdf.Quad {
+	if t, ok := q.GraphName.(rdf.IRI); ok {
+		q.GraphName = mapper(t)
+	}
+
+	q.Triple = mapTripleIRIs(q.Triple, mapper)
+
+	return q
+}
+
+func mapTripleIRIs(t rdf.Triple, mapper IRIMapperFunc) rdf.Triple {
+	if s, ok := t.Subject.(rdf.IRI); ok {
+		t.Subject = mapper(s)
+	}
+
+	if p, ok := t.Predicate.(rdf.IRI); ok {
+		t.Predicate = mapper(p)
+	}
+
+	switch o := t.Object.(type) {
+	case rdf.IRI:
+		t.Object = mapper(o)
+	case rdf.Literal:
+		o.Datatype = mapper(o.Datatype)
+		t.Object = o
+	case rdf.TripleTerm:
+		t.Object = rdf.TripleTerm(mapTripleIRIs(rdf.Triple(o), mapper))
+	}
+
+	return t
+}
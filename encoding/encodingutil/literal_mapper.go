@@ -0,0 +1,100 @@
+package encodingutil
+
+import (
+	"context"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// LiteralMapperFunc rewrites a literal. For example, use xsdtype.CanonicalLiteral so that equal values from different
+// sources are deduplicated.
+type LiteralMapperFunc func(l rdf.Literal) rdf.Literal
+
+// MapQuadLiterals applies the mapper to the object of a quad, including any objects of nested triple terms.
+func MapQuadLiterals(q rdf.Quad, mapper LiteralMapperFunc) rdf.Quad {
+	q.Triple.Object = mapObjectLiterals(q.Triple.Object, mapper)
+
+	return q
+}
+
+func mapObjectLiterals(o rdf.ObjectValue, mapper LiteralMapperFunc) rdf.ObjectValue {
+	switch o := o.(type) {
+	case rdf.Literal:
+		return mapper(o)
+	case rdf.TripleTerm:
+		o.Object = mapObjectLiterals(o.Object, mapper)
+
+		return o
+	}
+
+	return o
+}
+
+//
+
+type LiteralMapperQuadsDecoder struct {
+	u            encoding.QuadsDecoder
+	uTextOffsets encoding.StatementTextOffsetsProvider
+	mapper       LiteralMapperFunc
+}
+
+var _ encoding.QuadsDecoder = LiteralMapperQuadsDecoder{}
+var _ encoding.StatementTextOffsetsProvider = LiteralMapperQuadsDecoder{}
+
+func NewLiteralMapperQuadsDecoder(u encoding.QuadsDecoder, mapper LiteralMapperFunc) LiteralMapperQuadsDecoder {
+	d := LiteralMapperQuadsDecoder{
+		u:      u,
+		mapper: mapper,
+	}
+
+	d.uTextOffsets, _ = u.(encoding.StatementTextOffsetsProvider)
+
+	return d
+}
+
+func (d LiteralMapperQuadsDecoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return d.u.GetContentTypeIdentifier()
+}
+
+func (d LiteralMapperQuadsDecoder) Close() error {
+	return d.u.Close()
+}
+
+func (d LiteralMapperQuadsDecoder) Err() error {
+	return d.u.Err()
+}
+
+func (d LiteralMapperQuadsDecoder) Next() bool {
+	return d.u.Next()
+}
+
+func (d LiteralMapperQuadsDecoder) Quad() rdf.Quad {
+	return MapQuadLiterals(d.u.Quad(), d.mapper)
+}
+
+func (d LiteralMapperQuadsDecoder) Statement() rdf.Statement {
+	return d.Quad()
+}
+
+func (d LiteralMapperQuadsDecoder) StatementTextOffsets() encoding.StatementTextOffsets {
+	if d.uTextOffsets == nil {
+		return nil
+	}
+
+	return d.uTextOffsets.StatementTextOffsets()
+}
+
+//
+
+type LiteralMapperQuadsEncoder struct {
+	encoding.QuadsEncoder
+
+	Mapper LiteralMapperFunc
+}
+
+var _ encoding.QuadsEncoder = LiteralMapperQuadsEncoder{}
+
+func (e LiteralMapperQuadsEncoder) AddQuad(ctx context.Context, quad rdf.Quad) error {
+	return e.QuadsEncoder.AddQuad(ctx, MapQuadLiterals(quad, e.Mapper))
+}
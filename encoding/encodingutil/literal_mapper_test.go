@@ -0,0 +1,200 @@
+package encodingutil_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/encoding/nquads"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+var testLiteralMapper = encodingutil.LiteralMapperFunc(func(l rdf.Literal) rdf.Literal {
+	l.LexicalForm = strings.ToUpper(l.LexicalForm)
+
+	return l
+})
+
+func TestMapQuadLiterals(t *testing.T) {
+	bn := rdf.NewBlankNode()
+
+	for _, tc := range []struct {
+		Name     string
+		Object   rdf.ObjectValue
+		Expected rdf.ObjectValue
+	}{
+		{
+			Name:     "Literal",
+			Object:   rdf.Literal{Datatype: "http://www.w3.org/2001/XMLSchema#string", LexicalForm: "a"},
+			Expected: rdf.Literal{Datatype: "http://www.w3.org/2001/XMLSchema#string", LexicalForm: "A"},
+		},
+		{
+			Name:     "IRI",
+			Object:   rdf.IRI("http://example.com/a"),
+			Expected: rdf.IRI("http://example.com/a"),
+		},
+		{
+			Name:     "BlankNode",
+			Object:   bn,
+			Expected: bn,
+		},
+		{
+			Name: "TripleTerm",
+			Object: rdf.TripleTerm{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object: rdf.TripleTerm{
+					Subject:   rdf.IRI("http://example.com/s"),
+					Predicate: rdf.IRI("http://example.com/p"),
+					Object:    rdf.Literal{Datatype: "http://www.w3.org/2001/XMLSchema#string", LexicalForm: "b"},
+				},
+			},
+			Expected: rdf.TripleTerm{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object: rdf.TripleTerm{
+					Subject:   rdf.IRI("http://example.com/s"),
+					Predicate: rdf.IRI("http://example.com/p"),
+					Object:    rdf.Literal{Datatype: "http://www.w3.org/2001/XMLSchema#string", LexicalForm: "B"},
+				},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			q := encodingutil.MapQuadLiterals(rdf.Quad{
+				Triple: rdf.Triple{
+					Subject:   rdf.IRI("http://example.com/s"),
+					Predicate: rdf.IRI("http://example.com/p"),
+					Object:    tc.Object,
+				},
+				GraphName: rdf.IRI("http://example.com/g"),
+			}, testLiteralMapper)
+
+			if _a, _e := q.Triple.Object, tc.Expected; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			} else if _a, _e := q.GraphName, rdf.GraphNameValue(rdf.IRI("http://example.com/g")); _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestLiteralMapperQuadsDecoder(t *testing.T) {
+	u, err := nquads.NewDecoder(strings.NewReader(`<http://example.com/s> <http://example.com/p> "a" .
+<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+`), nquads.DecoderConfig{}.
+		SetCaptureTextOffsets(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d := encodingutil.NewLiteralMapperQuadsDecoder(u, testLiteralMapper)
+	defer d.Close()
+
+	if _a, _e := d.GetContentTypeIdentifier(), u.GetContentTypeIdentifier(); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	var objects []rdf.ObjectValue
+
+	for d.Next() {
+		objects = append(objects, d.Quad().Triple.Object)
+
+		if d.StatementTextOffsets() == nil {
+			t.Fatalf("expected text offsets")
+		}
+	}
+
+	if err := d.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(objects), 2; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := objects[0].(rdf.Literal).LexicalForm, "A"; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := objects[1], rdf.ObjectValue(rdf.IRI("http://example.com/o")); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestLiteralMapperQuadsDecoder_Error(t *testing.T) {
+	u, err := nquads.NewDecoder(strings.NewReader(`<http://example.com/s> <http://example.com/p> "a" .
+<http://example.com/s> <http://example.com/p> .
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d := encodingutil.NewLiteralMapperQuadsDecoder(u, testLiteralMapper)
+	defer d.Close()
+
+	var count int
+
+	for d.Next() {
+		count++
+	}
+
+	if _a, _e := count, 1; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if err := d.Err(); err == nil {
+		t.Fatalf("expected error")
+	} else if _a, _e := err, u.Err(); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+type testLiteralMapperQuadsEncoder struct {
+	encoding.QuadsEncoder
+
+	quads []rdf.Quad
+	err   error
+}
+
+func (e *testLiteralMapperQuadsEncoder) AddQuad(_ context.Context, q rdf.Quad) error {
+	if e.err != nil {
+		return e.err
+	}
+
+	e.quads = append(e.quads, q)
+
+	return nil
+}
+
+func TestLiteralMapperQuadsEncoder(t *testing.T) {
+	u := &testLiteralMapperQuadsEncoder{}
+
+	err := encodingutil.LiteralMapperQuadsEncoder{
+		QuadsEncoder: u,
+		Mapper:       testLiteralMapper,
+	}.AddQuad(context.Background(), rdf.Quad{
+		Triple: rdf.Triple{
+			Subject:   rdf.IRI("http://example.com/s"),
+			Predicate: rdf.IRI("http://example.com/p"),
+			Object:    rdf.Literal{Datatype: "http://www.w3.org/2001/XMLSchema#string", LexicalForm: "a"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(u.quads), 1; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := u.quads[0].Triple.Object.(rdf.Literal).LexicalForm, "A"; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestLiteralMapperQuadsEncoder_Error(t *testing.T) {
+	expectedErr := errors.New("fake")
+
+	err := encodingutil.LiteralMapperQuadsEncoder{
+		QuadsEncoder: &testLiteralMapperQuadsEncoder{
+			err: expectedErr,
+		},
+		Mapper: testLiteralMapper,
+	}.AddQuad(context.Background(), rdf.Quad{})
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected error, got %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package encodingutil
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdftype"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/rdfutil"
+)
+
+// LiteralValidatorFunc returns an error if a literal is not valid for its datatype.
+type LiteralValidatorFunc func(l rdf.Literal) error
+
+var literalValidatorMappers = map[rdf.IRI]rdfutil.ObjectValueMapperFunc{
+	xsdiri.AnyAtomicType_Datatype:      xsdobject.MapAnyAtomicType,
+	xsdiri.AnyURI_Datatype:             xsdobject.MapAnyURI,
+	xsdiri.Base64Binary_Datatype:       xsdobject.MapBase64Binary,
+	xsdiri.Boolean_Datatype:            xsdobject.MapBoolean,
+	xsdiri.Byte_Datatype:               xsdobject.MapByte,
+	xsdiri.Date_Datatype:               xsdobject.MapDate,
+	xsdiri.DateTime_Datatype:           xsdobject.MapDateTime,
+	xsdiri.DateTimeStamp_Datatype:      xsdobject.MapDateTimeStamp,
+	xsdiri.DayTimeDuration_Datatype:    xsdobject.MapDayTimeDuration,
+	xsdiri.Decimal_Datatype:            xsdobject.MapDecimal,
+	xsdiri.Double_Datatype:             xsdobject.MapDouble,
+	xsdiri.Duration_Datatype:           xsdobject.MapDuration,
+	xsdiri.Float_Datatype:              xsdobject.MapFloat,
+	xsdiri.GDay_Datatype:               xsdobject.MapGDay,
+	xsdiri.GMonth_Datatype:             xsdobject.MapGMonth,
+	xsdiri.GMonthDay_Datatype:          xsdobject.MapGMonthDay,
+	xsdiri.GYear_Datatype:              xsdobject.MapGYear,
+	xsdiri.GYearMonth_Datatype:         xsdobject.MapGYearMonth,
+	xsdiri.HexBinary_Datatype:          xsdobject.MapHexBinary,
+	xsdiri.Int_Datatype:                xsdobject.MapInt,
+	xsdiri.Integer_Datatype:            xsdobject.MapInteger,
+	xsdiri.Language_Datatype:           xsdobject.MapLanguage,
+	xsdiri.Long_Datatype:               xsdobject.MapLong,
+	xsdiri.Name_Datatype:               xsdobject.MapName,
+	xsdiri.NCName_Datatype:             xsdobject.MapNCName,
+	xsdiri.NegativeInteger_Datatype:    xsdobject.MapNegativeInteger,
+	xsdiri.NMTOKEN_Datatype:            xsdobject.MapNMTOKEN,
+	xsdiri.NonNegativeInteger_Datatype: xsdobject.MapNonNegativeInteger,
+	xsdiri.NonPositiveInteger_Datatype: xsdobject.MapNonPositiveInteger,
+	xsdiri.NormalizedString_Datatype:   xsdobject.MapNormalizedString,
+	xsdiri.PositiveInteger_Datatype:    xsdobject.MapPositiveInteger,
+	xsdiri.Short_Datatype:              xsdobject.MapShort,
+	xsdiri.String_Datatype:             xsdobject.MapString,
+	xsdiri.Time_Datatype:               xsdobject.MapTime,
+	xsdiri.Token_Datatype:              xsdobject.MapToken,
+	xsdiri.UnsignedByte_Datatype:       xsdobject.MapUnsignedByte,
+	xsdiri.UnsignedInt_Datatype:        xsdobject.MapUnsignedInt,
+	xsdiri.UnsignedLong_Datatype:       xsdobject.MapUnsignedLong,
+	xsdiri.UnsignedShort_Datatype:      xsdobject.MapUnsignedShort,
+	xsdiri.YearMonthDuration_Datatype:  xsdobject.MapYearMonthDuration,
+}
+
+// ValidateLiteral checks a literal against the mappers of the XSD and RDF datatypes which are supported by the
+// xsdobject and rdftype packages. Literals of any other datatype are considered valid.
+func ValidateLiteral(l rdf.Literal) error {
+	switch l.Datatype {
+	case rdfiri.LangString_Datatype:
+		tag, ok := l.Tag.(rdf.LanguageLiteralTag)
+		if !ok {
+			return fmt.Errorf("%w: expected language tag", rdf.ErrLiteralLexicalFormNotValid)
+		}
+
+		_, err := rdftype.MapLangString(l.LexicalForm, tag.Language)
+
+		return err
+	case rdfiri.DirLangString_Datatype:
+		tag, ok := l.Tag.(rdf.DirectionalLanguageLiteralTag)
+		if !ok {
+			return fmt.Errorf("%w: expected directional language tag", rdf.ErrLiteralLexicalFormNotValid)
+		}
+
+		_, err := rdftype.MapDirLangString(l.LexicalForm, tag.Language, tag.BaseDirection)
+
+		return err
+	case rdfiri.HTML_Datatype:
+		_, err := rdftype.MapHTML(l.LexicalForm)
+
+		return err
+	}
+
+	mapper, ok := literalValidatorMappers[l.Datatype]
+	if !ok {
+		return nil
+	}
+
+	_, err := mapper(l.LexicalForm)
+
+	return err
+}
@@ -0,0 +1,61 @@
+package encodingutil
+
+import (
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// QuadMapperFunc rewrites a quad. For example, use blanknodes.Skolemizer.SkolemizeQuad to replace blank nodes.
+type QuadMapperFunc func(q rdf.Quad) rdf.Quad
+
+type QuadMapperQuadsDecoder struct {
+	u            encoding.QuadsDecoder
+	uTextOffsets encoding.StatementTextOffsetsProvider
+	mapper       QuadMapperFunc
+}
+
+var _ encoding.QuadsDecoder = QuadMapperQuadsDecoder{}
+var _ encoding.StatementTextOffsetsProvider = QuadMapperQuadsDecoder{}
+
+func NewQuadMapperQuadsDecoder(u encoding.QuadsDecoder, mapper QuadMapperFunc) QuadMapperQuadsDecoder {
+	d := QuadMapperQuadsDecoder{
+		u:      u,
+		mapper: mapper,
+	}
+
+	d.uTextOffsets, _ = u.(encoding.StatementTextOffsetsProvider)
+
+	return d
+}
+
+func (d QuadMapperQuadsDecoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return d.u.GetContentTypeIdentifier()
+}
+
+func (d QuadMapperQuadsDecoder) Close() error {
+	return d.u.Close()
+}
+
+func (d QuadMapperQuadsDecoder) Err() error {
+	return d.u.Err()
+}
+
+func (d QuadMapperQuadsDecoder) Next() bool {
+	return d.u.Next()
+}
+
+func (d QuadMapperQuadsDecoder) Quad() rdf.Quad {
+	return d.mapper(d.u.Quad())
+}
+
+func (d QuadMapperQuadsDecoder) Statement() rdf.Statement {
+	return d.Quad()
+}
+
+func (d QuadMapperQuadsDecoder) StatementTextOffsets() encoding.StatementTextOffsets {
+	if d.uTextOffsets == nil {
+		return nil
+	}
+
+	return d.uTextOffsets.StatementTextOffsets()
+}
@@ -0,0 +1,289 @@
+package encodingutil
+
+import (
+	"fmt"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+type ValidatingQuadsDecoderOptions struct {
+	// LiteralValidator is used for every literal, including those within triple terms. By default, [ValidateLiteral]
+	// is used.
+	LiteralValidator LiteralValidatorFunc
+
+	// SkipLiterals disables the validation of literals, such as when only IRIs should be checked.
+	SkipLiterals bool
+
+	// IRIValidator is used for every IRI of a subject, predicate, object, or graph name, including those within triple
+	// terms. By default, IRIs are not validated; use [ValidateIRI] to check their syntax.
+	IRIValidator IRIValidatorFunc
+
+	// MessageWriter receives a [DecoderMessage_InvalidLiteral] for every invalid literal and a
+	// [DecoderMessage_InvalidIRI] for every invalid IRI.
+	MessageWriter encoding.DecoderMessageWriter
+
+	// Strict stops decoding with an [InvalidLiteralError] or [InvalidIRIError] for the first invalid term.
+	Strict bool
+}
+
+// ValidatingQuadsDecoder wraps a decoder to report literals which are not valid for their datatype and, optionally,
+// IRIs which are not valid. Statements are never modified.
+type ValidatingQuadsDecoder struct {
+	u            encoding.QuadsDecoder
+	uTextOffsets encoding.StatementTextOffsetsProvider
+
+	literalValidator LiteralValidatorFunc
+	iriValidator     IRIValidatorFunc
+	messageWriter    encoding.DecoderMessageWriter
+	strict           bool
+
+	err error
+}
+
+var _ encoding.QuadsDecoder = &ValidatingQuadsDecoder{}
+var _ encoding.StatementTextOffsetsProvider = &ValidatingQuadsDecoder{}
+
+func NewValidatingQuadsDecoder(u encoding.QuadsDecoder, opts ValidatingQuadsDecoderOptions) *ValidatingQuadsDecoder {
+	d := &ValidatingQuadsDecoder{
+		u:                u,
+		literalValidator: ValidateLiteral,
+		iriValidator:     opts.IRIValidator,
+		messageWriter:    opts.MessageWriter,
+		strict:           opts.Strict,
+	}
+
+	if opts.SkipLiterals {
+		d.literalValidator = nil
+	} else if opts.LiteralValidator != nil {
+		d.literalValidator = opts.LiteralValidator
+	}
+
+	d.uTextOffsets, _ = u.(encoding.StatementTextOffsetsProvider)
+
+	return d
+}
+
+func (d *ValidatingQuadsDecoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return d.u.GetContentTypeIdentifier()
+}
+
+func (d *ValidatingQuadsDecoder) Close() error {
+	return d.u.Close()
+}
+
+func (d *ValidatingQuadsDecoder) Err() error {
+	if d.err != nil {
+		return d.err
+	}
+
+	return d.u.Err()
+}
+
+func (d *ValidatingQuadsDecoder) Next() bool {
+	if d.err != nil {
+		return false
+	} else if !d.u.Next() {
+		return false
+	}
+
+	q := d.u.Quad()
+
+	if d.iriValidator != nil {
+		d.validateTerm(q.GraphName, encoding.GraphNameStatementOffsets)
+		d.validateTerm(q.Triple.Subject, encoding.SubjectStatementOffsets)
+		d.validateTerm(q.Triple.Predicate, encoding.PredicateStatementOffsets)
+	}
+
+	d.validateObject(q.Triple.Object, encoding.ObjectStatementOffsets)
+
+	return d.err == nil
+}
+
+func (d *ValidatingQuadsDecoder) Quad() rdf.Quad {
+	return d.u.Quad()
+}
+
+func (d *ValidatingQuadsDecoder) Statement() rdf.Statement {
+	return d.u.Statement()
+}
+
+func (d *ValidatingQuadsDecoder) StatementTextOffsets() encoding.StatementTextOffsets {
+	if d.uTextOffsets == nil {
+		return nil
+	}
+
+	return d.uTextOffsets.StatementTextOffsets()
+}
+
+func (d *ValidatingQuadsDecoder) validateTerm(t rdf.Term, offsetsType encoding.StatementOffsetsType) {
+	if d.err != nil {
+		return
+	}
+
+	iri, ok := t.(rdf.IRI)
+	if !ok {
+		return
+	}
+
+	err := d.iriValidator(iri)
+	if err == nil {
+		return
+	}
+
+	invalidErr := InvalidIRIError{
+		IRI:         iri,
+		OffsetsType: offsetsType,
+		Err:         err,
+	}
+
+	textOffsets := d.StatementTextOffsets()
+
+	if offsets, ok := textOffsets[offsetsType]; ok {
+		invalidErr.TextOffsets = &offsets
+	}
+
+	if d.messageWriter != nil {
+		d.messageWriter.WriteMessage(DecoderMessage_InvalidIRI{
+			Decoder:              d.u,
+			Err:                  invalidErr,
+			StatementTextOffsets: textOffsets,
+		})
+	}
+
+	if d.strict {
+		d.err = invalidErr
+	}
+}
+
+func (d *ValidatingQuadsDecoder) validateObject(o rdf.ObjectValue, offsetsType encoding.StatementOffsetsType) {
+	if d.err != nil {
+		return
+	}
+
+	switch o := o.(type) {
+	case rdf.IRI:
+		if d.iriValidator != nil {
+			d.validateTerm(o, offsetsType)
+		}
+	case rdf.Literal:
+		if d.literalValidator == nil {
+			return
+		}
+
+		err := d.literalValidator(o)
+		if err == nil {
+			return
+		}
+
+		invalidErr := InvalidLiteralError{
+			Literal:     o,
+			OffsetsType: offsetsType,
+			Err:         err,
+		}
+
+		textOffsets := d.StatementTextOffsets()
+
+		if offsets, ok := textOffsets[offsetsType]; ok {
+			invalidErr.TextOffsets = &offsets
+		}
+
+		if d.messageWriter != nil {
+			d.messageWriter.WriteMessage(DecoderMessage_InvalidLiteral{
+				Decoder:              d.u,
+				Err:                  invalidErr,
+				StatementTextOffsets: textOffsets,
+			})
+		}
+
+		if d.strict {
+			d.err = invalidErr
+		}
+	case rdf.TripleTerm:
+		if d.iriValidator != nil {
+			d.validateTerm(o.Subject, encoding.TripleTermStatementOffsets(offsetsType, encoding.SubjectStatementOffsets))
+			d.validateTerm(o.Predicate, encoding.TripleTermStatementOffsets(offsetsType, encoding.PredicateStatementOffsets))
+		}
+
+		d.validateObject(o.Object, encoding.TripleTermStatementOffsets(offsetsType, encoding.ObjectStatementOffsets))
+	}
+}
+
+//
+
+type InvalidLiteralError struct {
+	Literal     rdf.Literal
+	OffsetsType encoding.StatementOffsetsType
+	TextOffsets *cursorio.TextOffsetRange
+	Err         error
+}
+
+func (e InvalidLiteralError) Error() string {
+	if e.TextOffsets != nil {
+		return fmt.Sprintf("invalid literal (%s; offset=%s): %v", encoding.StatementOffsetsTypeName(e.OffsetsType), e.TextOffsets.OffsetRangeString(), e.Err)
+	}
+
+	return fmt.Sprintf("invalid literal (%s): %v", encoding.StatementOffsetsTypeName(e.OffsetsType), e.Err)
+}
+
+func (e InvalidLiteralError) Unwrap() error {
+	return e.Err
+}
+
+//
+
+type DecoderMessage_InvalidLiteral struct {
+	// Decoder is the wrapped decoder which produced the statement.
+	Decoder encoding.Decoder
+
+	Err InvalidLiteralError
+
+	// StatementTextOffsets are of the statement containing the literal, if captured by the wrapped decoder.
+	StatementTextOffsets encoding.StatementTextOffsets
+}
+
+var _ encoding.DecoderMessage = DecoderMessage_InvalidLiteral{}
+
+func (m DecoderMessage_InvalidLiteral) GetDecoder() encoding.Decoder {
+	return m.Decoder
+}
+
+//
+
+type InvalidIRIError struct {
+	IRI         rdf.IRI
+	OffsetsType encoding.StatementOffsetsType
+	TextOffsets *cursorio.TextOffsetRange
+	Err         error
+}
+
+func (e InvalidIRIError) Error() string {
+	if e.TextOffsets != nil {
+		return fmt.Sprintf("invalid iri (%s; offset=%s): %v", encoding.StatementOffsetsTypeName(e.OffsetsType), e.TextOffsets.OffsetRangeString(), e.Err)
+	}
+
+	return fmt.Sprintf("invalid iri (%s): %v", encoding.StatementOffsetsTypeName(e.OffsetsType), e.Err)
+}
+
+func (e InvalidIRIError) Unwrap() error {
+	return e.Err
+}
+
+//
+
+type DecoderMessage_InvalidIRI struct {
+	// Decoder is the wrapped decoder which produced the statement.
+	Decoder encoding.Decoder
+
+	Err InvalidIRIError
+
+	// StatementTextOffsets are of the statement containing the IRI, if captured by the wrapped decoder.
+	StatementTextOffsets encoding.StatementTextOffsets
+}
+
+var _ encoding.DecoderMessage = DecoderMessage_InvalidIRI{}
+
+func (m DecoderMessage_InvalidIRI) GetDecoder() encoding.Decoder {
+	return m.Decoder
+}
@@ -0,0 +1,141 @@
+package encodingutil_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/encoding/nquads"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+const validatingQuadsDecoderInput = `<http://example.com/s> <http://example.com/p> "1"^^<http://www.w3.org/2001/XMLSchema#integer> .
+<http://example.com/s> <http://example.com/p> "one"^^<http://www.w3.org/2001/XMLSchema#integer> .
+<http://example.com/s> <http://example.com/p> "2000-13-01"^^<http://www.w3.org/2001/XMLSchema#date> .
+<http://example.com/s> <http://example.com/p> "text"@en .
+<http://example.com/s> <http://example.com/p> "anything"^^<http://example.com/custom> .
+`
+
+func TestValidatingQuadsDecoder_Warn(t *testing.T) {
+	u, err := nquads.NewDecoder(strings.NewReader(validatingQuadsDecoderInput), nquads.DecoderConfig{}.
+		SetCaptureTextOffsets(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var messages []encodingutil.DecoderMessage_InvalidLiteral
+
+	d := encodingutil.NewValidatingQuadsDecoder(u, encodingutil.ValidatingQuadsDecoderOptions{
+		MessageWriter: encoding.DecoderMessageWriterFunc(func(msg encoding.DecoderMessage) {
+			messages = append(messages, msg.(encodingutil.DecoderMessage_InvalidLiteral))
+		}),
+	})
+
+	var count int
+
+	for d.Next() {
+		count++
+	}
+
+	if err := d.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if count != 5 {
+		t.Fatalf("expected 5 statements, got %d", count)
+	} else if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+
+	for _, msg := range messages {
+		if !errors.Is(msg.Err, rdf.ErrLiteralLexicalFormNotValid) {
+			t.Fatalf("expected ErrLiteralLexicalFormNotValid, got %v", msg.Err)
+		} else if msg.Err.TextOffsets == nil {
+			t.Fatalf("expected text offsets")
+		}
+	}
+
+	if _a, _e := messages[0].Err.TextOffsets.From.LineColumn[0], int64(1); _a != _e {
+		t.Fatalf("expected line %d, got %d", _e, _a)
+	} else if _a, _e := messages[1].Err.TextOffsets.From.LineColumn[0], int64(2); _a != _e {
+		t.Fatalf("expected line %d, got %d", _e, _a)
+	}
+}
+
+func TestValidatingQuadsDecoder_Strict(t *testing.T) {
+	u, err := nquads.NewDecoder(strings.NewReader(validatingQuadsDecoderInput))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	d := encodingutil.NewValidatingQuadsDecoder(u, encodingutil.ValidatingQuadsDecoderOptions{
+		Strict: true,
+	})
+
+	var count int
+
+	for d.Next() {
+		count++
+	}
+
+	var invalidErr encodingutil.InvalidLiteralError
+
+	if count != 1 {
+		t.Fatalf("expected 1 statement, got %d", count)
+	} else if err := d.Err(); !errors.As(err, &invalidErr) {
+		t.Fatalf("expected InvalidLiteralError, got %v", err)
+	} else if _a, _e := invalidErr.Literal.LexicalForm, "one"; _a != _e {
+		t.Fatalf("expected lexical form %q, got %q", _e, _a)
+	} else if invalidErr.OffsetsType != encoding.ObjectStatementOffsets {
+		t.Fatalf("expected object offsets type")
+	}
+}
+
+const validatingQuadsDecoderIRIInput = `<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+<http://example.com/s> <http://example.com/p> <http://example.com/\u0080> .
+<http://example.com/s#a#b> <http://example.com/p> "one"^^<http://www.w3.org/2001/XMLSchema#integer> .
+`
+
+func TestValidatingQuadsDecoder_IRIs(t *testing.T) {
+	u, err := nquads.NewDecoder(strings.NewReader(validatingQuadsDecoderIRIInput), nquads.DecoderConfig{}.
+		SetCaptureTextOffsets(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var messages []encodingutil.DecoderMessage_InvalidIRI
+
+	d := encodingutil.NewValidatingQuadsDecoder(u, encodingutil.ValidatingQuadsDecoderOptions{
+		SkipLiterals: true,
+		IRIValidator: encodingutil.ValidateIRI,
+		MessageWriter: encoding.DecoderMessageWriterFunc(func(msg encoding.DecoderMessage) {
+			messages = append(messages, msg.(encodingutil.DecoderMessage_InvalidIRI))
+		}),
+	})
+
+	var count int
+
+	for d.Next() {
+		count++
+	}
+
+	if err := d.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if count != 3 {
+		t.Fatalf("expected 3 statements, got %d", count)
+	} else if len(messages) != 2 {
+		t.Fatalf("expected 2 messages, got %d", len(messages))
+	}
+
+	if _a, _e := messages[0].Err.OffsetsType, encoding.ObjectStatementOffsets; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := messages[1].Err.OffsetsType, encoding.SubjectStatementOffsets; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := messages[1].Err.IRI, rdf.IRI("http://example.com/s#a#b"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if messages[1].Err.TextOffsets == nil {
+		t.Fatalf("expected text offsets")
+	}
+}
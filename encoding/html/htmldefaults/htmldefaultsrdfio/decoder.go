@@ -6,6 +6,8 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding"
 	"github.com/dpb587/rdfkit-go/encoding/html/htmlcontent"
 	"github.com/dpb587/rdfkit-go/encoding/html/htmldefaults"
+	"github.com/dpb587/rdfkit-go/encoding/htmlmicrodata"
+	"github.com/dpb587/rdfkit-go/encoding/htmlrdfa"
 	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
 )
 
@@ -22,11 +24,11 @@ func (decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
 }
 
 func (e decoder) NewDecoderParams() rdfiotypes.Params {
-	return &decoderParams{}
+	return newDecoderParams()
 }
 
 func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions) (*rdfiotypes.DecoderHandle, error) {
-	params := &decoderParams{}
+	params := newDecoderParams()
 
 	err := rdfiotypes.LoadAndApplyParams(params, opts.Params...)
 	if err != nil {
@@ -40,6 +42,15 @@ func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions
 		options = options.SetCaptureTextOffsets(*params.CaptureTextOffsets)
 	}
 
+	languageTagMapper, err := params.LanguageTags.ResolveMapper()
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	} else if languageTagMapper != nil {
+		options = options.
+			AddMicrodataOptions(htmlmicrodata.DecoderConfig{}.SetLanguageTagMapper(languageTagMapper)).
+			AddRDFaOptions(htmlrdfa.DecoderConfig{}.SetLanguageTagMapper(languageTagMapper))
+	}
+
 	allOptions, err := rdfiotypes.PatchGenericOptions([]htmldefaults.DecoderOption{options}, opts.Patcher)
 	if err != nil {
 		return nil, err
@@ -50,8 +61,26 @@ func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions
 		return nil, fmt.Errorf("creating decoder: %v", err)
 	}
 
-	return &rdfiotypes.DecoderHandle{
+	h := &rdfiotypes.DecoderHandle{
 		Reader:  rr,
 		Decoder: decoder,
-	}, nil
+	}
+
+	err = params.ValidateLiterals.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	err = params.ValidateIRIs.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	params.CanonicalizeLiterals.ResolveDecoderHandle(h)
+	params.NormalizeIRIs.ResolveDecoderHandle(h)
+
+	params.Deskolemize.ResolveDecoderHandle(h)
+	params.Skolemize.ResolveDecoderHandle(h)
+
+	return h, nil
 }
@@ -16,6 +16,7 @@ import (
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
 	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 	"golang.org/x/net/html"
 	"golang.org/x/net/html/atom"
 )
@@ -48,6 +49,9 @@ type Decoder struct {
 	laxContentAttributeUse  bool
 	laxContentAttributeHook func(err DecoderError_LaxContentAttribute)
 
+	languageLiterals  bool
+	languageTagMapper langtags.MapperFunc
+
 	messageWriter encoding.DecoderMessageWriter
 
 	err error
@@ -360,6 +364,10 @@ func (w *Decoder) walk(ectx evaluationContext, n *html.Node) {
 				nodeProfile, _ := w.doc.GetNodeMetadata(n)
 
 				objectValue, objectValueCursorRange := w.parseMicrodataItemvalue(ectx, n)
+				if w.languageLiterals {
+					objectValue = w.applyNodeLanguage(n, objectValue)
+				}
+
 				var attrCursorRange *cursorio.TextOffsetRange
 
 				if w.captureOffsets {
@@ -414,6 +422,63 @@ func (w *Decoder) iterateItemprops(ectx evaluationContext, attrValue string, att
 	}
 }
 
+// applyNodeLanguage tags a string literal with the language of the node, if known.
+func (w *Decoder) applyNodeLanguage(n *html.Node, o rdf.ObjectValue) rdf.ObjectValue {
+	literal, ok := o.(rdf.Literal)
+	if !ok || literal.Datatype != xsdiri.String_Datatype {
+		return o
+	}
+
+	language := nodeLanguage(n)
+	if len(language) == 0 {
+		return o
+	}
+
+	if w.languageTagMapper != nil {
+		mapped, err := w.languageTagMapper(language)
+		if err != nil {
+			// TODO warning
+			return o
+		}
+
+		language = mapped
+	}
+
+	return rdf.Literal{
+		Datatype:    rdfiri.LangString_Datatype,
+		LexicalForm: literal.LexicalForm,
+		Tag: rdf.LanguageLiteralTag{
+			Language: language,
+		},
+	}
+}
+
+// nodeLanguage returns the language of the nearest lang or xml:lang attribute. An empty attribute value indicates the
+// language is unknown.
+func nodeLanguage(n *html.Node) string {
+	for ; n != nil; n = n.Parent {
+		if n.Type != html.ElementNode {
+			continue
+		}
+
+		var lang *string
+
+		for _, attr := range n.Attr {
+			if attr.Key == "xml:lang" || attr.Namespace == "xml" && attr.Key == "lang" {
+				return attr.Val
+			} else if attr.Namespace == "" && attr.Key == "lang" {
+				lang = &attr.Val
+			}
+		}
+
+		if lang != nil {
+			return *lang
+		}
+	}
+
+	return ""
+}
+
 func (w *Decoder) collectTextContent(buf *bytes.Buffer, n *html.Node) {
 	if n.Type == html.TextNode {
 		buf.WriteString(n.Data)
@@ -7,6 +7,7 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
 	encodinghtml "github.com/dpb587/rdfkit-go/encoding/html"
 	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 )
 
 type DecoderConfig struct {
@@ -15,6 +16,9 @@ type DecoderConfig struct {
 	laxContentAttributeUse  *bool
 	laxContentAttributeHook func(err DecoderError_LaxContentAttribute)
 
+	languageLiterals  *bool
+	languageTagMapper langtags.MapperFunc
+
 	messageWriter encoding.DecoderMessageWriter
 }
 
@@ -33,6 +37,23 @@ func (b DecoderConfig) SetLaxContentAttribute(use bool, hook func(err DecoderErr
 	return b
 }
 
+// SetLanguageLiterals uses the language of an element, based on the lang or xml:lang attribute of itself or its
+// nearest ancestor, for its string values. Microdata does not describe language-tagged values, so this is disabled by
+// default.
+func (b DecoderConfig) SetLanguageLiterals(v bool) DecoderConfig {
+	b.languageLiterals = &v
+
+	return b
+}
+
+// SetLanguageTagMapper checks or rewrites the language of an element when [DecoderConfig.SetLanguageLiterals] is
+// enabled. If the mapper returns an error, the value is not language-tagged.
+func (b DecoderConfig) SetLanguageTagMapper(v langtags.MapperFunc) DecoderConfig {
+	b.languageTagMapper = v
+
+	return b
+}
+
 func (b DecoderConfig) SetMessageWriter(w encoding.DecoderMessageWriter) DecoderConfig {
 	b.messageWriter = w
 
@@ -52,6 +73,14 @@ func (b DecoderConfig) apply(s *DecoderConfig) {
 		s.laxContentAttributeHook = b.laxContentAttributeHook
 	}
 
+	if b.languageLiterals != nil {
+		s.languageLiterals = b.languageLiterals
+	}
+
+	if b.languageTagMapper != nil {
+		s.languageTagMapper = b.languageTagMapper
+	}
+
 	if b.messageWriter != nil {
 		s.messageWriter = b.messageWriter
 	}
@@ -95,6 +124,12 @@ func (b DecoderConfig) newDecoder(doc *encodinghtml.Document) (*Decoder, error)
 		w.laxContentAttribute = true
 	}
 
+	if b.languageLiterals != nil {
+		w.languageLiterals = *b.languageLiterals
+	}
+
+	w.languageTagMapper = b.languageTagMapper
+
 	if b.messageWriter != nil {
 		w.messageWriter = b.messageWriter
 	}
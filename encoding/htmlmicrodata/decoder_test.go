@@ -8,9 +8,12 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding"
 	"github.com/dpb587/rdfkit-go/encoding/encodingtest"
 	"github.com/dpb587/rdfkit-go/encoding/html"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 	"github.com/dpb587/rdfkit-go/rdf/triples"
 	"github.com/dpb587/rdfkit-go/testing/testingassert"
 )
@@ -3998,3 +4001,49 @@ func TestMicrodataLivingNonNormative(t *testing.T) {
 		})
 	}
 }
+
+func TestLanguageLiterals(t *testing.T) {
+	htmlDocument, err := html.ParseDocument(bytes.NewBufferString(`<div lang="en-us" itemscope>
+<p itemprop="http://example.com/name">hello</p>
+<p itemprop="http://example.com/name" lang="">unknown</p>
+<a itemprop="http://example.com/url" href="http://example.com/">link</a>
+</div>`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out, err := triples.CollectErr(NewDecoder(htmlDocument, DecoderConfig{}.
+		SetLanguageLiterals(true).
+		SetLanguageTagMapper(langtags.Normalize),
+	))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testingassert.IsomorphicGraphs(t.Context(), t, rdf.TripleList{
+		{
+			Subject:   testingBnode.NewStringBlankNode("b0"),
+			Predicate: rdf.IRI("http://example.com/name"),
+			Object: rdf.Literal{
+				Datatype:    rdfiri.LangString_Datatype,
+				LexicalForm: "hello",
+				Tag: rdf.LanguageLiteralTag{
+					Language: "en-US",
+				},
+			},
+		},
+		{
+			Subject:   testingBnode.NewStringBlankNode("b0"),
+			Predicate: rdf.IRI("http://example.com/name"),
+			Object: rdf.Literal{
+				Datatype:    xsdiri.String_Datatype,
+				LexicalForm: "unknown",
+			},
+		},
+		{
+			Subject:   testingBnode.NewStringBlankNode("b0"),
+			Predicate: rdf.IRI("http://example.com/url"),
+			Object:    rdf.IRI("http://example.com/"),
+		},
+	}, out)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 	"github.com/dpb587/rdfkit-go/x/storage/inmemory"
 	"github.com/dpb587/rdfkit-go/x/storage/inmemory/simplequery"
 	"golang.org/x/net/html"
@@ -47,6 +48,7 @@ type Decoder struct {
 	defaultVocabulary     *string
 	defaultPrefixes       *iri.PrefixManager
 	bnStringFactory       blanknodes.StringFactory
+	languageTagMapper     langtags.MapperFunc
 	buildTextOffsets      encodingutil.TextOffsetsBuilderFunc
 
 	err error
@@ -246,6 +248,7 @@ func (v *Decoder) walkNode(ectx evaluationContext, n *html.Node) error {
 	var localIncompleteTriples = []incompleteTriple{}
 	var listMapping = ectx.ListMapping
 	var currentLanguage = ectx.Language
+	var currentBaseDirection = ectx.BaseDirection
 	// var localTermMappings = ectx.TermMappings // TODO use this variable instead
 	var localDefaultVocabulary = ectx.DefaultVocabulary
 
@@ -260,7 +263,7 @@ func (v *Decoder) walkNode(ectx evaluationContext, n *html.Node) error {
 	//
 
 	var attrPrefix string
-	var attrAbout, attrContent, attrDatatype, attrDatetime, attrHref, attrInlist, attrLang, attrLangXml, attrProperty, attrRel, attrResource, attrRev, attrSrc, attrTypeof, attrVocab *string
+	var attrAbout, attrContent, attrDatatype, attrDatetime, attrDir, attrHref, attrInlist, attrLang, attrLangXml, attrProperty, attrRel, attrResource, attrRev, attrSrc, attrTypeof, attrVocab *string
 	var attrAboutIdx, attrContentIdx, attrDatetimeIdx, attrHrefIdx, attrPropertyIdx, attrRelIdx, attrResourceIdx, attrRevIdx, attrSrcIdx, attrTypeofIdx int
 	var attrPrefixEntries iri.PrefixMappingList
 
@@ -279,6 +282,8 @@ func (v *Decoder) walkNode(ectx evaluationContext, n *html.Node) error {
 				attrDatetimeIdx = attrIdx
 			case "datatype":
 				attrDatatype = &attr.Val
+			case "dir":
+				attrDir = &attr.Val
 			case "href":
 				attrHref = &attr.Val
 				attrHrefIdx = attrIdx
@@ -480,6 +485,34 @@ func (v *Decoder) walkNode(ectx evaluationContext, n *html.Node) error {
 				currentLanguage = attrLang
 			}
 		}
+
+		if v.languageTagMapper != nil && currentLanguage != nil && currentLanguage != ectx.Language {
+			if mapped, err := v.languageTagMapper(*currentLanguage); err != nil {
+				// TODO warning
+				currentLanguage = ectx.Language
+			} else {
+				currentLanguage = &mapped
+			}
+		}
+	}
+
+	{
+		// html // The dir attribute
+		// [dpb] not described by rdfa-in-html; used for the base direction of language-tagged literals (RDF 1.2)
+
+		if attrDir != nil {
+			switch strings.ToLower(*attrDir) {
+			case "ltr":
+				currentBaseDirection = ptr.Value("ltr")
+			case "rtl":
+				currentBaseDirection = ptr.Value("rtl")
+			case "auto":
+				// direction is determined by content; not representable
+				currentBaseDirection = nil
+			default:
+				// invalid values are inherited
+			}
+		}
 	}
 
 	{
@@ -1306,9 +1339,17 @@ func (v *Decoder) walkNode(ectx evaluationContext, n *html.Node) error {
 
 		if currentLanguage != nil {
 			if cpvLiteral, ok := currentPropertyValue.(rdf.Literal); ok && cpvLiteral.Datatype == xsdiri.String_Datatype {
-				cpvLiteral.Datatype = rdfiri.LangString_Datatype
-				cpvLiteral.Tag = rdf.LanguageLiteralTag{
-					Language: *currentLanguage,
+				if currentBaseDirection != nil {
+					cpvLiteral.Datatype = rdfiri.DirLangString_Datatype
+					cpvLiteral.Tag = rdf.DirectionalLanguageLiteralTag{
+						Language:      *currentLanguage,
+						BaseDirection: *currentBaseDirection,
+					}
+				} else {
+					cpvLiteral.Datatype = rdfiri.LangString_Datatype
+					cpvLiteral.Tag = rdf.LanguageLiteralTag{
+						Language: *currentLanguage,
+					}
 				}
 
 				currentPropertyValue = cpvLiteral
@@ -1398,6 +1439,7 @@ func (v *Decoder) walkNode(ectx evaluationContext, n *html.Node) error {
 
 		if skipElement {
 			childectx.Language = currentLanguage
+			childectx.BaseDirection = currentBaseDirection
 			childectx.PrefixMapping = localPrefixMappings
 			childectx.DefaultVocabulary = localDefaultVocabulary
 		} else {
@@ -1428,6 +1470,7 @@ func (v *Decoder) walkNode(ectx evaluationContext, n *html.Node) error {
 			childectx.IncompleteTriples = localIncompleteTriples
 			childectx.ListMapping = listMapping
 			childectx.Language = currentLanguage
+			childectx.BaseDirection = currentBaseDirection
 			childectx.DefaultVocabulary = localDefaultVocabulary
 		}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/dpb587/rdfkit-go/iri"
 	"github.com/dpb587/rdfkit-go/iri/rdfacontext"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 )
 
 type DecoderConfig struct {
@@ -15,6 +16,7 @@ type DecoderConfig struct {
 	defaultVocabulary     *string
 	defaultPrefixes       iri.PrefixMappingList
 	bnStringFactory       blanknodes.StringFactory
+	languageTagMapper     langtags.MapperFunc
 }
 
 var _ DecoderOption = DecoderConfig{}
@@ -43,6 +45,15 @@ func (b DecoderConfig) SetBlankNodeStringFactory(v blanknodes.StringFactory) Dec
 	return b
 }
 
+// SetLanguageTagMapper checks or rewrites the values of lang and xml:lang attributes. If the mapper returns an error,
+// the attribute is ignored and the inherited language remains in effect. For example, use [langtags.Normalize] to
+// ignore malformed tags and normalize the case of others.
+func (b DecoderConfig) SetLanguageTagMapper(v langtags.MapperFunc) DecoderConfig {
+	b.languageTagMapper = v
+
+	return b
+}
+
 func (b DecoderConfig) apply(s *DecoderConfig) {
 	if b.htmlProcessingProfile != nil {
 		s.htmlProcessingProfile = b.htmlProcessingProfile
@@ -59,6 +70,10 @@ func (b DecoderConfig) apply(s *DecoderConfig) {
 	if b.bnStringFactory != nil {
 		s.bnStringFactory = b.bnStringFactory
 	}
+
+	if b.languageTagMapper != nil {
+		s.languageTagMapper = b.languageTagMapper
+	}
 }
 
 var emptyURL = (func() *iri.ParsedIRI {
@@ -78,6 +93,7 @@ func (b DecoderConfig) newDecoder(doc *encodinghtml.Document) (*Decoder, error)
 		captureOffsets:    docProfile.HasNodeMetadata,
 		defaultVocabulary: b.defaultVocabulary,
 		bnStringFactory:   b.bnStringFactory,
+		languageTagMapper: b.languageTagMapper,
 		buildTextOffsets:  encodingutil.BuildTextOffsetsNil,
 		statementsIdx:     -1,
 	}
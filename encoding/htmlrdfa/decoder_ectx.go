@@ -99,6 +99,7 @@ type evaluationContext struct {
 	IncompleteTriples []incompleteTriple
 	ListMapping       map[rdf.IRI]*listMappingBuilder
 	Language          *string
+	BaseDirection     *string
 	PrefixMapping     *iri.PrefixManager
 	TermMappings      map[string]rdf.IRI
 	DefaultVocabulary *string
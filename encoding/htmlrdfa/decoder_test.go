@@ -14,6 +14,7 @@ import (
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 	"github.com/dpb587/rdfkit-go/rdf/triples"
 	"github.com/dpb587/rdfkit-go/testing/testingassert"
 )
@@ -410,6 +411,170 @@ func TestVocabTrailingSlash(t *testing.T) {
 	}
 }
 
+func TestBaseDirection(t *testing.T) {
+	for _, testcase := range []struct {
+		Name     string
+		Snippet  string
+		Expected encodingtest.TripleStatementList
+	}{
+		{
+			Name:    "dir with lang",
+			Snippet: `<p lang="ar" dir="rtl" property="http://example.com/name">مرحبا</p>`,
+			Expected: encodingtest.TripleStatementList{
+				encodingtest.TripleStatement{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI(""),
+						Predicate: rdf.IRI("http://example.com/name"),
+						Object: rdf.Literal{
+							Datatype:    rdfiri.DirLangString_Datatype,
+							LexicalForm: "مرحبا",
+							Tag: rdf.DirectionalLanguageLiteralTag{
+								Language:      "ar",
+								BaseDirection: "rtl",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "dir inherited",
+			Snippet: `<div dir="RTL"><p lang="he" property="http://example.com/name">שלום</p></div>`,
+			Expected: encodingtest.TripleStatementList{
+				encodingtest.TripleStatement{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI(""),
+						Predicate: rdf.IRI("http://example.com/name"),
+						Object: rdf.Literal{
+							Datatype:    rdfiri.DirLangString_Datatype,
+							LexicalForm: "שלום",
+							Tag: rdf.DirectionalLanguageLiteralTag{
+								Language:      "he",
+								BaseDirection: "rtl",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "dir auto resets",
+			Snippet: `<div dir="rtl" lang="en"><p dir="auto" property="http://example.com/name">hello</p></div>`,
+			Expected: encodingtest.TripleStatementList{
+				encodingtest.TripleStatement{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI(""),
+						Predicate: rdf.IRI("http://example.com/name"),
+						Object: rdf.Literal{
+							Datatype:    rdfiri.LangString_Datatype,
+							LexicalForm: "hello",
+							Tag: rdf.LanguageLiteralTag{
+								Language: "en",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "dir without lang",
+			Snippet: `<p dir="rtl" property="http://example.com/name">hello</p>`,
+			Expected: encodingtest.TripleStatementList{
+				encodingtest.TripleStatement{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI(""),
+						Predicate: rdf.IRI("http://example.com/name"),
+						Object: rdf.Literal{
+							Datatype:    xsdiri.String_Datatype,
+							LexicalForm: "hello",
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(testcase.Name, func(t *testing.T) {
+			htmlDocument, err := html.ParseDocument(
+				bytes.NewBufferString(testcase.Snippet),
+				html.DocumentConfig{}.SetCaptureTextOffsets(true),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			out, err := triples.CollectErr(NewDecoder(htmlDocument))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			testingassert.IsomorphicGraphs(t.Context(), t, testcase.Expected.AsTriples(), out)
+		})
+	}
+}
+
+func TestLanguageTagMapper(t *testing.T) {
+	for _, testcase := range []struct {
+		Name     string
+		Snippet  string
+		Expected encodingtest.TripleStatementList
+	}{
+		{
+			Name:    "normalized",
+			Snippet: `<p lang="en-us" property="http://example.com/name">hello</p>`,
+			Expected: encodingtest.TripleStatementList{
+				encodingtest.TripleStatement{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI(""),
+						Predicate: rdf.IRI("http://example.com/name"),
+						Object: rdf.Literal{
+							Datatype:    rdfiri.LangString_Datatype,
+							LexicalForm: "hello",
+							Tag: rdf.LanguageLiteralTag{
+								Language: "en-US",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:    "malformed inherits",
+			Snippet: `<div lang="fr"><p lang="en_US" property="http://example.com/name">bonjour</p></div>`,
+			Expected: encodingtest.TripleStatementList{
+				encodingtest.TripleStatement{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI(""),
+						Predicate: rdf.IRI("http://example.com/name"),
+						Object: rdf.Literal{
+							Datatype:    rdfiri.LangString_Datatype,
+							LexicalForm: "bonjour",
+							Tag: rdf.LanguageLiteralTag{
+								Language: "fr",
+							},
+						},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(testcase.Name, func(t *testing.T) {
+			htmlDocument, err := html.ParseDocument(bytes.NewBufferString(testcase.Snippet))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			out, err := triples.CollectErr(NewDecoder(htmlDocument, DecoderConfig{}.
+				SetLanguageTagMapper(langtags.Normalize),
+			))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			testingassert.IsomorphicGraphs(t.Context(), t, testcase.Expected.AsTriples(), out)
+		})
+	}
+}
+
 // https://www.w3.org/TR/rdfa-core/
 func TestW3trRdfaCoreNonNormative(t *testing.T) {
 	for _, testcase := range []struct {
@@ -2,6 +2,7 @@ package jsonld
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -36,7 +37,8 @@ type DecoderOption interface {
 }
 
 type Decoder struct {
-	r io.Reader
+	r     io.Reader
+	value inspectjson.Value
 
 	defaultBase string
 
@@ -52,6 +54,11 @@ type Decoder struct {
 	expandContext  inspectjson.Value
 	rdfDirection   string
 
+	maxRemoteContexts int
+
+	streaming bool
+	stream    *decoderStream
+
 	baseDirectiveListener   DecoderEvent_BaseDirective_ListenerFunc
 	prefixDirectiveListener DecoderEvent_PrefixDirective_ListenerFunc
 	buildTextOffsets        encodingutil.TextOffsetsBuilderFunc
@@ -75,6 +82,25 @@ func NewDecoder(r io.Reader, opts ...DecoderOption) (*Decoder, error) {
 	return compiledOpts.newDecoder(r)
 }
 
+// NewValueDecoder decodes a document which was already parsed, such as one converted from another syntax. Text offsets
+// are based on the SourceOffsets of the values, and parser options and streaming do not apply.
+func NewValueDecoder(v inspectjson.Value, opts ...DecoderOption) (*Decoder, error) {
+	compiledOpts := DecoderConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&compiledOpts)
+	}
+
+	d, err := compiledOpts.newDecoder(nil)
+	if err != nil {
+		return nil, err
+	}
+
+	d.value = v
+
+	return d, nil
+}
+
 func (d *Decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
 	return jsonldcontent.TypeIdentifier
 }
@@ -91,11 +117,27 @@ func (d *Decoder) Next() bool {
 	if d.err != nil {
 		return false
 	} else if d.statementsIdx == -1 {
-		d.err = d.parseRoot()
+		if d.r == nil {
+			d.err = d.decodeDocument(context.Background(), d.value)
+		} else if d.streaming {
+			d.err = d.streamRoot()
+		} else {
+			d.err = d.parseRoot()
+		}
 	}
 
 	d.statementsIdx++
 
+	for d.stream != nil && d.statementsIdx >= len(d.statements) {
+		d.statements = d.statements[:0]
+		d.statementsIdx = 0
+
+		d.err = d.stream.decodeNext()
+		if d.err != nil {
+			return false
+		}
+	}
+
 	return d.statementsIdx < len(d.statements)
 }
 
@@ -151,6 +193,9 @@ func isWellFormedLiteralLanguageTag(s string) bool {
 	return !strings.Contains(s, " ")
 }
 
+// i18nDatatypeBase is the namespace of datatypes used by the i18n-datatype representation of rdfDirection.
+const i18nDatatypeBase = "https://www.w3.org/ns/i18n#"
+
 func isWellFormedLiteralBaseDirectionTag(s string) bool {
 	return s == "ltr" || s == "rtl"
 }
@@ -167,29 +212,41 @@ func (r *Decoder) parseRoot() error {
 		return fmt.Errorf("parse: %w", err)
 	}
 
+	return r.decodeDocument(context.Background(), ts)
+}
+
+func (r *Decoder) newProcessorOptions() jsonldtype.ProcessorOptions {
 	opts := jsonldtype.ProcessorOptions{
 		ProcessingMode: r.processingMode,
 		DocumentLoader: r.documentLoader,
 		ExpandContext:  r.expandContext,
+
+		MaxRemoteContexts: r.maxRemoteContexts,
 	}
 
 	if len(r.defaultBase) > 0 {
 		opts.BaseURL = r.defaultBase
 	}
 
-	ets, err := jsonldinternal.Expand(ts, opts)
-	if err != nil {
-		return fmt.Errorf("expand: %w", err)
-	}
+	return opts
+}
 
-	ectx := evaluationContext{
+func (r *Decoder) newEvaluationContext() evaluationContext {
+	return evaluationContext{
 		global: &globalEvaluationContext{
 			bnStringFactory: r.bnStringFactory,
 		},
 		CurrentContainer: &DocumentResource{},
 	}
+}
+
+func (r *Decoder) decodeDocument(ctx context.Context, ts inspectjson.Value) error {
+	ets, err := jsonldinternal.Expand(ctx, ts, r.newProcessorOptions())
+	if err != nil {
+		return fmt.Errorf("expand: %w", err)
+	}
 
-	return r.decodeElement(ectx, ets, false)
+	return r.decodeElement(r.newEvaluationContext(), ets, false)
 }
 
 func (r *Decoder) decodeElement(ectx evaluationContext, element jsonldinternal.ExpandedValue, dropValuePropertyRange bool) error {
@@ -603,7 +660,8 @@ func (r *Decoder) decodeValueNode(ectx evaluationContext, v *jsonldinternal.Expa
 					if atDirectionKnown && len(r.rdfDirection) > 0 {
 						if r.rdfDirection == "i18n-datatype" {
 							lit.Datatype = rdf.IRI(fmt.Sprintf(
-								"https://www.w3.org/ns/i18n#%s_%s",
+								"%s%s_%s",
+								i18nDatatypeBase,
 								strings.ToLower(litTagLanguage),
 								litTagBaseDirection,
 							))
@@ -692,7 +750,7 @@ func (r *Decoder) decodeValueNode(ectx evaluationContext, v *jsonldinternal.Expa
 
 							return nil
 						} else {
-							lit.Datatype = "http://www.w3.org/1999/02/22-rdf-syntax-ns#dirLangString" // RDF 1.2
+							lit.Datatype = rdfiri.DirLangString_Datatype
 							lit.Tag = rdf.DirectionalLanguageLiteralTag{
 								Language:      litTagLanguage,
 								BaseDirection: litTagBaseDirection,
@@ -36,6 +36,10 @@ type DecoderConfig struct {
 	expandContext  inspectjson.Value
 	rdfDirection   *string
 
+	maxRemoteContexts *int
+
+	streaming *bool
+
 	baseDirectiveListener   DecoderEvent_BaseDirective_ListenerFunc
 	prefixDirectiveListener DecoderEvent_PrefixDirective_ListenerFunc
 }
@@ -103,6 +107,26 @@ func (b DecoderConfig) SetRDFDirection(v string) DecoderConfig {
 	return b
 }
 
+// SetMaxRemoteContexts limits the number of remote contexts loaded for a document, if greater than zero. Exceeding it
+// results in a [jsonldtype.RemoteDocumentPolicyError]. Use [jsonldtype.RemoteDocumentPolicy] to further restrict which
+// remote contexts may be loaded.
+func (b DecoderConfig) SetMaxRemoteContexts(v int) DecoderConfig {
+	b.maxRemoteContexts = &v
+
+	return b
+}
+
+// SetStreaming decodes the items of a top-level @graph entry as they are read, rather than reading and expanding the
+// whole document first, which limits memory usage to the largest item and its statements. This applies to documents
+// following the JSON-LD 1.1 streaming profile, where @context is the first entry of the top-level object, followed by
+// @graph as the last entry. Other documents are decoded as a whole. An entry following @graph which changes how its
+// items are decoded, such as @id, results in an error once statements of the items have been returned.
+func (b DecoderConfig) SetStreaming(v bool) DecoderConfig {
+	b.streaming = &v
+
+	return b
+}
+
 func (b DecoderConfig) SetBaseDirectiveListener(v DecoderEvent_BaseDirective_ListenerFunc) DecoderConfig {
 	b.baseDirectiveListener = v
 
@@ -152,6 +176,14 @@ func (b DecoderConfig) apply(s *DecoderConfig) {
 		s.rdfDirection = b.rdfDirection
 	}
 
+	if b.maxRemoteContexts != nil {
+		s.maxRemoteContexts = b.maxRemoteContexts
+	}
+
+	if b.streaming != nil {
+		s.streaming = b.streaming
+	}
+
 	if b.baseDirectiveListener != nil {
 		s.baseDirectiveListener = b.baseDirectiveListener
 	}
@@ -200,6 +232,14 @@ func (b DecoderConfig) newDecoder(r io.Reader) (*Decoder, error) {
 		d.processingMode = *b.processingMode
 	}
 
+	if b.maxRemoteContexts != nil {
+		d.maxRemoteContexts = *b.maxRemoteContexts
+	}
+
+	if b.streaming != nil {
+		d.streaming = *b.streaming
+	}
+
 	if b.rdfDirection != nil {
 		switch *b.rdfDirection {
 		case "i18n-datatype", "compound-literal":
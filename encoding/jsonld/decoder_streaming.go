@@ -0,0 +1,420 @@
+package jsonld
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/internal/jsonldinternal"
+)
+
+// decoderStream decodes the items of a top-level @graph entry as they are read.
+type decoderStream struct {
+	decoder   *Decoder
+	values    *decoderValueReader
+	expander  *jsonldinternal.GraphExpander
+	ectx      evaluationContext
+	root      inspectjson.ObjectValue
+	graphName inspectjson.StringValue
+	graph     inspectjson.ArrayValue
+
+	// decoded is true once any item has resulted in statements.
+	decoded bool
+}
+
+func (r *Decoder) streamRoot() error {
+	var topts []inspectjson.TokenizerOption
+
+	for _, opt := range r.parserOptions {
+		if opt == nil {
+			continue
+		}
+
+		topt, ok := opt.(inspectjson.TokenizerOption)
+		if !ok {
+			// [dpb] other parser options (e.g. duplicate object member handlers) are only supported by inspectjson.Parse
+
+			return r.parseRoot()
+		}
+
+		topts = append(topts, topt)
+	}
+
+	if r.captureTextOffsets {
+		topts = append(topts, inspectjson.TokenizerConfig{}.SetSourceInitialOffset(r.initialTextOffset))
+	}
+
+	ctx := context.Background()
+	values := &decoderValueReader{
+		t: inspectjson.NewTokenizer(r.r, topts...),
+	}
+
+	token, err := values.nextToken()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return r.decodeDocument(ctx, nil)
+		}
+
+		return fmt.Errorf("parse: %w", err)
+	}
+
+	beginObjectToken, ok := token.(inspectjson.BeginObjectToken)
+	if !ok {
+		value, err := values.readValue(token)
+		if err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+
+		err = values.expectEOF()
+		if err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+
+		return r.decodeDocument(ctx, value)
+	}
+
+	root := inspectjson.ObjectValue{
+		BeginToken: beginObjectToken,
+		Members:    map[string]inspectjson.ObjectMember{},
+	}
+
+	for {
+		token, err := values.nextToken()
+		if err != nil {
+			return fmt.Errorf("parse: %w", values.unexpectedEOF(err))
+		}
+
+		if endObjectToken, ok := token.(inspectjson.EndObjectToken); ok {
+			root.EndToken = endObjectToken
+
+			err = values.expectEOF()
+			if err != nil {
+				return fmt.Errorf("parse: %w", err)
+			}
+
+			return r.decodeDocument(ctx, root)
+		}
+
+		nameToken, ok := token.(inspectjson.StringToken)
+		if !ok {
+			return fmt.Errorf("parse: unexpected token: %s", token.GetGrammarName())
+		}
+
+		valueToken, err := values.nextToken()
+		if err != nil {
+			return fmt.Errorf("parse: %w", values.unexpectedEOF(err))
+		}
+
+		// [spec // streaming] the items of @graph may only be processed independently when @context was the only
+		// preceding entry; otherwise, the remaining document is read and processed as a whole.
+
+		if nameToken.Content == "@graph" && len(root.Members) == 1 {
+			contextMember, hasContext := root.Members["@context"]
+			_, isArray := valueToken.(inspectjson.BeginArrayToken)
+
+			if hasContext && isArray {
+				expander, err := jsonldinternal.NewGraphExpander(ctx, contextMember.Value, r.newProcessorOptions())
+				if err != nil {
+					return fmt.Errorf("expand: %w", err)
+				}
+
+				r.stream = &decoderStream{
+					decoder:  r,
+					values:   values,
+					expander: expander,
+					ectx:     r.newEvaluationContext(),
+					root:     root,
+					graphName: inspectjson.StringValue{
+						SourceOffsets: nameToken.SourceOffsets,
+						Value:         nameToken.Content,
+					},
+					graph: inspectjson.ArrayValue{
+						BeginToken: valueToken.(inspectjson.BeginArrayToken),
+					},
+				}
+
+				return nil
+			}
+		}
+
+		value, err := values.readValue(valueToken)
+		if err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+
+		root.Members[nameToken.Content] = inspectjson.ObjectMember{
+			Name: inspectjson.StringValue{
+				SourceOffsets: nameToken.SourceOffsets,
+				Value:         nameToken.Content,
+			},
+			Value: value,
+		}
+	}
+}
+
+// decodeNext decodes the statements of the next item of @graph, or the remaining entries once the array ends.
+//
+// [dpb] items are decoded as statements of the default graph, assuming @graph is the last entry as the streaming
+// profile requires. Only the current item and its statements are kept in memory.
+func (s *decoderStream) decodeNext() error {
+	token, err := s.values.nextToken()
+	if err != nil {
+		return fmt.Errorf("parse: %w", s.values.unexpectedEOF(err))
+	}
+
+	if endArrayToken, ok := token.(inspectjson.EndArrayToken); ok {
+		s.graph.EndToken = endArrayToken
+
+		return s.decodeRoot()
+	}
+
+	item, err := s.values.readValue(token)
+	if err != nil {
+		return fmt.Errorf("parse: %w", err)
+	}
+
+	expandedItem, err := s.expander.Expand(item)
+	if err != nil {
+		return fmt.Errorf("expand: %w", err)
+	}
+
+	err = s.decoder.decodeElement(s.ectx, expandedItem, false)
+	if err != nil {
+		return err
+	}
+
+	if len(s.decoder.statements) > 0 {
+		s.decoded = true
+	}
+
+	return nil
+}
+
+// decodeRoot reads any remaining entries of the top-level object. Entries which make the top-level object a node
+// (such as @id, which makes @graph a named graph) are decoded as a whole if no items resulted in statements; otherwise,
+// they are an error since the statements of the items were already returned for the default graph.
+func (s *decoderStream) decodeRoot() error {
+	var trailing []string
+
+	for {
+		token, err := s.values.nextToken()
+		if err != nil {
+			return fmt.Errorf("parse: %w", s.values.unexpectedEOF(err))
+		}
+
+		if endObjectToken, ok := token.(inspectjson.EndObjectToken); ok {
+			s.root.EndToken = endObjectToken
+
+			break
+		}
+
+		nameToken, ok := token.(inspectjson.StringToken)
+		if !ok {
+			return fmt.Errorf("parse: unexpected token: %s", token.GetGrammarName())
+		}
+
+		valueToken, err := s.values.nextToken()
+		if err != nil {
+			return fmt.Errorf("parse: %w", s.values.unexpectedEOF(err))
+		}
+
+		value, err := s.values.readValue(valueToken)
+		if err != nil {
+			return fmt.Errorf("parse: %w", err)
+		}
+
+		trailing = append(trailing, nameToken.Content)
+
+		s.root.Members[nameToken.Content] = inspectjson.ObjectMember{
+			Name: inspectjson.StringValue{
+				SourceOffsets: nameToken.SourceOffsets,
+				Value:         nameToken.Content,
+			},
+			Value: value,
+		}
+	}
+
+	err := s.values.expectEOF()
+	if err != nil {
+		return fmt.Errorf("parse: %w", err)
+	}
+
+	s.decoder.stream = nil
+
+	if len(trailing) == 0 {
+		return nil
+	}
+
+	// the remaining entries are expanded with an empty @graph which, if the top-level object is still unwrapped, leaves
+	// nothing; otherwise it is a node object which the items belong to.
+
+	s.root.Members["@graph"] = inspectjson.ObjectMember{
+		Name: s.graphName,
+		Value: inspectjson.ArrayValue{
+			BeginToken: s.graph.BeginToken,
+			EndToken:   s.graph.EndToken,
+		},
+	}
+
+	expandedRoot, err := jsonldinternal.Expand(context.Background(), s.root, s.decoder.newProcessorOptions())
+	if err != nil {
+		return fmt.Errorf("expand: %w", err)
+	}
+
+	if expandedRootArray, ok := expandedRoot.(*jsonldinternal.ExpandedArray); !ok || len(expandedRootArray.Values) == 0 {
+		return nil
+	} else if s.decoded {
+		return fmt.Errorf("streaming: unexpected entry after @graph: %s", strings.Join(trailing, ", "))
+	}
+
+	return s.decoder.decodeElement(s.ectx, expandedRoot, false)
+}
+
+// decoderValueReader builds values from tokens, equivalent to [inspectjson.Parse] with its default options.
+type decoderValueReader struct {
+	t *inspectjson.Tokenizer
+}
+
+func (vr *decoderValueReader) nextToken() (inspectjson.Token, error) {
+	for {
+		token, err := vr.t.Next()
+		if err != nil {
+			return nil, err
+		}
+
+		switch token.(type) {
+		case inspectjson.WhitespaceToken, inspectjson.NameSeparatorToken, inspectjson.ValueSeparatorToken:
+			continue
+		}
+
+		return token, nil
+	}
+}
+
+func (vr *decoderValueReader) unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+func (vr *decoderValueReader) expectEOF() error {
+	token, err := vr.nextToken()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		return err
+	}
+
+	return fmt.Errorf("unexpected token: %s", token.GetGrammarName())
+}
+
+func (vr *decoderValueReader) readValue(token inspectjson.Token) (inspectjson.Value, error) {
+	switch tokenT := token.(type) {
+	case inspectjson.BeginArrayToken:
+		value := inspectjson.ArrayValue{
+			BeginToken: tokenT,
+		}
+
+		for {
+			itemToken, err := vr.nextToken()
+			if err != nil {
+				return nil, vr.unexpectedEOF(err)
+			}
+
+			if endArrayToken, ok := itemToken.(inspectjson.EndArrayToken); ok {
+				value.EndToken = endArrayToken
+
+				return value, nil
+			}
+
+			item, err := vr.readValue(itemToken)
+			if err != nil {
+				return nil, err
+			}
+
+			value.Values = append(value.Values, item)
+		}
+	case inspectjson.BeginObjectToken:
+		value := inspectjson.ObjectValue{
+			BeginToken: tokenT,
+			Members:    map[string]inspectjson.ObjectMember{},
+		}
+
+		for {
+			nameToken, err := vr.nextToken()
+			if err != nil {
+				return nil, vr.unexpectedEOF(err)
+			}
+
+			if endObjectToken, ok := nameToken.(inspectjson.EndObjectToken); ok {
+				value.EndToken = endObjectToken
+
+				return value, nil
+			}
+
+			nameString, ok := nameToken.(inspectjson.StringToken)
+			if !ok {
+				return nil, fmt.Errorf("unexpected token: %s", nameToken.GetGrammarName())
+			}
+
+			memberToken, err := vr.nextToken()
+			if err != nil {
+				return nil, vr.unexpectedEOF(err)
+			}
+
+			memberValue, err := vr.readValue(memberToken)
+			if err != nil {
+				return nil, err
+			}
+
+			// [dpb] duplicate members keep the last value, same as the default of inspectjson.Parse
+
+			value.Members[nameString.Content] = inspectjson.ObjectMember{
+				Name: inspectjson.StringValue{
+					SourceOffsets: nameString.SourceOffsets,
+					Value:         nameString.Content,
+				},
+				Value: memberValue,
+			}
+		}
+	case inspectjson.StringToken:
+		return inspectjson.StringValue{
+			SourceOffsets: tokenT.SourceOffsets,
+			Value:         tokenT.Content,
+		}, nil
+	case inspectjson.NumberToken:
+		valueFloat64, err := strconv.ParseFloat(tokenT.Content, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse number (float): %v", err)
+		}
+
+		return inspectjson.NumberValue{
+			SourceOffsets: tokenT.SourceOffsets,
+			Value:         valueFloat64,
+		}, nil
+	case inspectjson.TrueToken:
+		return inspectjson.BooleanValue{
+			SourceOffsets: tokenT.SourceOffsets,
+			Value:         true,
+		}, nil
+	case inspectjson.FalseToken:
+		return inspectjson.BooleanValue{
+			SourceOffsets: tokenT.SourceOffsets,
+			Value:         false,
+		}, nil
+	case inspectjson.NullToken:
+		return inspectjson.NullValue{
+			SourceOffsets: tokenT.SourceOffsets,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected token: %s", token.GetGrammarName())
+}
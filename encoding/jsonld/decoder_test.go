@@ -1,14 +1,23 @@
 package jsonld
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"testing"
+	"testing/iotest"
 
+	"github.com/dpb587/inspectjson-go/inspectjson"
 	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/quads"
+	"github.com/dpb587/rdfkit-go/testing/testingassert"
 )
 
 var documentLoader = jsonldtype.NewCachingDocumentLoader(jsonldtype.NewDefaultDocumentLoader(http.DefaultClient))
@@ -291,3 +300,211 @@ func TestTwo(t *testing.T) {
 
 	fmt.Fprintf(os.Stderr, "%#+v\n", statements)
 }
+
+func TestDecoder_MaxRemoteContexts(t *testing.T) {
+	var loaded []string
+
+	documentLoader := jsonldtype.DocumentLoaderFunc(func(ctx context.Context, u string, opts jsonldtype.DocumentLoaderOptions) (jsonldtype.RemoteDocument, error) {
+		loaded = append(loaded, u)
+
+		documentURL, err := url.Parse(u)
+		if err != nil {
+			return jsonldtype.RemoteDocument{}, err
+		}
+
+		document, err := inspectjson.Parse(strings.NewReader(`{"@context":{}}`))
+		if err != nil {
+			return jsonldtype.RemoteDocument{}, err
+		}
+
+		return jsonldtype.RemoteDocument{
+			DocumentURL: documentURL,
+			Document:    document,
+		}, nil
+	})
+
+	input := `{
+  "@context": ["http://example.com/a", "http://example.com/b", "http://example.com/a"],
+  "@id": "http://example.com/resource",
+  "http://example.com/name": "value"
+}`
+
+	_, err := quads.CollectErr(
+		NewDecoder(
+			strings.NewReader(input),
+			DecoderConfig{}.SetDocumentLoader(documentLoader).SetMaxRemoteContexts(2),
+		),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(loaded), 2; _a != _e {
+		t.Fatalf("expected %d, got %d", _e, _a)
+	}
+
+	_, err = quads.CollectErr(
+		NewDecoder(
+			strings.NewReader(input),
+			DecoderConfig{}.SetDocumentLoader(documentLoader).SetMaxRemoteContexts(1),
+		),
+	)
+
+	var errPolicy jsonldtype.RemoteDocumentPolicyError
+	if !errors.As(err, &errPolicy) {
+		t.Fatalf("expected RemoteDocumentPolicyError, got %v", err)
+	} else if _a, _e := errPolicy.Reason, jsonldtype.RemoteDocumentPolicyReasonTooManyRemoteContexts; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	} else if _a, _e := errPolicy.URL, "http://example.com/b"; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+
+	var errJSONLD jsonldtype.Error
+	if !errors.As(err, &errJSONLD) {
+		t.Fatalf("expected Error, got %v", err)
+	} else if _a, _e := errJSONLD.Code, jsonldtype.LoadingRemoteContextFailed; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestDecoder_Streaming(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Input string
+	}{
+		{
+			Name: "streaming profile",
+			Input: `{
+  "@context": {"@vocab": "http://example.com/", "knows": {"@type": "@id"}},
+  "@graph": [
+    {"@id": "http://example.com/a", "name": "A", "knows": "_:b"},
+    {"@id": "_:b", "name": "B", "list": {"@list": [1, 2]}},
+    {"@id": "http://example.com/g", "@graph": {"@id": "http://example.com/c", "name": "C"}},
+    "free-floating",
+    [{"name": "nested"}]
+  ]
+}`,
+		},
+		{
+			Name:  "empty graph",
+			Input: `{"@context": {"@vocab": "http://example.com/"}, "@graph": []}`,
+		},
+		{
+			Name:  "graph before context",
+			Input: `{"@graph": [{"name": "A"}], "@context": {"@vocab": "http://example.com/"}}`,
+		},
+		{
+			Name:  "named graph",
+			Input: `{"@context": {"@vocab": "http://example.com/"}, "@id": "http://example.com/g", "@graph": [{"name": "A"}]}`,
+		},
+		{
+			Name:  "single node",
+			Input: `{"@context": {"@vocab": "http://example.com/"}, "@id": "http://example.com/a", "name": "A"}`,
+		},
+		{
+			Name:  "id after empty graph",
+			Input: `{"@context": {"@vocab": "http://example.com/"}, "@graph": [], "@id": "http://example.com/g", "name": "G"}`,
+		},
+		{
+			Name:  "dropped entry after graph",
+			Input: `{"@context": {"name": "http://example.com/name"}, "@graph": [{"name": "A"}], "unmapped": "G"}`,
+		},
+		{
+			Name:  "top-level array",
+			Input: `[{"@id": "http://example.com/a", "http://example.com/name": "A"}]`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			expectedStatements, err := quads.CollectErr(NewDecoder(strings.NewReader(tc.Input)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			actualStatements, err := quads.CollectErr(NewDecoder(strings.NewReader(tc.Input), DecoderConfig{}.SetStreaming(true)))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			testingassert.IsomorphicDatasets(t.Context(), t, expectedStatements, actualStatements)
+		})
+	}
+}
+
+func TestDecoder_StreamingIncremental(t *testing.T) {
+	errReader := errors.New("reader error")
+
+	decoder, err := NewDecoder(
+		io.MultiReader(
+			strings.NewReader(`{"@context": {"@vocab": "http://example.com/"}, "@graph": [{"@id": "http://example.com/a", "name": "A"},`),
+			iotest.ErrReader(errReader),
+		),
+		DecoderConfig{}.SetStreaming(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expectedObject := rdf.Literal{
+		LexicalForm: "A",
+		Datatype:    xsdiri.String_Datatype,
+	}
+
+	// the statement is returned before the closing bracket of @graph is read
+	if !decoder.Next() {
+		t.Fatalf("expected statement, got error: %v", decoder.Err())
+	} else if _a, _e := decoder.Quad().Triple.Object, expectedObject; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	if decoder.Next() {
+		t.Fatalf("expected no statement")
+	} else if !errors.Is(decoder.Err(), errReader) {
+		t.Fatalf("expected reader error, got %v", decoder.Err())
+	}
+}
+
+func TestDecoder_StreamingInvalidItem(t *testing.T) {
+	errReader := errors.New("reader error")
+
+	// the invalid item is reported before the reader error since items are expanded as they are read
+	_, err := quads.CollectErr(NewDecoder(
+		io.MultiReader(
+			strings.NewReader(`{"@context": {"@vocab": "http://example.com/"}, "@graph": [{"@id": true, "name": "A"},`),
+			iotest.ErrReader(errReader),
+		),
+		DecoderConfig{}.SetStreaming(true),
+	))
+	if err == nil {
+		t.Fatalf("expected error")
+	} else if errors.Is(err, errReader) {
+		t.Fatalf("expected expansion error, got %v", err)
+	} else if _a, _e := err.Error(), "expand: "; !strings.HasPrefix(_a, _e) {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestDecoder_StreamingEntryAfterGraph(t *testing.T) {
+	for _, tc := range []struct {
+		Name  string
+		Input string
+		Error string
+	}{
+		{
+			Name:  "id",
+			Input: `{"@context": {"@vocab": "http://example.com/"}, "@graph": [{"name": "A"}], "@id": "http://example.com/g"}`,
+			Error: "streaming: unexpected entry after @graph: @id",
+		},
+		{
+			Name:  "property",
+			Input: `{"@context": {"@vocab": "http://example.com/"}, "@graph": [{"name": "A"}], "name": "G"}`,
+			Error: "streaming: unexpected entry after @graph: name",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := quads.CollectErr(NewDecoder(strings.NewReader(tc.Input), DecoderConfig{}.SetStreaming(true)))
+			if err == nil {
+				t.Fatalf("expected error")
+			} else if _a, _e := err.Error(), tc.Error; _a != _e {
+				t.Fatalf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
@@ -6,14 +6,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"math"
 	"slices"
+	"strconv"
+	"strings"
 
+	"github.com/dpb587/inspectjson-go/inspectjson"
 	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/internal/jsonldinternal"
 	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldcontent"
 	"github.com/dpb587/rdfkit-go/iri"
 	"github.com/dpb587/rdfkit-go/iri/iriutil"
 	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdtype"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
 	"github.com/dpb587/rdfkit-go/rdfdescription"
@@ -30,8 +36,16 @@ type Encoder struct {
 	base             *iri.BaseIRI
 	prefixes         *iriutil.UsagePrefixMapper
 	buffered         bool
+	rdfDirection     string
+	graphContainer   bool
+	useNativeTypes   bool
+	useRdfType       bool
+	convertLists     bool
 	bnStringProvider blanknodes.StringProvider
 
+	frame      inspectjson.Value
+	frameQuads rdf.QuadList
+
 	err     error
 	builder *rdfdescription.DatasetResourceListBuilder
 }
@@ -62,34 +76,62 @@ func (e *Encoder) Close() error {
 		return e.err
 	}
 
-	var graphItems = []any{}
+	if e.frame != nil {
+		return e.closeFramed()
+	}
+
+	graphNames := e.builder.GetGraphNames()
 
-	for _, graphName := range e.builder.GetGraphNames() {
-		if graphName != nil {
-			continue // TODO multi-graph support
+	e.linkBlankNodes(graphNames)
+
+	namedGraphItems := map[rdf.GraphNameValue][]any{}
+
+	for _, graphName := range graphNames {
+		if graphName == nil {
+			continue
 		}
 
-		builder := e.builder.GetResourceListBuilder(graphName)
+		namedGraphItems[graphName] = e.buildGraphItems(e.builder.GetResourceListBuilder(graphName))
+	}
 
+	var graphItems = []any{}
+
+	if builder := e.builder.GetResourceListBuilder(nil); builder != nil {
 		for resource := range builder.ExportResources(rdfdescription.DefaultExportResourceOptions) {
-			graphItems = append(graphItems, e.buildResource(builder, resource, true))
+			graphItem := e.buildResource(builder, resource, true)
+
+			if graphName, ok := resource.GetResourceSubject().(rdf.GraphNameValue); ok {
+				if items, ok := namedGraphItems[graphName]; ok {
+					graphItem["@graph"] = items
+
+					delete(namedGraphItems, graphName)
+				}
+			}
+
+			graphItems = append(graphItems, graphItem)
 		}
 	}
 
-	if e.buffered && len(graphItems) > 1 {
-		for i, item := range graphItems {
-			marshaled, _ := json.Marshal(item)
-			graphItems[i] = json.RawMessage(marshaled)
+	for _, graphName := range graphNames {
+		items, ok := namedGraphItems[graphName]
+		if !ok {
+			// default graph, or already merged into its node
+			continue
 		}
 
-		slices.SortFunc(graphItems, func(a, b any) int {
-			return bytes.Compare(a.(json.RawMessage), b.(json.RawMessage))
+		graphItems = append(graphItems, map[string]any{
+			"@id":    e.buildNodeID(graphName.(rdf.SubjectValue)),
+			"@graph": items,
 		})
 	}
 
+	if e.buffered && len(graphItems) > 1 {
+		sortJSONValues(graphItems)
+	}
+
 	var wrapped map[string]any
 
-	if len(graphItems) == 1 {
+	if len(graphItems) == 1 && !e.graphContainer {
 		wrapped = graphItems[0].(map[string]any)
 	} else {
 		wrapped = map[string]any{
@@ -130,6 +172,12 @@ func (w *Encoder) AddQuad(ctx context.Context, t rdf.Quad) error {
 		return w.err
 	}
 
+	if w.frame != nil {
+		w.frameQuads = append(w.frameQuads, t)
+
+		return nil
+	}
+
 	w.builder.Add(t)
 
 	return nil
@@ -140,113 +188,153 @@ func (w *Encoder) AddDatasetResource(ctx context.Context, resource rdfdescriptio
 		return w.err
 	}
 
+	if w.frame != nil {
+		w.frameQuads = append(w.frameQuads, resource.NewQuads()...)
+
+		return nil
+	}
+
 	w.builder.AddDatasetResource(ctx, resource)
 
 	return nil
 }
 
-func (e *Encoder) buildResource(builder *rdfdescription.ResourceListBuilder, resource rdfdescription.Resource, root bool) map[string]any {
-	graphProperties := make(map[string][]any)
+// closeFramed converts the statements to expanded JSON-LD and writes the result of framing it.
+func (e *Encoder) closeFramed() error {
+	popts := ProcessorConfig{}.
+		SetUseNativeTypes(e.useNativeTypes).
+		SetUseRdfType(e.useRdfType)
 
-	for _, statement := range resource.GetResourceStatements() {
-		var statementObject any
-		var predicate rdf.IRI
+	if e.base != nil {
+		popts = popts.SetBase(e.base.String())
+	}
 
-		switch statementT := statement.(type) {
-		case rdfdescription.AnonResourceStatement:
-			predicate = statementT.Predicate.(rdf.IRI)
-			statementObject = e.buildResource(builder, statementT.AnonResource, false)
-		case rdfdescription.ObjectStatement:
-			predicate = statementT.Predicate.(rdf.IRI)
-
-			switch obj := statementT.Object.(type) {
-			case rdf.IRI:
-				var wrapID string
-
-				if pr, ok := e.prefixes.CompactPrefix(string(obj)); ok {
-					wrapID = pr.String()
-				} else if e.base != nil {
-					if rel, ok := e.base.RelativizeIRI(string(obj)); ok {
-						wrapID = rel
-					} else {
-						wrapID = string(obj)
-					}
-				} else {
-					wrapID = string(obj)
-				}
+	if len(e.rdfDirection) > 0 {
+		popts = popts.SetRDFDirection(e.rdfDirection)
+	}
+
+	opts, err := popts.newProcessorOptions()
+	if err != nil {
+		return err
+	}
+
+	expanded, err := jsonldinternal.FromRDF(e.frameQuads, e.bnStringProvider, opts)
+	if err != nil {
+		return fmt.Errorf("from rdf: %v", err)
+	}
+
+	framed, err := jsonldinternal.FrameExpanded(context.Background(), expanded, e.frame, opts)
+	if err != nil {
+		return fmt.Errorf("frame: %v", err)
+	}
+
+	err = e.w.Encode(framed)
+	if err != nil {
+		return fmt.Errorf("encode: %v", err)
+	}
+
+	e.err = io.ErrClosedPipe
+
+	return nil
+}
+
+// linkBlankNodes marks the blank nodes which are used by more than one graph, or as the name of a graph, so that they
+// keep their identifier rather than being inlined within a single graph.
+func (e *Encoder) linkBlankNodes(graphNames rdf.GraphNameValueList) {
+	graphsByBlankNode := map[rdf.BlankNodeIdentifier]map[rdf.GraphNameValue]struct{}{}
+
+	addUsage := func(bn rdf.BlankNode, graphName rdf.GraphNameValue) {
+		if graphsByBlankNode[bn.Identifier] == nil {
+			graphsByBlankNode[bn.Identifier] = map[rdf.GraphNameValue]struct{}{}
+		}
+
+		graphsByBlankNode[bn.Identifier][graphName] = struct{}{}
+	}
 
-				if predicate == rdfiri.Type_Property {
-					statementObject = wrapID
-				} else {
-					statementObject = map[string]any{
-						"@id": wrapID,
+	defaultBuilder := e.builder.GetResourceListBuilder(nil)
+
+	for _, graphName := range graphNames {
+		builder := e.builder.GetResourceListBuilder(graphName)
+
+		if bn, ok := graphName.(rdf.BlankNode); ok {
+			addUsage(bn, nil)
+
+			// the graph object is merged into the node object of the default graph
+			if defaultBuilder != nil {
+				defaultBuilder.AddExternalReference(bn)
+			}
+		}
+
+		for subject := range builder.Subjects() {
+			if bn, ok := subject.(rdf.BlankNode); ok {
+				addUsage(bn, graphName)
+			}
+
+			for _, statement := range builder.GetSubjectStatements(subject) {
+				if objectStatement, ok := statement.(rdfdescription.ObjectStatement); ok {
+					if bn, ok := objectStatement.Object.(rdf.BlankNode); ok {
+						addUsage(bn, graphName)
 					}
 				}
-			case rdf.BlankNode:
-				statementObject = map[string]any{
-					"@id": "_:" + e.bnStringProvider.GetBlankNodeString(obj),
-				}
-			case rdf.Literal:
-				switch obj.Datatype {
-				case xsdiri.String_Datatype:
-					statementObject = obj.LexicalForm
-				case xsdiri.Integer_Datatype, xsdiri.Double_Datatype:
-					// TODO avoid number overflow
-					statementObject = json.Number(obj.LexicalForm)
-				case xsdiri.Boolean_Datatype:
-					switch obj.LexicalForm {
-					case "true":
-						statementObject = true
-					case "false":
-						statementObject = false
-					default:
-						pr, ok := e.prefixes.CompactPrefix(string(obj.Datatype))
-						if ok {
-							statementObject = map[string]any{
-								"@value": obj.LexicalForm,
-								"@type":  pr.String(),
-							}
-						} else {
-							statementObject = map[string]any{
-								"@value": obj.LexicalForm,
-								"@type":  string(obj.Datatype),
-							}
-						}
-					}
-				default:
-					if obj.Datatype == rdfiri.LangString_Datatype {
-						if tag, ok := obj.Tag.(rdf.LanguageLiteralTag); ok {
-							statementObject = map[string]any{
-								"@value":    obj.LexicalForm,
-								"@language": tag.Language,
-							}
-
-							continue
-						}
-					}
+			}
+		}
+	}
 
-					pr, ok := e.prefixes.CompactPrefix(string(obj.Datatype))
-					if ok {
-						statementObject = map[string]any{
-							"@value": obj.LexicalForm,
-							"@type":  pr.String(),
-						}
-					} else {
-						statementObject = map[string]any{
-							"@value": obj.LexicalForm,
-							"@type":  string(obj.Datatype),
-						}
-					}
+	for bnIdentifier, graphs := range graphsByBlankNode {
+		if len(graphs) < 2 {
+			continue
+		}
 
-				}
+		for graphName := range graphs {
+			if builder := e.builder.GetResourceListBuilder(graphName); builder != nil {
+				builder.AddExternalReference(rdf.BlankNode{Identifier: bnIdentifier})
+			}
+		}
+	}
+}
+
+func (e *Encoder) buildGraphItems(builder *rdfdescription.ResourceListBuilder) []any {
+	var graphItems = []any{}
+
+	for resource := range builder.ExportResources(rdfdescription.DefaultExportResourceOptions) {
+		graphItems = append(graphItems, e.buildResource(builder, resource, true))
+	}
+
+	if e.buffered {
+		sortJSONValues(graphItems)
+	}
+
+	return graphItems
+}
+
+func (e *Encoder) buildNodeID(v rdf.SubjectValue) string {
+	switch v := v.(type) {
+	case rdf.IRI:
+		if pr, ok := e.prefixes.CompactPrefix(string(v)); ok {
+			return pr.String()
+		} else if e.base != nil {
+			if rel, ok := e.base.RelativizeIRI(string(v)); ok {
+				return rel
 			}
-		default:
-			panic(fmt.Errorf("unsupported statement type: %T", statementT))
 		}
 
+		return string(v)
+	case rdf.BlankNode:
+		return "_:" + e.bnStringProvider.GetBlankNodeString(v)
+	}
+
+	panic(fmt.Errorf("unsupported node type: %T", v))
+}
+
+func (e *Encoder) buildResource(builder *rdfdescription.ResourceListBuilder, resource rdfdescription.Resource, root bool) map[string]any {
+	graphProperties := make(map[string][]any)
+
+	for _, statement := range resource.GetResourceStatements() {
+		predicate, statementObject, typeKeyword := e.buildStatementObject(builder, statement)
+
 		var key string = string(predicate)
 
-		if predicate == rdfiri.Type_Property {
+		if typeKeyword {
 			key = "@type"
 		} else if pr, ok := e.prefixes.CompactPrefix(string(predicate)); ok {
 			key = pr.String()
@@ -259,24 +347,14 @@ func (e *Encoder) buildResource(builder *rdfdescription.ResourceListBuilder, res
 
 	switch v := resource.GetResourceSubject().(type) {
 	case rdf.IRI:
-		if pr, ok := e.prefixes.CompactPrefix(string(v)); ok {
-			graphItem["@id"] = pr.String()
-		} else if e.base != nil {
-			if rel, ok := e.base.RelativizeIRI(string(v)); ok {
-				graphItem["@id"] = rel
-			} else {
-				graphItem["@id"] = string(v)
-			}
-		} else {
-			graphItem["@id"] = string(v)
-		}
+		graphItem["@id"] = e.buildNodeID(v)
 	case rdf.BlankNode:
 		if root {
 			if builder.GetBlankNodeReferences(v) > 0 {
-				graphItem["@id"] = "_:" + e.bnStringProvider.GetBlankNodeString(v)
+				graphItem["@id"] = e.buildNodeID(v)
 			}
 		} else if builder.GetBlankNodeReferences(v) > 1 {
-			graphItem["@id"] = "_:" + e.bnStringProvider.GetBlankNodeString(v)
+			graphItem["@id"] = e.buildNodeID(v)
 		}
 	case nil:
 		// AnonResource
@@ -289,14 +367,7 @@ func (e *Encoder) buildResource(builder *rdfdescription.ResourceListBuilder, res
 			graphItem[key] = values[0]
 		} else if len(values) > 1 {
 			if e.buffered {
-				for i, value := range values {
-					marshaled, _ := json.Marshal(value)
-					values[i] = json.RawMessage(marshaled)
-				}
-
-				slices.SortFunc(values, func(a, b any) int {
-					return bytes.Compare(a.(json.RawMessage), b.(json.RawMessage))
-				})
+				sortJSONValues(values)
 			}
 
 			graphItem[key] = values
@@ -305,3 +376,322 @@ func (e *Encoder) buildResource(builder *rdfdescription.ResourceListBuilder, res
 
 	return graphItem
 }
+
+// buildStatementObject returns the predicate and the JSON-LD value for the object of a statement. If typeKeyword is
+// true, the value is a node identifier which should be used with @type.
+func (e *Encoder) buildStatementObject(builder *rdfdescription.ResourceListBuilder, statement rdfdescription.Statement) (rdf.IRI, any, bool) {
+	switch statementT := statement.(type) {
+	case rdfdescription.AnonResourceStatement:
+		predicate := statementT.Predicate.(rdf.IRI)
+
+		if listObject, ok := e.buildListObject(builder, statementT.AnonResource); ok {
+			return predicate, listObject, false
+		} else if compoundLiteral, ok := e.buildCompoundLiteral(statementT.AnonResource); ok {
+			return predicate, compoundLiteral, false
+		}
+
+		return predicate, e.buildResource(builder, statementT.AnonResource, false), false
+	case rdfdescription.ObjectStatement:
+		predicate := statementT.Predicate.(rdf.IRI)
+
+		switch obj := statementT.Object.(type) {
+		case rdf.IRI:
+			// only node identifiers may be used with @type; otherwise the rdf:type property is written
+			if predicate == rdfiri.Type_Property && !e.useRdfType {
+				return predicate, e.buildNodeID(obj), true
+			} else if obj == rdfiri.Nil_List && e.convertLists {
+				return predicate, map[string]any{
+					"@list": []any{},
+				}, false
+			}
+
+			return predicate, map[string]any{
+				"@id": e.buildNodeID(obj),
+			}, false
+		case rdf.BlankNode:
+			if predicate == rdfiri.Type_Property && !e.useRdfType {
+				return predicate, e.buildNodeID(obj), true
+			}
+
+			return predicate, map[string]any{
+				"@id": e.buildNodeID(obj),
+			}, false
+		case rdf.Literal:
+			return predicate, e.buildLiteral(obj), false
+		}
+
+		return predicate, nil, false
+	}
+
+	panic(fmt.Errorf("unsupported statement type: %T", statement))
+}
+
+// buildListObject converts a resource which is the head of a well-formed rdf:first and rdf:rest chain into a list
+// object. Each node of the chain must only have one rdf:first and one rdf:rest statement, and, optionally, a type of
+// rdf:List. Chains with any other statements are not converted.
+func (e *Encoder) buildListObject(builder *rdfdescription.ResourceListBuilder, resource rdfdescription.AnonResource) (map[string]any, bool) {
+	if !e.convertLists {
+		return nil, false
+	}
+
+	var items = []any{}
+
+	for {
+		var first, rest rdfdescription.Statement
+		var typed bool
+
+		for _, statement := range resource.GetResourceStatements() {
+			var predicate rdf.PredicateValue
+
+			switch statementT := statement.(type) {
+			case rdfdescription.ObjectStatement:
+				predicate = statementT.Predicate
+
+				if predicate == rdfiri.Type_Property && statementT.Object == rdfiri.List_Class && !e.useRdfType && !typed {
+					typed = true
+
+					continue
+				}
+			case rdfdescription.AnonResourceStatement:
+				predicate = statementT.Predicate
+			default:
+				return nil, false
+			}
+
+			switch predicate {
+			case rdfiri.First_Property:
+				if first != nil {
+					return nil, false
+				}
+
+				first = statement
+			case rdfiri.Rest_Property:
+				if rest != nil {
+					return nil, false
+				}
+
+				rest = statement
+			default:
+				return nil, false
+			}
+		}
+
+		if first == nil || rest == nil {
+			return nil, false
+		}
+
+		_, item, _ := e.buildStatementObject(builder, first)
+
+		items = append(items, item)
+
+		switch restT := rest.(type) {
+		case rdfdescription.ObjectStatement:
+			if restT.Object != rdfiri.Nil_List {
+				return nil, false
+			}
+
+			return map[string]any{
+				"@list": items,
+			}, true
+		case rdfdescription.AnonResourceStatement:
+			resource = restT.AnonResource
+		}
+	}
+}
+
+func (e *Encoder) buildLiteral(obj rdf.Literal) any {
+	switch obj.Datatype {
+	case xsdiri.String_Datatype:
+		return obj.LexicalForm
+	case xsdiri.Integer_Datatype:
+		if !e.useNativeTypes {
+			break
+		}
+
+		if v, err := xsdtype.MapBigInteger(obj.LexicalForm); err == nil && v.Int.IsInt64() && isSafeJSONInteger(v.Int.Int64()) {
+			return json.Number(v.CanonicalLexicalForm())
+		}
+	case xsdiri.Double_Datatype:
+		if !e.useNativeTypes {
+			break
+		}
+
+		// integral values would be read as an integer
+		if v, err := strconv.ParseFloat(obj.LexicalForm, 64); err == nil && !math.IsInf(v, 0) && !math.IsNaN(v) && (v != math.Trunc(v) || math.Abs(v) >= 1e21) {
+			return json.Number(formatCanonicalDouble(v))
+		}
+	case xsdiri.Boolean_Datatype:
+		if !e.useNativeTypes {
+			break
+		}
+
+		switch obj.LexicalForm {
+		case "true":
+			return true
+		case "false":
+			return false
+		}
+	case rdfiri.LangString_Datatype:
+		if tag, ok := obj.Tag.(rdf.LanguageLiteralTag); ok {
+			return map[string]any{
+				"@value":    obj.LexicalForm,
+				"@language": tag.Language,
+			}
+		}
+	case rdfiri.DirLangString_Datatype:
+		if tag, ok := obj.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+			return newDirectionValueObject(obj.LexicalForm, tag.Language, tag.BaseDirection)
+		}
+	default:
+		if e.rdfDirection == "i18n-datatype" {
+			if i18nTag, ok := strings.CutPrefix(string(obj.Datatype), i18nDatatypeBase); ok {
+				if idx := strings.LastIndex(i18nTag, "_"); idx > -1 && isWellFormedLiteralBaseDirectionTag(i18nTag[idx+1:]) {
+					return newDirectionValueObject(obj.LexicalForm, i18nTag[:idx], i18nTag[idx+1:])
+				}
+			}
+		}
+	}
+
+	if pr, ok := e.prefixes.CompactPrefix(string(obj.Datatype)); ok {
+		return map[string]any{
+			"@value": obj.LexicalForm,
+			"@type":  pr.String(),
+		}
+	}
+
+	return map[string]any{
+		"@value": obj.LexicalForm,
+		"@type":  string(obj.Datatype),
+	}
+}
+
+// buildCompoundLiteral converts a resource which was described by the compound-literal representation of rdfDirection
+// back into a value object. Resources with any other statements are not converted.
+func (e *Encoder) buildCompoundLiteral(resource rdfdescription.AnonResource) (map[string]any, bool) {
+	if e.rdfDirection != "compound-literal" {
+		return nil, false
+	}
+
+	var value, language, direction *string
+
+	for _, statement := range resource.GetResourceStatements() {
+		objectStatement, ok := statement.(rdfdescription.ObjectStatement)
+		if !ok {
+			return nil, false
+		}
+
+		literal, ok := objectStatement.Object.(rdf.Literal)
+		if !ok || literal.Datatype != xsdiri.String_Datatype {
+			return nil, false
+		}
+
+		var target **string
+
+		switch objectStatement.Predicate {
+		case rdfiri.Value_Property:
+			target = &value
+		case rdfiri.Language_Property:
+			target = &language
+		case rdfiri.Direction_Property:
+			target = &direction
+		default:
+			return nil, false
+		}
+
+		if *target != nil {
+			return nil, false
+		}
+
+		*target = &literal.LexicalForm
+	}
+
+	if value == nil || direction == nil || !isWellFormedLiteralBaseDirectionTag(*direction) {
+		return nil, false
+	}
+
+	var languageValue string
+
+	if language != nil {
+		languageValue = *language
+	}
+
+	return newDirectionValueObject(*value, languageValue, *direction), true
+}
+
+// sortJSONValues replaces values with their marshaled form, ordered bytewise, for deterministic output.
+func sortJSONValues(values []any) {
+	for i, value := range values {
+		marshaled, _ := json.Marshal(value)
+		values[i] = json.RawMessage(marshaled)
+	}
+
+	slices.SortFunc(values, func(a, b any) int {
+		return bytes.Compare(a.(json.RawMessage), b.(json.RawMessage))
+	})
+}
+
+// isSafeJSONInteger checks whether a value can be used as a native JSON number without a loss of precision by
+// consumers which use IEEE 754 doubles.
+func isSafeJSONInteger(v int64) bool {
+	const maxSafeInteger = 1<<53 - 1
+
+	return -maxSafeInteger <= v && v <= maxSafeInteger
+}
+
+// formatCanonicalDouble formats a value in the canonical lexical form of xsd:double used by JSON-LD (e.g. 1.5E0).
+func formatCanonicalDouble(v float64) string {
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(v, 'E', -1, 64), "E")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+
+	exponentInt, _ := strconv.Atoi(exponent)
+
+	return mantissa + "E" + strconv.Itoa(exponentInt)
+}
+
+func newDirectionValueObject(value, language, direction string) map[string]any {
+	valueObject := map[string]any{
+		"@value":     value,
+		"@direction": direction,
+	}
+
+	if len(language) > 0 {
+		valueObject["@language"] = language
+	}
+
+	return valueObject
+}
+
+// c1EscapingWriter escapes the C1 control characters within the output of json.Encoder, which otherwise writes them
+// as-is. Some parsers, including the one used by Decoder, require them to be escaped.
+type c1EscapingWriter struct {
+	w io.Writer
+}
+
+func (w c1EscapingWriter) Write(p []byte) (int, error) {
+	if !bytes.Contains(p, []byte{0xc2}) {
+		return w.w.Write(p)
+	}
+
+	buf := make([]byte, 0, len(p)+16)
+
+	for i := 0; i < len(p); i++ {
+		// U+0080 through U+009F are encoded as 0xC2 0x80 through 0xC2 0x9F
+		if p[i] == 0xc2 && i+1 < len(p) && p[i+1] >= 0x80 && p[i+1] <= 0x9f {
+			buf = fmt.Appendf(buf, "\\u%04x", p[i+1])
+			i++
+
+			continue
+		}
+
+		buf = append(buf, p[i])
+	}
+
+	_, err := w.w.Write(buf)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
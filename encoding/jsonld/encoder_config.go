@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/dpb587/inspectjson-go/inspectjson"
 	"github.com/dpb587/rdfkit-go/iri"
 	"github.com/dpb587/rdfkit-go/iri/iriutil"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
@@ -16,6 +17,18 @@ type EncoderConfig struct {
 	prefixes iri.PrefixMappingList
 	buffered *bool
 
+	autoPrefixes *bool
+
+	rdfDirection *string
+
+	graphContainer *bool
+
+	useNativeTypes *bool
+	useRdfType     *bool
+	convertLists   *bool
+
+	frame inspectjson.Value
+
 	jsonPrefix     *string
 	jsonIndent     *string
 	jsonEscapeHTML *bool
@@ -41,6 +54,63 @@ func (s EncoderConfig) SetBuffered(v bool) EncoderConfig {
 	return s
 }
 
+// SetAutoPrefixes creates prefixes in @context for the namespaces of IRIs which are not covered by the configured
+// prefixes. See [iriutil.AutoPrefixMapper].
+func (s EncoderConfig) SetAutoPrefixes(v bool) EncoderConfig {
+	s.autoPrefixes = &v
+
+	return s
+}
+
+// SetRDFDirection enables recognizing the alternative representations of base direction which are produced by a
+// decoder using the same option. Valid values are "i18n-datatype" and "compound-literal".
+//
+// Literals using the dirLangString datatype are always encoded with @direction.
+func (s EncoderConfig) SetRDFDirection(v string) EncoderConfig {
+	s.rdfDirection = &v
+
+	return s
+}
+
+// SetGraphContainer always writes the top-level nodes within a @graph array of the document, even when there is only
+// one node. By default, a single node is written as the document itself.
+func (s EncoderConfig) SetGraphContainer(v bool) EncoderConfig {
+	s.graphContainer = &v
+
+	return s
+}
+
+// SetUseNativeTypes writes xsd:boolean, xsd:integer, and xsd:double literals as native JSON values when they can be
+// read back without a loss of precision. By default, true.
+func (s EncoderConfig) SetUseNativeTypes(v bool) EncoderConfig {
+	s.useNativeTypes = &v
+
+	return s
+}
+
+// SetUseRdfType writes rdf:type statements as properties rather than using @type. By default, false.
+func (s EncoderConfig) SetUseRdfType(v bool) EncoderConfig {
+	s.useRdfType = &v
+
+	return s
+}
+
+// SetConvertLists writes well-formed rdf:first and rdf:rest chains of blank nodes, and references to rdf:nil, as @list
+// objects. By default, false.
+func (s EncoderConfig) SetConvertLists(v bool) EncoderConfig {
+	s.convertLists = &v
+
+	return s
+}
+
+// SetFrame reshapes the output to match a JSON-LD frame, which is compacted according to the @context of the frame.
+// All statements are loaded into memory before writing, and the configured prefixes are not used. See [Frame].
+func (s EncoderConfig) SetFrame(v inspectjson.Value) EncoderConfig {
+	s.frame = v
+
+	return s
+}
+
 func (s EncoderConfig) SetBlankNodeStringProvider(v blanknodes.StringProvider) EncoderConfig {
 	s.bnStringProvider = v
 
@@ -73,6 +143,34 @@ func (s EncoderConfig) apply(d *EncoderConfig) {
 		d.buffered = s.buffered
 	}
 
+	if s.autoPrefixes != nil {
+		d.autoPrefixes = s.autoPrefixes
+	}
+
+	if s.rdfDirection != nil {
+		d.rdfDirection = s.rdfDirection
+	}
+
+	if s.graphContainer != nil {
+		d.graphContainer = s.graphContainer
+	}
+
+	if s.useNativeTypes != nil {
+		d.useNativeTypes = s.useNativeTypes
+	}
+
+	if s.useRdfType != nil {
+		d.useRdfType = s.useRdfType
+	}
+
+	if s.convertLists != nil {
+		d.convertLists = s.convertLists
+	}
+
+	if s.frame != nil {
+		d.frame = s.frame
+	}
+
 	if s.bnStringProvider != nil {
 		d.bnStringProvider = s.bnStringProvider
 	}
@@ -91,9 +189,10 @@ func (s EncoderConfig) apply(d *EncoderConfig) {
 }
 
 func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
+	prefixManager := iri.NewPrefixManager(s.prefixes)
+
 	e := &Encoder{
-		w:                json.NewEncoder(w),
-		prefixes:         iriutil.NewUsagePrefixMapper(iri.NewPrefixManager(s.prefixes)),
+		w:                json.NewEncoder(c1EscapingWriter{w: w}),
 		bnStringProvider: s.bnStringProvider,
 		builder:          rdfdescription.NewDatasetResourceListBuilder(),
 	}
@@ -107,10 +206,60 @@ func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
 		e.base = baseIRI
 	}
 
+	if s.autoPrefixes != nil && *s.autoPrefixes {
+		var autoOptions iriutil.AutoPrefixMapperOptions
+
+		if e.base != nil {
+			// prefer relative references
+			autoOptions.Exclude = func(v string) bool {
+				_, ok := e.base.RelativizeIRI(v)
+
+				return ok
+			}
+		}
+
+		e.prefixes = iriutil.NewUsagePrefixMapper(iriutil.NewAutoPrefixMapper(prefixManager, autoOptions))
+	} else {
+		e.prefixes = iriutil.NewUsagePrefixMapper(prefixManager)
+	}
+
 	if s.buffered != nil {
 		e.buffered = *s.buffered
 	}
 
+	if s.rdfDirection != nil {
+		switch *s.rdfDirection {
+		case "i18n-datatype", "compound-literal":
+		// good
+		default:
+			return nil, fmt.Errorf("rdf direction: invalid value: %v", *s.rdfDirection)
+		}
+
+		e.rdfDirection = *s.rdfDirection
+	}
+
+	if s.graphContainer != nil {
+		e.graphContainer = *s.graphContainer
+	}
+
+	if s.useNativeTypes != nil {
+		e.useNativeTypes = *s.useNativeTypes
+	} else {
+		e.useNativeTypes = true
+	}
+
+	if s.useRdfType != nil {
+		e.useRdfType = *s.useRdfType
+	}
+
+	if s.convertLists != nil {
+		e.convertLists = *s.convertLists
+	}
+
+	if s.frame != nil {
+		e.frame = s.frame
+	}
+
 	if e.bnStringProvider == nil {
 		e.bnStringProvider = blanknodes.NewInt64StringProvider("b%d")
 	}
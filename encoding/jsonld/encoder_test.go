@@ -0,0 +1,698 @@
+package jsonld
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+)
+
+func TestEncoder_Direction(t *testing.T) {
+	compoundNode := rdf.NewBlankNode()
+
+	for _, tc := range []struct {
+		Name              string
+		InputRDFDirection string
+		InputQuads        rdf.QuadList
+		OutputJSON        string
+	}{
+		{
+			Name: "langString",
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object: rdf.Literal{
+							Datatype:    rdfiri.LangString_Datatype,
+							LexicalForm: "hello",
+							Tag: rdf.LanguageLiteralTag{
+								Language: "en",
+							},
+						},
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@language":"en","@value":"hello"}}`,
+		},
+		{
+			Name: "dirLangString",
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object: rdf.Literal{
+							Datatype:    rdfiri.DirLangString_Datatype,
+							LexicalForm: "مرحبا",
+							Tag: rdf.DirectionalLanguageLiteralTag{
+								Language:      "ar",
+								BaseDirection: "rtl",
+							},
+						},
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@direction":"rtl","@language":"ar","@value":"مرحبا"}}`,
+		},
+		{
+			Name: "i18n-datatype without option",
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object: rdf.Literal{
+							Datatype:    rdf.IRI("https://www.w3.org/ns/i18n#ar_rtl"),
+							LexicalForm: "مرحبا",
+						},
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@type":"https://www.w3.org/ns/i18n#ar_rtl","@value":"مرحبا"}}`,
+		},
+		{
+			Name:              "i18n-datatype",
+			InputRDFDirection: "i18n-datatype",
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object: rdf.Literal{
+							Datatype:    rdf.IRI("https://www.w3.org/ns/i18n#ar_rtl"),
+							LexicalForm: "مرحبا",
+						},
+					},
+				},
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/q"),
+						Object: rdf.Literal{
+							Datatype:    rdf.IRI("https://www.w3.org/ns/i18n#_ltr"),
+							LexicalForm: "hello",
+						},
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@direction":"rtl","@language":"ar","@value":"مرحبا"},"http://example.com/q":{"@direction":"ltr","@value":"hello"}}`,
+		},
+		{
+			Name:              "compound-literal",
+			InputRDFDirection: "compound-literal",
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object:    compoundNode,
+					},
+				},
+				{
+					Triple: rdf.Triple{
+						Subject:   compoundNode,
+						Predicate: rdfiri.Value_Property,
+						Object: rdf.Literal{
+							Datatype:    xsdiri.String_Datatype,
+							LexicalForm: "مرحبا",
+						},
+					},
+				},
+				{
+					Triple: rdf.Triple{
+						Subject:   compoundNode,
+						Predicate: rdfiri.Language_Property,
+						Object: rdf.Literal{
+							Datatype:    xsdiri.String_Datatype,
+							LexicalForm: "ar",
+						},
+					},
+				},
+				{
+					Triple: rdf.Triple{
+						Subject:   compoundNode,
+						Predicate: rdfiri.Direction_Property,
+						Object: rdf.Literal{
+							Datatype:    xsdiri.String_Datatype,
+							LexicalForm: "rtl",
+						},
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@direction":"rtl","@language":"ar","@value":"مرحبا"}}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			opts := EncoderConfig{}
+
+			if len(tc.InputRDFDirection) > 0 {
+				opts = opts.SetRDFDirection(tc.InputRDFDirection)
+			}
+
+			e, err := NewEncoder(buf, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, quad := range tc.InputQuads {
+				if err := e.AddQuad(t.Context(), quad); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputJSON+"\n", buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestEncoder_Numbers(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		InputLiteral rdf.Literal
+		OutputJSON   string
+	}{
+		{
+			Name: "integer",
+			InputLiteral: rdf.Literal{
+				Datatype:    xsdiri.Integer_Datatype,
+				LexicalForm: "+042",
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":42}`,
+		},
+		{
+			Name: "integer beyond safe range",
+			InputLiteral: rdf.Literal{
+				Datatype:    xsdiri.Integer_Datatype,
+				LexicalForm: "123456789012345678901234567890",
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@type":"http://www.w3.org/2001/XMLSchema#integer","@value":"123456789012345678901234567890"}}`,
+		},
+		{
+			Name: "integer invalid",
+			InputLiteral: rdf.Literal{
+				Datatype:    xsdiri.Integer_Datatype,
+				LexicalForm: "12abc",
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@type":"http://www.w3.org/2001/XMLSchema#integer","@value":"12abc"}}`,
+		},
+		{
+			Name: "double",
+			InputLiteral: rdf.Literal{
+				Datatype:    xsdiri.Double_Datatype,
+				LexicalForm: "2.5E0",
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":2.5E0}`,
+		},
+		{
+			Name: "double large",
+			InputLiteral: rdf.Literal{
+				Datatype:    xsdiri.Double_Datatype,
+				LexicalForm: "1.5e21",
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":1.5E21}`,
+		},
+		{
+			Name: "double integral",
+			InputLiteral: rdf.Literal{
+				Datatype:    xsdiri.Double_Datatype,
+				LexicalForm: "1.0E0",
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@type":"http://www.w3.org/2001/XMLSchema#double","@value":"1.0E0"}}`,
+		},
+		{
+			Name: "double infinite",
+			InputLiteral: rdf.Literal{
+				Datatype:    xsdiri.Double_Datatype,
+				LexicalForm: "INF",
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@type":"http://www.w3.org/2001/XMLSchema#double","@value":"INF"}}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			e, err := NewEncoder(buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			err = e.AddQuad(t.Context(), rdf.Quad{
+				Triple: rdf.Triple{
+					Subject:   rdf.IRI("http://example.com/s"),
+					Predicate: rdf.IRI("http://example.com/p"),
+					Object:    tc.InputLiteral,
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputJSON+"\n", buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+
+			statements, err := quads.CollectErr(NewDecoder(buf))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if _e, _a := 1, len(statements); _e != _a {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			} else if _e, _a := tc.InputLiteral.Datatype, statements[0].Triple.Object.(rdf.Literal).Datatype; _e != _a {
+				t.Errorf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestEncoder_ControlCharacters(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	e, err := NewEncoder(buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = e.AddQuad(t.Context(), rdf.Quad{
+		Triple: rdf.Triple{
+			Subject:   rdf.IRI("http://example.com/s"),
+			Predicate: rdf.IRI("http://example.com/p"),
+			Object: rdf.Literal{
+				Datatype:    xsdiri.String_Datatype,
+				LexicalForm: "a\u0085b\u009fc",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := e.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _e, _a := `{"@id":"http://example.com/s","http://example.com/p":"a\u0085b\u009fc"}`+"\n", buf.String(); _e != _a {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+
+	statements, err := quads.CollectErr(NewDecoder(buf))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _e, _a := 1, len(statements); _e != _a {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _e, _a := "a\u0085b\u009fc", statements[0].Triple.Object.(rdf.Literal).LexicalForm; _e != _a {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_SerializeOptions(t *testing.T) {
+	list1, list2 := rdf.NewBlankNode(), rdf.NewBlankNode()
+
+	listQuads := rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    list1,
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   list1,
+				Predicate: rdfiri.First_Property,
+				Object:    xsdobject.String("a"),
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   list1,
+				Predicate: rdfiri.Rest_Property,
+				Object:    list2,
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   list2,
+				Predicate: rdfiri.First_Property,
+				Object:    rdf.IRI("http://example.com/b"),
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   list2,
+				Predicate: rdfiri.Rest_Property,
+				Object:    rdfiri.Nil_List,
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Options    EncoderConfig
+		InputQuads rdf.QuadList
+		OutputJSON string
+	}{
+		{
+			Name:    "useNativeTypes false",
+			Options: EncoderConfig{}.SetUseNativeTypes(false),
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object: rdf.Literal{
+							Datatype:    xsdiri.Integer_Datatype,
+							LexicalForm: "42",
+						},
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@type":"http://www.w3.org/2001/XMLSchema#integer","@value":"42"}}`,
+		},
+		{
+			Name:    "useRdfType",
+			Options: EncoderConfig{}.SetUseRdfType(true),
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdfiri.Type_Property,
+						Object:    rdf.IRI("http://example.com/T"),
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://www.w3.org/1999/02/22-rdf-syntax-ns#type":{"@id":"http://example.com/T"}}`,
+		},
+		{
+			Name:       "convertLists",
+			Options:    EncoderConfig{}.SetConvertLists(true),
+			InputQuads: listQuads,
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@list":["a",{"@id":"http://example.com/b"}]}}`,
+		},
+		{
+			Name:    "convertLists rdf:nil",
+			Options: EncoderConfig{}.SetConvertLists(true),
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object:    rdfiri.Nil_List,
+					},
+				},
+			},
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"@list":[]}}`,
+		},
+		{
+			Name:    "convertLists not well-formed",
+			Options: EncoderConfig{}.SetConvertLists(true),
+			InputQuads: append(rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   list2,
+						Predicate: rdf.IRI("http://example.com/other"),
+						Object:    xsdobject.String("c"),
+					},
+				},
+			}, listQuads...),
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"http://www.w3.org/1999/02/22-rdf-syntax-ns#first":"a","http://www.w3.org/1999/02/22-rdf-syntax-ns#rest":{"http://example.com/other":"c","http://www.w3.org/1999/02/22-rdf-syntax-ns#first":{"@id":"http://example.com/b"},"http://www.w3.org/1999/02/22-rdf-syntax-ns#rest":{"@list":[]}}}}`,
+		},
+		{
+			Name:       "convertLists disabled",
+			InputQuads: listQuads,
+			OutputJSON: `{"@id":"http://example.com/s","http://example.com/p":{"http://www.w3.org/1999/02/22-rdf-syntax-ns#first":"a","http://www.w3.org/1999/02/22-rdf-syntax-ns#rest":{"http://www.w3.org/1999/02/22-rdf-syntax-ns#first":{"@id":"http://example.com/b"},"http://www.w3.org/1999/02/22-rdf-syntax-ns#rest":{"@id":"http://www.w3.org/1999/02/22-rdf-syntax-ns#nil"}}}}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			e, err := NewEncoder(buf, tc.Options)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, quad := range tc.InputQuads {
+				err = e.AddQuad(t.Context(), quad)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputJSON+"\n", buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestEncoder_AutoPrefixes(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	e, err := NewEncoder(buf, EncoderConfig{}.SetAutoPrefixes(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = e.AddQuad(t.Context(), rdf.Quad{
+		Triple: rdf.Triple{
+			Subject:   rdf.IRI("http://example.com/things/s"),
+			Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+			Object: rdf.Literal{
+				Datatype:    xsdiri.Date_Datatype,
+				LexicalForm: "2001-02-03",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := e.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _e, _a := `{"@context":{"foaf":"http://xmlns.com/foaf/0.1/","things":"http://example.com/things/","xsd":"http://www.w3.org/2001/XMLSchema#"},"@id":"things:s","foaf:name":{"@type":"xsd:date","@value":"2001-02-03"}}`+"\n", buf.String(); _e != _a {
+		t.Errorf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_NamedGraphs(t *testing.T) {
+	graphNode := rdf.NewBlankNode()
+
+	for _, tc := range []struct {
+		Name       string
+		Options    EncoderConfig
+		InputQuads rdf.QuadList
+		OutputJSON string
+	}{
+		{
+			Name: "graph described in default graph",
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/g"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object:    xsdobject.String("default"),
+					},
+				},
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object:    xsdobject.String("named"),
+					},
+					GraphName: rdf.IRI("http://example.com/g"),
+				},
+			},
+			OutputJSON: `{"@graph":[{"@id":"http://example.com/s","http://example.com/p":"named"}],"@id":"http://example.com/g","http://example.com/p":"default"}`,
+		},
+		{
+			Name: "blank node graph name",
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object:    graphNode,
+					},
+				},
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object:    xsdobject.String("named"),
+					},
+					GraphName: graphNode,
+				},
+			},
+			OutputJSON: `{"@graph":[{"@graph":[{"@id":"http://example.com/s","http://example.com/p":"named"}],"@id":"_:b0"},{"@id":"http://example.com/s","http://example.com/p":{"@id":"_:b0"}}]}`,
+		},
+		{
+			Name:    "graph container",
+			Options: EncoderConfig{}.SetGraphContainer(true),
+			InputQuads: rdf.QuadList{
+				{
+					Triple: rdf.Triple{
+						Subject:   rdf.IRI("http://example.com/s"),
+						Predicate: rdf.IRI("http://example.com/p"),
+						Object:    xsdobject.String("named"),
+					},
+					GraphName: rdf.IRI("http://example.com/g"),
+				},
+			},
+			OutputJSON: `{"@graph":[{"@graph":[{"@id":"http://example.com/s","http://example.com/p":"named"}],"@id":"http://example.com/g"}]}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			e, err := NewEncoder(buf, tc.Options.SetBuffered(true))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, quad := range tc.InputQuads {
+				err = e.AddQuad(t.Context(), quad)
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputJSON+"\n", buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestEncoder_NamedGraphs_Order(t *testing.T) {
+	var inputQuads rdf.QuadList
+
+	for _, graphName := range []string{"g3", "g1", "g4", "g2"} {
+		inputQuads = append(inputQuads, rdf.Quad{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    xsdobject.String(graphName),
+			},
+			GraphName: rdf.IRI("http://example.com/" + graphName),
+		})
+	}
+
+	expected := `{"@graph":[` +
+		`{"@graph":[{"@id":"http://example.com/s","http://example.com/p":"g3"}],"@id":"http://example.com/g3"},` +
+		`{"@graph":[{"@id":"http://example.com/s","http://example.com/p":"g1"}],"@id":"http://example.com/g1"},` +
+		`{"@graph":[{"@id":"http://example.com/s","http://example.com/p":"g4"}],"@id":"http://example.com/g4"},` +
+		`{"@graph":[{"@id":"http://example.com/s","http://example.com/p":"g2"}],"@id":"http://example.com/g2"}` +
+		`]}` + "\n"
+
+	// named graphs were previously written in map order
+	for range 16 {
+		buf := &bytes.Buffer{}
+
+		e, err := NewEncoder(buf)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		for _, quad := range inputQuads {
+			err = e.AddQuad(t.Context(), quad)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		}
+
+		if err := e.Close(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if _e, _a := expected, buf.String(); _e != _a {
+			t.Fatalf("expected %q, got %q", _e, _a)
+		}
+	}
+}
+
+func TestEncoder_Frame(t *testing.T) {
+	frame, err := inspectjson.Parse(strings.NewReader(`{
+		"@context": {"@vocab": "http://xmlns.com/foaf/0.1/"},
+		"@type": "Person"
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	buf := &bytes.Buffer{}
+
+	e, err := NewEncoder(buf, EncoderConfig{}.SetFrame(frame))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	knowsNode := rdf.NewBlankNode()
+
+	quads := rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://xmlns.com/foaf/0.1/Person"),
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/knows"),
+				Object:    knowsNode,
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   knowsNode,
+				Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+				Object:    xsdobject.String("Bob"),
+			},
+		},
+	}
+
+	for _, quad := range quads {
+		err = e.AddQuad(t.Context(), quad)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if err := e.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _e, _a := `{"@context":{"@vocab":"http://xmlns.com/foaf/0.1/"},"@id":"http://example.com/a","@type":"Person","knows":{"name":"Bob"}}`+"\n", buf.String(); _e != _a {
+		t.Errorf("expected %q, got %q", _e, _a)
+	}
+}
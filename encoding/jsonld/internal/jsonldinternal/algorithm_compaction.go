@@ -0,0 +1,952 @@
+package jsonldinternal
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+)
+
+type algorithmCompaction struct {
+	activeContext  *Context
+	activeProperty *string
+
+	// [spec] element to be compacted
+	element any
+
+	// [spec] compactArrays flag, used to replace arrays with a single element with that element
+	compactArrays bool
+
+	// [spec] ordered flag, used to order map entry keys lexicographically, where noted
+	// [dpb] maps are always processed in order so that results are stable
+	// ordered bool
+}
+
+func (vars algorithmCompaction) Call() (any, error) {
+	activeProperty := ""
+
+	if vars.activeProperty != nil {
+		activeProperty = *vars.activeProperty
+	}
+
+	// [spec // 6.1.2 // 1] Initialize *type-scoped context* to *active context*. This is used for compacting values that may be relevant to any previous type-scoped context.
+
+	typeScopedContext := vars.activeContext
+
+	// [spec // 6.1.2 // 2] If *element* is a scalar, it is already in its most compact form, so simply return *element*.
+
+	if vars.element == nil || isBuiltinScalar(vars.element) {
+		return vars.element, nil
+	}
+
+	// [spec // 6.1.2 // 3] If *element* is an array:
+
+	if elementArray, ok := vars.element.([]any); ok {
+
+		// [spec // 6.1.2 // 3.1] Initialize *result* to an empty array.
+
+		result := []any{}
+
+		// [spec // 6.1.2 // 3.2] For each *item* in *element*:
+
+		for _, item := range elementArray {
+
+			// [spec // 6.1.2 // 3.2.1] Initialize *compacted item* to the result of using this algorithm recursively, passing *active context*, *active property*, *item* for *element*, and the `compactArrays` and `ordered` flags.
+
+			compactedItem, err := algorithmCompaction{
+				activeContext:  vars.activeContext,
+				activeProperty: vars.activeProperty,
+				element:        item,
+				compactArrays:  vars.compactArrays,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			// [spec // 6.1.2 // 3.2.2] If *compacted item* is not `null`, then append it to *result*.
+
+			if compactedItem != nil {
+				result = append(result, compactedItem)
+			}
+		}
+
+		// [spec // 6.1.2 // 3.3] If *result* is empty or contains more than one value, or `compactArrays` is `false`, or *active property* is either `@graph` or `@set`, or container mapping for *active property* in *active context* includes either `@list` or `@set`, return *result*.
+
+		if len(result) != 1 || !vars.compactArrays || activeProperty == "@graph" || activeProperty == "@set" {
+			return result, nil
+		}
+
+		if termDefinition := vars.activeContext.TermDefinitions[activeProperty]; termDefinition != nil {
+			if slices.Contains(termDefinition.ContainerMapping, "@list") || slices.Contains(termDefinition.ContainerMapping, "@set") {
+				return result, nil
+			}
+		}
+
+		// [spec // 6.1.2 // 3.4] Otherwise, return the value in *result*.
+
+		return result[0], nil
+	}
+
+	// [spec // 6.1.2 // 4] Otherwise *element* is a map.
+
+	element, ok := vars.element.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type: %T", vars.element)
+	}
+
+	activeContext := vars.activeContext
+
+	// [spec // 6.1.2 // 5] If *active context* has a previous context, the *active context* is not propagated. If *element* does not contain an `@value` entry, and *element* does not consist of a single `@id` entry, set *active context* to previous context from *active context*, as the scope of a term-scoped context does not apply when processing new node objects.
+
+	if activeContext.PreviousContext != nil {
+		_, hasValue := element["@value"]
+		_, hasID := element["@id"]
+
+		if !hasValue && !(hasID && len(element) == 1) {
+			activeContext = activeContext.PreviousContext
+		}
+	}
+
+	// [spec // 6.1.2 // 6] If the term definition for *active property* in *active context* has a local context:
+	// [spec // 6.1.2 // 6.1] Set *active context* to the result of the Context Processing algorithm, passing *active context*, the value of the *active property*'s local context as *local context*, *base URL* from the term definition for *active property* in *active context*, and `true` for *override protected*.
+
+	activePropertyTermDefinition := activeContext.TermDefinitions[activeProperty]
+
+	if vars.activeProperty != nil && activePropertyTermDefinition != nil && activePropertyTermDefinition.Context != nil {
+		nextActiveContext, err := algorithmContextProcessing{
+			ActiveContext:     activeContext,
+			LocalContext:      activePropertyTermDefinition.Context,
+			BaseURL:           coalesceBaseURL(activePropertyTermDefinition.BaseURL, activeContext.BaseURL),
+			OverrideProtected: true,
+			// defaults
+			RemoteContexts:        nil,
+			Propagate:             true,
+			ValidateScopedContext: true,
+		}.Call()
+		if err != nil {
+			return nil, err
+		}
+
+		activeContext = nextActiveContext
+	}
+
+	// [dpb] term definitions below are resolved from the updated active context
+
+	activePropertyTermDefinition = activeContext.TermDefinitions[activeProperty]
+	if vars.activeProperty == nil || activePropertyTermDefinition == nil {
+		activePropertyTermDefinition = &TermDefinition{}
+	}
+
+	// [spec // 6.1.2 // 7] If *element* has an `@value` or `@id` entry and the result of using the Value Compaction algorithm, passing *active context*, *active property*, and *element* as *value* is a scalar, or the term definition for *active property* has a type mapping of `@json`, return that result.
+
+	_, elementHasValue := element["@value"]
+	_, elementHasID := element["@id"]
+
+	if elementHasValue || elementHasID {
+		compactedValue, err := algorithmValueCompaction{
+			activeContext:  activeContext,
+			activeProperty: vars.activeProperty,
+			value:          element,
+		}.Call()
+		if err != nil {
+			return nil, err
+		}
+
+		if isBuiltinScalar(compactedValue) {
+			return compactedValue, nil
+		} else if activePropertyTermDefinition.TypeMapping != nil && activePropertyTermDefinition.TypeMapping.String() == "@json" {
+			return compactedValue, nil
+		}
+	}
+
+	// [spec // 6.1.2 // 8] If *element* is a list object, and the container mapping for *active property* in *active context* includes `@list`, return the result of using this algorithm recursively, passing *active context*, *active property*, value of `@list` in *element* for *element*, and the `compactArrays` and `ordered` flags.
+
+	if listValue, ok := element["@list"]; ok && slices.Contains(activePropertyTermDefinition.ContainerMapping, "@list") {
+		return algorithmCompaction{
+			activeContext:  activeContext,
+			activeProperty: vars.activeProperty,
+			element:        listValue,
+			compactArrays:  vars.compactArrays,
+		}.Call()
+	}
+
+	// [spec // 6.1.2 // 9] Initialize *inside reverse* to `true` if *active property* equals `@reverse`, otherwise to `false`.
+
+	insideReverse := activeProperty == "@reverse"
+
+	// [spec // 6.1.2 // 10] Initialize *result* to a new empty map.
+
+	result := map[string]any{}
+
+	// [spec // 6.1.2 // 11] If *element* has an `@type` entry, create a new array *compacted types* initialized by transforming each expanded type of that entry into its compacted form by IRI compacting expanded type. Then, for each *term* in *compacted types* ordered lexicographically:
+
+	if typeValue, ok := element["@type"]; ok {
+		var compactedTypes []string
+
+		for _, expandedType := range builtinAsArray(typeValue) {
+			expandedTypeString, ok := expandedType.(string)
+			if !ok {
+				continue
+			}
+
+			compactedType, err := algorithmIRICompaction{
+				activeContext: typeScopedContext,
+				keyIRI:        expandedTypeString,
+				vocab:         true,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			compactedTypes = append(compactedTypes, compactedType)
+		}
+
+		slices.Sort(compactedTypes)
+
+		for _, term := range compactedTypes {
+
+			// [spec // 6.1.2 // 11.1] If the term definition for *term* in *type-scoped context* has a local context set *active context* to the result of the Context Processing algorithm, passing *active context* and the value of *term*'s local context in *type-scoped context* as *local context* *base URL* from the term definition for *term* in *type-scoped context*, and `false` for *propagate*.
+
+			termDefinition := typeScopedContext.TermDefinitions[term]
+			if termDefinition == nil || termDefinition.Context == nil {
+				continue
+			}
+
+			nextActiveContext, err := algorithmContextProcessing{
+				ActiveContext: activeContext,
+				LocalContext:  termDefinition.Context,
+				BaseURL:       coalesceBaseURL(termDefinition.BaseURL, activeContext.BaseURL),
+				Propagate:     false,
+				// defaults
+				RemoteContexts:        nil,
+				OverrideProtected:     false,
+				ValidateScopedContext: true,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			activeContext = nextActiveContext
+		}
+	}
+
+	// [spec // 6.1.2 // 12] For each key *expanded property* and value *expanded value* in *element*, ordered lexicographically by *expanded property* if `ordered` is `true`:
+
+	for _, expandedProperty := range slices.Sorted(maps.Keys(element)) {
+		expandedValue := element[expandedProperty]
+
+		switch expandedProperty {
+		case "@id":
+
+			// [spec // 6.1.2 // 12.1] If *expanded property* is `@id`:
+			// [spec // 6.1.2 // 12.1.1] If *expanded value* is a string, then initialize *compacted value* by IRI compacting *expanded value* with *vocab* set to `false`.
+
+			compactedValue := expandedValue
+
+			if expandedValueString, ok := expandedValue.(string); ok {
+				compactedString, err := algorithmIRICompaction{
+					activeContext: activeContext,
+					keyIRI:        expandedValueString,
+					vocab:         false,
+				}.Call()
+				if err != nil {
+					return nil, err
+				}
+
+				compactedValue = compactedString
+			}
+
+			// [spec // 6.1.2 // 12.1.2] Initialize *alias* by IRI compacting *expanded property*.
+			// [spec // 6.1.2 // 12.1.3] Add an entry *alias* to *result* whose value is set to *compacted value* and continue to the next *expanded property*.
+
+			alias, err := compactKeyword(activeContext, expandedProperty)
+			if err != nil {
+				return nil, err
+			}
+
+			result[alias] = compactedValue
+
+			continue
+		case "@type":
+
+			// [spec // 6.1.2 // 12.2] If *expanded property* is `@type`:
+
+			var compactedValue any
+
+			if expandedValueString, ok := expandedValue.(string); ok {
+
+				// [spec // 6.1.2 // 12.2.1] If *expanded value* is a string, then initialize *compacted value* by IRI compacting *expanded value* using *type-scoped context* for *active context*.
+
+				compactedString, err := algorithmIRICompaction{
+					activeContext: typeScopedContext,
+					keyIRI:        expandedValueString,
+					vocab:         true,
+				}.Call()
+				if err != nil {
+					return nil, err
+				}
+
+				compactedValue = compactedString
+			} else {
+
+				// [spec // 6.1.2 // 12.2.2] Otherwise, *expanded value* must be a `@type` array:
+				// [spec // 6.1.2 // 12.2.2.1] Initialize *compacted value* to an empty array.
+
+				compactedArray := []any{}
+
+				// [spec // 6.1.2 // 12.2.2.2] For each item *expanded type* in *expanded value*:
+
+				for _, expandedType := range builtinAsArray(expandedValue) {
+					expandedTypeString, ok := expandedType.(string)
+					if !ok {
+						continue
+					}
+
+					// [spec // 6.1.2 // 12.2.2.2.1] Set *term* by IRI compacting *expanded type* using *type-scoped context* for *active context*.
+
+					term, err := algorithmIRICompaction{
+						activeContext: typeScopedContext,
+						keyIRI:        expandedTypeString,
+						vocab:         true,
+					}.Call()
+					if err != nil {
+						return nil, err
+					}
+
+					// [spec // 6.1.2 // 12.2.2.2.2] Append *term*, to *compacted value*.
+
+					compactedArray = append(compactedArray, term)
+				}
+
+				compactedValue = compactedArray
+			}
+
+			// [spec // 6.1.2 // 12.2.3] Initialize *alias* by IRI compacting *expanded property*.
+
+			alias, err := compactKeyword(activeContext, expandedProperty)
+			if err != nil {
+				return nil, err
+			}
+
+			// [spec // 6.1.2 // 12.2.4] Initialize *as array* to `true` if processing mode is `json-ld-1.1` and the container mapping for *alias* in the *active context* includes `@set`, otherwise to the negation of `compactArrays`.
+
+			asArray := !vars.compactArrays
+
+			if activeContext._processor.processingMode != ProcessingMode_JSON_LD_1_0 {
+				if aliasDefinition := activeContext.TermDefinitions[alias]; aliasDefinition != nil && slices.Contains(aliasDefinition.ContainerMapping, "@set") {
+					asArray = true
+				}
+			}
+
+			// [spec // 6.1.2 // 12.2.5] Use add value to add *compacted value* to the *alias* entry in *result* using *as array*.
+
+			builtinAddValue(result, alias, compactedValue, asArray)
+
+			// [spec // 6.1.2 // 12.2.6] Continue to the next *expanded property*.
+
+			continue
+		case "@reverse":
+
+			// [spec // 6.1.2 // 12.3] If *expanded property* is `@reverse`:
+			// [spec // 6.1.2 // 12.3.1] Initialize *compacted value* to the result of using this algorithm recursively, passing *active context*, `@reverse` for *active property*, *expanded value* for *element*, and the `compactArrays` and `ordered` flags.
+
+			compactedValue, err := algorithmCompaction{
+				activeContext:  activeContext,
+				activeProperty: ptrString("@reverse"),
+				element:        expandedValue,
+				compactArrays:  vars.compactArrays,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			compactedMap, _ := compactedValue.(map[string]any)
+
+			// [spec // 6.1.2 // 12.3.2] For each *property* and *value* in *compacted value*:
+
+			for _, property := range slices.Sorted(maps.Keys(compactedMap)) {
+				value := compactedMap[property]
+
+				// [spec // 6.1.2 // 12.3.2.1] If the term definition for *property* in the *active context* indicates that *property* is a reverse property
+
+				propertyDefinition := activeContext.TermDefinitions[property]
+				if propertyDefinition == nil || !propertyDefinition.ReverseProperty {
+					continue
+				}
+
+				// [spec // 6.1.2 // 12.3.2.1.1] Initialize *as array* to `true` if the container mapping for *property* in the *active context* includes `@set`, otherwise the negation of `compactArrays`.
+				// [spec // 6.1.2 // 12.3.2.1.2] Use add value to add *value* to the *property* entry in *result* using *as array*.
+				// [spec // 6.1.2 // 12.3.2.1.3] Remove the *property* entry from *compacted value*.
+
+				builtinAddValue(result, property, value, slices.Contains(propertyDefinition.ContainerMapping, "@set") || !vars.compactArrays)
+
+				delete(compactedMap, property)
+			}
+
+			// [spec // 6.1.2 // 12.3.3] If *compacted value* has some remaining map entries, i.e., it is not an empty map:
+
+			if len(compactedMap) > 0 {
+
+				// [spec // 6.1.2 // 12.3.3.1] Initialize *alias* by IRI compacting `@reverse`.
+				// [spec // 6.1.2 // 12.3.3.2] Set the value of the *alias* entry of *result* to *compacted value*.
+
+				alias, err := compactKeyword(activeContext, "@reverse")
+				if err != nil {
+					return nil, err
+				}
+
+				result[alias] = compactedMap
+			}
+
+			// [spec // 6.1.2 // 12.3.4] Continue with the next *expanded property* from *element*.
+
+			continue
+		case "@preserve":
+
+			// [spec // 6.1.2 // 12.4] If *expanded property* is `@preserve` then:
+			// [spec // 6.1.2 // 12.4.1] Initialize *compacted value* to the result of using this algorithm recursively, passing *active context*, *active property*, *expanded value* for *element*, and the `compactArrays` and `ordered` flags.
+
+			compactedValue, err := algorithmCompaction{
+				activeContext:  activeContext,
+				activeProperty: vars.activeProperty,
+				element:        expandedValue,
+				compactArrays:  vars.compactArrays,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			// [spec // 6.1.2 // 12.4.2] Add *compacted value* as the value of `@preserve` in *result* unless *expanded value* is an empty array.
+
+			if expandedArray, ok := expandedValue.([]any); !ok || len(expandedArray) > 0 {
+				result["@preserve"] = compactedValue
+			}
+
+			// [spec // 6.1.2 // 12.4.3] Continue with the next *expanded property* from *element*.
+
+			continue
+		case "@index":
+
+			// [spec // 6.1.2 // 12.5] If *expanded property* is `@index` and *active property* has a container mapping in *active context* that includes `@index`, then the compacted result will be inside of an `@index` container, drop the `@index` entry by continuing to the next *expanded property*.
+
+			if slices.Contains(activePropertyTermDefinition.ContainerMapping, "@index") {
+				continue
+			}
+
+			fallthrough
+		case "@direction", "@language", "@value":
+
+			// [spec // 6.1.2 // 12.6] Otherwise, if *expanded property* is `@direction`, `@index`, `@language`, or `@value`:
+			// [spec // 6.1.2 // 12.6.1] Initialize *alias* by IRI compacting *expanded property*.
+			// [spec // 6.1.2 // 12.6.2] Add an entry *alias* to *result* whose value is set to *expanded value* and continue with the next *expanded property*.
+
+			alias, err := compactKeyword(activeContext, expandedProperty)
+			if err != nil {
+				return nil, err
+			}
+
+			result[alias] = expandedValue
+
+			continue
+		}
+
+		expandedArray := builtinAsArray(expandedValue)
+
+		// [spec // 6.1.2 // 12.7] If *expanded value* is an empty array:
+
+		if len(expandedArray) == 0 {
+
+			// [spec // 6.1.2 // 12.7.1] Initialize *item active property* by IRI compacting *expanded property*, using *expanded value* for *value* and *inside reverse* for *reverse*.
+
+			itemActiveProperty, err := algorithmIRICompaction{
+				activeContext: activeContext,
+				keyIRI:        expandedProperty,
+				value:         expandedArray,
+				vocab:         true,
+				reverse:       insideReverse,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			// [spec // 6.1.2 // 12.7.2] If the term definition for *item active property* in the *active context* has a nest value entry (*nest term*):
+			// [spec // 6.1.2 // 12.7.3] Otherwise, use add value to add an empty array to the *item active property* entry in *result* using `true` for *as array*.
+
+			nestResult, err := compactionNestResult(activeContext, result, itemActiveProperty)
+			if err != nil {
+				return nil, err
+			}
+
+			builtinAddValue(nestResult, itemActiveProperty, []any{}, true)
+		}
+
+		// [spec // 6.1.2 // 12.8] At this point, *expanded value* must be an array due to the Expansion algorithm. For each item *expanded item* in *expanded value*:
+
+		for _, expandedItem := range expandedArray {
+
+			// [spec // 6.1.2 // 12.8.1] Initialize *item active property* by IRI compacting *expanded property*, using *expanded item* for *value* and *inside reverse* for *reverse*.
+
+			itemActiveProperty, err := algorithmIRICompaction{
+				activeContext: activeContext,
+				keyIRI:        expandedProperty,
+				value:         expandedItem,
+				vocab:         true,
+				reverse:       insideReverse,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			// [spec // 6.1.2 // 12.8.2] If the term definition for *item active property* in the *active context* has a nest value entry (*nest term*):
+			// [spec // 6.1.2 // 12.8.3] Otherwise, initialize *nest result* to *result*.
+
+			nestResult, err := compactionNestResult(activeContext, result, itemActiveProperty)
+			if err != nil {
+				return nil, err
+			}
+
+			// [spec // 6.1.2 // 12.8.4] Initialize *container* to container mapping for *item active property* in *active context*, or to a new empty array, if there is no such container mapping.
+
+			var container []string
+
+			if itemActivePropertyDefinition := activeContext.TermDefinitions[itemActiveProperty]; itemActivePropertyDefinition != nil {
+				container = itemActivePropertyDefinition.ContainerMapping
+			}
+
+			// [spec // 6.1.2 // 12.8.5] Initialize *as array* to `true` if *container* includes `@set`, or if *item active property* is `@graph` or `@list`, otherwise the negation of `compactArrays`.
+
+			asArray := slices.Contains(container, "@set") || itemActiveProperty == "@graph" || itemActiveProperty == "@list" || !vars.compactArrays
+
+			// [spec // 6.1.2 // 12.8.6] Initialize *compacted item* to the result of using this algorithm recursively, passing *active context*, *item active property* for *active property*, *expanded item* for *element*, along with the `compactArrays` and `ordered` flags. If *expanded item* is a list object or a graph object, use the value of the `@list` or `@graph` entries, respectively, for *element* instead of *expanded item*.
+
+			expandedItemMap, _ := expandedItem.(map[string]any)
+			itemElement := expandedItem
+
+			if isBuiltinListObject(expandedItem) {
+				itemElement = expandedItemMap["@list"]
+			} else if isBuiltinGraphObject(expandedItem) {
+				itemElement = expandedItemMap["@graph"]
+			}
+
+			compactedItem, err := algorithmCompaction{
+				activeContext:  activeContext,
+				activeProperty: &itemActiveProperty,
+				element:        itemElement,
+				compactArrays:  vars.compactArrays,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			if isBuiltinListObject(expandedItem) {
+
+				// [spec // 6.1.2 // 12.8.7] If *expanded item* is a list object:
+				// [spec // 6.1.2 // 12.8.7.1] If *compacted item* is not an array, then set *compacted item* to an array containing only *compacted item*.
+
+				if _, ok := compactedItem.([]any); !ok {
+					compactedItem = []any{compactedItem}
+				}
+
+				if !slices.Contains(container, "@list") {
+
+					// [spec // 6.1.2 // 12.8.7.2] If *container* does not include `@list`:
+					// [spec // 6.1.2 // 12.8.7.2.1] Convert *compacted item* to a list object by setting it to a map containing an entry where the key is the result of IRI compacting `@list` and the value is the original *compacted item*.
+
+					listAlias, err := compactKeyword(activeContext, "@list")
+					if err != nil {
+						return nil, err
+					}
+
+					compactedListItem := map[string]any{
+						listAlias: compactedItem,
+					}
+
+					// [spec // 6.1.2 // 12.8.7.2.2] If *expanded item* contains the entry `@index`-*value*, then add an entry to *compacted item* where the key is the result of IRI compacting `@index` and value is *value*.
+
+					if indexValue, ok := expandedItemMap["@index"]; ok {
+						indexAlias, err := compactKeyword(activeContext, "@index")
+						if err != nil {
+							return nil, err
+						}
+
+						compactedListItem[indexAlias] = indexValue
+					}
+
+					// [spec // 6.1.2 // 12.8.7.2.3] Use add value to add *compacted item* to the *item active property* entry in *nest result* using *as array*.
+
+					builtinAddValue(nestResult, itemActiveProperty, compactedListItem, asArray)
+				} else {
+
+					// [spec // 6.1.2 // 12.8.7.3] Otherwise, set the value of the *item active property* entry in *nest result* to *compacted item*.
+
+					nestResult[itemActiveProperty] = compactedItem
+				}
+			} else if isBuiltinGraphObject(expandedItem) {
+
+				// [spec // 6.1.2 // 12.8.8] If *expanded item* is a graph object:
+
+				if slices.Contains(container, "@graph") && slices.Contains(container, "@id") {
+
+					// [spec // 6.1.2 // 12.8.8.1] If *container* includes `@graph` and `@id`:
+					// [spec // 6.1.2 // 12.8.8.1.1] Initialize *map object* to the value of *item active property* in *nest result*, initializing it to a new empty map, if necessary.
+
+					mapObject := compactionMapObject(nestResult, itemActiveProperty)
+
+					// [spec // 6.1.2 // 12.8.8.1.2] Initialize *map key* by IRI compacting the value of `@id` in *expanded item* or `@none` if no such value exists with *vocab* set to `false` if there is an `@id` entry in *expanded item*.
+
+					var mapKey string
+
+					if expandedItemID, ok := expandedItemMap["@id"].(string); ok {
+						mapKey, err = algorithmIRICompaction{
+							activeContext: activeContext,
+							keyIRI:        expandedItemID,
+							vocab:         false,
+						}.Call()
+					} else {
+						mapKey, err = compactKeyword(activeContext, "@none")
+					}
+
+					if err != nil {
+						return nil, err
+					}
+
+					// [spec // 6.1.2 // 12.8.8.1.3] Use add value to add *compacted item* to the *map key* entry in *map object* using *as array*.
+
+					builtinAddValue(mapObject, mapKey, compactedItem, asArray)
+				} else if slices.Contains(container, "@graph") && slices.Contains(container, "@index") && isBuiltinSimpleGraphObject(expandedItem) {
+
+					// [spec // 6.1.2 // 12.8.8.2] Otherwise, if *container* includes `@graph` and `@index` and *expanded item* is a simple graph object:
+					// [spec // 6.1.2 // 12.8.8.2.1] Initialize *map object* to the value of *item active property* in *nest result*, initializing it to a new empty map, if necessary.
+
+					mapObject := compactionMapObject(nestResult, itemActiveProperty)
+
+					// [spec // 6.1.2 // 12.8.8.2.2] Initialize *map key* the value of `@index` in *expanded item* or `@none`, if no such value exists.
+
+					mapKey, ok := expandedItemMap["@index"].(string)
+					if !ok {
+						mapKey, err = compactKeyword(activeContext, "@none")
+						if err != nil {
+							return nil, err
+						}
+					}
+
+					// [spec // 6.1.2 // 12.8.8.2.3] Use add value to add *compacted item* to the *map key* entry in *map object* using *as array*.
+
+					builtinAddValue(mapObject, mapKey, compactedItem, asArray)
+				} else if slices.Contains(container, "@graph") && isBuiltinSimpleGraphObject(expandedItem) {
+
+					// [spec // 6.1.2 // 12.8.8.3] Otherwise, if *container* includes `@graph` and *expanded item* is a simple graph object the value cannot be represented as a map object.
+					// [spec // 6.1.2 // 12.8.8.3.1] If *compacted item* is an array with more than one value, it cannot be directly represented, as multiple objects would be interpreted as different named graphs. Set *compacted item* to a new map, containing the key from IRI compacting `@included` and the original *compacted item* as a value.
+
+					if compactedArray, ok := compactedItem.([]any); ok && len(compactedArray) > 1 {
+						includedAlias, err := compactKeyword(activeContext, "@included")
+						if err != nil {
+							return nil, err
+						}
+
+						compactedItem = map[string]any{
+							includedAlias: compactedArray,
+						}
+					}
+
+					// [spec // 6.1.2 // 12.8.8.3.2] Use add value to add *compacted item* to the *item active property* entry in *nest result* using *as array*.
+
+					builtinAddValue(nestResult, itemActiveProperty, compactedItem, asArray)
+				} else {
+
+					// [spec // 6.1.2 // 12.8.8.4] Otherwise, the value cannot be represented as a map object:
+					// [spec // 6.1.2 // 12.8.8.4.1] Set *compacted item* to a new map containing the key from IRI compacting `@graph` using the original *compacted item* as a value.
+
+					if compactedArray, ok := compactedItem.([]any); ok && len(compactedArray) == 1 && vars.compactArrays {
+						compactedItem = compactedArray[0]
+					}
+
+					graphAlias, err := compactKeyword(activeContext, "@graph")
+					if err != nil {
+						return nil, err
+					}
+
+					compactedGraphItem := map[string]any{
+						graphAlias: compactedItem,
+					}
+
+					// [spec // 6.1.2 // 12.8.8.4.2] If *expanded item* contains an `@id` entry, add an entry in *compacted item* using the key from IRI compacting `@id` using the value of IRI compacting the value of `@id` in *expanded item* using `false` for *vocab*.
+
+					if expandedItemID, ok := expandedItemMap["@id"].(string); ok {
+						idAlias, err := compactKeyword(activeContext, "@id")
+						if err != nil {
+							return nil, err
+						}
+
+						compactedID, err := algorithmIRICompaction{
+							activeContext: activeContext,
+							keyIRI:        expandedItemID,
+							vocab:         false,
+						}.Call()
+						if err != nil {
+							return nil, err
+						}
+
+						compactedGraphItem[idAlias] = compactedID
+					}
+
+					// [spec // 6.1.2 // 12.8.8.4.3] If *expanded item* contains an `@index` entry, add an entry in *compacted item* using the key from IRI compacting `@index` and the value of `@index` in *expanded item*.
+
+					if indexValue, ok := expandedItemMap["@index"]; ok {
+						indexAlias, err := compactKeyword(activeContext, "@index")
+						if err != nil {
+							return nil, err
+						}
+
+						compactedGraphItem[indexAlias] = indexValue
+					}
+
+					// [spec // 6.1.2 // 12.8.8.4.4] Use add value to add *compacted item* to the *item active property* entry in *nest result* using *as array*.
+
+					builtinAddValue(nestResult, itemActiveProperty, compactedGraphItem, asArray)
+				}
+			} else if !slices.Contains(container, "@graph") && (slices.Contains(container, "@language") || slices.Contains(container, "@index") || slices.Contains(container, "@id") || slices.Contains(container, "@type")) {
+
+				// [spec // 6.1.2 // 12.8.9] Otherwise, if *container* includes `@language`, `@index`, `@id`, or `@type` and *container* does not include `@graph`:
+				// [spec // 6.1.2 // 12.8.9.1] Initialize *map object* to the value of *item active property* in *nest result*, initializing it to a new empty map, if necessary.
+
+				mapObject := compactionMapObject(nestResult, itemActiveProperty)
+
+				// [spec // 6.1.2 // 12.8.9.2] Initialize *container key* by IRI compacting either `@language`, `@index`, `@id`, or `@type` based on the contents of *container*.
+
+				var containerKeyword string
+
+				switch {
+				case slices.Contains(container, "@language"):
+					containerKeyword = "@language"
+				case slices.Contains(container, "@index"):
+					containerKeyword = "@index"
+				case slices.Contains(container, "@id"):
+					containerKeyword = "@id"
+				default:
+					containerKeyword = "@type"
+				}
+
+				containerKey, err := compactKeyword(activeContext, containerKeyword)
+				if err != nil {
+					return nil, err
+				}
+
+				// [spec // 6.1.2 // 12.8.9.3] Initialize *index key* to the value of index mapping in the term definition associated with *item active property* in *active context*, or `@index`, if no such value exists.
+
+				indexKey := "@index"
+
+				if itemActivePropertyDefinition := activeContext.TermDefinitions[itemActiveProperty]; itemActivePropertyDefinition != nil && itemActivePropertyDefinition.IndexMapping != nil {
+					indexKey = *itemActivePropertyDefinition.IndexMapping
+				}
+
+				var mapKey *string
+
+				compactedItemMap, _ := compactedItem.(map[string]any)
+
+				switch containerKeyword {
+				case "@language":
+
+					// [spec // 6.1.2 // 12.8.9.4] If *container* includes `@language` and *expanded item* contains a `@value` entry, then set *compacted item* to the value associated with its `@value` entry. Set *map key* to the value of `@language` in *expanded item*, if any.
+
+					if expandedItemValue, ok := expandedItemMap["@value"]; ok && compactedItemMap != nil {
+						compactedItem = expandedItemValue
+					}
+
+					if expandedItemLanguage, ok := expandedItemMap["@language"].(string); ok {
+						mapKey = &expandedItemLanguage
+					}
+				case "@index":
+					if indexKey == "@index" {
+
+						// [spec // 6.1.2 // 12.8.9.5] Otherwise, if *container* includes `@index` and *index key* is `@index`, set *map key* to the value of `@index` in *expanded item*, if any.
+
+						if expandedItemIndex, ok := expandedItemMap["@index"].(string); ok {
+							mapKey = &expandedItemIndex
+						}
+					} else {
+
+						// [spec // 6.1.2 // 12.8.9.6] Otherwise, if *container* includes `@index` and *index key* is not `@index`:
+						// [spec // 6.1.2 // 12.8.9.6.1] Reinitialize *container key* by IRI compacting *index key* after first IRI expanding it.
+
+						expandedIndexKey, err := expandIRIString(activeContext, indexKey)
+						if err != nil {
+							return nil, err
+						}
+
+						containerKey, err = algorithmIRICompaction{
+							activeContext: activeContext,
+							keyIRI:        expandedIndexKey,
+							vocab:         true,
+						}.Call()
+						if err != nil {
+							return nil, err
+						}
+
+						// [spec // 6.1.2 // 12.8.9.6.2] Set *map key* to the first value of *container key* in *compacted item*, if any.
+						// [spec // 6.1.2 // 12.8.9.6.3] If there are remaining values in *compacted item* for *container key*, use add value to add those remaining values to the *container key* in *compacted item*. Otherwise, remove that entry from *compacted item*.
+
+						mapKey = compactionShiftMapKey(compactedItemMap, containerKey)
+					}
+				case "@id":
+
+					// [spec // 6.1.2 // 12.8.9.7] Otherwise, if *container* includes `@id`, set *map key* to the value of *container key* in *compacted item* and remove *container key* from *compacted item*.
+
+					if compactedItemID, ok := compactedItemMap[containerKey].(string); ok {
+						mapKey = &compactedItemID
+					}
+
+					delete(compactedItemMap, containerKey)
+				case "@type":
+
+					// [spec // 6.1.2 // 12.8.9.8] Otherwise, if *container* includes `@type`:
+					// [spec // 6.1.2 // 12.8.9.8.1] Set *map key* to the first value of *container key* in *compacted item*, if any.
+					// [spec // 6.1.2 // 12.8.9.8.2] If there are remaining values in *compacted item* for *container key*, use add value to add those remaining values to the *container key* in *compacted item*.
+					// [spec // 6.1.2 // 12.8.9.8.3] Otherwise, remove that entry from *compacted item*.
+
+					mapKey = compactionShiftMapKey(compactedItemMap, containerKey)
+
+					// [spec // 6.1.2 // 12.8.9.8.4] If *compacted item* contains a single entry with a key expanding to `@id`, set *compacted item* to the result of using this algorithm recursively, passing *active context*, *item active property* for *active property*, and a map composed of the single entry for `@id` from *expanded item* for *element*.
+
+					if len(compactedItemMap) == 1 {
+						for compactedItemKey := range compactedItemMap {
+							expandedKey, err := expandIRIString(activeContext, compactedItemKey)
+							if err != nil {
+								return nil, err
+							} else if expandedKey != "@id" {
+								continue
+							}
+
+							compactedItem, err = algorithmCompaction{
+								activeContext:  activeContext,
+								activeProperty: &itemActiveProperty,
+								element: map[string]any{
+									"@id": expandedItemMap["@id"],
+								},
+								compactArrays: vars.compactArrays,
+							}.Call()
+							if err != nil {
+								return nil, err
+							}
+						}
+					}
+				}
+
+				// [spec // 6.1.2 // 12.8.9.9] If *map key* is `null`, set it to the result of IRI compacting `@none`.
+
+				if mapKey == nil {
+					noneAlias, err := compactKeyword(activeContext, "@none")
+					if err != nil {
+						return nil, err
+					}
+
+					mapKey = &noneAlias
+				}
+
+				// [spec // 6.1.2 // 12.8.9.10] Use add value to add *compacted item* to the *map key* entry in *map object* using *as array*.
+
+				builtinAddValue(mapObject, *mapKey, compactedItem, asArray)
+			} else {
+
+				// [spec // 6.1.2 // 12.8.10] Otherwise, use add value to add *compacted item* to the *item active property* entry in *nest result* using *as array*.
+
+				builtinAddValue(nestResult, itemActiveProperty, compactedItem, asArray)
+			}
+		}
+	}
+
+	// [spec // 6.1.2 // 13] Return *result*.
+
+	return result, nil
+}
+
+// compactKeyword IRI compacts a keyword to find any alias defined by the active context.
+func compactKeyword(activeContext *Context, keyword string) (string, error) {
+	return algorithmIRICompaction{
+		activeContext: activeContext,
+		keyIRI:        keyword,
+		vocab:         true,
+	}.Call()
+}
+
+// expandIRIString IRI expands a compacted key, such as an alias or term, with vocab set to true.
+func expandIRIString(activeContext *Context, v string) (string, error) {
+	expanded, err := algorithmIRIExpansion{
+		activeContext: activeContext,
+		value: inspectjson.StringValue{
+			Value: v,
+		},
+		vocab: true,
+	}.Call()
+	if err != nil {
+		return "", err
+	} else if expanded == nil {
+		return "", nil
+	}
+
+	return expanded.String(), nil
+}
+
+func compactionNestResult(activeContext *Context, result map[string]any, itemActiveProperty string) (map[string]any, error) {
+	termDefinition := activeContext.TermDefinitions[itemActiveProperty]
+	if termDefinition == nil || termDefinition.NestValue == nil {
+		return result, nil
+	}
+
+	nestTerm := *termDefinition.NestValue
+
+	// [spec // 6.1.2 // 12.8.2.1] If *nest term* is not `@nest`, or a term in the *active context* that expands to `@nest`, an `invalid @nest value` error has been detected, and processing is aborted.
+
+	if nestTerm != "@nest" {
+		expandedNestTerm, err := expandIRIString(activeContext, nestTerm)
+		if err != nil {
+			return nil, err
+		} else if expandedNestTerm != "@nest" {
+			return nil, jsonldtype.Error{
+				Code: jsonldtype.InvalidAtNestValue,
+				Err:  fmt.Errorf("term does not expand to @nest: %s", nestTerm),
+			}
+		}
+	}
+
+	// [spec // 6.1.2 // 12.8.2.2] If *result* does not have a *nest term* entry, initialize it to an empty map.
+	// [spec // 6.1.2 // 12.8.2.3] Initialize *nest result* to the value of *nest term* in *result*.
+
+	return compactionMapObject(result, nestTerm), nil
+}
+
+func compactionMapObject(result map[string]any, key string) map[string]any {
+	mapObject, ok := result[key].(map[string]any)
+	if !ok {
+		mapObject = map[string]any{}
+		result[key] = mapObject
+	}
+
+	return mapObject
+}
+
+// compactionShiftMapKey removes and returns the first string value of key. Any remaining values are kept.
+func compactionShiftMapKey(compactedItem map[string]any, key string) *string {
+	values := builtinAsArray(compactedItem[key])
+	if _, ok := compactedItem[key]; !ok || len(values) == 0 {
+		return nil
+	}
+
+	first, ok := values[0].(string)
+	if !ok {
+		return nil
+	}
+
+	switch remaining := values[1:]; len(remaining) {
+	case 0:
+		delete(compactedItem, key)
+	case 1:
+		compactedItem[key] = remaining[0]
+	default:
+		compactedItem[key] = slices.Clone(remaining)
+	}
+
+	return &first
+}
@@ -194,14 +194,7 @@ func (vars algorithmContextProcessing) Call() (*Context, error) {
 
 				// [spec // 4.1.2 // 5.2.5] Otherwise, set *context document* to the `RemoteDocument` obtained by dereferencing *context* using the `LoadDocumentCallback`, passing *context* for url, and `http://www.w3.org/ns/json-ld#context` for `profile` and for `requestProfile`.
 
-				contextDocument, err := result._processor.documentLoader.LoadDocument(
-					result._processor.ctx,
-					contextURL.String(),
-					jsonldtype.DocumentLoaderOptions{
-						Profile:        &profileContextIRI,
-						RequestProfile: []string{profileContextIRI},
-					},
-				)
+				contextDocument, err := result._processor.loadRemoteContext(contextURL.String())
 
 				// [spec // 4.1.2 // 5.2.5.1] If *context* cannot be dereferenced, or the `document` from *context document* cannot be transformed into the internal representation , a `loading remote context failed` error has been detected and processing is aborted.
 
@@ -344,14 +337,7 @@ func (vars algorithmContextProcessing) Call() (*Context, error) {
 
 			// [spec // 4.1.2 // 5.6.4] Dereference *import* using the `LoadDocumentCallback`, passing *import* for url, and `http://www.w3.org/ns/json-ld#context` for `profile` and for `requestProfile`.
 
-			importDocument, err := result._processor.documentLoader.LoadDocument(
-				result._processor.ctx,
-				importURL.String(),
-				jsonldtype.DocumentLoaderOptions{
-					Profile:        &profileContextIRI,
-					RequestProfile: []string{profileContextIRI},
-				},
-			)
+			importDocument, err := result._processor.loadRemoteContext(importURL.String())
 
 			// [spec // 4.1.2 // 5.6.5] If *import* cannot be dereferenced, or cannot be transformed into the internal representation, a `loading remote context failed` error has been detected and processing is aborted.
 
@@ -1,6 +1,7 @@
 package jsonldinternal
 
 import (
+	"errors"
 	"fmt"
 	"slices"
 	"strings"
@@ -25,8 +26,7 @@ type algorithmExpansion struct {
 	baseURL *iri.ParsedIRI
 
 	// [spec] frameExpansion flag allowing special forms of input used for frame expansion
-	// [dpb] not implemented
-	// frameExpansion bool
+	frameExpansion bool
 
 	// [spec] used to order map entry keys lexicographically, where noted
 	ordered bool
@@ -48,12 +48,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 	// [spec // 5.1.2 // 2] If *active property* is `@default`, initialize the `frameExpansion` flag to `false`.
 
-	if false {
-		// [dpb] frameExpansion not implemented; always false
-
-		if vars.activeProperty != nil && *vars.activeProperty == "@default" {
-			// vars.frameExpansion = false
-		}
+	if vars.activeProperty != nil && *vars.activeProperty == "@default" {
+		vars.frameExpansion = false
 	}
 
 	// [spec // 5.1.2 // 3] If *active property* has a term definition in *active context* with a local context, initialize *property-scoped context* to that local context.
@@ -136,9 +132,9 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 				activePropertySourceOffsets: vars.activePropertySourceOffsets,
 				element:                     elementItem,
 				baseURL:                     vars.baseURL,
-				// frameExpansion: vars.frameExpansion,
-				ordered: vars.ordered,
-				fromMap: vars.fromMap,
+				frameExpansion:              vars.frameExpansion,
+				ordered:                     vars.ordered,
+				fromMap:                     vars.fromMap,
 			}.Call()
 			if err != nil {
 				return nil, err
@@ -489,6 +485,61 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 					// [spec // 5.1.2 // 13.4.3.1] If *value* is not a string, an `invalid @id value` error has been detected and processing is aborted. When the `frameExpansion` flag is set, *value* *MAY* be an empty map, or an array of one or more strings.
 
+					if vars.frameExpansion {
+						if valueObject, ok := value.(inspectjson.ObjectValue); ok && len(valueObject.Members) == 0 {
+							expandedValue = &ExpandedArray{
+								Values: []ExpandedValue{
+									&ExpandedObject{
+										Members: map[string]ExpandedValue{},
+									},
+								},
+							}
+
+							break
+						}
+
+						if valueArray, ok := value.(inspectjson.ArrayValue); ok {
+							if len(valueArray.Values) == 0 {
+								return jsonldtype.Error{
+									Code: jsonldtype.InvalidAtIDValue,
+									Err:  errors.New("invalid value: empty array"),
+								}
+							}
+
+							expandedValueArray := &ExpandedArray{}
+
+							for _, valueItem := range valueArray.Values {
+								valueItemString, ok := valueItem.(inspectjson.StringValue)
+								if !ok {
+									return jsonldtype.Error{
+										Code: jsonldtype.InvalidAtIDValue,
+										Err:  fmt.Errorf("invalid type: %s", valueItem.GetGrammarName()),
+									}
+								}
+
+								expandedIRI, err := algorithmIRIExpansion{
+									value:            valueItemString,
+									documentRelative: true,
+									vocab:            false,
+									// implicit
+									activeContext: vars.activeContext,
+								}.Call()
+								if err != nil {
+									return err
+								}
+
+								expandedValueArray.Values = append(expandedValueArray.Values, expandedIRI.NewPropertyValue(
+									elementObject.Members[key].Name.SourceOffsets,
+									valueItemString.SourceOffsets,
+								))
+							}
+
+							expandedValue = expandedValueArray
+
+							break
+						}
+					}
+
 					valueString, ok := value.(inspectjson.StringValue)
 					if !ok {
 						return jsonldtype.Error{
@@ -515,6 +566,12 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 						valueString.SourceOffsets,
 					)
 
+					if vars.frameExpansion {
+						expandedValue = &ExpandedArray{
+							Values: []ExpandedValue{expandedValue},
+						}
+					}
+
 				// [spec // 5.1.2 // 13.4.4] If *expanded property* is `@type`:
 
 				case "@type":
@@ -533,10 +590,57 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 					// type validation handled later with [spec 13.4.4.4]
 
-					if false {
-						// [dpb] unimplemented (only frameExpansion allows for a map)
+					if valueObject, ok := value.(inspectjson.ObjectValue); ok && vars.frameExpansion {
+
 						// [spec // 5.1.2 // 13.4.4.2] If *value* is an empty map, set *expanded value* to *value*.
+
+						if len(valueObject.Members) == 0 {
+							expandedValue = &ExpandedObject{
+								Members: map[string]ExpandedValue{},
+							}
+
+							break
+						}
+
 						// [spec // 5.1.2 // 13.4.4.3] Otherwise, if *value* is a default object, set *expanded value* to a new default object with the value of `@default` set to the result of IRI expanding value using *type-scoped context* for *active context*, and `true` for *document relative*.
+
+						defaultMember, ok := valueObject.Members["@default"]
+						if !ok || len(valueObject.Members) != 1 {
+							return jsonldtype.Error{
+								Code: jsonldtype.InvalidTypeValue,
+								Err:  errors.New("invalid type: object"),
+							}
+						}
+
+						defaultString, ok := defaultMember.Value.(inspectjson.StringValue)
+						if !ok {
+							return jsonldtype.Error{
+								Code: jsonldtype.InvalidTypeValue,
+								Err:  fmt.Errorf("invalid @default type: %s", defaultMember.Value.GetGrammarName()),
+							}
+						}
+
+						expandedIRI, err := algorithmIRIExpansion{
+							value:            defaultString,
+							documentRelative: true,
+							vocab:            true,
+							// implicit
+							activeContext: typeScopedContext,
+						}.Call()
+						if err != nil {
+							return err
+						}
+
+						expandedValue = &ExpandedObject{
+							Members: map[string]ExpandedValue{
+								"@default": expandedIRI.NewPropertyValue(
+									defaultMember.Name.SourceOffsets,
+									defaultString.SourceOffsets,
+								),
+							},
+						}
+
+						break
 					}
 
 					// [spec // 5.1.2 // 13.4.4.4] Otherwise, set *expanded value* to the result of IRI expanding each of its values using *type-scoped context* for *active context*, and `true` for *document relative*.
@@ -603,8 +707,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 						activePropertySourceOffsets: elementObject.Members[key].Name.SourceOffsets,
 						element:                     value,
 						baseURL:                     vars.baseURL,
-						// frameExpansion: vars.frameExpansion,
-						ordered: vars.ordered,
+						frameExpansion:              vars.frameExpansion,
+						ordered:                     vars.ordered,
 					}.Call()
 					if err != nil {
 						return err
@@ -634,8 +738,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 						activePropertySourceOffsets: nil,
 						element:                     value,
 						baseURL:                     vars.baseURL,
-						// frameExpansion: vars.frameExpansion,
-						ordered: vars.ordered,
+						frameExpansion:              vars.frameExpansion,
+						ordered:                     vars.ordered,
 					}.Call()
 					if err != nil {
 						return err
@@ -706,6 +810,21 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 						// [spec // 5.1.2 // 13.4.7.2] Otherwise, if *value* is not a scalar or `null`, an `invalid value object value` error has been detected and processing is aborted. When the `frameExpansion` flag is set, *value* *MAY* be an empty map or an array of scalar values.
 
+						if vars.frameExpansion {
+							if framedValue, ok := expandFrameMatchValues(value, func(v inspectjson.Value) bool {
+								switch v.GetGrammarName() {
+								case "boolean", "string", "number":
+									return true
+								}
+
+								return false
+							}); ok {
+								expandedValue = framedValue
+
+								break
+							}
+						}
+
 						if elementGrammarName := value.GetGrammarName(); elementGrammarName != "boolean" && elementGrammarName != "string" && elementGrammarName != "number" && elementGrammarName != "null" {
 							return jsonldtype.Error{
 								Code: jsonldtype.InvalidValueObjectValue,
@@ -734,6 +853,18 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 					// [spec // 5.1.2 // 13.4.8.1] If *value* is not a string, an `invalid language-tagged string` error has been detected and processing is aborted. When the frameExpansion flag is set, *value* *MAY* be an empty map or an array of zero or more strings.
 
+					if vars.frameExpansion {
+						if framedValue, ok := expandFrameMatchValues(value, func(v inspectjson.Value) bool {
+							_, ok := v.(inspectjson.StringValue)
+
+							return ok
+						}); ok {
+							expandedValue = framedValue
+
+							break
+						}
+					}
+
 					if _, ok := value.(inspectjson.StringValue); !ok {
 						return jsonldtype.Error{
 							Code: jsonldtype.InvalidLanguageTaggedString,
@@ -762,6 +893,18 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 					// [spec // 5.1.2 // 13.4.9.2] If *value* is neither `"ltr"` nor `"rtl"`, an invalid base direction error has been detected and processing is aborted. When the `frameExpansion` flag is set, *value* MAY be an empty map or an array of zero or more strings.
 
+					if vars.frameExpansion {
+						if framedValue, ok := expandFrameMatchValues(value, func(v inspectjson.Value) bool {
+							vString, ok := v.(inspectjson.StringValue)
+
+							return ok && (vString.Value == "ltr" || vString.Value == "rtl")
+						}); ok {
+							expandedValue = framedValue
+
+							break
+						}
+					}
+
 					valueString, ok := value.(inspectjson.StringValue)
 					if !ok {
 						return jsonldtype.Error{
@@ -820,8 +963,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 						activePropertySourceOffsets: vars.activePropertySourceOffsets,
 						element:                     value,
 						baseURL:                     vars.baseURL,
-						// frameExpansion: vars.frameExpansion,
-						ordered: vars.ordered,
+						frameExpansion:              vars.frameExpansion,
+						ordered:                     vars.ordered,
 					}.Call()
 					if err != nil {
 						return err
@@ -860,8 +1003,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 						activePropertySourceOffsets: vars.activePropertySourceOffsets,
 						element:                     value,
 						baseURL:                     vars.baseURL,
-						// frameExpansion: vars.frameExpansion,
-						ordered: vars.ordered,
+						frameExpansion:              vars.frameExpansion,
+						ordered:                     vars.ordered,
 					}.Call()
 					if err != nil {
 						return err
@@ -891,8 +1034,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 						activePropertySourceOffsets: elementObject.Members[key].Name.SourceOffsets,
 						element:                     valueObject,
 						baseURL:                     vars.baseURL,
-						// frameExpansion: vars.frameExpansion,
-						ordered: vars.ordered,
+						frameExpansion:              vars.frameExpansion,
+						ordered:                     vars.ordered,
 					}.Call()
 					if err != nil {
 						return err
@@ -1023,7 +1166,41 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 				case "@default", "@embed", "@explicit", "@omitDefault", "@requireAll":
 
-					// [dpb] unimplemented (frameExpansion)
+					if !vars.frameExpansion {
+						// [dpb] only meaningful within frames
+						continue
+					}
+
+					if expandedPropertyKeyword != "@default" {
+						// [dpb] flags are kept as-is since free-floating scalars would otherwise be dropped
+
+						expandedValue = &ExpandedScalarPrimitive{
+							Value: value,
+						}
+					} else {
+						var err error
+
+						expandedValue, err = algorithmExpansion{
+							activeContext:               vars.activeContext,
+							activeProperty:              vars.activeProperty,
+							activePropertySourceOffsets: elementObject.Members[key].Name.SourceOffsets,
+							element:                     value,
+							baseURL:                     vars.baseURL,
+							frameExpansion:              false,
+							ordered:                     vars.ordered,
+						}.Call()
+						if err != nil {
+							return err
+						}
+					}
+
+					if expandedValue == nil {
+						expandedValue = &ExpandedArray{}
+					} else if _, ok := expandedValue.(*ExpandedArray); !ok {
+						expandedValue = &ExpandedArray{
+							Values: []ExpandedValue{expandedValue},
+						}
+					}
 
 				}
 
@@ -1295,8 +1472,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 								element:                     indexValue,
 								baseURL:                     vars.baseURL,
 								fromMap:                     true,
-								// frameExpansion: vars.frameExpansion,
-								ordered: vars.ordered,
+								frameExpansion:              vars.frameExpansion,
+								ordered:                     vars.ordered,
 							}.Call()
 							if err != nil {
 								return err
@@ -1478,8 +1655,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 							activePropertySourceOffsets: propertySourceOffsets,
 							element:                     value,
 							baseURL:                     vars.baseURL,
-							// frameExpansion: vars.frameExpansion,
-							ordered: vars.ordered,
+							frameExpansion:              vars.frameExpansion,
+							ordered:                     vars.ordered,
 						}.Call()
 						if err != nil {
 							return err
@@ -1725,8 +1902,8 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 						activePropertySourceOffsets: elementObject.Members[nestingKey].Name.SourceOffsets,
 						element:                     nestedValue,
 						baseURL:                     vars.baseURL,
-						// frameExpansion: vars.frameExpansion,
-						ordered: vars.ordered,
+						frameExpansion:              vars.frameExpansion,
+						ordered:                     vars.ordered,
 					}.Call()
 					if err != nil {
 						return err
@@ -1758,7 +1935,9 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 	// [spec // 5.1.2 // 15] If *result* contains the entry `@value`:
 
-	if resultValueMember, ok := resultObject.Members["@value"]; ok {
+	// [dpb] value patterns of frames are not validated
+
+	if resultValueMember, ok := resultObject.Members["@value"]; ok && !vars.frameExpansion {
 
 		// [spec // 5.1.2 // 15.1] The *result* must not contain any entries other than `@direction`, `@index`, `@language`, `@type`, and `@value`. It must not contain an `@type` entry if it contains either `@language` or `@direction` entries. Otherwise, an `invalid value object` error has been detected and processing is aborted.
 
@@ -1925,7 +2104,9 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 	// [spec // 5.1.2 // 19] If *active property* is `null` or `@graph`, drop free-floating values as follows:
 
-	if vars.activeProperty == nil || *vars.activeProperty == "@graph" {
+	// [dpb] frames retain free-floating values as patterns
+
+	if (vars.activeProperty == nil || *vars.activeProperty == "@graph") && !vars.frameExpansion {
 
 		// [spec // 5.1.2 // 19.1] If *result* is a map which is empty, or contains only the entries `@value` or `@list`, set result to null.
 		// [dpb] testsuites define that `@value` + related language/type should be dropped, too; not strictly "only the entry `@value`" but closer to exclusively a value object?
@@ -1988,3 +2169,38 @@ func (vars algorithmExpansion) Call() (ExpandedValue, error) {
 
 	return result, nil
 }
+
+// expandFrameMatchValues expands the value patterns which are only valid within frames: an empty map, which matches
+// any value, or an array of values.
+func expandFrameMatchValues(value inspectjson.Value, isValid func(v inspectjson.Value) bool) (ExpandedValue, bool) {
+	switch valueT := value.(type) {
+	case inspectjson.ObjectValue:
+		if len(valueT.Members) > 0 {
+			return nil, false
+		}
+
+		return &ExpandedArray{
+			Values: []ExpandedValue{
+				&ExpandedObject{
+					Members: map[string]ExpandedValue{},
+				},
+			},
+		}, true
+	case inspectjson.ArrayValue:
+		expanded := &ExpandedArray{}
+
+		for _, item := range valueT.Values {
+			if !isValid(item) {
+				return nil, false
+			}
+
+			expanded.Values = append(expanded.Values, &ExpandedScalarPrimitive{
+				Value: item,
+			})
+		}
+
+		return expanded, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,86 @@
+package jsonldinternal
+
+import (
+	"maps"
+	"slices"
+)
+
+type algorithmFlattening struct {
+	element any
+
+	// [spec] ordered flag, used to order map entry keys lexicographically, where noted
+	// [dpb] graphs and nodes are always processed in order so that results are stable
+	// ordered bool
+}
+
+func (vars algorithmFlattening) Call() ([]any, error) {
+
+	// [spec // 7.1.2 // 1] Initialize *node map* to a map consisting of a single entry whose key is `@default` and whose value is an empty map.
+
+	nodeMap := NodeMap{
+		"@default": {},
+	}
+
+	// [spec // 7.1.2 // 2] Perform the Node Map Generation algorithm, passing *element* and *node map*.
+
+	err := algorithmNodeMapGeneration{
+		element: vars.element,
+		nodeMap: nodeMap,
+		issuer:  newBlankNodeIdentifierIssuer("_:b"),
+	}.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // 7.1.2 // 3] Initialize *default graph* to the value of the `@default` entry of *node map*, which is a map representing the default graph.
+
+	defaultGraph := nodeMap["@default"]
+
+	// [spec // 7.1.2 // 4] For each key-value pair *graph name*-*graph* in *node map* where *graph name* is not `@default`, ordered lexicographically by *graph name* if `ordered` is `true`, perform the following steps:
+
+	for _, graphName := range slices.Sorted(maps.Keys(nodeMap)) {
+		if graphName == "@default" {
+			continue
+		}
+
+		graph := nodeMap[graphName]
+
+		// [spec // 7.1.2 // 4.1] If *default graph* does not have a *graph name* entry, create one and initialize its value to a map consisting of an `@id` entry whose value is set to *graph name*.
+		// [spec // 7.1.2 // 4.2] Reference the value associated with the *graph name* entry in *default graph* using the variable *entry*.
+
+		entry, ok := defaultGraph[graphName]
+		if !ok {
+			entry = map[string]any{
+				"@id": graphName,
+			}
+			defaultGraph[graphName] = entry
+		}
+
+		// [spec // 7.1.2 // 4.3] Add an `@graph` entry to *entry* and set it to an empty array.
+		// [spec // 7.1.2 // 4.4] For each *id*-*node* pair in *graph* ordered by *id*, add *node* to the `@graph` entry of *entry*, unless the only entry of *node* is `@id`.
+
+		entry["@graph"] = flattenGraphNodes(graph)
+	}
+
+	// [spec // 7.1.2 // 5] Initialize an empty array *flattened*.
+	// [spec // 7.1.2 // 6] For each *id*-*node* pair in *default graph* ordered by *id*, add *node* to *flattened*, unless the only entry of *node* is `@id`.
+	// [spec // 7.1.2 // 7] Return *flattened*.
+
+	return flattenGraphNodes(defaultGraph), nil
+}
+
+func flattenGraphNodes(graph map[string]map[string]any) []any {
+	nodes := []any{}
+
+	for _, id := range slices.Sorted(maps.Keys(graph)) {
+		node := graph[id]
+
+		if _, ok := node["@id"]; ok && len(node) == 1 {
+			continue
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
@@ -0,0 +1,936 @@
+package jsonldinternal
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+)
+
+// [dpb] see https://www.w3.org/TR/json-ld11-framing/#framing-algorithm
+
+type framingFlags struct {
+	embed       string
+	explicit    bool
+	requireAll  bool
+	omitDefault bool
+}
+
+type framingStackEntry struct {
+	graph string
+	id    string
+}
+
+type framingState struct {
+	// [spec] defaults for the framing flags
+	defaults framingFlags
+
+	graphMap NodeMap
+
+	// [spec] graph name, the current graph being processed
+	graph string
+
+	// [spec] subject stack, used for detecting circular references
+	subjectStack *[]framingStackEntry
+
+	// [spec] embedded flag; unused since @embed of @last is not supported
+	// embedded bool
+
+	// [dpb] previously embedded node identifiers by graph name, used by @once
+	uniqueEmbeds *map[string]map[string]struct{}
+
+	// [dpb] usages of blank node identifiers within the output, used for pruning
+	bnodeUsages map[string]int
+}
+
+type algorithmFraming struct {
+	state framingState
+
+	// [spec] subjects, a list of node identifiers to be framed
+	subjects []string
+
+	// [spec] frame, the expanded frame
+	frame []any
+
+	// [spec] parent, where the output is added
+	parent    map[string]any
+	parentKey string
+
+	// [spec] active property, defaulting to `null`
+	activeProperty *string
+}
+
+func (vars algorithmFraming) Call() error {
+	frame, err := validateFrame(vars.frame)
+	if err != nil {
+		return err
+	}
+
+	state := vars.state
+
+	// [spec // framing 4.1 // 1] If *frame* does not have the `@embed`, `@explicit`, `@omitDefault`, or `@requireAll` flags, use the values from *state*.
+
+	flags, err := getFramingFlags(frame, state.defaults)
+	if err != nil {
+		return err
+	}
+
+	// [spec // framing 4.1 // 2] Initialize *matched subjects* to the result of Frame Matching using *state*, *subjects*, *frame*, and `requireAll` flag.
+
+	graphNodes := state.graphMap[state.graph]
+	matchedSubjects := []string{}
+
+	for _, id := range vars.subjects {
+		node, ok := graphNodes[id]
+		if !ok {
+			continue
+		}
+
+		matches, err := framingMatchNode(state, node, frame, flags.requireAll)
+		if err != nil {
+			return err
+		} else if matches {
+			matchedSubjects = append(matchedSubjects, id)
+		}
+	}
+
+	// [spec // framing 4.1 // 3] For each *id* and associated node object *node* from the set of matched subjects, ordered lexicographically by *id*:
+
+	slices.Sort(matchedSubjects)
+
+	for _, id := range matchedSubjects {
+		node := graphNodes[id]
+
+		// [dpb] each top-level match is a separate result; embedded subjects are only tracked within a result
+
+		if vars.activeProperty == nil {
+			*state.uniqueEmbeds = map[string]map[string]struct{}{
+				state.graph: {},
+			}
+		} else if _, ok := (*state.uniqueEmbeds)[state.graph]; !ok {
+			(*state.uniqueEmbeds)[state.graph] = map[string]struct{}{}
+		}
+
+		// [spec // framing 4.1 // 3.1] Initialize *output* to a new map with `@id` and *id*.
+
+		output := map[string]any{
+			"@id": id,
+		}
+
+		if strings.HasPrefix(id, "_:") {
+			state.bnodeUsages[id]++
+		}
+
+		// [spec // framing 4.1 // 3.2] If the `@embed` flag is `@never`, or if a circular reference would be created by an embed, add *output* to *parent* and do not perform additional processing for this *node*.
+
+		if flags.embed == "@never" || slices.Contains(*state.subjectStack, framingStackEntry{graph: state.graph, id: id}) {
+			addFramingOutput(vars.parent, vars.parentKey, output)
+
+			continue
+		}
+
+		// [spec // framing 4.1 // 3.3] Otherwise, if the `@embed` flag is `@once` and *node* has already been embedded, add *output* to *parent* and do not perform additional processing for this *node*.
+
+		if flags.embed == "@once" {
+			if _, ok := (*state.uniqueEmbeds)[state.graph][id]; ok {
+				addFramingOutput(vars.parent, vars.parentKey, output)
+
+				continue
+			}
+		}
+
+		(*state.uniqueEmbeds)[state.graph][id] = struct{}{}
+
+		*state.subjectStack = append(*state.subjectStack, framingStackEntry{graph: state.graph, id: id})
+
+		// [spec // framing 4.1 // 3.4] If *graph map* in *state* has an entry for *id*:
+
+		if _, ok := state.graphMap[id]; ok && id != "@default" && id != "@merged" {
+			var recurse bool
+			var subframe []any
+
+			if frameGraph, ok := frame["@graph"]; !ok {
+
+				// [spec // framing 4.1 // 3.4.1] If *frame* does not have the key `@graph`, set *recurse* to `true`, unless *graph name* in *state* is `@merged` and set *subframe* to a new empty map.
+
+				recurse = state.graph != "@merged"
+				subframe = []any{map[string]any{}}
+			} else {
+
+				// [spec // framing 4.1 // 3.4.2] Otherwise, set *subframe* to the first entry for `@graph` in *frame*, or a new empty map, if it does not exist, and set *recurse* to `true`, unless *id* is `@merged` or `@default`.
+
+				recurse = true
+				subframe = []any{map[string]any{}}
+
+				if frameGraphArray := framingAsArray(frameGraph); len(frameGraphArray) > 0 {
+					if frameGraphMap, ok := frameGraphArray[0].(map[string]any); ok {
+						subframe = []any{frameGraphMap}
+					}
+				}
+			}
+
+			// [spec // framing 4.1 // 3.4.3] If *recurse* is `true`, invoke the recursive algorithm using a copy of *state* with the value of *graph name* set to *id* and the *subject stack* set to an empty array, the keys of the *id* entry of *graph map* as *subjects*, *subframe* as *frame*, *output* as *parent*, and `@graph` as *active property*.
+
+			if recurse {
+				graphState := state
+				graphState.graph = id
+				graphState.subjectStack = &[]framingStackEntry{}
+
+				err := algorithmFraming{
+					state:          graphState,
+					subjects:       slices.Sorted(maps.Keys(state.graphMap[id])),
+					frame:          subframe,
+					parent:         output,
+					parentKey:      "@graph",
+					activeProperty: ptrString("@graph"),
+				}.Call()
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// [spec // framing 4.1 // 3.5] If *frame* has an `@included` entry, invoke the recursive algorithm using *state*, *subjects*, the value of `@included` as *frame*, *output* as *parent*, and `@included` as *active property*.
+
+		if frameIncluded, ok := frame["@included"]; ok {
+			includedState := state
+			includedState.subjectStack = &[]framingStackEntry{}
+
+			err := algorithmFraming{
+				state:          includedState,
+				subjects:       vars.subjects,
+				frame:          framingAsArray(frameIncluded),
+				parent:         output,
+				parentKey:      "@included",
+				activeProperty: ptrString("@included"),
+			}.Call()
+			if err != nil {
+				return err
+			}
+		}
+
+		// [spec // framing 4.1 // 3.6] For each *property* and *objects* in *node*, ordered by *property*:
+
+		for _, property := range slices.Sorted(maps.Keys(node)) {
+			objects := node[property]
+
+			// [spec // framing 4.1 // 3.6.1] If *property* is a keyword, add *property* and *objects* to *output*.
+
+			if isKeywordLikeProperty(property) {
+				output[property] = builtinClone(objects)
+
+				if property == "@type" {
+					for _, typeValue := range framingAsArray(objects) {
+						if typeString, ok := typeValue.(string); ok && strings.HasPrefix(typeString, "_:") {
+							state.bnodeUsages[typeString]++
+						}
+					}
+				}
+
+				continue
+			}
+
+			// [spec // framing 4.1 // 3.6.2] Otherwise, if *property* is not in *frame*, and `explicit` is `true`, processors *MUST NOT* add any values for *property* to *output*, and the following steps are skipped.
+
+			frameProperty, frameHasProperty := frame[property]
+
+			if flags.explicit && !frameHasProperty {
+				continue
+			}
+
+			// [spec // framing 4.1 // 3.6.3] For each *item* in *objects*:
+
+			for _, item := range framingAsArray(objects) {
+				subframe := framingAsArray(frameProperty)
+				if !frameHasProperty {
+					subframe = newImplicitFrame(flags)
+				}
+
+				if isBuiltinListObject(item) {
+
+					// [spec // framing 4.1 // 3.6.3.1] If *item* is a list object, let *list* be a new map with `@list` and an empty array. If *frame* has a `@list` entry for *property*, use it as *subframe*.
+
+					listSubframe := newImplicitFrame(flags)
+
+					if len(subframe) > 0 {
+						if subframeMap, ok := subframe[0].(map[string]any); ok {
+							if subframeList, ok := subframeMap["@list"]; ok {
+								listSubframe = framingAsArray(subframeList)
+							}
+						}
+					}
+
+					list := map[string]any{
+						"@list": []any{},
+					}
+
+					addFramingOutput(output, property, list)
+
+					// [spec // framing 4.1 // 3.6.3.1.1] For each *listitem* in the `@list` entry of *item*:
+
+					for _, listItem := range framingAsArray(item.(map[string]any)["@list"]) {
+						if listItemID, ok := framingNodeReference(listItem); ok {
+
+							// [spec // framing 4.1 // 3.6.3.1.1.1] If *listitem* is a node reference, invoke the recursive algorithm using *state*, the value of `@id` from *listitem* as the sole item in a new *subjects* array, *subframe* as *frame*, *list* as *parent*, and `@list` as *active property*.
+
+							err := algorithmFraming{
+								state:          state,
+								subjects:       []string{listItemID},
+								frame:          listSubframe,
+								parent:         list,
+								parentKey:      "@list",
+								activeProperty: ptrString("@list"),
+							}.Call()
+							if err != nil {
+								return err
+							}
+						} else {
+
+							// [spec // framing 4.1 // 3.6.3.1.1.2] Otherwise, append a copy of *listitem* to `@list` in *list*.
+
+							addFramingOutput(list, "@list", builtinClone(listItem))
+						}
+					}
+				} else if itemID, ok := framingNodeReference(item); ok {
+
+					// [spec // framing 4.1 // 3.6.3.2] If *item* is a node reference, invoke the recursive algorithm using *state*, the value of `@id` from *item* as the sole item in a new *subjects* array, *subframe* as *frame*, *output* as *parent*, and *property* as *active property*.
+
+					err := algorithmFraming{
+						state:          state,
+						subjects:       []string{itemID},
+						frame:          subframe,
+						parent:         output,
+						parentKey:      property,
+						activeProperty: ptrString(property),
+					}.Call()
+					if err != nil {
+						return err
+					}
+				} else if len(subframe) > 0 {
+
+					// [spec // framing 4.1 // 3.6.3.3] If *item* is a value object, and it matches the value pattern of *subframe*, add a copy of *item* to *output*.
+
+					if subframeMap, ok := subframe[0].(map[string]any); ok && framingMatchValue(subframeMap, item) {
+						addFramingOutput(output, property, builtinClone(item))
+					}
+				}
+			}
+		}
+
+		// [spec // framing 4.1 // 3.7] For each non-keyword *property* and *objects* in *frame* (other than `@type`) that is not in *output*:
+
+		for _, property := range slices.Sorted(maps.Keys(frame)) {
+			objects := framingAsArray(frame[property])
+
+			if property == "@type" {
+				// [dpb] default types are allowed through
+
+				if len(objects) == 0 {
+					continue
+				} else if typeMap, ok := objects[0].(map[string]any); !ok {
+					continue
+				} else if _, ok := typeMap["@default"]; !ok {
+					continue
+				}
+			} else if isKeywordLikeProperty(property) {
+				continue
+			}
+
+			// [spec // framing 4.1 // 3.7.1] Let *item* be the first element in *objects*, which *MUST* be a frame object.
+
+			item := map[string]any{}
+
+			if len(objects) > 0 {
+				if objectsMap, ok := objects[0].(map[string]any); ok {
+					item = objectsMap
+				}
+			}
+
+			// [spec // framing 4.1 // 3.7.2] Set *property frame* to the first item in *objects* or a newly created frame object if *objects* is empty.
+			// [spec // framing 4.1 // 3.7.3] Skip *property* and *property frame* if *property frame* contains `@omitDefault` with a value of `true`, or does not contain `@omitDefault` and the value of the `omitDefault` flag is `true`.
+
+			omitDefault, err := getFramingFlag(item, "@omitDefault", flags.omitDefault)
+			if err != nil {
+				return err
+			} else if omitDefault.(bool) {
+				continue
+			} else if _, ok := output[property]; ok {
+				continue
+			}
+
+			// [spec // framing 4.1 // 3.7.4] Add a new entry to *output* using *property* as key and a new map as value having a single entry `@preserve` with the value of `@default` in *property frame*, if it exists, or the string `@null` otherwise.
+
+			var preserve any = "@null"
+
+			if itemDefault, ok := item["@default"]; ok {
+				preserve = builtinClone(itemDefault)
+			}
+
+			output[property] = []any{
+				map[string]any{
+					"@preserve": framingAsArray(preserve),
+				},
+			}
+		}
+
+		// [spec // framing 4.1 // 3.8] If *frame* has an `@reverse` entry, then for each *reverse property* and *sub frame* in the value of `@reverse`:
+
+		if frameReverse, ok := frame["@reverse"].(map[string]any); ok {
+			for _, reverseProperty := range slices.Sorted(maps.Keys(frameReverse)) {
+				subframe := framingAsArray(frameReverse[reverseProperty])
+
+				// [spec // framing 4.1 // 3.8.1] For each *reverse id* and *node* in the map of flattened subjects that has the a *reverse property* entry containing a node reference with an `@id` of *id*:
+
+				for _, reverseID := range slices.Sorted(maps.Keys(graphNodes)) {
+					var referencesID bool
+
+					for _, reverseValue := range framingAsArray(graphNodes[reverseID][reverseProperty]) {
+						if reverseValueID, ok := framingNodeReference(reverseValue); ok && reverseValueID == id {
+							referencesID = true
+
+							break
+						}
+					}
+
+					if !referencesID {
+						continue
+					}
+
+					// [spec // framing 4.1 // 3.8.1.1] Add *reverse property* to `@reverse` in *output* if it does not already exist, and initialize it to an empty array.
+					// [spec // framing 4.1 // 3.8.1.2] Invoke the recursive algorithm using *state*, the *reverse id* as the sole item in a new *subjects* array, *sub frame* as *frame*, `null` as *active property*, and the array value of *reverse property* in `@reverse` in *output* as *parent*.
+
+					outputReverse, ok := output["@reverse"].(map[string]any)
+					if !ok {
+						outputReverse = map[string]any{}
+						output["@reverse"] = outputReverse
+					}
+
+					if _, ok := outputReverse[reverseProperty]; !ok {
+						outputReverse[reverseProperty] = []any{}
+					}
+
+					err := algorithmFraming{
+						state:          state,
+						subjects:       []string{reverseID},
+						frame:          subframe,
+						parent:         outputReverse,
+						parentKey:      reverseProperty,
+						activeProperty: ptrString("@reverse"),
+					}.Call()
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		// [spec // framing 4.1 // 3.9] Once output has been set are required in the previous steps, add *output* to *parent*.
+
+		addFramingOutput(vars.parent, vars.parentKey, output)
+
+		*state.subjectStack = (*state.subjectStack)[:len(*state.subjectStack)-1]
+	}
+
+	return nil
+}
+
+func addFramingOutput(parent map[string]any, key string, output any) {
+	parentValues, _ := parent[key].([]any)
+	parent[key] = append(parentValues, output)
+}
+
+func framingNodeReference(v any) (string, bool) {
+	vMap, ok := v.(map[string]any)
+	if !ok || len(vMap) != 1 {
+		return "", false
+	}
+
+	id, ok := vMap["@id"].(string)
+
+	return id, ok
+}
+
+func isKeywordLikeProperty(property string) bool {
+	return strings.HasPrefix(property, "@")
+}
+
+func newImplicitFrame(flags framingFlags) []any {
+	return []any{
+		map[string]any{
+			"@embed":       []any{flags.embed},
+			"@explicit":    []any{flags.explicit},
+			"@requireAll":  []any{flags.requireAll},
+			"@omitDefault": []any{flags.omitDefault},
+		},
+	}
+}
+
+func validateFrame(frame []any) (map[string]any, error) {
+	if len(frame) != 1 {
+		return nil, jsonldtype.Error{
+			Code: jsonldtype.InvalidFrame,
+			Err:  fmt.Errorf("expected a single frame object, found %d", len(frame)),
+		}
+	}
+
+	frameMap, ok := frame[0].(map[string]any)
+	if !ok {
+		return nil, jsonldtype.Error{
+			Code: jsonldtype.InvalidFrame,
+			Err:  fmt.Errorf("expected frame object, found %T", frame[0]),
+		}
+	}
+
+	for _, idValue := range framingAsArray(frameMap["@id"]) {
+		switch idValueT := idValue.(type) {
+		case map[string]any:
+			if len(idValueT) == 0 {
+				continue
+			}
+		case string:
+			if !strings.HasPrefix(idValueT, "_:") {
+				continue
+			}
+		}
+
+		return nil, jsonldtype.Error{
+			Code: jsonldtype.InvalidFrame,
+			Err:  fmt.Errorf("invalid @id value: %v", idValue),
+		}
+	}
+
+	for _, typeValue := range framingAsArray(frameMap["@type"]) {
+		switch typeValueT := typeValue.(type) {
+		case map[string]any:
+			if len(typeValueT) == 0 {
+				continue
+			} else if _, ok := typeValueT["@default"]; ok && len(typeValueT) == 1 {
+				continue
+			}
+		case string:
+			if !strings.HasPrefix(typeValueT, "_:") {
+				continue
+			}
+		}
+
+		return nil, jsonldtype.Error{
+			Code: jsonldtype.InvalidFrame,
+			Err:  fmt.Errorf("invalid @type value: %v", typeValue),
+		}
+	}
+
+	return frameMap, nil
+}
+
+func getFramingFlags(frame map[string]any, defaults framingFlags) (framingFlags, error) {
+	embed, err := getFramingFlag(frame, "@embed", defaults.embed)
+	if err != nil {
+		return framingFlags{}, err
+	}
+
+	explicit, err := getFramingFlag(frame, "@explicit", defaults.explicit)
+	if err != nil {
+		return framingFlags{}, err
+	}
+
+	requireAll, err := getFramingFlag(frame, "@requireAll", defaults.requireAll)
+	if err != nil {
+		return framingFlags{}, err
+	}
+
+	omitDefault, err := getFramingFlag(frame, "@omitDefault", defaults.omitDefault)
+	if err != nil {
+		return framingFlags{}, err
+	}
+
+	return framingFlags{
+		embed:       embed.(string),
+		explicit:    explicit.(bool),
+		requireAll:  requireAll.(bool),
+		omitDefault: omitDefault.(bool),
+	}, nil
+}
+
+func getFramingFlag(frame map[string]any, flag string, fallback any) (any, error) {
+	values := framingAsArray(frame[flag])
+	if len(values) == 0 {
+		return fallback, nil
+	}
+
+	value := values[0]
+
+	if valueMap, ok := value.(map[string]any); ok {
+		value = valueMap["@value"]
+	}
+
+	if flag == "@embed" {
+		switch valueT := value.(type) {
+		case bool:
+			// [dpb] backwards compatibility with JSON-LD 1.0 framing
+
+			if valueT {
+				return "@once", nil
+			}
+
+			return "@never", nil
+		case string:
+			switch valueT {
+			case "@always", "@once", "@never":
+				return valueT, nil
+			}
+		}
+
+		return nil, jsonldtype.Error{
+			Code: jsonldtype.InvalidAtEmbedValue,
+			Err:  fmt.Errorf("invalid value: %v", value),
+		}
+	}
+
+	switch valueT := value.(type) {
+	case bool:
+		return valueT, nil
+	case string:
+		return valueT == "true", nil
+	}
+
+	return fallback, nil
+}
+
+// framingMatchNode implements the Frame Matching Algorithm for a single node.
+func framingMatchNode(state framingState, node map[string]any, frame map[string]any, requireAll bool) (bool, error) {
+	var matchesSome bool
+
+	// [dpb] a frame without @type or any properties matches any node
+	wildcard := true
+
+	// [dpb] @id and @type are matched before other properties, consistent with reference implementations
+
+	properties := slices.Sorted(maps.Keys(frame))
+
+	slices.SortStableFunc(properties, func(a, b string) int {
+		rank := func(v string) int {
+			switch v {
+			case "@id":
+				return 0
+			case "@type":
+				return 1
+			}
+
+			return 2
+		}
+
+		return rank(a) - rank(b)
+	})
+
+	for _, property := range properties {
+		var matchesThis bool
+
+		frameValues := framingAsArray(frame[property])
+		nodeValues := framingAsArray(node[property])
+
+		switch {
+		case property == "@id":
+
+			// [spec // framing 4.2 // 1] *node* matches if it has an `@id` property value which is also a value of the `@id` property in *frame*, or if the `@id` property in *frame* is a wildcard.
+
+			if len(frameValues) == 0 {
+				matchesThis = true
+			} else if frameValueMap, ok := frameValues[0].(map[string]any); ok && len(frameValueMap) == 0 {
+				matchesThis = true
+			} else {
+				matchesThis = slices.Contains(frameValues, node["@id"])
+			}
+
+			if !requireAll {
+				return matchesThis, nil
+			}
+		case property == "@type":
+
+			// [spec // framing 4.2 // 2] *node* matches if it has no `@type` property and `@type` in *frame* is match none, any `@type` when `@type` in *frame* is a wildcard, or any of the values of `@type` in *frame*.
+
+			wildcard = false
+
+			if len(frameValues) == 0 {
+				if len(nodeValues) > 0 {
+					return false, nil
+				}
+
+				matchesThis = true
+			} else if frameValueMap, ok := frameValues[0].(map[string]any); ok && len(frameValues) == 1 && len(frameValueMap) == 0 {
+				matchesThis = len(nodeValues) > 0
+			} else {
+				for _, frameValue := range frameValues {
+					if frameValueMap, ok := frameValue.(map[string]any); ok {
+						if _, ok := frameValueMap["@default"]; ok {
+							matchesThis = true
+						}
+					} else if slices.Contains(nodeValues, frameValue) {
+						matchesThis = true
+					}
+				}
+
+				if !requireAll {
+					return matchesThis, nil
+				}
+			}
+		case isKeywordLikeProperty(property):
+			continue
+		default:
+
+			// [spec // framing 4.2 // 3] *node* matches if every other property in *frame* is matched by a property in *node*.
+
+			wildcard = false
+
+			var propertyFrame map[string]any
+			var hasDefault bool
+
+			if len(frameValues) > 0 {
+				propertyFrameMap, ok := frameValues[0].(map[string]any)
+				if !ok {
+					return false, jsonldtype.Error{
+						Code: jsonldtype.InvalidFrame,
+						Err:  fmt.Errorf("invalid property frame: %s", property),
+					}
+				}
+
+				propertyFrame = propertyFrameMap
+				_, hasDefault = propertyFrame["@default"]
+			}
+
+			// [spec // framing 4.2 // 3.1] If *property frame* has a default, and *node* has no values for *property*, it is considered a match.
+
+			if len(nodeValues) == 0 && hasDefault {
+				continue
+			}
+
+			// [spec // framing 4.2 // 3.2] If *property frame* is match none, *node* only matches if it has no values for *property*.
+
+			if len(frameValues) == 0 {
+				if len(nodeValues) > 0 {
+					return false, nil
+				}
+
+				matchesThis = true
+			} else if frameList, ok := propertyFrame["@list"]; ok {
+
+				// [spec // framing 4.2 // 3.3] If *property frame* is a list object, *node* matches if any list values match.
+
+				frameListValues := framingAsArray(frameList)
+
+				if len(frameListValues) > 0 && len(nodeValues) > 0 && isBuiltinListObject(nodeValues[0]) {
+					frameListValue, _ := frameListValues[0].(map[string]any)
+
+					for _, nodeListValue := range framingAsArray(nodeValues[0].(map[string]any)["@list"]) {
+						if framingIsValuePattern(frameListValue) {
+							matchesThis = framingMatchValue(frameListValue, nodeListValue)
+						} else {
+							matched, err := framingMatchNodeReference(state, frameListValue, nodeListValue, requireAll)
+							if err != nil {
+								return false, err
+							}
+
+							matchesThis = matched
+						}
+
+						if matchesThis {
+							break
+						}
+					}
+				}
+			} else if framingIsValuePattern(propertyFrame) {
+
+				// [spec // framing 4.2 // 3.4] If *property frame* is a value pattern, *node* matches if any of its values match the value pattern.
+
+				for _, nodeValue := range nodeValues {
+					if framingMatchValue(propertyFrame, nodeValue) {
+						matchesThis = true
+
+						break
+					}
+				}
+			} else if _, ok := propertyFrame["@id"]; ok && len(propertyFrame) == 1 {
+
+				// [spec // framing 4.2 // 3.5] If *property frame* is a node reference, *node* matches if any of its values match the referenced node.
+
+				for _, nodeValue := range nodeValues {
+					matched, err := framingMatchNodeReference(state, propertyFrame, nodeValue, requireAll)
+					if err != nil {
+						return false, err
+					} else if matched {
+						matchesThis = true
+
+						break
+					}
+				}
+			} else {
+
+				// [spec // framing 4.2 // 3.6] Otherwise, *property frame* is a wildcard, and *node* matches if it has any values for *property*.
+
+				matchesThis = len(nodeValues) > 0
+			}
+		}
+
+		// [spec // framing 4.2 // 4] If `requireAll` is `true`, *node* matches only if all of the properties in *frame* match.
+
+		if !matchesThis && requireAll {
+			return false, nil
+		}
+
+		matchesSome = matchesSome || matchesThis
+	}
+
+	return wildcard || matchesSome, nil
+}
+
+func framingMatchNodeReference(state framingState, pattern map[string]any, value any, requireAll bool) (bool, error) {
+	valueMap, ok := value.(map[string]any)
+	if !ok {
+		return false, nil
+	}
+
+	valueID, ok := valueMap["@id"].(string)
+	if !ok {
+		return false, nil
+	}
+
+	node, ok := state.graphMap[state.graph][valueID]
+	if !ok {
+		return false, nil
+	}
+
+	return framingMatchNode(state, node, pattern, requireAll)
+}
+
+func framingIsValuePattern(pattern map[string]any) bool {
+	_, ok := pattern["@value"]
+
+	return ok
+}
+
+// framingMatchValue implements the Value Pattern Matching Algorithm.
+func framingMatchValue(pattern map[string]any, value any) bool {
+	valueMap, ok := value.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	// [spec // framing 4.3 // 1] Let *v1*, *t1*, and *l1* be the values of `@value`, `@type`, and `@language` in *value*, or `null` if none exists.
+
+	v1, hasV1 := valueMap["@value"]
+	t1, hasT1 := valueMap["@type"]
+	l1, hasL1 := valueMap["@language"]
+
+	if !hasV1 {
+		return false
+	}
+
+	// [spec // framing 4.3 // 2] Let *v2*, *t2*, and *l2* be the values of `@value`, `@type`, and `@language` in *pattern*, or `null` if none exists.
+
+	v2 := framingAsArray(pattern["@value"])
+	t2 := framingAsArray(pattern["@type"])
+	l2 := framingAsArray(pattern["@language"])
+
+	// [spec // framing 4.3 // 3] Return `true` if *v2*, *t2*, and *l2* are `null` or if all of the following conditions are true.
+
+	if len(v2) == 0 && len(t2) == 0 && len(l2) == 0 {
+		return true
+	}
+
+	matchesPattern := func(v any, has bool, patternValues []any, fold bool) bool {
+		if len(patternValues) == 0 {
+			return !has
+		} else if patternMap, ok := patternValues[0].(map[string]any); ok && len(patternMap) == 0 {
+			return has
+		} else if !has {
+			return false
+		}
+
+		for _, patternValue := range patternValues {
+			if fold {
+				vString, vOK := v.(string)
+				patternString, patternOK := patternValue.(string)
+
+				if vOK && patternOK && strings.EqualFold(vString, patternString) {
+					return true
+				}
+			} else if patternValue == v {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	// [spec // framing 4.3 // 3.1] *v1* is an element of *v2* or *v2* is empty.
+	// [spec // framing 4.3 // 3.2] *t1* is `null` and *t2* is empty, or *t1* is an element of *t2*, or *t1* is not `null` and *t2* is a wildcard.
+	// [spec // framing 4.3 // 3.3] *l1* is `null` and *l2* is empty, or *l1* is an element of *l2*, or *l1* is not `null` and *l2* is a wildcard.
+
+	if len(v2) > 0 && !matchesPattern(v1, hasV1, v2, false) {
+		return false
+	}
+
+	return matchesPattern(t1, hasT1, t2, false) && matchesPattern(l1, hasL1, l2, true)
+}
+
+// mergeNodeMaps implements the Merge Node Maps algorithm.
+func mergeNodeMaps(graphMap NodeMap) map[string]map[string]any {
+
+	// [spec // 7.3.2 // 1] Create *result* as an empty map.
+
+	result := map[string]map[string]any{}
+
+	// [spec // 7.3.2 // 2] For each *graph name* and *node map* in *graph map* and for each *id* and *node* in *node map*:
+
+	for _, graphName := range slices.Sorted(maps.Keys(graphMap)) {
+		nodeMap := graphMap[graphName]
+
+		for _, id := range slices.Sorted(maps.Keys(nodeMap)) {
+			node := nodeMap[id]
+
+			// [spec // 7.3.2 // 2.1] Let *merged node* be the value for *id* in *result*, initializing it with a new map consisting of a single entry `@id` whose value is *id*, if it does not exist.
+
+			mergedNode, ok := result[id]
+			if !ok {
+				mergedNode = map[string]any{
+					"@id": id,
+				}
+				result[id] = mergedNode
+			}
+
+			// [spec // 7.3.2 // 2.2] For each *property* and *values* in *node*:
+
+			for _, property := range slices.Sorted(maps.Keys(node)) {
+				values := node[property]
+
+				if property != "@type" && isKeywordLikeProperty(property) {
+
+					// [spec // 7.3.2 // 2.2.1] If *property* is a keyword other than `@type`, add *property* and *values* to *merged node*.
+
+					mergedNode[property] = builtinClone(values)
+				} else {
+
+					// [spec // 7.3.2 // 2.2.2] Otherwise, merge each element from *values* into the values for *property* in *merged node*.
+
+					for _, value := range framingAsArray(values) {
+						builtinAppendUnique(mergedNode, property, builtinClone(value))
+					}
+				}
+			}
+		}
+	}
+
+	// [spec // 7.3.2 // 3] Return *result*.
+
+	return result
+}
+
+// framingAsArray is similar to [builtinAsArray], but a missing value is an empty array.
+func framingAsArray(v any) []any {
+	if v == nil {
+		return nil
+	}
+
+	return builtinAsArray(v)
+}
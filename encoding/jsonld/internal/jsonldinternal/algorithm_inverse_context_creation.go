@@ -0,0 +1,241 @@
+package jsonldinternal
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+)
+
+// InverseContext is keyed by IRI, then by container, then by one of `@language`, `@type`, or `@any`, and finally by the
+// language, direction, or type value which is mapped to the preferred term.
+type InverseContext map[string]map[string]map[string]map[string]string
+
+func (c *Context) getInverseContext() InverseContext {
+
+	// [spec // 6.2.2 // 2] If the active context has a `null` inverse context, set inverse context in active context to the result of calling the Inverse Context Creation algorithm using active context.
+
+	if c.InverseContext == nil {
+		c.InverseContext = algorithmInverseContextCreation{
+			activeContext: c,
+		}.Call()
+	}
+
+	return c.InverseContext
+}
+
+type algorithmInverseContextCreation struct {
+	activeContext *Context
+}
+
+func (vars algorithmInverseContextCreation) Call() InverseContext {
+
+	// [spec // 4.3.2 // 1] Initialize *result* to an empty map.
+
+	result := InverseContext{}
+
+	// [spec // 4.3.2 // 2] Initialize *default language* to `@none`. If the *active context* has a default language, set *default language* to the default language from the *active context* normalized to lower case.
+
+	defaultLanguage := "@none"
+
+	if vars.activeContext.DefaultLanguageValue != nil {
+		defaultLanguage = strings.ToLower(vars.activeContext.DefaultLanguageValue.Value)
+	}
+
+	// [spec // 4.3.2 // 3] For each key *term* and value *term definition* in the *active context*, ordered by shortest *term* first (breaking ties by choosing the lexicographically least *term*):
+
+	orderedTerms := make([]string, 0, len(vars.activeContext.TermDefinitions))
+
+	for term := range vars.activeContext.TermDefinitions {
+		orderedTerms = append(orderedTerms, term)
+	}
+
+	slices.SortFunc(orderedTerms, compareShortestLeast)
+
+	for _, term := range orderedTerms {
+		termDefinition := vars.activeContext.TermDefinitions[term]
+
+		// [spec // 4.3.2 // 3.1] If the *term definition* is `null`, *term* cannot be selected during compaction, so continue to the next *term*.
+
+		if termDefinition == nil || termDefinition.IRI == nil {
+			continue
+		} else if _, ok := termDefinition.IRI.(ExpandedIRIasNil); ok {
+			continue
+		}
+
+		// [spec // 4.3.2 // 3.2] Initialize *container* to `@none`. If the container mapping is not empty, set *container* to the concatenation of all values of the container mapping in lexicographical order.
+
+		container := "@none"
+
+		if len(termDefinition.ContainerMapping) > 0 {
+			containerMapping := slices.Clone(termDefinition.ContainerMapping)
+			slices.Sort(containerMapping)
+			containerMapping = slices.Compact(containerMapping)
+
+			container = strings.Join(containerMapping, "")
+		}
+
+		// [spec // 4.3.2 // 3.3] Initialize *var* to the value of the IRI mapping for the *term definition*.
+
+		iriMapping := termDefinition.IRI.String()
+
+		// [spec // 4.3.2 // 3.4] If *var* is not an entry of *result*, add an entry where the key is *var* and the value is an empty map to *result*.
+		// [spec // 4.3.2 // 3.5] Reference the value associated with the *var* entry in *result* using the variable *container map*.
+
+		containerMap, ok := result[iriMapping]
+		if !ok {
+			containerMap = map[string]map[string]map[string]string{}
+			result[iriMapping] = containerMap
+		}
+
+		// [spec // 4.3.2 // 3.6] If *container map* has no *container* entry, create one and set its value to a new map with three entries. The first entry is `@language` and its value is a new empty map, the second entry is `@type` and its value is a new empty map, and the third entry is `@any` and its value is a new map with the entry `@none` set to the *term* being processed.
+		// [spec // 4.3.2 // 3.7] Reference the value associated with the *container* entry in *container map* using the variable *type/language map*.
+
+		typeLanguageMap, ok := containerMap[container]
+		if !ok {
+			typeLanguageMap = map[string]map[string]string{
+				"@language": {},
+				"@type":     {},
+				"@any": {
+					"@none": term,
+				},
+			}
+			containerMap[container] = typeLanguageMap
+		}
+
+		// [spec // 4.3.2 // 3.8] Reference the value associated with the `@type` entry in *type/language map* using the variable *type map*.
+		// [spec // 4.3.2 // 3.9] Reference the value associated with the `@language` entry in *type/language map* using the variable *language map*.
+
+		typeMap := typeLanguageMap["@type"]
+		languageMap := typeLanguageMap["@language"]
+
+		if termDefinition.ReverseProperty {
+
+			// [spec // 4.3.2 // 3.10] If the *term definition* indicates that the *term* represents a reverse property:
+			// [spec // 4.3.2 // 3.10.1] If *type map* does not have an `@reverse` entry, create one and set its value to the *term* being processed.
+
+			addInverseContextTerm(typeMap, "@reverse", term)
+		} else if termDefinition.TypeMapping != nil && termDefinition.TypeMapping.String() == "@none" {
+
+			// [spec // 4.3.2 // 3.11] Otherwise, if *term definition* has a type mapping which is `@none`:
+			// [spec // 4.3.2 // 3.11.1] If *language map* does not have an `@any` entry, create one and set its value to the *term* being processed.
+			// [spec // 4.3.2 // 3.11.2] If *type map* does not have an `@any` entry, create one and set its value to the *term* being processed.
+
+			addInverseContextTerm(languageMap, "@any", term)
+			addInverseContextTerm(typeMap, "@any", term)
+		} else if termDefinition.TypeMapping != nil {
+
+			// [spec // 4.3.2 // 3.12] Otherwise, if *term definition* has a type mapping:
+			// [spec // 4.3.2 // 3.12.1] If *type map* does not have an entry corresponding to the type mapping in *term definition*, create one and set its value to the *term* being processed.
+
+			addInverseContextTerm(typeMap, termDefinition.TypeMapping.String(), term)
+		} else if termDefinition.LanguageMappingValue != nil && termDefinition.DirectionMappingValue != nil {
+
+			// [spec // 4.3.2 // 3.13] Otherwise, if *term definition* has both a language mapping and a direction mapping:
+
+			language, hasLanguage := termDefinition.LanguageMappingValue.(inspectjson.StringValue)
+			direction, hasDirection := termDefinition.DirectionMappingValue.(inspectjson.StringValue)
+
+			var languageDirection string
+
+			if hasLanguage && hasDirection {
+
+				// [spec // 4.3.2 // 3.13.2] If neither the language mapping nor the direction mapping are `null`, set *lang dir* to the concatenation of language mapping and direction mapping separated by an underscore (`"_"`), normalized to lower case.
+
+				languageDirection = strings.ToLower(language.Value + "_" + direction.Value)
+			} else if hasLanguage {
+
+				// [spec // 4.3.2 // 3.13.3] Otherwise, if language mapping is not `null`, set *lang dir* to the language mapping, normalized to lower case.
+
+				languageDirection = strings.ToLower(language.Value)
+			} else if hasDirection {
+
+				// [spec // 4.3.2 // 3.13.4] Otherwise, if direction mapping is not `null`, set *lang dir* to direction mapping preceded by an underscore (`"_"`).
+
+				languageDirection = "_" + direction.Value
+			} else {
+
+				// [spec // 4.3.2 // 3.13.5] Otherwise, set *lang dir* to `@null`.
+
+				languageDirection = "@null"
+			}
+
+			// [spec // 4.3.2 // 3.13.6] If *language map* does not have a *lang dir* entry, create one and set its value to the *term* being processed.
+
+			addInverseContextTerm(languageMap, languageDirection, term)
+		} else if termDefinition.LanguageMappingValue != nil {
+
+			// [spec // 4.3.2 // 3.14] Otherwise, if *term definition* has a language mapping (might be `null`):
+			// [spec // 4.3.2 // 3.14.1] If the language mapping equals `null`, set *language* to `@null`; otherwise set it to the language mapping, normalized to lower case.
+			// [spec // 4.3.2 // 3.14.2] If *language map* does not have a *language* entry, create one and set its value to the *term* being processed.
+
+			language := "@null"
+
+			if languageString, ok := termDefinition.LanguageMappingValue.(inspectjson.StringValue); ok {
+				language = strings.ToLower(languageString.Value)
+			}
+
+			addInverseContextTerm(languageMap, language, term)
+		} else if termDefinition.DirectionMappingValue != nil {
+
+			// [spec // 4.3.2 // 3.15] Otherwise, if *term definition* has a direction mapping (might be `null`):
+			// [spec // 4.3.2 // 3.15.1] If the direction mapping equals `null`, set *direction* to `@none`; otherwise set *direction* to a string concatenating an underscore (`"_"`) followed by the direction mapping.
+			// [spec // 4.3.2 // 3.15.2] If *language map* does not have a *direction* entry, create one and set its value to the *term* being processed.
+
+			direction := "@none"
+
+			if directionString, ok := termDefinition.DirectionMappingValue.(inspectjson.StringValue); ok {
+				direction = "_" + directionString.Value
+			}
+
+			addInverseContextTerm(languageMap, direction, term)
+		} else if vars.activeContext.DefaultDirectionValue != nil {
+
+			// [spec // 4.3.2 // 3.16] Otherwise, if *active context* has a default base direction:
+			// [spec // 4.3.2 // 3.16.1] Initialize a variable *lang dir* with the concatenation of *default language* and default base direction, separated by an underscore (`"_"`), normalized to lower case.
+			// [dpb] the default language is omitted when it is `@none`, matching the lang dir of values without a language
+			// [spec // 4.3.2 // 3.16.2] If *language map* does not have a *lang dir* entry, create one and set its value to the *term* being processed.
+			// [spec // 4.3.2 // 3.16.3] If *language map* does not have an `@none` entry, create one and set its value to the *term* being processed.
+			// [spec // 4.3.2 // 3.16.4] If *type map* does not have a `@none` entry, create one and set its value to the *term* being processed.
+
+			languageDirection := "_" + strings.ToLower(vars.activeContext.DefaultDirectionValue.Value)
+
+			if defaultLanguage != "@none" {
+				languageDirection = defaultLanguage + languageDirection
+			}
+
+			addInverseContextTerm(languageMap, languageDirection, term)
+			addInverseContextTerm(languageMap, "@none", term)
+			addInverseContextTerm(typeMap, "@none", term)
+		} else {
+
+			// [spec // 4.3.2 // 3.17] Otherwise:
+			// [spec // 4.3.2 // 3.17.1] If *language map* does not have a *default language* entry (after being normalized to lower case), create one and set its value to the *term* being processed.
+			// [spec // 4.3.2 // 3.17.2] If *language map* does not have an `@none` entry, create one and set its value to the *term* being processed.
+			// [spec // 4.3.2 // 3.17.3] If *type map* does not have an `@none` entry, create one and set its value to the *term* being processed.
+
+			addInverseContextTerm(languageMap, defaultLanguage, term)
+			addInverseContextTerm(languageMap, "@none", term)
+			addInverseContextTerm(typeMap, "@none", term)
+		}
+	}
+
+	// [spec // 4.3.2 // 4] Return *result*.
+
+	return result
+}
+
+func addInverseContextTerm(m map[string]string, key, term string) {
+	if _, ok := m[key]; !ok {
+		m[key] = term
+	}
+}
+
+// compareShortestLeast orders shorter strings first, breaking ties lexicographically.
+func compareShortestLeast(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+
+	return strings.Compare(a, b)
+}
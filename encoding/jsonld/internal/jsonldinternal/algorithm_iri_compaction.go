@@ -0,0 +1,569 @@
+package jsonldinternal
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/iri"
+)
+
+type algorithmIRICompaction struct {
+	activeContext *Context
+
+	// [spec] var, the IRI to be compacted
+	keyIRI string
+
+	// Optional
+
+	// [spec] value, the value associated with var, used to choose the most appropriate term
+	value any
+
+	// [spec] vocab, defaulting to false, specifying if var is relative to the vocabulary mapping
+	vocab bool
+
+	// [spec] reverse, defaulting to false, specifying if a reverse property is being compacted
+	reverse bool
+}
+
+func (vars algorithmIRICompaction) Call() (string, error) {
+
+	// [spec // 6.2.2 // 1] If *var* is `null`, return `null`.
+	// [dpb] callers never pass `null`
+
+	// [spec // 6.2.2 // 2] If the *active context* has a `null` inverse context, set inverse context in *active context* to the result of calling the Inverse Context Creation algorithm using *active context*.
+	// [spec // 6.2.2 // 3] Initialize *inverse context* to the value of inverse context in *active context*.
+
+	inverseContext := vars.activeContext.getInverseContext()
+
+	// [spec // 6.2.2 // 4] If *vocab* is `true` and *var* is an entry of *inverse context*:
+
+	if _, ok := inverseContext[vars.keyIRI]; vars.vocab && ok {
+
+		// [spec // 6.2.2 // 4.1] Initialize *default language* based on the *active context*'s default language, normalized to lower case and default base direction:
+		// [spec // 6.2.2 // 4.1.1] If the *active context* has a default base direction, the concatenation of the *active context*'s default language and default base direction, separated by an underscore (`"_"`), normalized to lower case.
+		// [spec // 6.2.2 // 4.1.2] Otherwise, the *active context*'s default language, if it has one, normalized to lower case, otherwise `@none`.
+
+		var defaultLanguage string
+
+		if vars.activeContext.DefaultDirectionValue != nil {
+			defaultLanguage = "_" + strings.ToLower(vars.activeContext.DefaultDirectionValue.Value)
+
+			if vars.activeContext.DefaultLanguageValue != nil {
+				defaultLanguage = strings.ToLower(vars.activeContext.DefaultLanguageValue.Value) + defaultLanguage
+			}
+		} else if vars.activeContext.DefaultLanguageValue != nil {
+			defaultLanguage = strings.ToLower(vars.activeContext.DefaultLanguageValue.Value)
+		} else {
+			defaultLanguage = "@none"
+		}
+
+		// [spec // 6.2.2 // 4.2] If *value* is a map containing an `@preserve` entry, use the first element from the value of `@preserve` as *value*.
+
+		value := vars.value
+		valueMap, _ := value.(map[string]any)
+
+		if preserveValue, ok := valueMap["@preserve"]; ok {
+			if preserveArray := builtinAsArray(preserveValue); len(preserveArray) > 0 {
+				value = preserveArray[0]
+				valueMap, _ = value.(map[string]any)
+			}
+		}
+
+		// [spec // 6.2.2 // 4.3] Initialize *containers* to an empty array. This array will be used to keep track of an ordered list of preferred container mapping for a term, based on what is compatible with *value*.
+
+		var containers []string
+
+		// [spec // 6.2.2 // 4.4] Initialize *type/language* to `@language`, and *type/language value* to `@null`. These two variables will keep track of the preferred type mapping or language mapping for a term, based on what is compatible with *value*.
+
+		typeLanguage := "@language"
+		typeLanguageValue := "@null"
+
+		// [spec // 6.2.2 // 4.5] If *value* is a map containing an `@index` entry, and *value* is not a graph object then append the values `@index` and `@index@set` to *containers*.
+
+		_, valueHasIndex := valueMap["@index"]
+
+		if valueHasIndex && !isBuiltinGraphObject(value) {
+			containers = append(containers, "@index", "@index@set")
+		}
+
+		if vars.reverse {
+
+			// [spec // 6.2.2 // 4.6] If *reverse* is `true`, set *type/language* to `@type`, *type/language value* to `@reverse`, and append `@set` to *containers*.
+
+			typeLanguage = "@type"
+			typeLanguageValue = "@reverse"
+			containers = append(containers, "@set")
+		} else if isBuiltinListObject(value) {
+
+			// [spec // 6.2.2 // 4.7] Otherwise, if *value* is a list object, then set *type/language* and *type/language value* to the most specific values that work for all items in the list as follows:
+			// [spec // 6.2.2 // 4.7.1] If `@index` is not an entry in *value*, then append `@list` to *containers*.
+
+			if !valueHasIndex {
+				containers = append(containers, "@list")
+			}
+
+			// [spec // 6.2.2 // 4.7.2] Initialize *list* to the value associated with the key `@list` in *value*.
+
+			list := builtinAsArray(valueMap["@list"])
+
+			// [spec // 6.2.2 // 4.7.3] Initialize *common type* and *common language* to `null`. If *list* is empty, set *common language* to *default language*.
+
+			var commonType, commonLanguage *string
+
+			if len(list) == 0 {
+				commonLanguage = &defaultLanguage
+			}
+
+			// [spec // 6.2.2 // 4.7.4] For each *item* in *list*:
+
+			for _, item := range list {
+
+				// [spec // 6.2.2 // 4.7.4.1] Initialize *item language* to `@none` and *item type* to `@none`.
+
+				itemLanguage := "@none"
+				itemType := "@none"
+
+				itemMap, _ := item.(map[string]any)
+				_, itemIsValue := itemMap["@value"]
+
+				if itemIsValue {
+
+					// [spec // 6.2.2 // 4.7.4.2] If *item* contains an `@value` entry:
+
+					if itemDirection, ok := itemMap["@direction"]; ok {
+
+						// [spec // 6.2.2 // 4.7.4.2.1] If *item* contains an `@direction` entry, then set *item language* to the concatenation of the *item*'s `@language` entry (if any) the *item*'s `@direction`, separated by an underscore (`"_"`), normalized to lower case.
+
+						itemLanguageString, _ := itemMap["@language"].(string)
+						itemDirectionString, _ := itemDirection.(string)
+
+						itemLanguage = strings.ToLower(itemLanguageString + "_" + itemDirectionString)
+					} else if itemLanguageValue, ok := itemMap["@language"]; ok {
+
+						// [spec // 6.2.2 // 4.7.4.2.2] Otherwise, if *item* contains an `@language` entry, then set *item language* to its associated value, normalized to lower case.
+
+						itemLanguageString, _ := itemLanguageValue.(string)
+						itemLanguage = strings.ToLower(itemLanguageString)
+					} else if itemTypeValue, ok := itemMap["@type"]; ok {
+
+						// [spec // 6.2.2 // 4.7.4.2.3] Otherwise, if *item* contains a `@type` entry, set *item type* to its associated value.
+
+						itemType, _ = itemTypeValue.(string)
+					} else {
+
+						// [spec // 6.2.2 // 4.7.4.2.4] Otherwise, set *item language* to `@null`.
+
+						itemLanguage = "@null"
+					}
+				} else {
+
+					// [spec // 6.2.2 // 4.7.4.3] Otherwise, set *item type* to `@id`.
+
+					itemType = "@id"
+				}
+
+				if commonLanguage == nil {
+
+					// [spec // 6.2.2 // 4.7.4.4] If *common language* is `null`, set *common language* to *item language*.
+
+					commonLanguage = &itemLanguage
+				} else if itemLanguage != *commonLanguage && itemIsValue {
+
+					// [spec // 6.2.2 // 4.7.4.5] Otherwise, if *item language* does not exactly equal *common language* and *item* contains a `@value` entry, then set *common language* to `@none` because list items have conflicting languages.
+
+					commonLanguage = ptrString("@none")
+				}
+
+				if commonType == nil {
+
+					// [spec // 6.2.2 // 4.7.4.6] If *common type* is `null`, set *common type* to *item type*.
+
+					commonType = &itemType
+				} else if itemType != *commonType {
+
+					// [spec // 6.2.2 // 4.7.4.7] Otherwise, if *item type* does not exactly equal *common type*, then set *common type* to `@none` because list items have conflicting types.
+
+					commonType = ptrString("@none")
+				}
+
+				// [spec // 6.2.2 // 4.7.4.8] If *common language* is `@none` and *common type* is `@none`, then stop processing items in the list because it has been detected that there is no common language or type amongst the items.
+
+				if *commonLanguage == "@none" && *commonType == "@none" {
+					break
+				}
+			}
+
+			// [spec // 6.2.2 // 4.7.5] If *common language* is `null`, set *common language* to `@none`.
+
+			if commonLanguage == nil {
+				commonLanguage = ptrString("@none")
+			}
+
+			// [spec // 6.2.2 // 4.7.6] If *common type* is `null`, set *common type* to `@none`.
+
+			if commonType == nil {
+				commonType = ptrString("@none")
+			}
+
+			if *commonType != "@none" {
+
+				// [spec // 6.2.2 // 4.7.7] If *common type* is not `@none` then set *type/language* to `@type` and *type/language value* to *common type*.
+
+				typeLanguage = "@type"
+				typeLanguageValue = *commonType
+			} else {
+
+				// [spec // 6.2.2 // 4.7.8] Otherwise, set *type/language value* to *common language*.
+
+				typeLanguageValue = *commonLanguage
+			}
+		} else if isBuiltinGraphObject(value) {
+
+			// [spec // 6.2.2 // 4.8] Otherwise, if *value* is a graph object, prefer a mapping most appropriate for the particular value.
+
+			_, valueHasID := valueMap["@id"]
+
+			// [spec // 6.2.2 // 4.8.1] If *value* contains an `@index` entry, append the values `@graph@index` and `@graph@index@set` to *containers*.
+
+			if valueHasIndex {
+				containers = append(containers, "@graph@index", "@graph@index@set")
+			}
+
+			// [spec // 6.2.2 // 4.8.2] If *value* contains an `@id` entry, append the values `@graph@id` and `@graph@id@set` to *containers*.
+
+			if valueHasID {
+				containers = append(containers, "@graph@id", "@graph@id@set")
+			}
+
+			// [spec // 6.2.2 // 4.8.3] Append the values `@graph`, `@graph@set`, and `@set` to *containers*.
+
+			containers = append(containers, "@graph", "@graph@set", "@set")
+
+			// [spec // 6.2.2 // 4.8.4] If *value* does not contain an `@index` entry, append the values `@graph@index` and `@graph@index@set` to *containers*.
+
+			if !valueHasIndex {
+				containers = append(containers, "@graph@index", "@graph@index@set")
+			}
+
+			// [spec // 6.2.2 // 4.8.5] If the value does not contain an `@id` entry, append the values `@graph@id` and `@graph@id@set` to *containers*.
+
+			if !valueHasID {
+				containers = append(containers, "@graph@id", "@graph@id@set")
+			}
+
+			// [spec // 6.2.2 // 4.8.6] Append the values `@index` and `@index@set` to *containers*.
+			// [spec // 6.2.2 // 4.8.7] Set *type/language* to `@type` and set *type/language value* to `@id`.
+
+			containers = append(containers, "@index", "@index@set")
+			typeLanguage = "@type"
+			typeLanguageValue = "@id"
+		} else {
+
+			// [spec // 6.2.2 // 4.9] Otherwise:
+
+			if isBuiltinValueObject(value) {
+
+				// [spec // 6.2.2 // 4.9.1] If *value* is a value object:
+
+				if valueDirection, ok := valueMap["@direction"]; ok && !valueHasIndex {
+
+					// [spec // 6.2.2 // 4.9.1.1] If *value* contains an `@direction` entry and does not contain an `@index` entry, then set *type/language value* to the concatenation of the *value*'s `@language` entry (if any) and the *value*'s `@direction` entry, separated by an underscore (`"_"`), normalized to lower case. Append `@language` and `@language@set` to *containers*.
+
+					valueLanguageString, _ := valueMap["@language"].(string)
+					valueDirectionString, _ := valueDirection.(string)
+
+					typeLanguageValue = strings.ToLower(valueLanguageString + "_" + valueDirectionString)
+					containers = append(containers, "@language", "@language@set")
+				} else if valueLanguage, ok := valueMap["@language"]; ok && !valueHasIndex {
+
+					// [spec // 6.2.2 // 4.9.1.2] Otherwise, if *value* contains an `@language` entry and does not contain an `@index` entry, then set *type/language value* to the value of `@language` normalized to lower case, and append `@language`, and `@language@set` to *containers*.
+
+					valueLanguageString, _ := valueLanguage.(string)
+
+					typeLanguageValue = strings.ToLower(valueLanguageString)
+					containers = append(containers, "@language", "@language@set")
+				} else if valueType, ok := valueMap["@type"]; ok {
+
+					// [spec // 6.2.2 // 4.9.1.3] Otherwise, if *value* contains an `@type` entry, then set *type/language value* to its associated value and set *type/language* to `@type`.
+
+					typeLanguageValue, _ = valueType.(string)
+					typeLanguage = "@type"
+				}
+			} else {
+
+				// [spec // 6.2.2 // 4.9.2] Otherwise, set *type/language* to `@type` and set *type/language value* to `@id`, and append `@id`, `@id@set`, `@type`, and `@set@type`, to *containers*.
+
+				typeLanguage = "@type"
+				typeLanguageValue = "@id"
+				containers = append(containers, "@id", "@id@set", "@type", "@set@type")
+			}
+
+			// [spec // 6.2.2 // 4.9.3] Append `@set` to *containers*.
+
+			containers = append(containers, "@set")
+		}
+
+		// [spec // 6.2.2 // 4.10] Append `@none` to *containers*. This represents the non-existence of a container mapping, and it will be the last container mapping value to be checked as it is the most generic.
+
+		containers = append(containers, "@none")
+
+		processingMode := vars.activeContext._processor.processingMode
+
+		// [spec // 6.2.2 // 4.11] If processing mode is not `json-ld-1.0` and *value* is not a map or does not contain an `@index` entry, append `@index` and `@index@set` to *containers*.
+
+		if processingMode != ProcessingMode_JSON_LD_1_0 && (valueMap == nil || !valueHasIndex) {
+			containers = append(containers, "@index", "@index@set")
+		}
+
+		// [spec // 6.2.2 // 4.12] If processing mode is not `json-ld-1.0` and *value* is a map containing only an `@value` entry, append `@language` and `@language@set` to *containers*.
+
+		if _, ok := valueMap["@value"]; processingMode != ProcessingMode_JSON_LD_1_0 && ok && len(valueMap) == 1 {
+			containers = append(containers, "@language", "@language@set")
+		}
+
+		// [spec // 6.2.2 // 4.13] If *type/language value* is `null`, set *type/language value* to `@null`. This is the key under which `null` values are stored in the inverse context entry.
+		// [dpb] handled by the zero values above
+
+		if len(typeLanguageValue) == 0 {
+			typeLanguageValue = "@null"
+		}
+
+		// [spec // 6.2.2 // 4.14] Initialize *preferred values* to an empty array. This array will indicate, in order, the preferred values for a term's type mapping or language mapping.
+
+		var preferredValues []string
+
+		// [spec // 6.2.2 // 4.15] If *type/language value* is `@reverse`, append `@reverse` to *preferred values*.
+
+		if typeLanguageValue == "@reverse" {
+			preferredValues = append(preferredValues, "@reverse")
+		}
+
+		if valueID, ok := valueMap["@id"].(string); ok && (typeLanguageValue == "@id" || typeLanguageValue == "@reverse") {
+
+			// [spec // 6.2.2 // 4.16] If *type/language value* is `@id` or `@reverse` and *value* is a map containing an `@id` entry:
+
+			compactedID, err := algorithmIRICompaction{
+				activeContext: vars.activeContext,
+				keyIRI:        valueID,
+				vocab:         true,
+			}.Call()
+			if err != nil {
+				return "", err
+			}
+
+			if termDefinition := vars.activeContext.TermDefinitions[compactedID]; termDefinition != nil && termDefinition.IRI != nil && termDefinition.IRI.String() == valueID {
+
+				// [spec // 6.2.2 // 4.16.1] If the result of IRI compacting the value of the `@id` entry in *value* has a term definition in the *active context* with an IRI mapping that equals the value of the `@id` entry in *value*, then append `@vocab`, `@id`, and `@none`, in that order, to *preferred values*.
+
+				preferredValues = append(preferredValues, "@vocab", "@id", "@none")
+			} else {
+
+				// [spec // 6.2.2 // 4.16.2] Otherwise, append `@id`, `@vocab`, and `@none`, in that order, to *preferred values*.
+
+				preferredValues = append(preferredValues, "@id", "@vocab", "@none")
+			}
+		} else {
+
+			// [spec // 6.2.2 // 4.17] Otherwise, append *type/language value* and `@none`, in that order, to *preferred values*. If *value* is a list object with an empty array as the value of `@list`, set *type/language* to `@any`.
+
+			preferredValues = append(preferredValues, typeLanguageValue, "@none")
+
+			if isBuiltinListObject(value) && len(builtinAsArray(valueMap["@list"])) == 0 {
+				typeLanguage = "@any"
+			}
+		}
+
+		// [spec // 6.2.2 // 4.18] Append `@any` to *preferred values*.
+
+		preferredValues = append(preferredValues, "@any")
+
+		// [spec // 6.2.2 // 4.19] If *preferred values* contains any entry having an underscore (`"_"`), append the substring of that entry from the underscore to the end of the string to *preferred values*.
+
+		if idx := slices.IndexFunc(preferredValues, func(v string) bool { return strings.Contains(v, "_") }); idx > -1 {
+			preferredValue := preferredValues[idx]
+			preferredValues = append(preferredValues, preferredValue[strings.Index(preferredValue, "_"):])
+		}
+
+		// [spec // 6.2.2 // 4.20] Initialize *term* to the result of the Term Selection algorithm, passing *var*, *containers*, *type/language*, and *preferred values*.
+		// [spec // 6.2.2 // 4.21] If *term* is not `null`, return *term*.
+
+		if term, ok := (algorithmTermSelection{
+			activeContext:   vars.activeContext,
+			keyIRI:          vars.keyIRI,
+			containers:      containers,
+			typeLanguage:    typeLanguage,
+			preferredValues: preferredValues,
+		}).Call(); ok {
+			return term, nil
+		}
+	}
+
+	// [spec // 6.2.2 // 5] At this point, there is no simple term that *var* can be compacted to. If *vocab* is `true` and *active context* has a vocabulary mapping:
+	// [spec // 6.2.2 // 5.1] If *var* begins with the vocabulary mapping's value and its length is greater than the length of the vocabulary mapping, set *suffix* to the substring of *var* that does not match. If *suffix* does not have a term definition in *active context*, then return *suffix*.
+
+	if vars.vocab && vars.activeContext.VocabularyMapping != nil {
+		if _, ok := vars.activeContext.VocabularyMapping.(ExpandedIRIasNil); !ok {
+			vocabularyMapping := vars.activeContext.VocabularyMapping.String()
+
+			if len(vars.keyIRI) > len(vocabularyMapping) && strings.HasPrefix(vars.keyIRI, vocabularyMapping) {
+				suffix := vars.keyIRI[len(vocabularyMapping):]
+
+				if _, ok := vars.activeContext.TermDefinitions[suffix]; !ok {
+					return suffix, nil
+				}
+			}
+		}
+	}
+
+	// [spec // 6.2.2 // 6] The *var* could not be compacted using the *active context*'s vocabulary mapping. Try to create a compact IRI, starting by initializing *compact IRI* to `null`. This variable will be used to store the created compact IRI, if any.
+
+	var compactIRI string
+
+	// [spec // 6.2.2 // 7] For each term definition *definition* in *active context*:
+
+	for term, definition := range vars.activeContext.TermDefinitions {
+
+		// [spec // 6.2.2 // 7.1] If the IRI mapping of *definition* is `null`, its IRI mapping equals *var*, its IRI mapping is not a substring at the beginning of *var*, or *definition* does not have a `true` prefix flag, *definition*'s key cannot be used as a prefix. Continue with the next *definition*.
+
+		if definition == nil || definition.IRI == nil || !definition.Prefix {
+			continue
+		} else if _, ok := definition.IRI.(ExpandedIRIasNil); ok {
+			continue
+		}
+
+		definitionIRI := definition.IRI.String()
+
+		if definitionIRI == vars.keyIRI || !strings.HasPrefix(vars.keyIRI, definitionIRI) {
+			continue
+		}
+
+		// [spec // 6.2.2 // 7.2] Initialize *candidate* by concatenating *definition* key, a colon (`:`), and the substring of *var* that follows after the value of the *definition*'s IRI mapping.
+
+		candidate := term + ":" + vars.keyIRI[len(definitionIRI):]
+
+		// [spec // 6.2.2 // 7.3] If either *compact IRI* is `null`, *candidate* is shorter or the same length but lexicographically less than *compact IRI* and *candidate* does not have a term definition in *active context*, or if that term definition has an IRI mapping that equals *var* and *value* is `null`, set *compact IRI* to *candidate*.
+
+		candidateDefinition, candidateDefined := vars.activeContext.TermDefinitions[candidate]
+
+		if candidateDefined && (vars.value != nil || candidateDefinition == nil || candidateDefinition.IRI == nil || candidateDefinition.IRI.String() != vars.keyIRI) {
+			continue
+		}
+
+		if len(compactIRI) == 0 || compareShortestLeast(candidate, compactIRI) < 0 {
+			compactIRI = candidate
+		}
+	}
+
+	// [spec // 6.2.2 // 8] If *compact IRI* is not `null`, return *compact IRI*.
+
+	if len(compactIRI) > 0 {
+		return compactIRI, nil
+	}
+
+	// [spec // 6.2.2 // 9] To ensure that the IRI *var* is not confused with a compact IRI, if the IRI scheme of *var* matches any term in *active context* with prefix flag set to `true`, and *var* has no IRI authority (preceded by double-forward-slash (`//`), an IRI confused with prefix error has been detected, and processing is aborted.
+
+	if scheme, rest, ok := strings.Cut(vars.keyIRI, ":"); ok && !strings.HasPrefix(rest, "//") {
+		if definition := vars.activeContext.TermDefinitions[scheme]; definition != nil && definition.Prefix {
+			return "", jsonldtype.Error{
+				Code: jsonldtype.IRIConfusedWithPrefix,
+				Err:  fmt.Errorf("iri scheme matches a prefix term: %s", vars.keyIRI),
+			}
+		}
+	}
+
+	// [spec // 6.2.2 // 10] If *vocab* is `false`, transform *var* to a relative IRI reference using the base IRI from *active context*, if it exists.
+
+	if !vars.vocab && vars.activeContext._processor.compactToRelative {
+		return relativizeIRI(vars.activeContext.BaseURL, vars.keyIRI), nil
+	}
+
+	// [spec // 6.2.2 // 11] Finally, return *var* as is.
+
+	return vars.keyIRI, nil
+}
+
+func ptrString(v string) *string {
+	return &v
+}
+
+//
+
+var reIRIReferenceComponents = regexp.MustCompile(`^(([^:/?#]+):)?(//([^/?#]*))?([^?#]*)(\?[^#]*)?(#.*)?$`)
+
+// relativizeIRI removes the scheme and authority of base from v, and then any leading path segments which are shared
+// with base. Unlike [iri.BaseIRI.RelativizeIRI], parent segments (`../`) are used when the paths diverge which is the
+// conventional form expected by JSON-LD processors.
+func relativizeIRI(base *iri.ParsedIRI, v string) string {
+	if base == nil {
+		return v
+	}
+
+	baseMatch := reIRIReferenceComponents.FindStringSubmatch(base.String())
+	if baseMatch == nil {
+		return v
+	}
+
+	root := baseMatch[1] + baseMatch[3]
+
+	if len(root) == 0 || !strings.HasPrefix(v, root) {
+		return v
+	}
+
+	rest := v[len(root):]
+
+	if len(rest) > 0 && !strings.ContainsRune("/?#", rune(rest[0])) {
+		// e.g. authority which only shares a prefix
+		return v
+	}
+
+	relMatch := reIRIReferenceComponents.FindStringSubmatch(rest)
+	if relMatch == nil {
+		return v
+	}
+
+	baseSegments := strings.Split(baseMatch[5], "/")
+	relSegments := strings.Split(relMatch[5], "/")
+
+	// the last segment is only removed when there is a query or fragment
+
+	lastSegments := 1
+
+	if len(relMatch[6]) > 0 || len(relMatch[7]) > 0 {
+		lastSegments = 0
+	}
+
+	for len(baseSegments) > 0 && len(relSegments) > lastSegments && baseSegments[0] == relSegments[0] {
+		baseSegments = baseSegments[1:]
+		relSegments = relSegments[1:]
+	}
+
+	var result strings.Builder
+
+	// the last segment of base is not a directory
+
+	for i := 0; i < len(baseSegments)-1; i++ {
+		result.WriteString("../")
+	}
+
+	relPath := strings.Join(relSegments, "/")
+
+	if result.Len() == 0 && strings.Contains(strings.SplitN(relPath, "/", 2)[0], ":") {
+		// avoid confusion with a scheme
+		result.WriteString("./")
+	}
+
+	result.WriteString(relPath)
+	result.WriteString(relMatch[6])
+	result.WriteString(relMatch[7])
+
+	if result.Len() == 0 {
+		return "./"
+	} else if reKeywordABNF.MatchString(result.String()) {
+		return "./" + result.String()
+	}
+
+	return result.String()
+}
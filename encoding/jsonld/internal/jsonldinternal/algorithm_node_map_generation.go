@@ -0,0 +1,390 @@
+package jsonldinternal
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+)
+
+// NodeMap is keyed by graph name (or `@default`), then by node identifier.
+type NodeMap map[string]map[string]map[string]any
+
+type algorithmNodeMapGeneration struct {
+	element any
+	nodeMap NodeMap
+
+	// Optional
+
+	// [spec] defaulting to `@default`
+	activeGraph string
+
+	// [spec] active subject, defaulting to `null`; a string identifier, or a map for reverse properties
+	activeSubject any
+
+	// [spec] active property, defaulting to `null`
+	activeProperty *string
+
+	// [spec] list, defaulting to `null`
+	list map[string]any
+
+	issuer *blankNodeIdentifierIssuer
+}
+
+func (vars algorithmNodeMapGeneration) Call() error {
+	if len(vars.activeGraph) == 0 {
+		vars.activeGraph = "@default"
+	}
+
+	// [spec // 7.2.2 // 1] If *element* is an array, process each *item* in *element* as follows and then return:
+	// [spec // 7.2.2 // 1.1] Run this algorithm recursively by passing *item* for *element*, *node map*, *active graph*, *active subject*, *active property*, and *list*.
+
+	if elementArray, ok := vars.element.([]any); ok {
+		for _, item := range elementArray {
+			nextVars := vars
+			nextVars.element = item
+
+			if err := nextVars.Call(); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	// [spec // 7.2.2 // 2] Otherwise *element* is a map. Reference the map which is the value of the *active graph* entry of *node map* using the variable *graph*. If the *active subject* is `null` or a map, set *subject node* to `null` otherwise reference the *active subject* entry of *graph* using the variable *subject node*.
+
+	element, ok := vars.element.(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	graph, ok := vars.nodeMap[vars.activeGraph]
+	if !ok {
+		graph = map[string]map[string]any{}
+		vars.nodeMap[vars.activeGraph] = graph
+	}
+
+	var subjectNode map[string]any
+
+	if activeSubjectString, ok := vars.activeSubject.(string); ok {
+		subjectNode = graph[activeSubjectString]
+	}
+
+	// [spec // 7.2.2 // 3] For each *item* in the `@type` entry of *element*, if any, or for the value of `@type`, if the value of `@type` exists and is not an array:
+	// [spec // 7.2.2 // 3.1] If *item* is a blank node identifier, replace it with a newly generated blank node identifier passing *item* for *identifier*.
+
+	if typeValue, ok := element["@type"]; ok {
+		if typeArray, ok := typeValue.([]any); ok {
+			for typeIdx, item := range typeArray {
+				if itemString, ok := item.(string); ok && strings.HasPrefix(itemString, "_:") {
+					typeArray[typeIdx] = vars.issuer.Issue(itemString)
+				}
+			}
+		} else if typeString, ok := typeValue.(string); ok && strings.HasPrefix(typeString, "_:") {
+			element["@type"] = vars.issuer.Issue(typeString)
+		}
+	}
+
+	if _, ok := element["@value"]; ok {
+
+		// [spec // 7.2.2 // 4] If *element* has an `@value` entry, perform the following steps:
+
+		if vars.list == nil {
+
+			// [spec // 7.2.2 // 4.1] If *list* is `null`:
+			// [spec // 7.2.2 // 4.1.1] If *subject node* does not have an *active property* entry, create one and initialize its value to an array containing *element*.
+			// [spec // 7.2.2 // 4.1.2] Otherwise, compare *element* against every item in the array associated with the *active property* entry of *subject node*. If there is no item equivalent to *element*, append *element* to the array. Two maps are considered equal if they have equivalent map entries.
+
+			if subjectNode != nil && vars.activeProperty != nil {
+				builtinAppendUnique(subjectNode, *vars.activeProperty, element)
+			}
+		} else {
+
+			// [spec // 7.2.2 // 4.2] Otherwise, append *element* to the `@list` entry of *list*.
+
+			vars.list["@list"] = append(vars.list["@list"].([]any), element)
+		}
+	} else if listValue, ok := element["@list"]; ok {
+
+		// [spec // 7.2.2 // 5] Otherwise, if *element* has an `@list` entry, perform the following steps:
+		// [spec // 7.2.2 // 5.1] Initialize a new map *result* consisting of a single entry `@list` whose value is initialized to an empty array.
+
+		result := map[string]any{
+			"@list": []any{},
+		}
+
+		// [spec // 7.2.2 // 5.2] Recursively call this algorithm passing the value of *element*'s `@list` entry for *element*, *node map*, *active graph*, *active subject*, *active property*, and *result* for *list*.
+
+		nextVars := vars
+		nextVars.element = listValue
+		nextVars.list = result
+
+		if err := nextVars.Call(); err != nil {
+			return err
+		}
+
+		if vars.list == nil {
+
+			// [spec // 7.2.2 // 5.3] If *list* is `null`, append *result* to the value of the *active property* entry of *subject node*.
+
+			if subjectNode != nil && vars.activeProperty != nil {
+				subjectNodeValues, _ := subjectNode[*vars.activeProperty].([]any)
+				subjectNode[*vars.activeProperty] = append(subjectNodeValues, result)
+			}
+		} else {
+
+			// [spec // 7.2.2 // 5.4] Otherwise, append *result* to the `@list` entry of *list*.
+
+			vars.list["@list"] = append(vars.list["@list"].([]any), result)
+		}
+	} else {
+
+		// [spec // 7.2.2 // 6] Otherwise *element* is a node object, perform the following steps:
+
+		var id string
+
+		if idValue, ok := element["@id"]; ok {
+
+			// [spec // 7.2.2 // 6.1] If *element* has an `@id` entry, set *id* to its value and remove the entry from *element*. If *id* is a blank node identifier, replace it with a newly generated blank node identifier passing *id* for *identifier*.
+
+			id, _ = idValue.(string)
+			delete(element, "@id")
+
+			if strings.HasPrefix(id, "_:") {
+				id = vars.issuer.Issue(id)
+			}
+		} else {
+
+			// [spec // 7.2.2 // 6.2] Otherwise, set *id* to the result of the Generate Blank Node Identifier algorithm passing `null` for *identifier*.
+
+			id = vars.issuer.Issue("")
+		}
+
+		// [spec // 7.2.2 // 6.3] If *graph* does not contain an entry *id*, create one and initialize its value to a map consisting of a single entry `@id` whose value is *id*.
+		// [spec // 7.2.2 // 6.4] Reference the value of the *id* entry of *graph* using the variable *node*.
+
+		node, ok := graph[id]
+		if !ok {
+			node = map[string]any{
+				"@id": id,
+			}
+			graph[id] = node
+		}
+
+		if activeSubjectMap, ok := vars.activeSubject.(map[string]any); ok {
+
+			// [spec // 7.2.2 // 6.5] If *active subject* is a map, a reverse property relationship is being processed. Perform the following steps:
+			// [spec // 7.2.2 // 6.5.1] If *node* does not have an *active property* entry, create one and initialize its value to an array containing *active subject*.
+			// [spec // 7.2.2 // 6.5.2] Otherwise, compare *active subject* against every item in the array associated with the *active property* entry of *node*. If there is no item equivalent to *active subject*, append *active subject* to the array. Two maps are considered equal if they have equivalent map entries.
+
+			builtinAppendUnique(node, *vars.activeProperty, activeSubjectMap)
+		} else if vars.activeProperty != nil {
+
+			// [spec // 7.2.2 // 6.6] Otherwise, if *active property* is not `null`, perform the following steps:
+			// [spec // 7.2.2 // 6.6.1] Create a new map *reference* consisting of a single entry `@id` whose value is *id*.
+
+			reference := map[string]any{
+				"@id": id,
+			}
+
+			if vars.list == nil {
+
+				// [spec // 7.2.2 // 6.6.2] If *list* is `null`:
+				// [spec // 7.2.2 // 6.6.2.1] If *subject node* does not have an *active property* entry, create one and initialize its value to an array containing *reference*.
+				// [spec // 7.2.2 // 6.6.2.2] Otherwise, compare *reference* against every item in the array associated with the *active property* entry of *subject node*. If there is no item equivalent to *reference*, append *reference* to the array. Two maps are considered equal if they have equivalent map entries.
+
+				if subjectNode != nil {
+					builtinAppendUnique(subjectNode, *vars.activeProperty, reference)
+				}
+			} else {
+
+				// [spec // 7.2.2 // 6.6.3] Otherwise, append *reference* to the `@list` entry of *list*.
+
+				vars.list["@list"] = append(vars.list["@list"].([]any), reference)
+			}
+		}
+
+		// [spec // 7.2.2 // 6.7] If *element* has an `@type` entry, append each item of its associated value to the array associated with the `@type` entry of *node* unless it is already in that array. Finally remove the `@type` entry from *element*.
+
+		if typeValue, ok := element["@type"]; ok {
+			for _, item := range builtinAsArray(typeValue) {
+				builtinAppendUnique(node, "@type", item)
+			}
+
+			delete(element, "@type")
+		}
+
+		// [spec // 7.2.2 // 6.8] If *element* has an `@index` entry, set the `@index` entry of *node* to its value. If *node* already has an `@index` entry with a different value, a `conflicting indexes` error has been detected and processing is aborted. Otherwise, continue by removing the `@index` entry from *element*.
+
+		if indexValue, ok := element["@index"]; ok {
+			if nodeIndexValue, ok := node["@index"]; ok && nodeIndexValue != indexValue {
+				return jsonldtype.Error{
+					Code: jsonldtype.ConflictingIndexes,
+					Err:  fmt.Errorf("node %s: %v, %v", id, nodeIndexValue, indexValue),
+				}
+			}
+
+			node["@index"] = indexValue
+
+			delete(element, "@index")
+		}
+
+		// [spec // 7.2.2 // 6.9] If *element* has an `@reverse` entry:
+
+		if reverseValue, ok := element["@reverse"]; ok {
+
+			// [spec // 7.2.2 // 6.9.1] Create a map *referenced node* with a single entry `@id` whose value is *id*.
+
+			referencedNode := map[string]any{
+				"@id": id,
+			}
+
+			// [spec // 7.2.2 // 6.9.2] Initialize *reverse map* to the value of the `@reverse` entry of *element*.
+
+			reverseMap, _ := reverseValue.(map[string]any)
+
+			// [spec // 7.2.2 // 6.9.3] For each key-value pair *property*-*values* in *reverse map*:
+
+			for _, property := range slices.Sorted(maps.Keys(reverseMap)) {
+
+				// [spec // 7.2.2 // 6.9.3.1] For each *value* of *values*:
+				// [spec // 7.2.2 // 6.9.3.1.1] Recursively invoke this algorithm passing *value* for *element*, *node map*, *active graph*, *referenced node* for *active subject*, and *property* for *active property*. Passing a map for *active subject* indicates to the algorithm that a reverse property relationship is being processed.
+
+				for _, value := range builtinAsArray(reverseMap[property]) {
+					err := algorithmNodeMapGeneration{
+						element:        value,
+						nodeMap:        vars.nodeMap,
+						activeGraph:    vars.activeGraph,
+						activeSubject:  referencedNode,
+						activeProperty: &property,
+						issuer:         vars.issuer,
+					}.Call()
+					if err != nil {
+						return err
+					}
+				}
+			}
+
+			// [spec // 7.2.2 // 6.9.4] Remove the `@reverse` entry from *element*.
+
+			delete(element, "@reverse")
+		}
+
+		// [spec // 7.2.2 // 6.10] If *element* has an `@graph` entry, recursively invoke this algorithm passing the value of the `@graph` entry for *element*, *node map*, and *id* for *active graph* before removing the `@graph` entry from *element*.
+
+		if graphValue, ok := element["@graph"]; ok {
+			if _, ok := vars.nodeMap[id]; !ok {
+				vars.nodeMap[id] = map[string]map[string]any{}
+			}
+
+			err := algorithmNodeMapGeneration{
+				element:     graphValue,
+				nodeMap:     vars.nodeMap,
+				activeGraph: id,
+				issuer:      vars.issuer,
+			}.Call()
+			if err != nil {
+				return err
+			}
+
+			delete(element, "@graph")
+		}
+
+		// [spec // 7.2.2 // 6.11] If *element* has an `@included` entry, recursively invoke this algorithm passing the value of the `@included` entry for *element*, *node map*, and *active graph* before removing the `@included` entry from *element*.
+
+		if includedValue, ok := element["@included"]; ok {
+			err := algorithmNodeMapGeneration{
+				element:     includedValue,
+				nodeMap:     vars.nodeMap,
+				activeGraph: vars.activeGraph,
+				issuer:      vars.issuer,
+			}.Call()
+			if err != nil {
+				return err
+			}
+
+			delete(element, "@included")
+		}
+
+		// [spec // 7.2.2 // 6.12] Finally, for each key-value pair *property*-*value* in *element* ordered by *property* perform the following steps:
+
+		for _, property := range slices.Sorted(maps.Keys(element)) {
+			value := element[property]
+
+			// [spec // 7.2.2 // 6.12.1] If *property* is a blank node identifier, replace it with a newly generated blank node identifier passing *property* for *identifier*.
+
+			if strings.HasPrefix(property, "_:") {
+				property = vars.issuer.Issue(property)
+			}
+
+			// [spec // 7.2.2 // 6.12.2] If *node* does not have a *property* entry, create one and initialize its value to an empty array.
+
+			if _, ok := node[property]; !ok {
+				node[property] = []any{}
+			}
+
+			// [spec // 7.2.2 // 6.12.3] Recursively invoke this algorithm passing *value* for *element*, *node map*, *active graph*, *id* for *active subject*, *property* for *active property*, and *list*.
+			// [dpb] list is not passed since property values are never list members
+
+			err := algorithmNodeMapGeneration{
+				element:        value,
+				nodeMap:        vars.nodeMap,
+				activeGraph:    vars.activeGraph,
+				activeSubject:  id,
+				activeProperty: &property,
+				issuer:         vars.issuer,
+			}.Call()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+//
+
+// blankNodeIdentifierIssuer implements the Generate Blank Node Identifier algorithm.
+type blankNodeIdentifierIssuer struct {
+	prefix  string
+	counter int
+	issued  map[string]string
+}
+
+func newBlankNodeIdentifierIssuer(prefix string) *blankNodeIdentifierIssuer {
+	return &blankNodeIdentifierIssuer{
+		prefix: prefix,
+		issued: map[string]string{},
+	}
+}
+
+func (b *blankNodeIdentifierIssuer) Issue(identifier string) string {
+
+	// [spec // 7.3.2 // 1] If *identifier* is not `null` and has an entry in the *identifier map*, return the mapped identifier.
+
+	if len(identifier) > 0 {
+		if issued, ok := b.issued[identifier]; ok {
+			return issued
+		}
+	}
+
+	// [spec // 7.3.2 // 2] Otherwise, generate a new blank node identifier by concatenating the string `_:b` and *counter*.
+	// [spec // 7.3.2 // 3] Increment *counter* by `1`.
+
+	issued := fmt.Sprintf("%s%d", b.prefix, b.counter)
+	b.counter++
+
+	// [spec // 7.3.2 // 4] If *identifier* is not `null`, create a new entry for *identifier* in *identifier map* and set its value to the new blank node identifier.
+
+	if len(identifier) > 0 {
+		b.issued[identifier] = issued
+	}
+
+	// [spec // 7.3.2 // 5] Return the new blank node identifier.
+
+	return issued
+}
@@ -0,0 +1,621 @@
+package jsonldinternal
+
+import (
+	"encoding/json"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+const i18nDatatypeBase = "https://www.w3.org/ns/i18n#"
+
+var (
+	reXSDInteger = regexp.MustCompile(`^[+-]?[0-9]+$`)
+	reXSDDouble  = regexp.MustCompile(`^[+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([Ee][+-]?[0-9]+)?$`)
+)
+
+type serializeRDFUsage struct {
+	node     map[string]any
+	property string
+	value    map[string]any
+}
+
+type algorithmSerializeRDFAsJSONLD struct {
+	dataset rdf.QuadList
+
+	// [spec] useNativeTypes flag, used to convert xsd:boolean, xsd:integer, and xsd:double literals to native JSON values
+	useNativeTypes bool
+
+	// [spec] useRdfType flag, used to keep rdf:type statements as properties rather than @type
+	useRdfType bool
+
+	// [spec] rdfDirection option, either i18n-datatype or compound-literal
+	rdfDirection string
+
+	processingMode string
+
+	// [dpb] blank node labels, without the _: prefix
+	bnStringProvider blanknodes.StringProvider
+
+	// [spec] ordered flag, used to order map entry keys lexicographically, where noted
+	// [dpb] graphs and nodes are always processed in order so that results are stable
+	// ordered bool
+}
+
+func (vars algorithmSerializeRDFAsJSONLD) Call() ([]any, error) {
+	bnStringProvider := vars.bnStringProvider
+	if bnStringProvider == nil {
+		bnStringProvider = blanknodes.NewInt64StringProvider("b%d")
+	}
+
+	termString := func(t rdf.Term) (string, bool) {
+		switch tt := t.(type) {
+		case rdf.IRI:
+			return string(tt), true
+		case rdf.BlankNode:
+			return "_:" + bnStringProvider.GetBlankNodeString(tt), true
+		}
+
+		return "", false
+	}
+
+	// [spec // 8.4.2 // 1] Initialize *default graph* to an empty map.
+
+	defaultGraph := map[string]map[string]any{}
+
+	// [spec // 8.4.2 // 2] Initialize *graph map* to a map consisting of a single entry `@default` whose value references *default graph*.
+
+	graphMap := map[string]map[string]map[string]any{
+		"@default": defaultGraph,
+	}
+
+	// [spec // 8.4.2 // 3] Initialize *referenced once* to an empty map.
+
+	referencedOnce := map[string]*serializeRDFUsage{}
+	referencedMultiple := map[string]struct{}{}
+
+	// [spec // 8.4.2 // 4] Initialize *compound literal subjects* to an empty map.
+
+	compoundLiteralSubjects := map[string]map[string]struct{}{}
+
+	// [dpb] usages of rdf:nil are tracked outside of the node objects
+
+	nilUsages := map[string][]*serializeRDFUsage{}
+
+	// [spec // 8.4.2 // 5] For each *graph* in *RDF dataset*:
+
+	for _, quad := range vars.dataset {
+
+		// [spec // 8.4.2 // 5.1] If *graph* is the default graph, set *name* to `@default`, otherwise to the graph name associated with *graph*.
+
+		name := "@default"
+
+		if quad.GraphName != nil {
+			graphName, ok := termString(quad.GraphName)
+			if !ok {
+				continue
+			}
+
+			name = graphName
+		}
+
+		// [spec // 8.4.2 // 5.2] If *graph map* has no *name* entry, create one and set its value to an empty map.
+		// [spec // 8.4.2 // 5.3] If *compound literal subjects* has no *name* entry, create one and set its value to an empty map.
+
+		nodeMap, ok := graphMap[name]
+		if !ok {
+			nodeMap = map[string]map[string]any{}
+			graphMap[name] = nodeMap
+		}
+
+		compoundMap, ok := compoundLiteralSubjects[name]
+		if !ok {
+			compoundMap = map[string]struct{}{}
+			compoundLiteralSubjects[name] = compoundMap
+		}
+
+		// [spec // 8.4.2 // 5.4] If *graph* is not the default graph and *default graph* does not have a *name* entry, create such an entry and initialize its value to a new map with a single entry `@id` whose value is *name*.
+
+		if name != "@default" {
+			if _, ok := defaultGraph[name]; !ok {
+				defaultGraph[name] = map[string]any{
+					"@id": name,
+				}
+			}
+		}
+
+		// [spec // 8.4.2 // 5.7] For each *triple* in *graph*:
+
+		subject, ok := termString(quad.Triple.Subject)
+		if !ok {
+			continue
+		}
+
+		predicate, ok := termString(quad.Triple.Predicate)
+		if !ok {
+			continue
+		}
+
+		object, objectIsNode := termString(quad.Triple.Object)
+		if !objectIsNode {
+			if _, ok := quad.Triple.Object.(rdf.Literal); !ok {
+				// [dpb] triple terms are not supported
+				continue
+			}
+		}
+
+		// [spec // 8.4.2 // 5.7.1] If *node map* does not have a *subject* entry, create one and initialize its value to a new map consisting of a single entry `@id` whose value is set to *subject*.
+		// [spec // 8.4.2 // 5.7.2] Initialize *node* to the value of the *subject* entry of *node map*.
+
+		node, ok := nodeMap[subject]
+		if !ok {
+			node = map[string]any{
+				"@id": subject,
+			}
+			nodeMap[subject] = node
+		}
+
+		// [spec // 8.4.2 // 5.7.3] If the `rdfDirection` option is `compound-literal` and *predicate* is `rdf:direction`, add an entry in *compound map* for *subject* with the value `true`.
+
+		if vars.rdfDirection == "compound-literal" && predicate == string(rdfiri.Direction_Property) {
+			compoundMap[subject] = struct{}{}
+		}
+
+		// [spec // 8.4.2 // 5.7.4] If *object* is an IRI or blank node identifier, and *node map* does not have an *object* entry, create one and initialize its value to a new map consisting of a single entry `@id` whose value is set to *object*.
+
+		if objectIsNode {
+			if _, ok := nodeMap[object]; !ok {
+				nodeMap[object] = map[string]any{
+					"@id": object,
+				}
+			}
+		}
+
+		// [spec // 8.4.2 // 5.7.5] If *predicate* equals `rdf:type`, the `useRdfType` flag is not `true`, and *object* is an IRI or blank node identifier, append *object* to the value of the `@type` entry of *node*; unless such an item already exists. If no such entry exists, create one and initialize it to an array whose only item is *object*. Finally, continue to the next RDF triple.
+
+		if predicate == string(rdfiri.Type_Property) && !vars.useRdfType && objectIsNode {
+			builtinAppendUnique(node, "@type", object)
+
+			continue
+		}
+
+		// [spec // 8.4.2 // 5.7.6] Initialize *value* to the result of using the RDF to Object Conversion algorithm, passing *object*, `rdfDirection`, and `useNativeTypes`.
+
+		var value map[string]any
+
+		if objectIsNode {
+			value = map[string]any{
+				"@id": object,
+			}
+		} else {
+			var err error
+
+			value, err = algorithmRDFToObjectConversion{
+				value:          quad.Triple.Object.(rdf.Literal),
+				rdfDirection:   vars.rdfDirection,
+				useNativeTypes: vars.useNativeTypes,
+				processingMode: vars.processingMode,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// [spec // 8.4.2 // 5.7.7] If *node* does not have a *predicate* entry, create one and initialize its value to an empty array.
+		// [spec // 8.4.2 // 5.7.8] If there is no item equivalent to *value* in the array associated with the *predicate* entry of *node*, append a reference to *value* to the array. Two maps are considered equal if they have equivalent map entries.
+
+		nodePredicate, _ := node[predicate].([]any)
+
+		var found bool
+
+		for _, item := range nodePredicate {
+			if reflect.DeepEqual(item, value) {
+				value = item.(map[string]any)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			node[predicate] = append(nodePredicate, value)
+		}
+
+		if !objectIsNode {
+			continue
+		}
+
+		usage := &serializeRDFUsage{
+			node:     node,
+			property: predicate,
+			value:    value,
+		}
+
+		if object == string(rdfiri.Nil_List) {
+
+			// [spec // 8.4.2 // 5.7.9] If *object* is `rdf:nil`, it represents the termination of an RDF collection:
+			// [spec // 8.4.2 // 5.7.9.1] Initialize *usages* to the value of the `usages` entry of the `rdf:nil` entry of *node map*. If that entry does not exist, create it and initialize it to an empty array.
+			// [spec // 8.4.2 // 5.7.9.2] Append a reference to a usage map to the array associated with the `usages` entry of *node map*.
+
+			nilUsages[name] = append(nilUsages[name], usage)
+		} else if _, ok := referencedOnce[object]; ok {
+
+			// [spec // 8.4.2 // 5.7.10] Otherwise, if *referenced once* has an entry for *object*, set the *object* entry of *referenced once* to `false`.
+
+			referencedOnce[object] = nil
+			referencedMultiple[object] = struct{}{}
+		} else if _, ok := referencedMultiple[object]; ok {
+			// already referenced multiple times
+		} else if strings.HasPrefix(object, "_:") {
+
+			// [spec // 8.4.2 // 5.7.11] Otherwise, if *object* is a blank node identifier, it might represent a list node:
+			// [spec // 8.4.2 // 5.7.11.1] Set the *object* entry of *referenced once* to a usage map.
+
+			referencedOnce[object] = usage
+		}
+	}
+
+	// [spec // 8.4.2 // 6] For each *name* and *graph object* in *graph map*:
+
+	for _, name := range slices.Sorted(maps.Keys(graphMap)) {
+		graphObject := graphMap[name]
+
+		// [spec // 8.4.2 // 6.1] If *compound literal subjects* has an entry for *name*, then for each *cl* which is a key in that entry:
+
+		for _, cl := range slices.Sorted(maps.Keys(compoundLiteralSubjects[name])) {
+
+			// [spec // 8.4.2 // 6.1.1] Initialize *cl entry* to the value of *cl* in *referenced once*, continuing to the next *cl* if *cl entry* is not a map.
+
+			clEntry := referencedOnce[cl]
+			if clEntry == nil {
+				continue
+			}
+
+			// [spec // 8.4.2 // 6.1.2] Initialize *node* to the value of `node` in *cl entry*.
+			// [spec // 8.4.2 // 6.1.3] Initialize *property* to the value of `property` in *cl entry*.
+			// [spec // 8.4.2 // 6.1.4] Initialize *value* to the value of `value` in *cl entry*.
+			// [spec // 8.4.2 // 6.1.5] Initialize *cl node* to the value of *cl* in *graph object*, and remove that entry from *graph object*, continuing to the next *cl* if *cl node* is not a map.
+
+			clNode, ok := graphObject[cl]
+			if !ok {
+				continue
+			}
+
+			delete(graphObject, cl)
+
+			// [spec // 8.4.2 // 6.1.6] For each map *cl reference* in the value of *property* in *node* where the value of `@id` in *cl reference* is *cl*:
+
+			clReferences, _ := clEntry.node[clEntry.property].([]any)
+
+			for _, clReferenceValue := range clReferences {
+				clReference, ok := clReferenceValue.(map[string]any)
+				if !ok || clReference["@id"] != cl {
+					continue
+				}
+
+				// [spec // 8.4.2 // 6.1.6.1] Delete the `@id` entry in *cl reference*.
+
+				delete(clReference, "@id")
+
+				// [spec // 8.4.2 // 6.1.6.2] Add an entry to *cl reference* for `@value` with the value taken from the `@value` entry of the first value of `rdf:value` in *cl node*.
+
+				if clValue, ok := serializeRDFFirstValue(clNode, string(rdfiri.Value_Property)); ok {
+					clReference["@value"] = clValue
+				}
+
+				// [spec // 8.4.2 // 6.1.6.3] Add an entry to *cl reference* for `@language` with the value taken from the `@value` entry of the first value of `rdf:language` in *cl node*, if any. If that value is not well-formed according to section 2.2.9 of [BCP47], an invalid language-tagged string error has been detected and processing is aborted.
+
+				if clLanguage, ok := serializeRDFFirstValue(clNode, string(rdfiri.Language_Property)); ok {
+					clLanguageString, ok := clLanguage.(string)
+					if !ok || strings.Contains(clLanguageString, " ") {
+						return nil, jsonldtype.Error{
+							Code: jsonldtype.InvalidLanguageTaggedString,
+						}
+					}
+
+					clReference["@language"] = clLanguageString
+				}
+
+				// [spec // 8.4.2 // 6.1.6.4] Add an entry to *cl reference* for `@direction` with the value taken from the `@value` entry of the first value of `rdf:direction` in *cl node*, if any. If that value is not "ltr" or "rtl", an invalid base direction error has been detected and processing is aborted.
+
+				if clDirection, ok := serializeRDFFirstValue(clNode, string(rdfiri.Direction_Property)); ok {
+					if clDirection != "ltr" && clDirection != "rtl" {
+						return nil, jsonldtype.Error{
+							Code: jsonldtype.InvalidBaseDirection,
+						}
+					}
+
+					clReference["@direction"] = clDirection
+				}
+			}
+		}
+
+		// [spec // 8.4.2 // 6.2] If *graph object* has no `rdf:nil` entry, continue with the next *name*-*graph object* pair as the graph does not contain any lists that need to be converted.
+		// [spec // 8.4.2 // 6.3] Initialize *nil* to the value of the `rdf:nil` entry of *graph object*.
+		// [spec // 8.4.2 // 6.4] For each item *usage* in the `usages` entry of *nil*, perform the following steps:
+
+		for _, usage := range nilUsages[name] {
+
+			// [spec // 8.4.2 // 6.4.1] Initialize *node* to the value of the value of the `node` entry of *usage*, *property* to the value of the `property` entry of *usage*, and *head* to the value of the `value` entry of *usage*.
+
+			node := usage.node
+			property := usage.property
+			head := usage.value
+
+			// [spec // 8.4.2 // 6.4.2] Initialize two empty arrays *list* and *list nodes*.
+
+			var list []any
+			var listNodes []string
+
+			// [spec // 8.4.2 // 6.4.3] While *property* equals `rdf:rest`, the value of the `@id` entry of *node* is a blank node identifier, the value of the entry of *referenced once* associated with the `@id` entry of *node* is a map, *node* has `rdf:first` and `rdf:rest` entries, both of which have as value an array consisting of a single element, and *node* has no other entries apart from an optional `@type` entry whose value is an array with a single item equal to `rdf:List`, *node* represents a well-formed list node. Perform the following steps to traverse the list backwards towards its head:
+
+			for property == string(rdfiri.Rest_Property) && isSerializeRDFListNode(node, referencedOnce) {
+				nodeID := node["@id"].(string)
+
+				// [spec // 8.4.2 // 6.4.3.1] Append the only item of `rdf:first` entry of *node* to the *list* array.
+				// [spec // 8.4.2 // 6.4.3.2] Append the value of the `@id` entry of *node* to the *list nodes* array.
+
+				list = append(list, node[string(rdfiri.First_Property)].([]any)[0])
+				listNodes = append(listNodes, nodeID)
+
+				// [spec // 8.4.2 // 6.4.3.3] Initialize *node usage* to the value of the entry of *referenced once* associated with the `@id` entry of *node*.
+				// [spec // 8.4.2 // 6.4.3.4] Set *node* to the value of the `node` entry of *node usage*, *property* to the value of the `property` entry of *node usage*, and *head* to the value of the `value` entry of *node usage*.
+
+				nodeUsage := referencedOnce[nodeID]
+				node = nodeUsage.node
+				property = nodeUsage.property
+				head = nodeUsage.value
+
+				// [spec // 8.4.2 // 6.4.3.5] If the `@id` entry of *node* is an IRI instead of a blank node identifier, exit the while loop.
+
+				if nodeID, _ := node["@id"].(string); !strings.HasPrefix(nodeID, "_:") {
+					break
+				}
+			}
+
+			// [spec // 8.4.2 // 6.4.4] Remove the `@id` entry from *head*.
+
+			delete(head, "@id")
+
+			// [spec // 8.4.2 // 6.4.5] Reverse the order of the *list* array.
+
+			slices.Reverse(list)
+
+			// [spec // 8.4.2 // 6.4.6] Add an `@list` entry to *head* and initialize its value to the *list* array.
+
+			if list == nil {
+				list = []any{}
+			}
+
+			head["@list"] = list
+
+			// [spec // 8.4.2 // 6.4.7] For each item *node id* in *list nodes*, remove the *node id* entry from *graph object*.
+
+			for _, nodeID := range listNodes {
+				delete(graphObject, nodeID)
+			}
+		}
+	}
+
+	// [spec // 8.4.2 // 7] Initialize an empty array *result*.
+
+	result := []any{}
+
+	// [spec // 8.4.2 // 8] For each *subject* and *node* in *default graph* ordered by *subject*:
+
+	for _, subject := range slices.Sorted(maps.Keys(defaultGraph)) {
+		node := defaultGraph[subject]
+
+		// [spec // 8.4.2 // 8.1] If *graph map* has a *subject* entry:
+
+		if graphObject, ok := graphMap[subject]; ok && subject != "@default" {
+
+			// [spec // 8.4.2 // 8.1.1] Add an `@graph` entry to *node* and initialize its value to an empty array.
+			// [spec // 8.4.2 // 8.1.2] For each key-value pair *s*-*n* in the *subject* entry of *graph map* ordered by *s*, append *n* to the `@graph` entry of *node* after removing its `usages` entry, unless the only remaining entry of *n* is `@id`.
+
+			node["@graph"] = flattenGraphNodes(graphObject)
+		}
+
+		// [spec // 8.4.2 // 8.2] Append *node* to *result*, unless the only remaining entry of *node* is `@id`.
+
+		if _, ok := node["@id"]; ok && len(node) == 1 {
+			continue
+		}
+
+		result = append(result, node)
+	}
+
+	// [spec // 8.4.2 // 9] Return *result*.
+
+	return result, nil
+}
+
+func isSerializeRDFListNode(node map[string]any, referencedOnce map[string]*serializeRDFUsage) bool {
+	nodeID, _ := node["@id"].(string)
+	if !strings.HasPrefix(nodeID, "_:") || referencedOnce[nodeID] == nil {
+		return false
+	}
+
+	first, _ := node[string(rdfiri.First_Property)].([]any)
+	rest, _ := node[string(rdfiri.Rest_Property)].([]any)
+
+	if len(first) != 1 || len(rest) != 1 {
+		return false
+	}
+
+	switch len(node) {
+	case 3:
+		return true
+	case 4:
+		nodeType, _ := node["@type"].([]any)
+
+		return len(nodeType) == 1 && nodeType[0] == string(rdfiri.List_Class)
+	}
+
+	return false
+}
+
+func serializeRDFFirstValue(node map[string]any, property string) (any, bool) {
+	values, _ := node[property].([]any)
+	if len(values) == 0 {
+		return nil, false
+	}
+
+	valueObject, ok := values[0].(map[string]any)
+	if !ok {
+		return nil, false
+	}
+
+	value, ok := valueObject["@value"]
+
+	return value, ok
+}
+
+//
+
+type algorithmRDFToObjectConversion struct {
+	value          rdf.Literal
+	rdfDirection   string
+	useNativeTypes bool
+	processingMode string
+}
+
+func (vars algorithmRDFToObjectConversion) Call() (map[string]any, error) {
+
+	// [spec // 8.5.2 // 1] If *value* is an IRI or a blank node identifier, return a new map consisting of a single entry `@id` whose value is set to *value*.
+	// [dpb] handled by the caller
+
+	// [spec // 8.5.2 // 2.1] Initialize a new empty map *result*.
+
+	result := map[string]any{}
+
+	// [spec // 8.5.2 // 2.2] Initialize *converted value* to *value*.
+
+	var convertedValue any = vars.value.LexicalForm
+
+	// [spec // 8.5.2 // 2.3] Initialize *type* to `null`
+
+	var typeValue *string
+
+	datatype := string(vars.value.Datatype)
+
+	if vars.useNativeTypes && (datatype == string(xsdiri.String_Datatype) || datatype == string(xsdiri.Boolean_Datatype) || datatype == string(xsdiri.Integer_Datatype) || datatype == string(xsdiri.Double_Datatype)) {
+
+		// [spec // 8.5.2 // 2.4] If `useNativeTypes` is `true`:
+
+		switch datatype {
+		case string(xsdiri.String_Datatype):
+
+			// [spec // 8.5.2 // 2.4.1] If the datatype IRI of *value* equals `xsd:string`, set *converted value* to the lexical form of *value*.
+
+		case string(xsdiri.Boolean_Datatype):
+
+			// [spec // 8.5.2 // 2.4.2] Otherwise, if the datatype IRI of *value* equals `xsd:boolean`, set *converted value* to `true` if the lexical form of *value* matches `true`, or `false` if the lexical form of *value* matches `false`. If it matches neither, set *type* to `xsd:boolean`.
+
+			switch vars.value.LexicalForm {
+			case "true":
+				convertedValue = true
+			case "false":
+				convertedValue = false
+			default:
+				typeValue = &datatype
+			}
+		default:
+
+			// [spec // 8.5.2 // 2.4.3] Otherwise, if the lexical form of *value* is a valid `xsd:integer` or `xsd:double` according its datatype IRI, set *converted value* to the result of converting the lexical form to a JSON number.
+			// [spec // 8.5.2 // 2.4.4] Otherwise, if the datatype IRI of *value* equals `xsd:integer` or `xsd:double`, set *type* to the datatype IRI of *value*.
+
+			var valid bool
+
+			if datatype == string(xsdiri.Integer_Datatype) {
+				valid = reXSDInteger.MatchString(vars.value.LexicalForm)
+			} else {
+				valid = reXSDDouble.MatchString(vars.value.LexicalForm)
+			}
+
+			if valid {
+				if v, err := strconv.ParseFloat(vars.value.LexicalForm, 64); err == nil {
+					convertedValue = v
+				} else {
+					valid = false
+				}
+			}
+
+			if !valid {
+				typeValue = &datatype
+			}
+		}
+	} else if vars.processingMode != ProcessingMode_JSON_LD_1_0 && datatype == string(rdfiri.JSON_Datatype) {
+
+		// [spec // 8.5.2 // 2.5] Otherwise, if processing mode is not `json-ld-1.0`, and *value* is a JSON literal, set *converted value* to the result of turning the lexical value of *value* into the JSON-LD internal representation, and set *type* to `@json`. If the lexical value of *value* is not valid JSON according to the JSON Grammar [RFC8259], an invalid JSON literal error has been detected and processing is aborted.
+
+		var parsed any
+
+		err := json.Unmarshal([]byte(vars.value.LexicalForm), &parsed)
+		if err != nil {
+			return nil, jsonldtype.Error{
+				Code: jsonldtype.InvalidJSONLiteral,
+				Err:  err,
+			}
+		}
+
+		convertedValue = parsed
+		typeValue = ptrString("@json")
+	} else if i18nTag, ok := strings.CutPrefix(datatype, i18nDatatypeBase); ok && vars.rdfDirection == "i18n-datatype" {
+
+		// [spec // 8.5.2 // 2.6] Otherwise, if the datatype IRI of *value* starts with `https://www.w3.org/ns/i18n#`, and `rdfDirection` is `i18n-datatype`:
+		// [spec // 8.5.2 // 2.6.1] Set *converted value* to the lexical form of *value*.
+		// [spec // 8.5.2 // 2.6.2] If the string prefix of the fragment identifier of the datatype IRI up until the underscore (`_`) is not empty, add an entry `@language` to *result* and set its value to that prefix.
+		// [spec // 8.5.2 // 2.6.3] Add an entry `@direction` to *result* and set its value to the substring of the fragment identifier following the underscore (`_`).
+
+		language, direction, _ := strings.Cut(i18nTag, "_")
+
+		if len(language) > 0 {
+			result["@language"] = language
+		}
+
+		result["@direction"] = direction
+	} else if tag, ok := vars.value.Tag.(rdf.LanguageLiteralTag); ok {
+
+		// [spec // 8.5.2 // 2.7] Otherwise, if *value* is a language-tagged string add an entry `@language` to *result* and set its value to the language tag of *value*.
+
+		result["@language"] = tag.Language
+	} else if tag, ok := vars.value.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+
+		// [dpb] directional language-tagged strings from RDF 1.2
+
+		if len(tag.Language) > 0 {
+			result["@language"] = tag.Language
+		}
+
+		result["@direction"] = tag.BaseDirection
+	} else if datatype != string(xsdiri.String_Datatype) && len(datatype) > 0 {
+
+		// [spec // 8.5.2 // 2.8] Otherwise, set *type* to the datatype IRI of *value*, unless it equals `xsd:string` which is ignored.
+
+		typeValue = &datatype
+	}
+
+	// [spec // 8.5.2 // 2.9] Add an entry `@value` to *result* whose value is set to *converted value*.
+
+	result["@value"] = convertedValue
+
+	// [spec // 8.5.2 // 2.10] If *type* is not `null`, add an entry `@type` to *result* whose value is set to *type*.
+
+	if typeValue != nil {
+		result["@type"] = *typeValue
+	}
+
+	// [spec // 8.5.2 // 2.11] Return *result*.
+
+	return result, nil
+}
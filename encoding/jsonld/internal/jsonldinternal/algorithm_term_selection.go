@@ -0,0 +1,54 @@
+package jsonldinternal
+
+type algorithmTermSelection struct {
+	activeContext   *Context
+	keyIRI          string
+	containers      []string
+	typeLanguage    string
+	preferredValues []string
+}
+
+func (vars algorithmTermSelection) Call() (string, bool) {
+
+	// [spec // 4.4.2 // 1] If the *active context* has a `null` inverse context, set inverse context in *active context* to the result of calling the Inverse Context Creation algorithm using *active context*.
+	// [spec // 4.4.2 // 2] Initialize *inverse context* to the value of inverse context in *active context*.
+
+	inverseContext := vars.activeContext.getInverseContext()
+
+	// [spec // 4.4.2 // 3] Initialize *container map* to the value associated with *var* in the *inverse context*.
+
+	containerMap := inverseContext[vars.keyIRI]
+
+	// [spec // 4.4.2 // 4] For each item *container* in *containers*:
+
+	for _, container := range vars.containers {
+
+		// [spec // 4.4.2 // 4.1] If *container* is not an entry of *container map*, then there is no term with a matching container mapping for it, so continue to the next *container*.
+
+		typeLanguageMap, ok := containerMap[container]
+		if !ok {
+			continue
+		}
+
+		// [spec // 4.4.2 // 4.2] Initialize *type/language map* to the value associated with the *container* entry in *container map*.
+		// [spec // 4.4.2 // 4.3] Initialize *value map* to the value associated with *type/language* entry in *type/language map*.
+
+		valueMap := typeLanguageMap[vars.typeLanguage]
+
+		// [spec // 4.4.2 // 4.4] For each *item* in *preferred values*:
+
+		for _, item := range vars.preferredValues {
+
+			// [spec // 4.4.2 // 4.4.1] If *item* is not an entry of *value map*, then there is no term with a matching type mapping or language mapping, so continue to the next *item*.
+			// [spec // 4.4.2 // 4.4.2] Otherwise, a matching term has been found, return the value associated with the *item* entry in *value map*.
+
+			if term, ok := valueMap[item]; ok {
+				return term, true
+			}
+		}
+	}
+
+	// [spec // 4.4.2 // 5] No matching term has been found. Return `null`.
+
+	return "", false
+}
@@ -0,0 +1,162 @@
+package jsonldinternal
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+)
+
+type algorithmValueCompaction struct {
+	activeContext  *Context
+	activeProperty *string
+	value          map[string]any
+}
+
+func (vars algorithmValueCompaction) Call() (any, error) {
+	var activePropertyTermDefinition *TermDefinition
+
+	if vars.activeProperty != nil {
+		activePropertyTermDefinition = vars.activeContext.TermDefinitions[*vars.activeProperty]
+	}
+
+	if activePropertyTermDefinition == nil {
+		activePropertyTermDefinition = &TermDefinition{}
+	}
+
+	var typeMapping string
+
+	if activePropertyTermDefinition.TypeMapping != nil {
+		typeMapping = activePropertyTermDefinition.TypeMapping.String()
+	}
+
+	// [spec // 6.3.2 // 1] Initialize *result* to a copy of *value*.
+
+	var result any = vars.value
+
+	// [spec // 6.3.2 // 2] If the *active context* has a `null` inverse context, set inverse context in *active context* to the result of calling the Inverse Context Creation algorithm using *active context*.
+	// [spec // 6.3.2 // 3] Initialize *inverse context* to the value of inverse context in *active context*.
+	// [dpb] only used indirectly through IRI compaction
+
+	// [spec // 6.3.2 // 4] Initialize *language* to the language mapping for *active property* in *active context*, if any, otherwise to the default language of *active context*.
+
+	var language *string
+
+	if activePropertyTermDefinition.LanguageMappingValue != nil {
+		if languageString, ok := activePropertyTermDefinition.LanguageMappingValue.(inspectjson.StringValue); ok {
+			language = &languageString.Value
+		}
+	} else if vars.activeContext.DefaultLanguageValue != nil {
+		language = &vars.activeContext.DefaultLanguageValue.Value
+	}
+
+	// [spec // 6.3.2 // 5] Initialize *direction* to the direction mapping for *active property* in *active context*, if any, otherwise to the default base direction of *active context*.
+
+	var direction *string
+
+	if activePropertyTermDefinition.DirectionMappingValue != nil {
+		if directionString, ok := activePropertyTermDefinition.DirectionMappingValue.(inspectjson.StringValue); ok {
+			direction = &directionString.Value
+		}
+	} else if vars.activeContext.DefaultDirectionValue != nil {
+		direction = &vars.activeContext.DefaultDirectionValue.Value
+	}
+
+	_, valueHasIndex := vars.value["@index"]
+	valueType, valueHasType := vars.value["@type"]
+	valueValue, valueHasValue := vars.value["@value"]
+
+	// [dpb] value objects with an @index are only compacted when it is dropped by an @index container
+
+	indexCompatible := !valueHasIndex || slices.Contains(activePropertyTermDefinition.ContainerMapping, "@index")
+
+	if valueID, ok := vars.value["@id"].(string); ok && (len(vars.value) == 1 || len(vars.value) == 2 && valueHasIndex) {
+
+		// [spec // 6.3.2 // 6] If *value* has an `@id` entry and has no other entries other than `@index`:
+
+		if typeMapping == "@id" {
+
+			// [spec // 6.3.2 // 6.1] If the type mapping of *active property* is set to `@id`, set *result* to the result of IRI compacting the value associated with the `@id` entry using `false` for *vocab*.
+
+			compacted, err := algorithmIRICompaction{
+				activeContext: vars.activeContext,
+				keyIRI:        valueID,
+				vocab:         false,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			result = compacted
+		} else if typeMapping == "@vocab" {
+
+			// [spec // 6.3.2 // 6.2] Otherwise, if the type mapping of *active property* is set to `@vocab`, set *result* to the result of IRI compacting the value associated with the `@id` entry.
+
+			compacted, err := algorithmIRICompaction{
+				activeContext: vars.activeContext,
+				keyIRI:        valueID,
+				vocab:         true,
+			}.Call()
+			if err != nil {
+				return nil, err
+			}
+
+			result = compacted
+		}
+	} else if valueTypeString, ok := valueType.(string); valueHasType && ok && valueTypeString == typeMapping && valueHasValue {
+
+		// [spec // 6.3.2 // 7] Otherwise, if *value* has an `@type` entry whose value matches the type mapping of *active property*, set *result* to the value associated with the `@value` entry of *value*.
+		// [dpb] the @index must still be dropped by the container for the result to be lossless
+
+		if indexCompatible {
+			result = valueValue
+		}
+	} else if typeMapping == "@none" || valueHasType {
+
+		// [spec // 6.3.2 // 8] Otherwise, if the type mapping of *active property* is `@none`, or *value* has an `@type` entry, and the value of `@type` in *value* does not match the type mapping of *active property*, leave *value* as is, as value compaction is disabled.
+		// [spec // 6.3.2 // 8.1] Replace any value of `@type` in *result* with the result of IRI compacting the value of the `@type` entry.
+		// [dpb] handled by the Compaction algorithm which continues with the map
+
+	} else if _, ok := valueValue.(string); valueHasValue && !ok {
+
+		// [spec // 6.3.2 // 9] Otherwise, if the value of the `@value` entry in *value* is not a string:
+		// [spec // 6.3.2 // 9.1] If *value* has an `@index` entry, and the container mapping associated to *active property* includes `@index`, or if *value* has no `@index` entry, set *result* to the value associated with the `@value` entry.
+
+		if indexCompatible {
+			result = valueValue
+		}
+	} else if valueHasValue {
+
+		// [spec // 6.3.2 // 10] Otherwise, if *value* has an `@language` entry whose value exactly matches *language*, using a case-insensitive comparison if it is not `null`, or is not present, if *language* is `null`, and the *value* has a `@direction` entry whose value exactly matches *direction*, if it is not `null`, or is not present, if *direction* is `null`:
+
+		valueLanguage, valueHasLanguage := vars.value["@language"].(string)
+		valueDirection, valueHasDirection := vars.value["@direction"].(string)
+
+		var languageMatches, directionMatches bool
+
+		if language != nil {
+			languageMatches = valueHasLanguage && strings.EqualFold(valueLanguage, *language)
+		} else {
+			languageMatches = !valueHasLanguage
+		}
+
+		if direction != nil {
+			directionMatches = valueHasDirection && valueDirection == *direction
+		} else {
+			directionMatches = !valueHasDirection
+		}
+
+		// [spec // 6.3.2 // 10.1] If *value* has an `@index` entry, and the container mapping associated to *active property* includes `@index`, or *value* has no `@index` entry, set *result* to the value associated with the `@value` entry.
+
+		if languageMatches && directionMatches && indexCompatible {
+			result = valueValue
+		}
+	}
+
+	// [spec // 6.3.2 // 11] If *result* is a map, replace each key in *result* with the result of IRI compacting that key.
+	// [dpb] handled by the Compaction algorithm which continues with the map
+
+	// [spec // 6.3.2 // 12] Return *result*.
+
+	return result, nil
+}
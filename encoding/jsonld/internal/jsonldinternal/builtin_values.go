@@ -0,0 +1,159 @@
+package jsonldinternal
+
+import "reflect"
+
+// [dpb] compaction, flattening, and RDF serialization operate on the builtin representation of expanded values (see
+// [ExpandedValue.AsBuiltin]); maps are `map[string]any` and arrays are `[]any`.
+
+func builtinAsArray(v any) []any {
+	if vArray, ok := v.([]any); ok {
+		return vArray
+	}
+
+	return []any{v}
+}
+
+func isBuiltinScalar(v any) bool {
+	switch v.(type) {
+	case string, float64, bool:
+		return true
+	}
+
+	return false
+}
+
+func isBuiltinValueObject(v any) bool {
+	vMap, ok := v.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	_, ok = vMap["@value"]
+
+	return ok
+}
+
+func isBuiltinListObject(v any) bool {
+	vMap, ok := v.(map[string]any)
+	if !ok {
+		return false
+	}
+
+	_, ok = vMap["@list"]
+
+	return ok
+}
+
+// [spec // 3.1] A graph object represents a named graph as the value of a map entry within a node object. When expanded, a graph object *MUST* have an `@graph` entry, and may also have `@id`, and `@index` entries.
+
+func isBuiltinGraphObject(v any) bool {
+	vMap, ok := v.(map[string]any)
+	if !ok {
+		return false
+	} else if _, ok := vMap["@graph"]; !ok {
+		return false
+	}
+
+	for k := range vMap {
+		switch k {
+		case "@graph", "@id", "@index":
+			// valid
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// [spec // 3.1] A simple graph object is a graph object which does not have an `@id` entry.
+
+func isBuiltinSimpleGraphObject(v any) bool {
+	if !isBuiltinGraphObject(v) {
+		return false
+	}
+
+	_, ok := v.(map[string]any)["@id"]
+
+	return !ok
+}
+
+// builtinAddValue is the builtin equivalent of [macroAddValue].
+func builtinAddValue(object map[string]any, key string, value any, asArray bool) {
+
+	// [spec // 1] If *as array* is `true` and the value of *key* in *object* does not exist or is not an array, set it to a new array containing any original value.
+
+	if asArray {
+		if originalValue, ok := object[key]; !ok {
+			object[key] = []any{}
+		} else if _, ok := originalValue.([]any); !ok {
+			object[key] = []any{originalValue}
+		}
+	}
+
+	// [spec // 2] If *value* is an array, then for each element *v* in *value*, use add value recursively to add *v* to *key* in *entry*.
+
+	if valueArray, ok := value.([]any); ok {
+		for _, v := range valueArray {
+			builtinAddValue(object, key, v, asArray)
+		}
+
+		return
+	}
+
+	// [spec // 3.1] If *key* is not an entry in *object*, add *value* as the value of *key* in *object*.
+
+	originalValue, ok := object[key]
+	if !ok {
+		object[key] = value
+
+		return
+	}
+
+	// [spec // 3.2.1] If the *value* of the *key* entry in *object* is not an array, set it to a new array containing the original value.
+	// [spec // 3.2.2] Append *value* to the value of the *key* entry in *object*.
+
+	originalArray, ok := originalValue.([]any)
+	if !ok {
+		originalArray = []any{originalValue}
+	}
+
+	object[key] = append(originalArray, value)
+}
+
+// builtinAppendUnique appends value to the array of key in object unless an equivalent value is already present.
+func builtinAppendUnique(object map[string]any, key string, value any) {
+	existing, _ := object[key].([]any)
+
+	for _, v := range existing {
+		if reflect.DeepEqual(v, value) {
+			return
+		}
+	}
+
+	object[key] = append(existing, value)
+}
+
+// builtinClone returns a deep copy of maps and arrays within v.
+func builtinClone(v any) any {
+	switch vT := v.(type) {
+	case map[string]any:
+		clone := make(map[string]any, len(vT))
+
+		for k, kv := range vT {
+			clone[k] = builtinClone(kv)
+		}
+
+		return clone
+	case []any:
+		clone := make([]any, len(vT))
+
+		for i, iv := range vT {
+			clone[i] = builtinClone(iv)
+		}
+
+		return clone
+	}
+
+	return v
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -21,6 +22,7 @@ func main() {
 	}
 
 	expanded, err := jsonldinternal.Expand(
+		context.Background(),
 		parsed,
 		jsonldtype.ProcessorOptions{
 			BaseURL:        "https://stdin.local/",
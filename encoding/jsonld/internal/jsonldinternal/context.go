@@ -17,7 +17,8 @@ type Context struct {
 	OriginalBaseURL *iri.ParsedIRI
 
 	// [4.1] an inverse context (inverse context),
-	InverseContext *Context
+	// [dpb] lazily created by compaction; see [Context.getInverseContext]
+	InverseContext InverseContext
 
 	// [4.1] an optional vocabulary mapping (IRI),
 	VocabularyMapping      ExpandedIRI
@@ -0,0 +1,91 @@
+package jsonldinternal
+
+import (
+	"context"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/iri"
+)
+
+// GraphExpander expands the items of a top-level `@graph` entry independently of each other, which supports streaming
+// documents following the JSON-LD 1.1 streaming profile.
+//
+// [dpb] this is only equivalent to [Expand] when the top-level map contains nothing other than `@context` and `@graph`
+// entries; otherwise the expanded output would not be unwrapped from its `@graph` entry. Callers are responsible for
+// verifying that.
+type GraphExpander struct {
+	activeContext *Context
+	baseURL       *iri.ParsedIRI
+}
+
+// NewGraphExpander processes the `@context` entry of the top-level map, if any, for expanding the `@graph` items.
+func NewGraphExpander(ctx context.Context, localContext inspectjson.Value, opts jsonldtype.ProcessorOptions) (*GraphExpander, error) {
+	activeContext, err := newActiveContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseIRI := activeContext.BaseURL
+
+	if opts.ExpandContext != nil {
+		activeContext, err = processLocalContext(activeContext, opts.ExpandContext)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// [spec // 5.1.2 // 9] If *element* contains the entry `@context`, set *active context* to the result of the Context Processing algorithm, passing *active context*, the value of the `@context` entry as *local context* and *base URL*.
+
+	if localContext != nil {
+		activeContext, err = algorithmContextProcessing{
+			ActiveContext: activeContext,
+			LocalContext:  localContext,
+			BaseURL:       baseIRI,
+			// defaults
+			RemoteContexts:        nil,
+			OverrideProtected:     false,
+			Propagate:             true,
+			ValidateScopedContext: true,
+		}.Call()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &GraphExpander{
+		activeContext: activeContext,
+		baseURL:       baseIRI,
+	}, nil
+}
+
+// Expand expands a single item of the `@graph` entry. The result is always an array, which may be empty if the item
+// was dropped.
+func (e *GraphExpander) Expand(item inspectjson.Value) (*ExpandedArray, error) {
+
+	// [spec // 5.1.2 // 13.4.5] If expanded property is @graph, set expanded value to the result of using this algorithm recursively passing active context, @graph for active property, value for element, base URL, and the frameExpansion and ordered flags, ensuring that expanded value is an array of one or more maps.
+
+	activeProperty := "@graph"
+
+	expandedValue, err := algorithmExpansion{
+		activeContext:  e.activeContext,
+		activeProperty: &activeProperty,
+		element:        item,
+		baseURL:        e.baseURL,
+		ordered:        true,
+	}.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	switch expandedValueT := expandedValue.(type) {
+	case nil:
+		return &ExpandedArray{}, nil
+	case *ExpandedArray:
+		return expandedValueT, nil
+	}
+
+	return &ExpandedArray{
+		Values: []ExpandedValue{expandedValue},
+	}, nil
+}
@@ -24,6 +24,14 @@ var definedKeywords = map[string]struct{}{
 	"@value":     {},
 	"@version":   {},
 	"@vocab":     {},
+
+	// [dpb] framing keywords; see https://www.w3.org/TR/json-ld11-framing/#syntax-tokens-and-keywords
+	"@default":     {},
+	"@embed":       {},
+	"@explicit":    {},
+	"@omitDefault": {},
+	"@preserve":    {},
+	"@requireAll":  {},
 }
 
 func IsKeyword(k string) bool {
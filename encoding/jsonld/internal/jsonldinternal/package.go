@@ -4,11 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/dpb587/inspectjson-go/inspectjson"
 	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
 	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
 )
 
 const MagicKeywordPropertySourceOffsets = "@rdfkit.property.sourceOffsets"
@@ -48,9 +53,7 @@ var (
 
 var reKeywordABNF = regexp.MustCompile(`^@[a-zA-Z]+$`)
 
-func Expand(input inspectjson.Value, opts jsonldtype.ProcessorOptions) (ExpandedValue, error) {
-	// [spec // 9.1 // expand // 9] Set *expanded output* to the result of using the Expansion algorithm, passing the *active context*, `document` from *remote document* or input if there is no *remote document* as *element*, `null` as *active property*, `documentUrl` as *base URL*, if available, otherwise to the `base` option from options, and the `frameExpansion` and and `ordered` flags from options.
-
+func newActiveContext(ctx context.Context, opts jsonldtype.ProcessorOptions) (*Context, error) {
 	if len(opts.ProcessingMode) == 0 {
 		opts.ProcessingMode = ProcessingMode_JSON_LD_1_1
 	}
@@ -72,37 +75,56 @@ func Expand(input inspectjson.Value, opts jsonldtype.ProcessorOptions) (Expanded
 		}
 	}
 
-	activeContext := &Context{
+	return &Context{
 		BaseURL:         baseIRI,
 		OriginalBaseURL: baseIRI,
 		TermDefinitions: map[string]*TermDefinition{},
 		_processor: &contextProcessor{
-			ctx:                       context.Background(),
+			ctx:                       ctx,
 			processingMode:            opts.ProcessingMode,
 			dereferencedDocumentByIRI: map[string]dereferencedDocument{},
 			documentLoader:            opts.DocumentLoader,
+			compactToRelative:         opts.CompactToRelative,
+			maxRemoteContexts:         opts.MaxRemoteContexts,
 		},
+	}, nil
+}
+
+func processLocalContext(activeContext *Context, localContext inspectjson.Value) (*Context, error) {
+	if localContextMap, ok := localContext.(inspectjson.ObjectValue); ok {
+		if contextMember, ok := localContextMap.Members["@context"]; ok {
+			localContext = contextMember.Value
+		}
 	}
 
-	if opts.ExpandContext != nil {
-		localContext := opts.ExpandContext
+	return algorithmContextProcessing{
+		ActiveContext: activeContext,
+		LocalContext:  localContext,
+		BaseURL:       activeContext.BaseURL,
+		// defaults
+		RemoteContexts:        nil,
+		OverrideProtected:     false,
+		Propagate:             true,
+		ValidateScopedContext: true,
+	}.Call()
+}
 
-		if localContextMap, ok := localContext.(inspectjson.ObjectValue); ok {
-			if contextMember, ok := localContextMap.Members["@context"]; ok {
-				localContext = contextMember.Value
-			}
-		}
+func Expand(ctx context.Context, input inspectjson.Value, opts jsonldtype.ProcessorOptions) (ExpandedValue, error) {
+	return expand(ctx, input, opts, false)
+}
+
+func expand(ctx context.Context, input inspectjson.Value, opts jsonldtype.ProcessorOptions, frameExpansion bool) (ExpandedValue, error) {
+	// [spec // 9.1 // expand // 9] Set *expanded output* to the result of using the Expansion algorithm, passing the *active context*, `document` from *remote document* or input if there is no *remote document* as *element*, `null` as *active property*, `documentUrl` as *base URL*, if available, otherwise to the `base` option from options, and the `frameExpansion` and and `ordered` flags from options.
+
+	activeContext, err := newActiveContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	baseIRI := activeContext.BaseURL
 
-		expandedContext, err := algorithmContextProcessing{
-			ActiveContext: activeContext,
-			LocalContext:  localContext,
-			BaseURL:       activeContext.BaseURL,
-			// defaults
-			RemoteContexts:        nil,
-			OverrideProtected:     false,
-			Propagate:             true,
-			ValidateScopedContext: true,
-		}.Call()
+	if opts.ExpandContext != nil {
+		expandedContext, err := processLocalContext(activeContext, opts.ExpandContext)
 		if err != nil {
 			return nil, err
 		}
@@ -111,10 +133,11 @@ func Expand(input inspectjson.Value, opts jsonldtype.ProcessorOptions) (Expanded
 	}
 
 	expandedOutput, err := algorithmExpansion{
-		activeContext: activeContext,
-		element:       input,
-		baseURL:       baseIRI,
-		ordered:       true,
+		activeContext:  activeContext,
+		element:        input,
+		baseURL:        baseIRI,
+		frameExpansion: frameExpansion,
+		ordered:        true,
 	}.Call()
 	if err != nil {
 		return nil, err
@@ -145,3 +168,371 @@ func Expand(input inspectjson.Value, opts jsonldtype.ProcessorOptions) (Expanded
 
 	return expandedOutput, nil
 }
+
+// Compact expands input and then compacts it according to localContext.
+func Compact(ctx context.Context, input inspectjson.Value, localContext inspectjson.Value, opts jsonldtype.ProcessorOptions) (map[string]any, error) {
+
+	// [spec // 9.1 // compact // 2] Set *expanded input* to the result of using the `expand()` method using *input* and *options*, with `ordered` set to `false`, and `extractAllScripts` defaulting to `false`.
+
+	expandedInput, err := Expand(ctx, input, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return compactExpanded(ctx, expandedInput.AsBuiltin(), localContext, opts, false)
+}
+
+func compactExpanded(ctx context.Context, expandedInput any, localContext inspectjson.Value, opts jsonldtype.ProcessorOptions, forceGraph bool) (map[string]any, error) {
+	// [spec // 9.1 // compact // 3] Set *context base* to the `documentUrl` from *remote document*, if available, otherwise to the `base` option from *options*.
+	// [spec // 9.1 // compact // 4] If *context* is a map having an `@context` entry, set *context* to that entry's value, otherwise to *context*.
+	// [spec // 9.1 // compact // 5] Initialize *active context* to the result of the Context Processing algorithm passing a new empty context as *active context*, *context* as *local context*, and *context base* as *base URL*.
+
+	activeContext, err := newActiveContext(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if localContext != nil {
+		activeContext, err = processLocalContext(activeContext, localContext)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// [spec // 9.1 // compact // 6] Set the base IRI of *active context* to the value of the `base` option from *options*, if set; otherwise, if the `compactToRelative` option is `true`, to the IRI of the currently being processed document, if available; otherwise to `null`.
+	// [dpb] relative IRIs are only produced when compactToRelative is set; see [algorithmIRICompaction]
+
+	// [spec // 9.1 // compact // 7] Set *compacted output* to the result of using the Compaction algorithm, passing *active context*, `null` as *active property*, *expanded input* as *element*, and the `compactArrays` and `ordered` flags from *options*.
+
+	compactedOutput, err := algorithmCompaction{
+		activeContext: activeContext,
+		element:       expandedInput,
+		compactArrays: opts.CompactArrays,
+	}.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	if compactedOutputMap, ok := compactedOutput.(map[string]any); ok && forceGraph {
+		if len(compactedOutputMap) == 0 {
+			compactedOutput = []any{}
+		} else {
+			compactedOutput = []any{compactedOutputMap}
+		}
+	}
+
+	var result map[string]any
+
+	switch compactedOutputValue := compactedOutput.(type) {
+	case map[string]any:
+		result = compactedOutputValue
+	case []any:
+
+		// [spec // 9.1 // compact // 7.1] If *compacted output* is an empty array, replace it with a new map.
+		// [spec // 9.1 // compact // 7.2] Otherwise, if *compacted output* is an array, replace it with a new map with a single entry whose key is the result of IRI compacting `@graph` and value is *compacted output*.
+
+		result = map[string]any{}
+
+		if len(compactedOutputValue) > 0 || forceGraph {
+			graphKey, err := compactKeyword(activeContext, "@graph")
+			if err != nil {
+				return nil, err
+			}
+
+			result[graphKey] = compactedOutputValue
+		}
+	default:
+		result = map[string]any{}
+	}
+
+	// [spec // 9.1 // compact // 8] If *context* was not `null`, add an `@context` entry to *compacted output* and set its value to the provided *context*.
+	// [dpb] empty contexts are not added
+
+	if contextValue := compactionContextValue(localContext); contextValue != nil {
+		result["@context"] = contextValue
+	}
+
+	return result, nil
+}
+
+func compactionContextValue(localContext inspectjson.Value) any {
+	if localContext == nil {
+		return nil
+	}
+
+	if localContextMap, ok := localContext.(inspectjson.ObjectValue); ok {
+		if contextMember, ok := localContextMap.Members["@context"]; ok {
+			localContext = contextMember.Value
+		}
+	}
+
+	switch localContextValue := localContext.AsBuiltin().(type) {
+	case nil:
+		return nil
+	case map[string]any:
+		if len(localContextValue) == 0 {
+			return nil
+		}
+
+		return localContextValue
+	case []any:
+		if len(localContextValue) == 0 {
+			return nil
+		}
+
+		return localContextValue
+	default:
+		return localContextValue
+	}
+}
+
+// Flatten expands input, flattens it into a list of node objects, and then compacts it according to localContext, if
+// not nil.
+func Flatten(ctx context.Context, input inspectjson.Value, localContext inspectjson.Value, opts jsonldtype.ProcessorOptions) (any, error) {
+
+	// [spec // 9.1 // flatten // 2] Set *expanded input* to the result of using the `expand()` method using *input* and *options* with `ordered` set to `false`.
+
+	expandedInput, err := Expand(ctx, input, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // 9.1 // flatten // 3] Initialize an empty *identifier map* and a *identifier counter* to zero.
+	// [spec // 9.1 // flatten // 4] Set *flattened output* to the result of using the Flattening algorithm, passing *expanded input* as *element*, and the `ordered` flag from *options*.
+
+	flattenedOutput, err := algorithmFlattening{
+		element: expandedInput.AsBuiltin(),
+	}.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // 9.1 // flatten // 5] If *context* is not `null`:
+
+	if localContext == nil {
+		return flattenedOutput, nil
+	} else if _, ok := localContext.(inspectjson.NullValue); ok {
+		return flattenedOutput, nil
+	}
+
+	// [spec // 9.1 // flatten // 5.1] Set *compacted output* to the result of using the `compact()` method, passing *flattened output*, *context*, and *options*.
+	// [dpb] the output always contains @graph, even when a single node is compacted to a map
+
+	compactedOutput, err := compactExpanded(ctx, flattenedOutput, localContext, opts, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // 9.1 // flatten // 6] Return *flattened output*.
+
+	return compactedOutput, nil
+}
+
+// Frame expands input and frame, reshapes the nodes of input to match frame, and then compacts the result according
+// to the context of frame.
+func Frame(ctx context.Context, input inspectjson.Value, frame inspectjson.Value, opts jsonldtype.ProcessorOptions) (map[string]any, error) {
+	// [spec // framing 9.1 // frame // 2] Set *expanded input* to the result of using the `expand()` method using *input* and *options* with `ordered` set to `false`.
+
+	expandedInput, err := Expand(ctx, input, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return FrameExpanded(ctx, expandedInput.AsBuiltin(), frame, opts)
+}
+
+// FrameExpanded is similar to [Frame], but expandedInput is already in expanded form, such as the result of [FromRDF].
+func FrameExpanded(ctx context.Context, expandedInput any, frame inspectjson.Value, opts jsonldtype.ProcessorOptions) (map[string]any, error) {
+	if len(opts.ProcessingMode) == 0 {
+		opts.ProcessingMode = ProcessingMode_JSON_LD_1_1
+	}
+
+	if len(opts.Embed) == 0 {
+		opts.Embed = "@once"
+	}
+
+	// [spec // framing 9.1 // frame // 3] Set *expanded frame* to the result of using the `expand()` method using *frame* and *options* with `ordered` set to `false` and `frameExpansion` set to `true`.
+
+	frameMap, ok := frame.(inspectjson.ObjectValue)
+	if !ok {
+		return nil, jsonldtype.Error{
+			Code: jsonldtype.InvalidFrame,
+			Err:  fmt.Errorf("expected object, found %s", frame.GetGrammarName()),
+		}
+	}
+
+	expandedFrame, err := expand(ctx, frame, opts, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // framing 9.1 // frame // 4] Set *context* to the value of `@context` from *frame*, if it exists, or to a new empty context, otherwise.
+
+	var frameContext inspectjson.Value
+
+	if contextMember, ok := frameMap.Members["@context"]; ok {
+		frameContext = contextMember.Value
+	}
+
+	// [spec // framing 9.1 // frame // 6] Initialize a new framing state *state* to an empty map.
+	// [spec // framing 9.1 // frame // 7] Create *graph map* using the Node Map Generation algorithm with *expanded input*.
+
+	graphMap := NodeMap{
+		"@default": {},
+	}
+
+	err = algorithmNodeMapGeneration{
+		element:     expandedInput,
+		nodeMap:     graphMap,
+		activeGraph: "@default",
+		issuer:      newBlankNodeIdentifierIssuer("_:b"),
+	}.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // framing 9.1 // frame // 8] If the `frameDefault` option is set to `true`, set *graph name* in *state* to `@default`. Otherwise, create *merged node map* using the Merge Node Maps algorithm with *graph map*, add *merged node map* as the value of `@merged` in *graph map* and set *graph name* in *state* to `@merged`.
+	// [dpb] a top-level @graph entry in frame also selects the default graph, consistent with reference implementations
+
+	graphName := "@default"
+
+	if _, ok := frameMap.Members["@graph"]; !ok && !opts.FrameDefault {
+		graphMap["@merged"] = mergeNodeMaps(graphMap)
+		graphName = "@merged"
+	}
+
+	// [spec // framing 9.1 // frame // 9] Initialize *results* as an empty array.
+	// [spec // framing 9.1 // frame // 10] Invoke the Framing algorithm, passing *state*, the keys from *subject map* in *state* for *subjects*, *expanded frame*, *results* as *parent*, and `null` as *active property*.
+
+	results := map[string]any{
+		"@graph": []any{},
+	}
+
+	bnodeUsages := map[string]int{}
+
+	err = algorithmFraming{
+		state: framingState{
+			defaults: framingFlags{
+				embed:       opts.Embed,
+				explicit:    opts.Explicit,
+				requireAll:  opts.RequireAll,
+				omitDefault: opts.OmitDefault,
+			},
+			graphMap:     graphMap,
+			graph:        graphName,
+			subjectStack: &[]framingStackEntry{},
+			uniqueEmbeds: &map[string]map[string]struct{}{},
+			bnodeUsages:  bnodeUsages,
+		},
+		subjects:  slices.Sorted(maps.Keys(graphMap[graphName])),
+		frame:     builtinAsArray(expandedFrame.AsBuiltin()),
+		parent:    results,
+		parentKey: "@graph",
+	}.Call()
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // framing 9.1 // frame // 11] If the `processingMode` option is not `json-ld-1.0`, remove the `@id` entry of each node object in *results* where the value is a blank node identifier which appears only once in *results*.
+
+	framedOutput := results["@graph"]
+
+	if opts.ProcessingMode != ProcessingMode_JSON_LD_1_0 {
+		pruneFramedBlankNodeIdentifiers(framedOutput, bnodeUsages)
+	}
+
+	// [spec // framing 9.1 // frame // 12] Set *compacted results* to the result of using the `compact()` method, passing *results*, *context*, and *options*, ensuring that the `@graph` entry is retained unless the `omitGraph` option is `true`.
+
+	compactedOutput, err := compactExpanded(ctx, framedOutput, frameContext, opts, !opts.OmitGraph)
+	if err != nil {
+		return nil, err
+	}
+
+	// [spec // framing 9.1 // frame // 13] Recursively, replace all entries in *compacted results* where the key is `@preserve` with the value of the entry. If the value of the entry is `@null`, replace the value with `null`. If, after replacement, an array contains only the value `null` remove the value, leaving an empty array.
+
+	for k, v := range compactedOutput {
+		if k == "@context" {
+			continue
+		}
+
+		compactedOutput[k] = replaceFramedPreserve(v)
+	}
+
+	// [spec // framing 9.1 // frame // 14] Return *compacted results*.
+
+	return compactedOutput, nil
+}
+
+func pruneFramedBlankNodeIdentifiers(v any, bnodeUsages map[string]int) {
+	switch vT := v.(type) {
+	case map[string]any:
+		for k, kv := range vT {
+			if k == "@id" {
+				if id, ok := kv.(string); ok && strings.HasPrefix(id, "_:") && bnodeUsages[id] == 1 {
+					delete(vT, k)
+				}
+
+				continue
+			} else if k == "@preserve" {
+				continue
+			}
+
+			pruneFramedBlankNodeIdentifiers(kv, bnodeUsages)
+		}
+	case []any:
+		for _, iv := range vT {
+			pruneFramedBlankNodeIdentifiers(iv, bnodeUsages)
+		}
+	}
+}
+
+func replaceFramedPreserve(v any) any {
+	switch vT := v.(type) {
+	case map[string]any:
+		if preserve, ok := vT["@preserve"]; ok {
+			return replaceFramedPreserve(preserve)
+		}
+
+		for k, kv := range vT {
+			vT[k] = replaceFramedPreserve(kv)
+		}
+
+		return vT
+	case []any:
+		replaced := make([]any, 0, len(vT))
+
+		for _, iv := range vT {
+			iv = replaceFramedPreserve(iv)
+			if iv == nil {
+				continue
+			}
+
+			replaced = append(replaced, iv)
+		}
+
+		return replaced
+	case string:
+		if vT == "@null" {
+			return nil
+		}
+	}
+
+	return v
+}
+
+// FromRDF serializes an RDF dataset as an expanded JSON-LD document. Blank nodes are labeled by bnStringProvider, or
+// sequentially if nil.
+func FromRDF(dataset rdf.QuadList, bnStringProvider blanknodes.StringProvider, opts jsonldtype.ProcessorOptions) ([]any, error) {
+	if len(opts.ProcessingMode) == 0 {
+		opts.ProcessingMode = ProcessingMode_JSON_LD_1_1
+	}
+
+	return algorithmSerializeRDFAsJSONLD{
+		dataset:          dataset,
+		useNativeTypes:   opts.UseNativeTypes,
+		useRdfType:       opts.UseRdfType,
+		rdfDirection:     opts.RDFDirection,
+		processingMode:   opts.ProcessingMode,
+		bnStringProvider: bnStringProvider,
+	}.Call()
+}
@@ -30,6 +30,7 @@ func TestOne(t *testing.T) {
 	}
 
 	expanded, err := Expand(
+		context.Background(),
 		parsed,
 		jsonldtype.ProcessorOptions{
 			BaseURL:        "http://units.example.com/sub/path",
@@ -63,6 +64,7 @@ func TestBugIgnoredBaseAfterRemoteContext(t *testing.T) {
 	}
 
 	expanded, err := Expand(
+		context.Background(),
 		parsed,
 		jsonldtype.ProcessorOptions{
 			BaseURL: "http://example.com/tests/toRdf-manifest.jsonld",
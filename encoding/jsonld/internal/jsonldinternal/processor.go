@@ -21,7 +21,11 @@ type contextProcessor struct {
 	ctx            context.Context
 	documentLoader jsonldtype.DocumentLoader
 
-	processingMode string
+	processingMode    string
+	compactToRelative bool
+
+	maxRemoteContexts    int
+	loadedRemoteContexts int
 
 	dereferencedDocumentByIRI map[string]dereferencedDocument
 }
@@ -30,3 +34,24 @@ type dereferencedDocument struct {
 	documentURL          *iri.ParsedIRI
 	documentContextValue inspectjson.Value
 }
+
+// loadRemoteContext loads a context or import document while enforcing the MaxRemoteContexts processor option.
+func (p *contextProcessor) loadRemoteContext(u string) (jsonldtype.RemoteDocument, error) {
+	if p.maxRemoteContexts > 0 && p.loadedRemoteContexts >= p.maxRemoteContexts {
+		return jsonldtype.RemoteDocument{}, jsonldtype.RemoteDocumentPolicyError{
+			URL:    u,
+			Reason: jsonldtype.RemoteDocumentPolicyReasonTooManyRemoteContexts,
+		}
+	}
+
+	p.loadedRemoteContexts++
+
+	return p.documentLoader.LoadDocument(
+		p.ctx,
+		u,
+		jsonldtype.DocumentLoaderOptions{
+			Profile:        &profileContextIRI,
+			RequestProfile: []string{profileContextIRI},
+		},
+	)
+}
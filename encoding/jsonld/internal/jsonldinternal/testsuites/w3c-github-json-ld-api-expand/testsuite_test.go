@@ -129,7 +129,7 @@ func Test(t *testing.T) {
 					return nil, fmt.Errorf("parse: %v", err)
 				}
 
-				return jsonldinternal.Expand(parsedInput, dopt)
+				return jsonldinternal.Expand(context.Background(), parsedInput, dopt)
 			}
 
 			if slices.Contains(sequence.Type, "jld:NegativeEvaluationTest") {
@@ -24,11 +24,11 @@ func (decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
 }
 
 func (e decoder) NewDecoderParams() rdfiotypes.Params {
-	return &decoderParams{}
+	return newDecoderParams()
 }
 
 func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions) (*rdfiotypes.DecoderHandle, error) {
-	params := &decoderParams{}
+	params := newDecoderParams()
 
 	err := rdfiotypes.LoadAndApplyParams(params, opts.Params...)
 	if err != nil {
@@ -47,6 +47,10 @@ func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions
 		tokenizerOptions = tokenizerOptions.SetLax(*params.TokenizerLax)
 	}
 
+	if params.Streaming != nil {
+		options = options.SetStreaming(*params.Streaming)
+	}
+
 	if params.CaptureTextOffsets != nil {
 		options = options.SetCaptureTextOffsets(*params.CaptureTextOffsets)
 		tokenizerOptions = tokenizerOptions.SetSourceOffsets(*params.CaptureTextOffsets)
@@ -54,6 +58,11 @@ func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions
 
 	options = options.SetParserOptions(tokenizerOptions)
 
+	options, err = params.DocumentLoader.ApplyDecoderConfig(options)
+	if err != nil {
+		return nil, fmt.Errorf("params: documentLoader: %v", err)
+	}
+
 	allOptions, err := rdfiotypes.PatchGenericOptions([]jsonld.DecoderOption{options}, opts.Patcher)
 	if err != nil {
 		return nil, err
@@ -64,9 +73,27 @@ func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions
 		return nil, err
 	}
 
-	return &rdfiotypes.DecoderHandle{
+	h := &rdfiotypes.DecoderHandle{
 		Reader:            rr,
 		Decoder:           decoder,
 		DecoderBlankNodes: bnFactory,
-	}, nil
+	}
+
+	err = params.ValidateLiterals.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	err = params.ValidateIRIs.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	params.CanonicalizeLiterals.ResolveDecoderHandle(h)
+	params.NormalizeIRIs.ResolveDecoderHandle(h)
+
+	params.Deskolemize.ResolveDecoderHandle(h)
+	params.Skolemize.ResolveDecoderHandle(h)
+
+	return h, nil
 }
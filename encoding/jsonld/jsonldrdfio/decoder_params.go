@@ -1,26 +1,71 @@
 package jsonldrdfio
 
 import (
+	"maps"
+
 	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
 	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfioutil"
 )
 
 type decoderParams struct {
 	CaptureTextOffsets *bool
 	TokenizerLax       *bool
+	Streaming          *bool
+
+	DocumentLoader       *DocumentLoaderParams
+	ValidateLiterals     *rdfioutil.LiteralValidation
+	CanonicalizeLiterals *rdfioutil.LiteralCanonicalization
+	ValidateIRIs         *rdfioutil.IRIValidation
+	NormalizeIRIs        *rdfioutil.IRINormalization
+	Skolemize            *rdfioutil.Skolemization
+	Deskolemize          *rdfioutil.Deskolemization
 }
 
 var _ rdfiotypes.Params = &decoderParams{}
 
+func newDecoderParams() *decoderParams {
+	return &decoderParams{
+		DocumentLoader:       &DocumentLoaderParams{},
+		ValidateLiterals:     &rdfioutil.LiteralValidation{},
+		CanonicalizeLiterals: &rdfioutil.LiteralCanonicalization{},
+		ValidateIRIs:         &rdfioutil.IRIValidation{},
+		NormalizeIRIs:        &rdfioutil.IRINormalization{},
+		Skolemize:            &rdfioutil.Skolemization{},
+		Deskolemize:          &rdfioutil.Deskolemization{},
+	}
+}
+
 func (f *decoderParams) NewParamsCollection() rdfiotypes.ParamsCollection {
-	return rdfiotypes.ParamsCollection{
+	c := rdfiotypes.ParamsCollection{
 		"captureTextOffsets": kvref.BoolPtr(&f.CaptureTextOffsets, rdfiotypes.ParamMeta{
 			Usage: "Capture the line+column offsets for statement properties",
 		}),
 		"tokenizer.lax": kvref.BoolPtr(&f.TokenizerLax, rdfiotypes.ParamMeta{
 			Usage: "Accept and recover common syntax errors",
 		}),
+		"streaming": kvref.BoolPtr(&f.Streaming, rdfiotypes.ParamMeta{
+			Usage: "Decode items of a top-level @graph as they are read, for documents following the streaming profile",
+		}),
 	}
+
+	maps.Copy(c, f.DocumentLoader.NewParamsCollection("documentLoader"))
+	maps.Copy(c, f.ValidateLiterals.NewParamsCollection("validateLiterals"))
+	maps.Copy(c, f.CanonicalizeLiterals.NewParamsCollection("canonicalizeLiterals"))
+	maps.Copy(c, f.ValidateIRIs.NewParamsCollection("validateIRIs"))
+	maps.Copy(c, f.NormalizeIRIs.NewParamsCollection("normalizeIRIs"))
+	maps.Copy(c, f.Skolemize.NewParamsCollection("skolemize"))
+	maps.Copy(c, f.Deskolemize.NewParamsCollection("deskolemize"))
+
+	return c
 }
 
-func (f *decoderParams) ApplyDefaults() {}
+func (f *decoderParams) ApplyDefaults() {
+	f.DocumentLoader.ApplyDefaults()
+	f.ValidateLiterals.ApplyDefaults()
+	f.CanonicalizeLiterals.ApplyDefaults()
+	f.ValidateIRIs.ApplyDefaults()
+	f.NormalizeIRIs.ApplyDefaults()
+	f.Skolemize.ApplyDefaults()
+	f.Deskolemize.ApplyDefaults()
+}
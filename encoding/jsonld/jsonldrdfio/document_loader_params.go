@@ -0,0 +1,199 @@
+package jsonldrdfio
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/dpb587/kvstrings-go/kvstrings"
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+// DocumentLoaderParams configures the loading of remote documents for decoders based on JSON-LD processing.
+type DocumentLoaderParams struct {
+	Static      []string
+	CacheDir    *string
+	MemoryCache *int
+	Network     *bool
+
+	AllowHosts            []string
+	DenyHosts             []string
+	AllowPrivateAddresses *bool
+	MaxDocumentSize       *int
+	MaxRemoteContexts     *int
+	Timeout               *string
+}
+
+func (f *DocumentLoaderParams) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base + ".static": kvref.StringList(&f.Static, rdfiotypes.ParamMeta{
+			Usage: "Directory of preloaded remote documents, organized by host and path (e.g. schema.org/index.jsonld)",
+		}),
+		base + ".cacheDir": kvref.StringPtr(&f.CacheDir, rdfiotypes.ParamMeta{
+			Usage: "Directory for caching remote documents according to their HTTP caching headers",
+		}),
+		base + ".memoryCache": kvref.IntPtr(&f.MemoryCache, rdfiotypes.ParamMeta{
+			Usage: "Maximum number of remote documents to keep in memory (0 for unlimited)",
+		}),
+		base + ".network": kvref.BoolPtr(&f.Network, rdfiotypes.ParamMeta{
+			Usage: "Allow loading remote documents from the network (default true)",
+		}),
+		base + ".allowHost": kvref.StringList(&f.AllowHosts, rdfiotypes.ParamMeta{
+			Usage: "Only load remote documents from a host, or its subdomains with the syntax of \"*.{host}\"",
+		}),
+		base + ".denyHost": kvref.StringList(&f.DenyHosts, rdfiotypes.ParamMeta{
+			Usage: "Never load remote documents from a host, or its subdomains with the syntax of \"*.{host}\"",
+		}),
+		base + ".allowPrivateAddresses": kvref.BoolPtr(&f.AllowPrivateAddresses, rdfiotypes.ParamMeta{
+			Usage: "Allow loading remote documents from loopback, link-local, and private addresses (default false when any other remote document policy param is used)",
+		}),
+		base + ".maxDocumentSize": kvref.IntPtr(&f.MaxDocumentSize, rdfiotypes.ParamMeta{
+			Usage: "Maximum number of bytes of a remote document",
+		}),
+		base + ".maxRemoteContexts": kvref.IntPtr(&f.MaxRemoteContexts, rdfiotypes.ParamMeta{
+			Usage: "Maximum number of remote contexts loaded for a document",
+		}),
+		base + ".timeout": kvref.StringPtr(&f.Timeout, rdfiotypes.ParamMeta{
+			Usage: "Maximum duration for loading a remote document (e.g. 10s)",
+		}),
+	}
+}
+
+func (f *DocumentLoaderParams) ApplyDefaults() {}
+
+// ApplyDecoderConfig updates the options with the configured document loader and limits. The decoder default document
+// loader remains in use if no document loader params were configured.
+func (f *DocumentLoaderParams) ApplyDecoderConfig(options jsonld.DecoderConfig) (jsonld.DecoderConfig, error) {
+	documentLoader, err := f.NewDocumentLoader()
+	if err != nil {
+		return options, err
+	} else if documentLoader != nil {
+		options = options.SetDocumentLoader(documentLoader)
+	}
+
+	if f.MaxRemoteContexts != nil {
+		options = options.SetMaxRemoteContexts(*f.MaxRemoteContexts)
+	}
+
+	return options, nil
+}
+
+// NewDocumentLoader returns nil if no document loader params were configured.
+func (f *DocumentLoaderParams) NewDocumentLoader() (jsonldtype.DocumentLoader, error) {
+	policy, hasPolicy, err := f.newRemoteDocumentPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	if !hasPolicy && len(f.Static) == 0 && f.CacheDir == nil && f.MemoryCache == nil && f.Network == nil {
+		return nil, nil
+	}
+
+	var loaders jsonldtype.ChainDocumentLoader
+
+	for _, dir := range f.Static {
+		loaders = append(loaders, jsonldtype.NewStaticDocumentLoader(os.DirFS(dir)))
+	}
+
+	client := f.newHTTPClient(policy, hasPolicy)
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	var networkLoader jsonldtype.DocumentLoader = jsonldtype.NewDefaultDocumentLoader(client)
+
+	if hasPolicy {
+		networkLoader = jsonldtype.NewPolicyDocumentLoader(networkLoader, policy)
+	}
+
+	loaders = append(loaders, networkLoader)
+
+	if f.MemoryCache != nil && *f.MemoryCache > 0 {
+		return jsonldtype.NewLRUDocumentLoader(loaders, *f.MemoryCache), nil
+	}
+
+	return jsonldtype.NewCachingDocumentLoader(loaders), nil
+}
+
+// NewHTTPClient returns a client for loading other remote resources, such as tabular data, with the same network,
+// cache, and policy restrictions as remote documents.
+func (f *DocumentLoaderParams) NewHTTPClient() (*http.Client, error) {
+	policy, hasPolicy, err := f.newRemoteDocumentPolicy()
+	if err != nil {
+		return nil, err
+	}
+
+	client := f.newHTTPClient(policy, hasPolicy)
+	if client == nil {
+		return http.DefaultClient, nil
+	} else if hasPolicy {
+		// [dpb] unlike PolicyDocumentLoader, the timeout must include reading the body since it is returned to callers
+		client.Timeout = policy.Timeout
+	}
+
+	return client, nil
+}
+
+// newHTTPClient returns nil if the default client may be used.
+func (f *DocumentLoaderParams) newHTTPClient(policy jsonldtype.RemoteDocumentPolicy, hasPolicy bool) *http.Client {
+	var transport http.RoundTripper
+
+	if f.Network != nil && !*f.Network {
+		transport = offlineRoundTripper{}
+	} else if hasPolicy {
+		transport = jsonldtype.NewPolicyTransport(policy, nil)
+	}
+
+	if f.CacheDir != nil {
+		transport = jsonldtype.NewDiskCacheTransport(*f.CacheDir, transport)
+	}
+
+	if transport == nil {
+		return nil
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}
+}
+
+func (f *DocumentLoaderParams) newRemoteDocumentPolicy() (jsonldtype.RemoteDocumentPolicy, bool, error) {
+	policy := jsonldtype.RemoteDocumentPolicy{
+		AllowedHosts: f.AllowHosts,
+		DeniedHosts:  f.DenyHosts,
+	}
+
+	hasPolicy := len(f.AllowHosts) > 0 || len(f.DenyHosts) > 0
+
+	if f.AllowPrivateAddresses != nil {
+		policy.AllowPrivateAddresses = *f.AllowPrivateAddresses
+		hasPolicy = true
+	}
+
+	if f.MaxDocumentSize != nil {
+		policy.MaxDocumentSize = int64(*f.MaxDocumentSize)
+		hasPolicy = true
+	}
+
+	if f.Timeout != nil {
+		timeout, err := time.ParseDuration(*f.Timeout)
+		if err != nil {
+			return policy, false, fmt.Errorf("timeout: %v", err)
+		}
+
+		policy.Timeout = timeout
+		hasPolicy = true
+	}
+
+	return policy, hasPolicy, nil
+}
+
+type offlineRoundTripper struct{}
+
+func (offlineRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("network access disabled: %s", req.URL)
+}
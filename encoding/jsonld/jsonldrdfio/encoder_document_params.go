@@ -0,0 +1,171 @@
+package jsonldrdfio
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+	"github.com/dpb587/rdfkit-go/internal/ptr"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/rdfacontext"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+// EncoderDocumentParams configures the structure of JSON-LD documents for encoders based on [jsonld.Encoder],
+// independent of their syntax.
+type EncoderDocumentParams struct {
+	Buffered        *bool
+	ConvertLists    *bool
+	Frame           *string
+	GraphContainer  *bool
+	IrisUseBase     *bool
+	IrisUsePrefixes []string
+	UseNativeTypes  *bool
+	UseRdfType      *bool
+}
+
+func (f *EncoderDocumentParams) NewParamsCollection() rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		"buffered": kvref.BoolPtr(&f.Buffered, rdfiotypes.ParamMeta{
+			Usage: "Load all statements into memory before writing any output",
+		}),
+		"convertLists": kvref.BoolPtr(&f.ConvertLists, rdfiotypes.ParamMeta{
+			Usage: "Write rdf:first and rdf:rest chains as @list objects",
+		}),
+		"frame": kvref.StringPtr(&f.Frame, rdfiotypes.ParamMeta{
+			Usage: "Path to a JSON-LD frame document for reshaping the output",
+		}),
+		"graphContainer": kvref.BoolPtr(&f.GraphContainer, rdfiotypes.ParamMeta{
+			Usage: "Always write nodes within a top-level @graph, even if there is only one",
+		}),
+		"iris.useBase": kvref.BoolPtr(&f.IrisUseBase, rdfiotypes.ParamMeta{
+			Usage: "Prefer IRIs relative to the resource IRI",
+		}),
+		"iris.usePrefix": kvref.StringList(&f.IrisUsePrefixes, rdfiotypes.ParamMeta{
+			Usage: "Prefer IRIs using a prefix. Use the syntax of \"{prefix}:{iri}\", \"rdfa-context\", \"auto\" (discover namespaces of written IRIs), or \"none\"",
+		}),
+		"useNativeTypes": kvref.BoolPtr(&f.UseNativeTypes, rdfiotypes.ParamMeta{
+			Usage: "Write boolean, integer, and double literals as native JSON values",
+		}),
+		"useRdfType": kvref.BoolPtr(&f.UseRdfType, rdfiotypes.ParamMeta{
+			Usage: "Write rdf:type statements as properties rather than @type",
+		}),
+	}
+}
+
+func (f *EncoderDocumentParams) ApplyDefaults() {
+	if f.Buffered == nil {
+		f.Buffered = ptr.Value(true)
+	}
+
+	if f.IrisUseBase == nil {
+		f.IrisUseBase = ptr.Value(true)
+	}
+
+	if len(f.IrisUsePrefixes) == 0 {
+		f.IrisUsePrefixes = []string{"rdfa-context"}
+	}
+}
+
+// NewEncoderConfig returns the options of a JSON-LD encoder for the params.
+func (f *EncoderDocumentParams) NewEncoderConfig(opts rdfiotypes.EncoderOptions) (jsonld.EncoderConfig, error) {
+	options := jsonld.EncoderConfig{}
+
+	if bnStringProvider := rdfiotypes.PropagateDecoderPipeBlankNodeStringProvider(opts.DecoderPipe); bnStringProvider != nil {
+		options = options.SetBlankNodeStringProvider(bnStringProvider)
+	}
+
+	if *f.Buffered {
+		options = options.SetBuffered(true)
+	}
+
+	if f.Frame != nil {
+		frame, err := loadFrame(*f.Frame)
+		if err != nil {
+			return options, fmt.Errorf("flag[frame]: %v", err)
+		}
+
+		options = options.SetFrame(frame)
+	}
+
+	if f.GraphContainer != nil && *f.GraphContainer {
+		options = options.SetGraphContainer(true)
+	}
+
+	if f.ConvertLists != nil && *f.ConvertLists {
+		options = options.SetConvertLists(true)
+	}
+
+	if f.UseNativeTypes != nil {
+		options = options.SetUseNativeTypes(*f.UseNativeTypes)
+	}
+
+	if f.UseRdfType != nil && *f.UseRdfType {
+		options = options.SetUseRdfType(true)
+	}
+
+	if *f.IrisUseBase && len(opts.BaseIRI) > 0 {
+		options = options.SetBase(string(opts.BaseIRI))
+	}
+
+	{
+		var prefixes iri.PrefixMappingList
+		var autoPrefixes bool
+
+		for _, prefix := range f.IrisUsePrefixes {
+			if prefix == "rdfa-context" {
+				prefixes = rdfacontext.AppendWidelyUsedInitialContext(prefixes)
+
+				continue
+			} else if prefix == "auto" {
+				autoPrefixes = true
+
+				continue
+			} else if prefix == "none" {
+				prefixes = nil
+				autoPrefixes = false
+
+				continue
+			}
+
+			prefixSplit := strings.SplitN(prefix, ":", 2)
+			if len(prefixSplit) != 2 {
+				return options, fmt.Errorf("flag[prefixes]: invalid prefix format")
+			}
+
+			prefixes = append(prefixes, iri.PrefixMapping{
+				Prefix:   prefixSplit[0],
+				Expanded: prefixSplit[1],
+			})
+		}
+
+		if len(prefixes) > 0 {
+			options = options.SetPrefixes(prefixes)
+		}
+
+		if autoPrefixes {
+			options = options.SetAutoPrefixes(true)
+		}
+	}
+
+	return options, nil
+}
+
+func loadFrame(path string) (inspectjson.Value, error) {
+	fh, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open: %v", err)
+	}
+
+	defer fh.Close()
+
+	frame, err := inspectjson.Parse(fh)
+	if err != nil {
+		return nil, fmt.Errorf("parse: %v", err)
+	}
+
+	return frame, nil
+}
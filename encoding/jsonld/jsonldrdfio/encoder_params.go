@@ -1,47 +1,37 @@
 package jsonldrdfio
 
 import (
+	"maps"
+
 	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
-	"github.com/dpb587/rdfkit-go/internal/ptr"
 	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
 )
 
 type encoderParams struct {
-	Buffered        *bool
-	IrisUseBase     *bool
-	IrisUsePrefixes []string
-	Pretty          *bool
+	Document *EncoderDocumentParams
+	Pretty   *bool
 }
 
 var _ rdfiotypes.Params = &encoderParams{}
 
+func newEncoderParams() *encoderParams {
+	return &encoderParams{
+		Document: &EncoderDocumentParams{},
+	}
+}
+
 func (f *encoderParams) NewParamsCollection() rdfiotypes.ParamsCollection {
-	return rdfiotypes.ParamsCollection{
-		"buffered": kvref.BoolPtr(&f.Buffered, rdfiotypes.ParamMeta{
-			Usage: "Load all statements into memory before writing any output",
-		}),
-		"iris.useBase": kvref.BoolPtr(&f.IrisUseBase, rdfiotypes.ParamMeta{
-			Usage: "Prefer IRIs relative to the resource IRI",
-		}),
-		"iris.usePrefix": kvref.StringList(&f.IrisUsePrefixes, rdfiotypes.ParamMeta{
-			Usage: "Prefer IRIs using a prefix. Use the syntax of \"{prefix}:{iri}\", \"rdfa-context\", or \"none\"",
-		}),
+	c := rdfiotypes.ParamsCollection{
 		"pretty": kvref.BoolPtr(&f.Pretty, rdfiotypes.ParamMeta{
 			Usage: "Use tab indentation for human-readable output",
 		}),
 	}
-}
 
-func (f *encoderParams) ApplyDefaults() {
-	if f.Buffered == nil {
-		f.Buffered = ptr.Value(true)
-	}
+	maps.Copy(c, f.Document.NewParamsCollection())
 
-	if f.IrisUseBase == nil {
-		f.IrisUseBase = ptr.Value(true)
-	}
+	return c
+}
 
-	if len(f.IrisUsePrefixes) == 0 {
-		f.IrisUsePrefixes = []string{"rdfa-context"}
-	}
+func (f *encoderParams) ApplyDefaults() {
+	f.Document.ApplyDefaults()
 }
@@ -28,7 +28,7 @@ func NewCachingDocumentLoader(upstream DocumentLoader) *CachingDocumentLoader {
 var _ DocumentLoader = &CachingDocumentLoader{}
 
 func (dl CachingDocumentLoader) LoadDocument(ctx context.Context, u string, opts DocumentLoaderOptions) (RemoteDocument, error) {
-	cacheKey := dl.cacheKey(u, opts)
+	cacheKey := documentLoaderCacheKey(u, opts)
 
 	if res, ok := dl.cache[cacheKey]; ok {
 		return res.RemoteDocument, res.Err
@@ -43,13 +43,13 @@ func (dl CachingDocumentLoader) LoadDocument(ctx context.Context, u string, opts
 	dl.cache[cacheKey] = cdlr
 
 	if cdlr.Err == nil && remoteDocument.DocumentURL.String() != u {
-		dl.cache[dl.cacheKey(remoteDocument.DocumentURL.String(), opts)] = cdlr
+		dl.cache[documentLoaderCacheKey(remoteDocument.DocumentURL.String(), opts)] = cdlr
 	}
 
 	return remoteDocument, err
 }
 
-func (dl CachingDocumentLoader) cacheKey(u string, opts DocumentLoaderOptions) string {
+func documentLoaderCacheKey(u string, opts DocumentLoaderOptions) string {
 	cacheKeyHash := sha256.New()
 	fmt.Fprintf(cacheKeyHash, "url: %s\nextractAllScripts: %v\n", u, opts.ExtractAllScripts)
 
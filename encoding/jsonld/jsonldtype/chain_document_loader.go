@@ -0,0 +1,38 @@
+package jsonldtype
+
+import (
+	"context"
+	"errors"
+)
+
+// ChainDocumentLoader tries each of its loaders in order, returning the first document which is successfully loaded.
+// If all loaders fail, their errors are joined.
+type ChainDocumentLoader []DocumentLoader
+
+var _ DocumentLoader = ChainDocumentLoader{}
+
+func NewChainDocumentLoader(loaders ...DocumentLoader) ChainDocumentLoader {
+	return ChainDocumentLoader(loaders)
+}
+
+func (dl ChainDocumentLoader) LoadDocument(ctx context.Context, u string, opts DocumentLoaderOptions) (RemoteDocument, error) {
+	var errs []error
+
+	for _, loader := range dl {
+		remoteDocument, err := loader.LoadDocument(ctx, u, opts)
+		if err == nil {
+			return remoteDocument, nil
+		}
+
+		errs = append(errs, err)
+	}
+
+	if len(errs) == 0 {
+		return RemoteDocument{}, Error{
+			Code: LoadingDocumentFailed,
+			Err:  errors.New("no document loaders configured"),
+		}
+	}
+
+	return RemoteDocument{}, errors.Join(errs...)
+}
@@ -0,0 +1,233 @@
+package jsonldtype
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DiskCacheTransport is an [http.RoundTripper] which stores successful GET responses in a directory, and is intended
+// for use with the client of [DefaultDocumentLoader].
+//
+// Stored responses are reused while they are fresh according to their Cache-Control max-age or Expires headers.
+// Afterwards, they are revalidated using their ETag or Last-Modified headers. Responses with Cache-Control no-store are
+// never stored. If the upstream request fails, a stale response is used, when available. Failures to write the cache
+// are ignored.
+type DiskCacheTransport struct {
+	dir      string
+	upstream http.RoundTripper
+}
+
+var _ http.RoundTripper = &DiskCacheTransport{}
+
+// NewDiskCacheTransport creates a transport storing responses in dir. If upstream is nil, [http.DefaultTransport] is
+// used.
+func NewDiskCacheTransport(dir string, upstream http.RoundTripper) *DiskCacheTransport {
+	if upstream == nil {
+		upstream = http.DefaultTransport
+	}
+
+	return &DiskCacheTransport{
+		dir:      dir,
+		upstream: upstream,
+	}
+}
+
+func (t *DiskCacheTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || len(req.Header.Get("Range")) > 0 {
+		return t.upstream.RoundTrip(req)
+	}
+
+	cachePath := t.cachePath(req)
+
+	cached, cachedBody, storedAt := t.readCache(cachePath, req)
+	if cached != nil {
+		if time.Since(storedAt) < diskCacheFreshnessLifetime(cached.Header, storedAt) {
+			return newDiskCacheResponse(cached, cachedBody), nil
+		}
+
+		req = req.Clone(req.Context())
+
+		if etag := cached.Header.Get("ETag"); len(etag) > 0 {
+			req.Header.Set("If-None-Match", etag)
+		}
+
+		if lastModified := cached.Header.Get("Last-Modified"); len(lastModified) > 0 {
+			req.Header.Set("If-Modified-Since", lastModified)
+		}
+	}
+
+	resp, err := t.upstream.RoundTrip(req)
+	if err != nil {
+		if cached != nil {
+			return newDiskCacheResponse(cached, cachedBody), nil
+		}
+
+		return nil, err
+	}
+
+	if cached != nil && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+
+		for _, key := range []string{"Cache-Control", "Date", "ETag", "Expires", "Last-Modified"} {
+			if values, ok := resp.Header[key]; ok {
+				cached.Header[key] = values
+			}
+		}
+
+		t.writeCache(cachePath, cached, cachedBody)
+
+		return newDiskCacheResponse(cached, cachedBody), nil
+	}
+
+	if resp.StatusCode != http.StatusOK || diskCacheHasDirective(resp.Header, "no-store") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	t.writeCache(cachePath, resp, body)
+
+	return newDiskCacheResponse(resp, body), nil
+}
+
+func (t *DiskCacheTransport) cachePath(req *http.Request) string {
+	cacheKeyHash := sha256.New()
+	fmt.Fprintf(cacheKeyHash, "url: %s\naccept: %s\n", req.URL.String(), req.Header.Get("Accept"))
+
+	return filepath.Join(t.dir, hex.EncodeToString(cacheKeyHash.Sum(nil))+".http")
+}
+
+func (t *DiskCacheTransport) readCache(cachePath string, req *http.Request) (*http.Response, []byte, time.Time) {
+	fh, err := os.Open(cachePath)
+	if err != nil {
+		return nil, nil, time.Time{}
+	}
+
+	defer fh.Close()
+
+	fhStat, err := fh.Stat()
+	if err != nil {
+		return nil, nil, time.Time{}
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(fh), req)
+	if err != nil {
+		return nil, nil, time.Time{}
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, nil, time.Time{}
+	}
+
+	return resp, body, fhStat.ModTime()
+}
+
+func (t *DiskCacheTransport) writeCache(cachePath string, resp *http.Response, body []byte) {
+	err := os.MkdirAll(t.dir, 0o755)
+	if err != nil {
+		return
+	}
+
+	fh, err := os.CreateTemp(t.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+
+	defer os.Remove(fh.Name())
+
+	err = newDiskCacheResponse(resp, body).Write(fh)
+	if closeErr := fh.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return
+	}
+
+	os.Rename(fh.Name(), cachePath)
+}
+
+func newDiskCacheResponse(resp *http.Response, body []byte) *http.Response {
+	respCopy := *resp
+	respCopy.Header = resp.Header.Clone()
+	respCopy.Body = io.NopCloser(bytes.NewReader(body))
+	respCopy.ContentLength = int64(len(body))
+	respCopy.TransferEncoding = nil
+
+	return &respCopy
+}
+
+// diskCacheFreshnessLifetime follows RFC 9111, Section 4.2.1, without any heuristic freshness.
+func diskCacheFreshnessLifetime(header http.Header, storedAt time.Time) time.Duration {
+	if diskCacheHasDirective(header, "no-cache") || diskCacheHasDirective(header, "no-store") {
+		return 0
+	}
+
+	for _, directive := range diskCacheDirectives(header) {
+		if value, ok := strings.CutPrefix(directive, "max-age="); ok {
+			seconds, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+			if err != nil || seconds < 0 {
+				return 0
+			}
+
+			return time.Duration(seconds) * time.Second
+		}
+	}
+
+	if expires := header.Get("Expires"); len(expires) > 0 {
+		expiresAt, err := http.ParseTime(expires)
+		if err != nil {
+			return 0
+		}
+
+		date := storedAt
+
+		if dateHeader := header.Get("Date"); len(dateHeader) > 0 {
+			if parsedDate, err := http.ParseTime(dateHeader); err == nil {
+				date = parsedDate
+			}
+		}
+
+		return expiresAt.Sub(date)
+	}
+
+	return 0
+}
+
+func diskCacheHasDirective(header http.Header, name string) bool {
+	for _, directive := range diskCacheDirectives(header) {
+		if directive == name || strings.HasPrefix(directive, name+"=") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func diskCacheDirectives(header http.Header) []string {
+	var directives []string
+
+	for _, value := range header.Values("Cache-Control") {
+		for _, directive := range strings.Split(value, ",") {
+			directives = append(directives, strings.ToLower(strings.TrimSpace(directive)))
+		}
+	}
+
+	return directives
+}
@@ -196,7 +196,7 @@ func (dl *DefaultDocumentLoader) LoadDocument(ctx context.Context, u string, opt
 		if err != nil {
 			return RemoteDocument{}, Error{
 				Code: LoadingDocumentFailed,
-				Err:  fmt.Errorf("parse (%s): %v", documentUrl, err),
+				Err:  fmt.Errorf("parse (%s): %w", documentUrl, err),
 			}
 		}
 
@@ -0,0 +1,248 @@
+package jsonldtype
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"testing/fstest"
+)
+
+func TestStaticDocumentLoader(t *testing.T) {
+	loader := NewStaticDocumentLoader(fstest.MapFS{
+		"schema.org/index.jsonld": &fstest.MapFile{
+			Data: []byte(`{"@context":{"@vocab":"https://schema.org/"}}`),
+		},
+		"www.w3.org/ns/credentials/v2": &fstest.MapFile{
+			Data: []byte(`{"@context":{}}`),
+		},
+	})
+
+	for _, u := range []string{
+		"https://schema.org",
+		"https://schema.org/",
+		"http://schema.org/#fragment",
+		"https://www.w3.org/ns/credentials/v2",
+	} {
+		remoteDocument, err := loader.LoadDocument(context.Background(), u, DocumentLoaderOptions{})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", u, err)
+		}
+
+		if _a, _e := remoteDocument.ContentType, "application/ld+json"; _a != _e {
+			t.Fatalf("%s: expected %q, got %q", u, _e, _a)
+		} else if remoteDocument.Document == nil {
+			t.Fatalf("%s: expected document", u)
+		}
+	}
+
+	for _, u := range []string{
+		"https://example.com/",
+		"https://schema.org/?query",
+		"urn:example",
+	} {
+		_, err := loader.LoadDocument(context.Background(), u, DocumentLoaderOptions{})
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Fatalf("%s: expected fs.ErrNotExist, got %v", u, err)
+		}
+
+		var errJSONLD Error
+		if !errors.As(err, &errJSONLD) {
+			t.Fatalf("%s: expected Error, got %T", u, err)
+		} else if _a, _e := errJSONLD.Code, LoadingDocumentFailed; _a != _e {
+			t.Fatalf("%s: expected %q, got %q", u, _e, _a)
+		}
+	}
+}
+
+func TestChainDocumentLoader(t *testing.T) {
+	var calls []string
+
+	newLoader := func(name string, err error) DocumentLoader {
+		return DocumentLoaderFunc(func(ctx context.Context, u string, opts DocumentLoaderOptions) (RemoteDocument, error) {
+			calls = append(calls, name)
+
+			if err != nil {
+				return RemoteDocument{}, err
+			}
+
+			return RemoteDocument{
+				Profile: name,
+			}, nil
+		})
+	}
+
+	errFirst := errors.New("first")
+
+	remoteDocument, err := NewChainDocumentLoader(
+		newLoader("first", errFirst),
+		newLoader("second", nil),
+		newLoader("third", nil),
+	).LoadDocument(context.Background(), "https://example.com/", DocumentLoaderOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := remoteDocument.Profile, "second"; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	} else if _a, _e := len(calls), 2; _a != _e {
+		t.Fatalf("expected %d, got %d", _e, _a)
+	}
+
+	errSecond := errors.New("second")
+
+	_, err = NewChainDocumentLoader(
+		newLoader("first", errFirst),
+		newLoader("second", errSecond),
+	).LoadDocument(context.Background(), "https://example.com/", DocumentLoaderOptions{})
+	if !errors.Is(err, errFirst) || !errors.Is(err, errSecond) {
+		t.Fatalf("expected joined errors, got %v", err)
+	}
+
+	_, err = NewChainDocumentLoader().LoadDocument(context.Background(), "https://example.com/", DocumentLoaderOptions{})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestLRUDocumentLoader(t *testing.T) {
+	var calls []string
+
+	loader := NewLRUDocumentLoader(
+		DocumentLoaderFunc(func(ctx context.Context, u string, opts DocumentLoaderOptions) (RemoteDocument, error) {
+			calls = append(calls, u)
+
+			documentURL, err := url.Parse(u)
+			if err != nil {
+				return RemoteDocument{}, err
+			}
+
+			return RemoteDocument{
+				DocumentURL: documentURL,
+			}, nil
+		}),
+		2,
+	)
+
+	for _, u := range []string{
+		"https://example.com/a",
+		"https://example.com/b",
+		"https://example.com/a",
+		"https://example.com/c",
+		"https://example.com/a",
+		"https://example.com/b",
+	} {
+		_, err := loader.LoadDocument(context.Background(), u, DocumentLoaderOptions{})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	expectedCalls := []string{
+		"https://example.com/a",
+		"https://example.com/b",
+		"https://example.com/c",
+		"https://example.com/b",
+	}
+
+	if _a, _e := len(calls), len(expectedCalls); _a != _e {
+		t.Fatalf("expected %d, got %d: %v", _e, _a, calls)
+	}
+
+	for i := range expectedCalls {
+		if _a, _e := calls[i], expectedCalls[i]; _a != _e {
+			t.Fatalf("[%d]: expected %q, got %q", i, _e, _a)
+		}
+	}
+}
+
+func TestDiskCacheTransport(t *testing.T) {
+	var requests []*http.Request
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r)
+
+		switch r.URL.Path {
+		case "/fresh":
+			w.Header().Set("Cache-Control", "max-age=3600")
+		case "/revalidate":
+			w.Header().Set("Cache-Control", "no-cache")
+			w.Header().Set("ETag", `"v1"`)
+
+			if r.Header.Get("If-None-Match") == `"v1"` {
+				w.WriteHeader(http.StatusNotModified)
+
+				return
+			}
+		case "/no-store":
+			w.Header().Set("Cache-Control", "no-store")
+		}
+
+		w.Write([]byte(r.URL.Path))
+	}))
+	defer server.Close()
+
+	client := &http.Client{
+		Transport: NewDiskCacheTransport(t.TempDir(), nil),
+	}
+
+	get := func(path string) string {
+		resp, err := client.Get(server.URL + path)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", path, err)
+		}
+
+		defer resp.Body.Close()
+
+		if _a, _e := resp.StatusCode, http.StatusOK; _a != _e {
+			t.Fatalf("%s: expected %d, got %d", path, _e, _a)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", path, err)
+		}
+
+		return string(body)
+	}
+
+	for _, tc := range []struct {
+		Path             string
+		ExpectedRequests int
+	}{
+		{
+			Path:             "/fresh",
+			ExpectedRequests: 1,
+		},
+		{
+			Path:             "/revalidate",
+			ExpectedRequests: 2,
+		},
+		{
+			Path:             "/no-store",
+			ExpectedRequests: 2,
+		},
+	} {
+		t.Run(tc.Path, func(t *testing.T) {
+			requests = nil
+
+			for range 2 {
+				if _a, _e := get(tc.Path), tc.Path; _a != _e {
+					t.Fatalf("expected %q, got %q", _e, _a)
+				}
+			}
+
+			if _a, _e := len(requests), tc.ExpectedRequests; _a != _e {
+				t.Fatalf("expected %d, got %d", _e, _a)
+			}
+		})
+	}
+
+	server.Close()
+
+	if _a, _e := get("/revalidate"), "/revalidate"; _a != _e {
+		t.Fatalf("stale: expected %q, got %q", _e, _a)
+	}
+}
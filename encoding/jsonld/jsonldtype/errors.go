@@ -30,6 +30,7 @@ const (
 	ConflictingIndexes          ErrorCode = "conflicting indexes"
 	ContextOverflow             ErrorCode = "context overflow"
 	CyclicIRIMapping            ErrorCode = "cyclic IRI mapping"
+	InvalidAtEmbedValue         ErrorCode = "invalid @embed value"
 	InvalidAtIDValue            ErrorCode = "invalid @id value"
 	InvalidAtImportValue        ErrorCode = "invalid @import value"
 	InvalidAtIncludedValue      ErrorCode = "invalid @included value"
@@ -46,6 +47,7 @@ const (
 	InvalidContextEntry         ErrorCode = "invalid context entry"
 	InvalidContextNullification ErrorCode = "invalid context nullification"
 	InvalidDefaultLanguage      ErrorCode = "invalid default language"
+	InvalidFrame                ErrorCode = "invalid frame"
 	InvalidIRIMapping           ErrorCode = "invalid IRI mapping"
 	InvalidJSONLiteral          ErrorCode = "invalid JSON literal"
 	InvalidKeywordAlias         ErrorCode = "invalid keyword alias"
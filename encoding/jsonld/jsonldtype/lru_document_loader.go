@@ -0,0 +1,86 @@
+package jsonldtype
+
+import (
+	"container/list"
+	"context"
+	"sync"
+)
+
+type lruDocumentLoaderEntry struct {
+	key            string
+	remoteDocument RemoteDocument
+}
+
+// LRUDocumentLoader caches successfully loaded documents in memory, discarding the least recently used documents once
+// the capacity is reached. Unlike [CachingDocumentLoader], errors are not cached, and it is safe for concurrent use.
+type LRUDocumentLoader struct {
+	upstream DocumentLoader
+	capacity int
+
+	mu      sync.Mutex
+	entries *list.List
+	index   map[string]*list.Element
+}
+
+var _ DocumentLoader = &LRUDocumentLoader{}
+
+func NewLRUDocumentLoader(upstream DocumentLoader, capacity int) *LRUDocumentLoader {
+	return &LRUDocumentLoader{
+		upstream: upstream,
+		capacity: max(capacity, 1),
+		entries:  list.New(),
+		index:    map[string]*list.Element{},
+	}
+}
+
+func (dl *LRUDocumentLoader) LoadDocument(ctx context.Context, u string, opts DocumentLoaderOptions) (RemoteDocument, error) {
+	cacheKey := documentLoaderCacheKey(u, opts)
+
+	dl.mu.Lock()
+
+	if element, ok := dl.index[cacheKey]; ok {
+		dl.entries.MoveToFront(element)
+		dl.mu.Unlock()
+
+		return element.Value.(*lruDocumentLoaderEntry).remoteDocument, nil
+	}
+
+	dl.mu.Unlock()
+
+	remoteDocument, err := dl.upstream.LoadDocument(ctx, u, opts)
+	if err != nil {
+		return RemoteDocument{}, err
+	}
+
+	dl.mu.Lock()
+	defer dl.mu.Unlock()
+
+	dl.add(cacheKey, remoteDocument)
+
+	if remoteDocument.DocumentURL != nil && remoteDocument.DocumentURL.String() != u {
+		dl.add(documentLoaderCacheKey(remoteDocument.DocumentURL.String(), opts), remoteDocument)
+	}
+
+	return remoteDocument, nil
+}
+
+func (dl *LRUDocumentLoader) add(cacheKey string, remoteDocument RemoteDocument) {
+	if element, ok := dl.index[cacheKey]; ok {
+		element.Value.(*lruDocumentLoaderEntry).remoteDocument = remoteDocument
+		dl.entries.MoveToFront(element)
+
+		return
+	}
+
+	dl.index[cacheKey] = dl.entries.PushFront(&lruDocumentLoaderEntry{
+		key:            cacheKey,
+		remoteDocument: remoteDocument,
+	})
+
+	for dl.entries.Len() > dl.capacity {
+		oldest := dl.entries.Back()
+
+		dl.entries.Remove(oldest)
+		delete(dl.index, oldest.Value.(*lruDocumentLoaderEntry).key)
+	}
+}
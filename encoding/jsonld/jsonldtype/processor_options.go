@@ -7,4 +7,41 @@ type ProcessorOptions struct {
 	BaseURL        string
 	DocumentLoader DocumentLoader
 	ExpandContext  inspectjson.Value
+
+	// MaxRemoteContexts limits the number of remote contexts (including @import) loaded for a document, if greater than
+	// zero. Contexts which were already loaded for the document are not counted again.
+	MaxRemoteContexts int
+
+	// CompactArrays replaces arrays with a single element with that element during compaction.
+	CompactArrays bool
+
+	// CompactToRelative compacts IRIs relative to BaseURL during compaction.
+	CompactToRelative bool
+
+	// RDFDirection is one of "", "i18n-datatype", or "compound-literal".
+	RDFDirection string
+
+	// UseNativeTypes converts xsd:boolean, xsd:integer, and xsd:double literals to native JSON values.
+	UseNativeTypes bool
+
+	// UseRdfType keeps rdf:type statements as properties rather than converting them to @type.
+	UseRdfType bool
+
+	// Embed is the default @embed flag of frames: "@always", "@once", or "@never". By default, "@once".
+	Embed string
+
+	// Explicit is the default @explicit flag of frames.
+	Explicit bool
+
+	// OmitDefault is the default @omitDefault flag of frames.
+	OmitDefault bool
+
+	// RequireAll is the default @requireAll flag of frames.
+	RequireAll bool
+
+	// FrameDefault frames only the default graph, rather than the merge of all graphs.
+	FrameDefault bool
+
+	// OmitGraph omits the top-level @graph of framed results with a single node object.
+	OmitGraph bool
 }
@@ -0,0 +1,311 @@
+package jsonldtype
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// RemoteDocumentPolicy restricts which remote documents may be loaded, and is intended for processing untrusted
+// documents. Use [NewPolicyTransport] to enforce it for HTTP requests, including redirects, and [NewPolicyDocumentLoader]
+// to enforce it for any document loader.
+//
+// The number of remote contexts loaded for a single document is limited by the MaxRemoteContexts processor option.
+type RemoteDocumentPolicy struct {
+	// AllowedSchemes are the permitted URL schemes. By default, "http" and "https".
+	AllowedSchemes []string
+
+	// AllowedHosts are the permitted hosts, if any are configured. A leading "*." matches any subdomain.
+	AllowedHosts []string
+
+	// DeniedHosts are forbidden hosts, taking precedence over AllowedHosts. A leading "*." matches any subdomain.
+	DeniedHosts []string
+
+	// AllowPrivateAddresses permits connecting to loopback, link-local, private, and other non-public addresses.
+	AllowPrivateAddresses bool
+
+	// MaxDocumentSize is the maximum number of (decompressed) bytes of a response body, if greater than zero.
+	MaxDocumentSize int64
+
+	// Timeout is the maximum duration for loading a single document, if greater than zero.
+	Timeout time.Duration
+}
+
+// CheckURL returns a [RemoteDocumentPolicyError] if the scheme or host of u is not permitted.
+func (p RemoteDocumentPolicy) CheckURL(u *url.URL) error {
+	allowedSchemes := p.AllowedSchemes
+	if len(allowedSchemes) == 0 {
+		allowedSchemes = []string{"http", "https"}
+	}
+
+	if !slices.Contains(allowedSchemes, strings.ToLower(u.Scheme)) {
+		return RemoteDocumentPolicyError{
+			URL:    u.String(),
+			Reason: RemoteDocumentPolicyReasonSchemeNotAllowed,
+		}
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+
+	if len(host) == 0 || remoteDocumentPolicyMatchHost(p.DeniedHosts, host) {
+		return RemoteDocumentPolicyError{
+			URL:    u.String(),
+			Reason: RemoteDocumentPolicyReasonHostNotAllowed,
+		}
+	} else if len(p.AllowedHosts) > 0 && !remoteDocumentPolicyMatchHost(p.AllowedHosts, host) {
+		return RemoteDocumentPolicyError{
+			URL:    u.String(),
+			Reason: RemoteDocumentPolicyReasonHostNotAllowed,
+		}
+	}
+
+	if !p.AllowPrivateAddresses {
+		if addr, err := netip.ParseAddr(host); err == nil && !isRemoteDocumentPolicyPublicAddr(addr) {
+			return RemoteDocumentPolicyError{
+				URL:    u.String(),
+				Reason: RemoteDocumentPolicyReasonAddressNotAllowed,
+			}
+		}
+	}
+
+	return nil
+}
+
+func remoteDocumentPolicyMatchHost(patterns []string, host string) bool {
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(strings.TrimSuffix(pattern, "."))
+
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			if strings.HasSuffix(host, "."+suffix) {
+				return true
+			}
+		} else if host == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+var remoteDocumentPolicySharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+func isRemoteDocumentPolicyPublicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	return addr.IsGlobalUnicast() &&
+		!addr.IsPrivate() &&
+		!remoteDocumentPolicySharedAddressSpace.Contains(addr)
+}
+
+//
+
+type RemoteDocumentPolicyReason string
+
+const (
+	RemoteDocumentPolicyReasonSchemeNotAllowed      RemoteDocumentPolicyReason = "scheme not allowed"
+	RemoteDocumentPolicyReasonHostNotAllowed        RemoteDocumentPolicyReason = "host not allowed"
+	RemoteDocumentPolicyReasonAddressNotAllowed     RemoteDocumentPolicyReason = "address not allowed"
+	RemoteDocumentPolicyReasonDocumentTooLarge      RemoteDocumentPolicyReason = "document too large"
+	RemoteDocumentPolicyReasonTooManyRemoteContexts RemoteDocumentPolicyReason = "too many remote contexts"
+)
+
+// RemoteDocumentPolicyError is returned when loading a remote document is refused by a [RemoteDocumentPolicy] or the
+// MaxRemoteContexts processor option. Decoders and processors wrap it within an [Error], so use [errors.As] to detect
+// it.
+type RemoteDocumentPolicyError struct {
+	URL    string
+	Reason RemoteDocumentPolicyReason
+}
+
+var _ error = RemoteDocumentPolicyError{}
+
+func (e RemoteDocumentPolicyError) Error() string {
+	return fmt.Sprintf("remote document policy: %s: %s", e.Reason, e.URL)
+}
+
+//
+
+// PolicyDocumentLoader checks the URL of requested and loaded documents against a [RemoteDocumentPolicy], and applies
+// its Timeout. Since it cannot observe the redirects or connections of its upstream, network loaders should also use
+// [NewPolicyTransport].
+type PolicyDocumentLoader struct {
+	upstream DocumentLoader
+	policy   RemoteDocumentPolicy
+}
+
+var _ DocumentLoader = &PolicyDocumentLoader{}
+
+func NewPolicyDocumentLoader(upstream DocumentLoader, policy RemoteDocumentPolicy) *PolicyDocumentLoader {
+	return &PolicyDocumentLoader{
+		upstream: upstream,
+		policy:   policy,
+	}
+}
+
+func (dl *PolicyDocumentLoader) LoadDocument(ctx context.Context, u string, opts DocumentLoaderOptions) (RemoteDocument, error) {
+	parsedURL, err := url.Parse(u)
+	if err != nil {
+		return RemoteDocument{}, Error{
+			Code: LoadingDocumentFailed,
+			Err:  err,
+		}
+	}
+
+	err = dl.policy.CheckURL(parsedURL)
+	if err != nil {
+		return RemoteDocument{}, err
+	}
+
+	if dl.policy.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, dl.policy.Timeout)
+		defer cancel()
+	}
+
+	remoteDocument, err := dl.upstream.LoadDocument(ctx, u, opts)
+	if err != nil {
+		return RemoteDocument{}, err
+	}
+
+	if remoteDocument.DocumentURL != nil {
+		err = dl.policy.CheckURL(remoteDocument.DocumentURL)
+		if err != nil {
+			return RemoteDocument{}, err
+		}
+	}
+
+	return remoteDocument, nil
+}
+
+//
+
+// PolicyTransport is an [http.RoundTripper] which enforces a [RemoteDocumentPolicy] for every request, including
+// redirects. Addresses are checked after name resolution, when connecting, and proxies are not used.
+//
+// To limit the decompressed size of responses, the Accept-Encoding header of requests is removed so that compression is
+// negotiated and decoded by the transport.
+type PolicyTransport struct {
+	policy    RemoteDocumentPolicy
+	transport *http.Transport
+}
+
+var _ http.RoundTripper = &PolicyTransport{}
+
+// NewPolicyTransport creates a transport based on a clone of upstream. If upstream is nil, [http.DefaultTransport] is
+// used.
+func NewPolicyTransport(policy RemoteDocumentPolicy, upstream *http.Transport) *PolicyTransport {
+	if upstream == nil {
+		upstream = http.DefaultTransport.(*http.Transport)
+	}
+
+	transport := upstream.Clone()
+	transport.Proxy = nil
+
+	if !policy.AllowPrivateAddresses {
+		dialer := &net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				addrPort, err := netip.ParseAddrPort(address)
+				if err != nil {
+					return err
+				}
+
+				if !isRemoteDocumentPolicyPublicAddr(addrPort.Addr()) {
+					return RemoteDocumentPolicyError{
+						URL:    address,
+						Reason: RemoteDocumentPolicyReasonAddressNotAllowed,
+					}
+				}
+
+				return nil
+			},
+		}
+
+		transport.DialContext = dialer.DialContext
+		transport.DialTLSContext = nil
+	}
+
+	return &PolicyTransport{
+		policy:    policy,
+		transport: transport,
+	}
+}
+
+func (t *PolicyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	err := t.policy.CheckURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Header.Get("Accept-Encoding")) > 0 {
+		req = req.Clone(req.Context())
+		req.Header.Del("Accept-Encoding")
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.policy.MaxDocumentSize > 0 {
+		if resp.ContentLength > t.policy.MaxDocumentSize {
+			resp.Body.Close()
+
+			return nil, RemoteDocumentPolicyError{
+				URL:    req.URL.String(),
+				Reason: RemoteDocumentPolicyReasonDocumentTooLarge,
+			}
+		}
+
+		resp.Body = &policyLimitedBody{
+			ReadCloser: resp.Body,
+			url:        req.URL.String(),
+			remaining:  t.policy.MaxDocumentSize,
+		}
+	}
+
+	return resp, nil
+}
+
+type policyLimitedBody struct {
+	io.ReadCloser
+	url       string
+	remaining int64
+}
+
+func (b *policyLimitedBody) Read(p []byte) (int, error) {
+	if b.remaining < 0 {
+		return 0, RemoteDocumentPolicyError{
+			URL:    b.url,
+			Reason: RemoteDocumentPolicyReasonDocumentTooLarge,
+		}
+	}
+
+	// read one more byte than permitted to detect oversized documents
+
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+
+	n, err := b.ReadCloser.Read(p)
+	b.remaining -= int64(n)
+
+	if b.remaining < 0 {
+		return n, RemoteDocumentPolicyError{
+			URL:    b.url,
+			Reason: RemoteDocumentPolicyReasonDocumentTooLarge,
+		}
+	}
+
+	return n, err
+}
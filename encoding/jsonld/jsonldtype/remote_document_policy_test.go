@@ -0,0 +1,167 @@
+package jsonldtype
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestRemoteDocumentPolicy_CheckURL(t *testing.T) {
+	policy := RemoteDocumentPolicy{
+		AllowedHosts: []string{"schema.org", "*.example.com"},
+		DeniedHosts:  []string{"private.example.com"},
+	}
+
+	for _, tc := range []struct {
+		URL            string
+		ExpectedReason RemoteDocumentPolicyReason
+	}{
+		{
+			URL: "https://schema.org/",
+		},
+		{
+			URL: "http://WWW.Example.com./context.jsonld",
+		},
+		{
+			URL:            "https://example.com/",
+			ExpectedReason: RemoteDocumentPolicyReasonHostNotAllowed,
+		},
+		{
+			URL:            "https://private.example.com/",
+			ExpectedReason: RemoteDocumentPolicyReasonHostNotAllowed,
+		},
+		{
+			URL:            "https://schema.org.evil.test/",
+			ExpectedReason: RemoteDocumentPolicyReasonHostNotAllowed,
+		},
+		{
+			URL:            "file:///etc/passwd",
+			ExpectedReason: RemoteDocumentPolicyReasonSchemeNotAllowed,
+		},
+		{
+			URL:            "ftp://schema.org/",
+			ExpectedReason: RemoteDocumentPolicyReasonSchemeNotAllowed,
+		},
+	} {
+		parsedURL, err := url.Parse(tc.URL)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.URL, err)
+		}
+
+		err = policy.CheckURL(parsedURL)
+		assertRemoteDocumentPolicyError(t, tc.URL, err, tc.ExpectedReason)
+	}
+
+	for _, u := range []string{
+		"http://127.0.0.1/",
+		"http://[::1]/",
+		"http://10.1.2.3/",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[::ffff:192.168.0.1]/",
+		"http://0.0.0.0/",
+	} {
+		parsedURL, err := url.Parse(u)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", u, err)
+		}
+
+		err = RemoteDocumentPolicy{}.CheckURL(parsedURL)
+		assertRemoteDocumentPolicyError(t, u, err, RemoteDocumentPolicyReasonAddressNotAllowed)
+
+		err = RemoteDocumentPolicy{AllowPrivateAddresses: true}.CheckURL(parsedURL)
+		assertRemoteDocumentPolicyError(t, u, err, "")
+	}
+}
+
+func TestPolicyTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/redirect":
+			http.Redirect(w, r, "http://denied.test/context.jsonld", http.StatusFound)
+		case "/large":
+			w.Header().Set("Content-Type", "application/ld+json")
+			w.Write([]byte(`{"@context":{"name":"` + strings.Repeat("x", 4096) + `"}}`))
+		default:
+			w.Header().Set("Content-Type", "application/ld+json")
+			w.Write([]byte(`{"@context":{}}`))
+		}
+	}))
+	defer server.Close()
+
+	newLoader := func(policy RemoteDocumentPolicy) DocumentLoader {
+		return NewPolicyDocumentLoader(
+			NewDefaultDocumentLoader(&http.Client{
+				Transport: NewPolicyTransport(policy, nil),
+			}),
+			policy,
+		)
+	}
+
+	for _, tc := range []struct {
+		Name           string
+		Path           string
+		Policy         RemoteDocumentPolicy
+		ExpectedReason RemoteDocumentPolicyReason
+	}{
+		{
+			Name: "allowed",
+			Path: "/context.jsonld",
+			Policy: RemoteDocumentPolicy{
+				AllowPrivateAddresses: true,
+				MaxDocumentSize:       1024,
+			},
+		},
+		{
+			Name:           "private address",
+			Path:           "/context.jsonld",
+			Policy:         RemoteDocumentPolicy{},
+			ExpectedReason: RemoteDocumentPolicyReasonAddressNotAllowed,
+		},
+		{
+			Name: "redirect",
+			Path: "/redirect",
+			Policy: RemoteDocumentPolicy{
+				AllowPrivateAddresses: true,
+				DeniedHosts:           []string{"denied.test"},
+			},
+			ExpectedReason: RemoteDocumentPolicyReasonHostNotAllowed,
+		},
+		{
+			Name: "document size",
+			Path: "/large",
+			Policy: RemoteDocumentPolicy{
+				AllowPrivateAddresses: true,
+				MaxDocumentSize:       1024,
+			},
+			ExpectedReason: RemoteDocumentPolicyReasonDocumentTooLarge,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := newLoader(tc.Policy).LoadDocument(context.Background(), server.URL+tc.Path, DocumentLoaderOptions{})
+			assertRemoteDocumentPolicyError(t, tc.Path, err, tc.ExpectedReason)
+		})
+	}
+}
+
+func assertRemoteDocumentPolicyError(t *testing.T, name string, err error, expectedReason RemoteDocumentPolicyReason) {
+	t.Helper()
+
+	if len(expectedReason) == 0 {
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", name, err)
+		}
+
+		return
+	}
+
+	var errPolicy RemoteDocumentPolicyError
+	if !errors.As(err, &errPolicy) {
+		t.Fatalf("%s: expected RemoteDocumentPolicyError, got %v", name, err)
+	} else if _a, _e := errPolicy.Reason, expectedReason; _a != _e {
+		t.Fatalf("%s: expected %q, got %q", name, _e, _a)
+	}
+}
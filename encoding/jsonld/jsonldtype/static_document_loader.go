@@ -0,0 +1,96 @@
+package jsonldtype
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+)
+
+// StaticDocumentLoader loads preloaded documents from a file system, without any network access. Documents are
+// located by the host and path of their URL, ignoring the scheme and fragment. URLs with an empty path, or a path
+// ending with a slash, use an index.jsonld file. For example:
+//
+//   - https://schema.org/ is loaded from schema.org/index.jsonld
+//   - https://www.w3.org/ns/credentials/v2 is loaded from www.w3.org/ns/credentials/v2
+//
+// Unknown documents result in an error which matches [fs.ErrNotExist].
+type StaticDocumentLoader struct {
+	fsys fs.FS
+}
+
+var _ DocumentLoader = &StaticDocumentLoader{}
+
+func NewStaticDocumentLoader(fsys fs.FS) *StaticDocumentLoader {
+	return &StaticDocumentLoader{
+		fsys: fsys,
+	}
+}
+
+func (dl *StaticDocumentLoader) LoadDocument(ctx context.Context, u string, opts DocumentLoaderOptions) (RemoteDocument, error) {
+	documentURL, err := url.Parse(u)
+	if err != nil {
+		return RemoteDocument{}, Error{
+			Code: LoadingDocumentFailed,
+			Err:  fmt.Errorf("parse url: %v", err),
+		}
+	}
+
+	documentURL.Fragment = ""
+
+	documentPath, err := staticDocumentPath(documentURL)
+	if err != nil {
+		return RemoteDocument{}, Error{
+			Code: LoadingDocumentFailed,
+			Err:  err,
+		}
+	}
+
+	fh, err := dl.fsys.Open(documentPath)
+	if err != nil {
+		return RemoteDocument{}, Error{
+			Code: LoadingDocumentFailed,
+			Err:  fmt.Errorf("open: %w", err),
+		}
+	}
+
+	defer fh.Close()
+
+	parsed, err := inspectjson.Parse(fh)
+	if err != nil {
+		return RemoteDocument{}, Error{
+			Code: LoadingDocumentFailed,
+			Err:  fmt.Errorf("parse (%s): %v", documentPath, err),
+		}
+	}
+
+	return RemoteDocument{
+		ContentType: "application/ld+json",
+		Document:    parsed,
+		DocumentURL: documentURL,
+	}, nil
+}
+
+func staticDocumentPath(u *url.URL) (string, error) {
+	if len(u.Host) == 0 {
+		return "", fmt.Errorf("unsupported url: missing host: %w", fs.ErrNotExist)
+	} else if len(u.RawQuery) > 0 {
+		return "", fmt.Errorf("unsupported url: query: %w", fs.ErrNotExist)
+	}
+
+	documentPath := path.Join(u.Host, u.Path)
+
+	if len(u.Path) == 0 || strings.HasSuffix(u.Path, "/") {
+		documentPath = path.Join(documentPath, "index.jsonld")
+	}
+
+	if !fs.ValidPath(documentPath) {
+		return "", fmt.Errorf("unsupported url: invalid path: %w", fs.ErrNotExist)
+	}
+
+	return documentPath, nil
+}
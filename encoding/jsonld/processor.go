@@ -0,0 +1,162 @@
+package jsonld
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/internal/jsonldinternal"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+// Expand removes the context from a JSON-LD document and returns its expanded form.
+//
+// See https://www.w3.org/TR/json-ld11-api/#dom-jsonldprocessor-expand
+func Expand(ctx context.Context, input inspectjson.Value, opts ...ProcessorOption) ([]any, error) {
+	compiledOpts := newProcessorConfig(opts)
+
+	popts, err := compiledOpts.newProcessorOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	expanded, err := jsonldinternal.Expand(ctx, input, popts)
+	if err != nil {
+		return nil, fmt.Errorf("expand: %w", err)
+	}
+
+	return expanded.AsBuiltin().([]any), nil
+}
+
+// Compact expands a JSON-LD document and then compacts it according to localContext, which may be a context
+// definition, an IRI of a remote context, an array of either, or a map with an @context entry.
+//
+// See https://www.w3.org/TR/json-ld11-api/#dom-jsonldprocessor-compact
+func Compact(ctx context.Context, input inspectjson.Value, localContext inspectjson.Value, opts ...ProcessorOption) (map[string]any, error) {
+	compiledOpts := newProcessorConfig(opts)
+
+	popts, err := compiledOpts.newProcessorOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	compacted, err := jsonldinternal.Compact(ctx, input, localContext, popts)
+	if err != nil {
+		return nil, fmt.Errorf("compact: %w", err)
+	}
+
+	return compacted, nil
+}
+
+// Flatten expands a JSON-LD document and collects all of its node objects into a single list, labeling any blank
+// nodes. If localContext is not nil, the result is compacted and returned as a map with a @graph entry; otherwise the
+// result is an array of expanded node objects.
+//
+// See https://www.w3.org/TR/json-ld11-api/#dom-jsonldprocessor-flatten
+func Flatten(ctx context.Context, input inspectjson.Value, localContext inspectjson.Value, opts ...ProcessorOption) (any, error) {
+	compiledOpts := newProcessorConfig(opts)
+
+	popts, err := compiledOpts.newProcessorOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	flattened, err := jsonldinternal.Flatten(ctx, input, localContext, popts)
+	if err != nil {
+		return nil, fmt.Errorf("flatten: %w", err)
+	}
+
+	return flattened, nil
+}
+
+// Frame expands a JSON-LD document and reshapes its nodes to match frame, which is then compacted according to the
+// @context of frame. By default, the nodes of all graphs are merged before framing.
+//
+// See https://www.w3.org/TR/json-ld11-framing/#dom-jsonldprocessor-frame
+func Frame(ctx context.Context, input inspectjson.Value, frame inspectjson.Value, opts ...ProcessorOption) (map[string]any, error) {
+	compiledOpts := newProcessorConfig(opts)
+
+	popts, err := compiledOpts.newProcessorOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	framed, err := jsonldinternal.Frame(ctx, input, frame, popts)
+	if err != nil {
+		return nil, fmt.Errorf("frame: %w", err)
+	}
+
+	return framed, nil
+}
+
+// ToRDF expands a JSON-LD document and converts it into an RDF dataset.
+//
+// See https://www.w3.org/TR/json-ld11-api/#dom-jsonldprocessor-tordf
+func ToRDF(ctx context.Context, input inspectjson.Value, opts ...ProcessorOption) (rdf.QuadList, error) {
+	compiledOpts := newProcessorConfig(opts)
+
+	popts, err := compiledOpts.newProcessorOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	d := &Decoder{
+		statementsIdx:     -1,
+		defaultBase:       popts.BaseURL,
+		bnStringFactory:   compiledOpts.bnStringFactory,
+		processingMode:    popts.ProcessingMode,
+		documentLoader:    popts.DocumentLoader,
+		expandContext:     popts.ExpandContext,
+		maxRemoteContexts: popts.MaxRemoteContexts,
+		rdfDirection:      popts.RDFDirection,
+		buildTextOffsets:  encodingutil.BuildTextOffsetsNil,
+	}
+
+	if d.bnStringFactory == nil {
+		d.bnStringFactory = blanknodes.NewStringFactory()
+	}
+
+	err = d.decodeDocument(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	quads := make(rdf.QuadList, 0, len(d.statements))
+
+	for _, s := range d.statements {
+		quads = append(quads, s.quad)
+	}
+
+	return quads, nil
+}
+
+// FromRDF converts an RDF dataset into an expanded JSON-LD document.
+//
+// See https://www.w3.org/TR/json-ld11-api/#dom-jsonldprocessor-fromrdf
+func FromRDF(ctx context.Context, dataset rdf.QuadList, opts ...ProcessorOption) ([]any, error) {
+	compiledOpts := newProcessorConfig(opts)
+
+	popts, err := compiledOpts.newProcessorOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	expanded, err := jsonldinternal.FromRDF(dataset, compiledOpts.bnStringProvider, popts)
+	if err != nil {
+		return nil, fmt.Errorf("from rdf: %w", err)
+	}
+
+	return expanded, nil
+}
+
+func newProcessorConfig(opts []ProcessorOption) ProcessorConfig {
+	compiledOpts := ProcessorConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&compiledOpts)
+	}
+
+	return compiledOpts
+}
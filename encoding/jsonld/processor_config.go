@@ -0,0 +1,326 @@
+package jsonld
+
+import (
+	"fmt"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/internal/jsonldinternal"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+type ProcessorOption interface {
+	apply(s *ProcessorConfig)
+}
+
+type ProcessorConfig struct {
+	base              *string
+	processingMode    *string
+	documentLoader    jsonldtype.DocumentLoader
+	expandContext     inspectjson.Value
+	maxRemoteContexts *int
+	compactArrays     *bool
+	compactToRelative *bool
+	rdfDirection      *string
+	useNativeTypes    *bool
+	useRdfType        *bool
+	embed             *string
+	explicit          *bool
+	omitDefault       *bool
+	requireAll        *bool
+	frameDefault      *bool
+	omitGraph         *bool
+
+	bnStringProvider blanknodes.StringProvider
+	bnStringFactory  blanknodes.StringFactory
+}
+
+var _ ProcessorOption = ProcessorConfig{}
+
+// SetBase is used for resolving relative IRIs, and, with compactToRelative, for compacting IRIs relative to it.
+func (b ProcessorConfig) SetBase(v string) ProcessorConfig {
+	b.base = &v
+
+	return b
+}
+
+func (b ProcessorConfig) SetProcessingMode(v string) ProcessorConfig {
+	b.processingMode = &v
+
+	return b
+}
+
+// SetDocumentLoader is used for dereferencing remote contexts. By default, [DefaultDocumentLoader] is used.
+func (b ProcessorConfig) SetDocumentLoader(v jsonldtype.DocumentLoader) ProcessorConfig {
+	b.documentLoader = v
+
+	return b
+}
+
+func (b ProcessorConfig) SetExpandContext(v inspectjson.Value) ProcessorConfig {
+	b.expandContext = v
+
+	return b
+}
+
+// SetMaxRemoteContexts limits the number of remote contexts loaded for a document, if greater than zero. Exceeding it
+// results in a [jsonldtype.RemoteDocumentPolicyError].
+func (b ProcessorConfig) SetMaxRemoteContexts(v int) ProcessorConfig {
+	b.maxRemoteContexts = &v
+
+	return b
+}
+
+// SetCompactArrays replaces arrays with a single element with that element during compaction. By default, true.
+func (b ProcessorConfig) SetCompactArrays(v bool) ProcessorConfig {
+	b.compactArrays = &v
+
+	return b
+}
+
+// SetCompactToRelative compacts IRIs relative to the base during compaction. By default, true.
+func (b ProcessorConfig) SetCompactToRelative(v bool) ProcessorConfig {
+	b.compactToRelative = &v
+
+	return b
+}
+
+// SetRDFDirection is one of "i18n-datatype" or "compound-literal" for converting base directions to and from RDF.
+func (b ProcessorConfig) SetRDFDirection(v string) ProcessorConfig {
+	b.rdfDirection = &v
+
+	return b
+}
+
+// SetUseNativeTypes converts xsd:boolean, xsd:integer, and xsd:double literals to native JSON values in [FromRDF].
+func (b ProcessorConfig) SetUseNativeTypes(v bool) ProcessorConfig {
+	b.useNativeTypes = &v
+
+	return b
+}
+
+// SetUseRdfType keeps rdf:type statements as properties rather than @type in [FromRDF].
+func (b ProcessorConfig) SetUseRdfType(v bool) ProcessorConfig {
+	b.useRdfType = &v
+
+	return b
+}
+
+// SetEmbed is one of "@always", "@once", or "@never" for the default embedding of nodes in [Frame]. By default,
+// "@once".
+func (b ProcessorConfig) SetEmbed(v string) ProcessorConfig {
+	b.embed = &v
+
+	return b
+}
+
+// SetExplicit only includes properties which are present in the frame in [Frame]. By default, false.
+func (b ProcessorConfig) SetExplicit(v bool) ProcessorConfig {
+	b.explicit = &v
+
+	return b
+}
+
+// SetOmitDefault skips adding default values for properties missing from framed nodes in [Frame]. By default, false.
+func (b ProcessorConfig) SetOmitDefault(v bool) ProcessorConfig {
+	b.omitDefault = &v
+
+	return b
+}
+
+// SetRequireAll requires all properties of a frame to match, rather than any, in [Frame]. By default, false.
+func (b ProcessorConfig) SetRequireAll(v bool) ProcessorConfig {
+	b.requireAll = &v
+
+	return b
+}
+
+// SetFrameDefault frames only the default graph, rather than the merge of all graphs, in [Frame]. By default, false.
+func (b ProcessorConfig) SetFrameDefault(v bool) ProcessorConfig {
+	b.frameDefault = &v
+
+	return b
+}
+
+// SetOmitGraph omits the top-level @graph entry when a single node is framed in [Frame]. By default, true unless the
+// processing mode is json-ld-1.0.
+func (b ProcessorConfig) SetOmitGraph(v bool) ProcessorConfig {
+	b.omitGraph = &v
+
+	return b
+}
+
+// SetBlankNodeStringProvider is used for labeling blank nodes in [FromRDF].
+func (b ProcessorConfig) SetBlankNodeStringProvider(v blanknodes.StringProvider) ProcessorConfig {
+	b.bnStringProvider = v
+
+	return b
+}
+
+// SetBlankNodeStringFactory is used for creating blank nodes in [ToRDF].
+func (b ProcessorConfig) SetBlankNodeStringFactory(v blanknodes.StringFactory) ProcessorConfig {
+	b.bnStringFactory = v
+
+	return b
+}
+
+func (b ProcessorConfig) apply(s *ProcessorConfig) {
+	if b.base != nil {
+		s.base = b.base
+	}
+
+	if b.processingMode != nil {
+		s.processingMode = b.processingMode
+	}
+
+	if b.documentLoader != nil {
+		s.documentLoader = b.documentLoader
+	}
+
+	if b.expandContext != nil {
+		s.expandContext = b.expandContext
+	}
+
+	if b.maxRemoteContexts != nil {
+		s.maxRemoteContexts = b.maxRemoteContexts
+	}
+
+	if b.compactArrays != nil {
+		s.compactArrays = b.compactArrays
+	}
+
+	if b.compactToRelative != nil {
+		s.compactToRelative = b.compactToRelative
+	}
+
+	if b.rdfDirection != nil {
+		s.rdfDirection = b.rdfDirection
+	}
+
+	if b.useNativeTypes != nil {
+		s.useNativeTypes = b.useNativeTypes
+	}
+
+	if b.useRdfType != nil {
+		s.useRdfType = b.useRdfType
+	}
+
+	if b.embed != nil {
+		s.embed = b.embed
+	}
+
+	if b.explicit != nil {
+		s.explicit = b.explicit
+	}
+
+	if b.omitDefault != nil {
+		s.omitDefault = b.omitDefault
+	}
+
+	if b.requireAll != nil {
+		s.requireAll = b.requireAll
+	}
+
+	if b.frameDefault != nil {
+		s.frameDefault = b.frameDefault
+	}
+
+	if b.omitGraph != nil {
+		s.omitGraph = b.omitGraph
+	}
+
+	if b.bnStringProvider != nil {
+		s.bnStringProvider = b.bnStringProvider
+	}
+
+	if b.bnStringFactory != nil {
+		s.bnStringFactory = b.bnStringFactory
+	}
+}
+
+func (b ProcessorConfig) newProcessorOptions() (jsonldtype.ProcessorOptions, error) {
+	opts := jsonldtype.ProcessorOptions{
+		DocumentLoader:    b.documentLoader,
+		ExpandContext:     b.expandContext,
+		CompactArrays:     true,
+		CompactToRelative: true,
+	}
+
+	if b.base != nil {
+		opts.BaseURL = *b.base
+	}
+
+	if b.processingMode != nil {
+		opts.ProcessingMode = *b.processingMode
+	}
+
+	if opts.DocumentLoader == nil {
+		opts.DocumentLoader = DefaultDocumentLoader
+	}
+
+	if b.maxRemoteContexts != nil {
+		opts.MaxRemoteContexts = *b.maxRemoteContexts
+	}
+
+	if b.compactArrays != nil {
+		opts.CompactArrays = *b.compactArrays
+	}
+
+	if b.compactToRelative != nil {
+		opts.CompactToRelative = *b.compactToRelative
+	}
+
+	if b.rdfDirection != nil {
+		switch *b.rdfDirection {
+		case "i18n-datatype", "compound-literal":
+		// good
+		default:
+			return opts, fmt.Errorf("rdf direction: invalid value: %v", *b.rdfDirection)
+		}
+
+		opts.RDFDirection = *b.rdfDirection
+	}
+
+	if b.useNativeTypes != nil {
+		opts.UseNativeTypes = *b.useNativeTypes
+	}
+
+	if b.useRdfType != nil {
+		opts.UseRdfType = *b.useRdfType
+	}
+
+	if b.embed != nil {
+		switch *b.embed {
+		case "@always", "@once", "@never":
+		// good
+		default:
+			return opts, fmt.Errorf("embed: invalid value: %v", *b.embed)
+		}
+
+		opts.Embed = *b.embed
+	}
+
+	if b.explicit != nil {
+		opts.Explicit = *b.explicit
+	}
+
+	if b.omitDefault != nil {
+		opts.OmitDefault = *b.omitDefault
+	}
+
+	if b.requireAll != nil {
+		opts.RequireAll = *b.requireAll
+	}
+
+	if b.frameDefault != nil {
+		opts.FrameDefault = *b.frameDefault
+	}
+
+	if b.omitGraph != nil {
+		opts.OmitGraph = *b.omitGraph
+	} else {
+		opts.OmitGraph = opts.ProcessingMode != jsonldinternal.ProcessingMode_JSON_LD_1_0
+	}
+
+	return opts, nil
+}
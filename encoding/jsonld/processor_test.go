@@ -0,0 +1,449 @@
+package jsonld
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func mustParseProcessorTestJSON(t *testing.T, v string) inspectjson.Value {
+	t.Helper()
+
+	parsed, err := inspectjson.Parse(strings.NewReader(v))
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+
+	return parsed
+}
+
+func mustMarshalProcessorTestJSON(t *testing.T, v any) string {
+	t.Helper()
+
+	marshaled, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	return string(marshaled)
+}
+
+func TestExpand(t *testing.T) {
+	expanded, err := Expand(
+		context.Background(),
+		mustParseProcessorTestJSON(t, `{
+			"@context": {"name": "http://xmlns.com/foaf/0.1/name"},
+			"@id": "me",
+			"name": "Manu Sporny"
+		}`),
+		ProcessorConfig{}.SetBase("http://example.com/"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_a := mustMarshalProcessorTestJSON(t, expanded)
+	_e := `[{"@id":"http://example.com/me","http://xmlns.com/foaf/0.1/name":[{"@value":"Manu Sporny"}]}]`
+
+	if _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestCompact(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		Input        string
+		InputContext string
+		InputOptions []ProcessorOption
+		Output       string
+	}{
+		{
+			Name: "terms",
+			Input: `[{
+				"http://xmlns.com/foaf/0.1/name": ["Manu Sporny"],
+				"http://xmlns.com/foaf/0.1/homepage": [{"@id": "https://manu.sporny.org/"}]
+			}]`,
+			InputContext: `{"@context": {
+				"name": "http://xmlns.com/foaf/0.1/name",
+				"homepage": {"@id": "http://xmlns.com/foaf/0.1/homepage", "@type": "@id"}
+			}}`,
+			Output: `{"@context":{"homepage":{"@id":"http://xmlns.com/foaf/0.1/homepage","@type":"@id"},"name":"http://xmlns.com/foaf/0.1/name"},"homepage":"https://manu.sporny.org/","name":"Manu Sporny"}`,
+		},
+		{
+			Name:         "compact IRI",
+			Input:        `{"@id": "http://example.com/s", "@type": "http://xmlns.com/foaf/0.1/Person"}`,
+			InputContext: `{"foaf": "http://xmlns.com/foaf/0.1/"}`,
+			Output:       `{"@context":{"foaf":"http://xmlns.com/foaf/0.1/"},"@id":"http://example.com/s","@type":"foaf:Person"}`,
+		},
+		{
+			Name:         "compactArrays false",
+			Input:        `{"http://example.com/p": "v"}`,
+			InputContext: `{"p": "http://example.com/p"}`,
+			InputOptions: []ProcessorOption{
+				ProcessorConfig{}.SetCompactArrays(false),
+			},
+			Output: `{"@context":{"p":"http://example.com/p"},"@graph":[{"p":["v"]}]}`,
+		},
+		{
+			Name:         "compactToRelative",
+			Input:        `{"@id": "http://example.com/a/b", "http://example.com/p": {"@id": "http://example.com/a/c"}}`,
+			InputContext: `{"p": {"@id": "http://example.com/p", "@type": "@id"}}`,
+			InputOptions: []ProcessorOption{
+				ProcessorConfig{}.SetBase("http://example.com/a/"),
+			},
+			Output: `{"@context":{"p":{"@id":"http://example.com/p","@type":"@id"}},"@id":"b","p":"c"}`,
+		},
+		{
+			Name:         "compactToRelative false",
+			Input:        `{"@id": "http://example.com/a/b", "http://example.com/p": "v"}`,
+			InputContext: `{}`,
+			InputOptions: []ProcessorOption{
+				ProcessorConfig{}.SetBase("http://example.com/a/"),
+				ProcessorConfig{}.SetCompactToRelative(false),
+			},
+			Output: `{"@id":"http://example.com/a/b","http://example.com/p":"v"}`,
+		},
+		{
+			Name:         "list",
+			Input:        `{"http://example.com/p": {"@list": ["a", "b"]}}`,
+			InputContext: `{"p": {"@id": "http://example.com/p", "@container": "@list"}}`,
+			Output:       `{"@context":{"p":{"@container":"@list","@id":"http://example.com/p"}},"p":["a","b"]}`,
+		},
+		{
+			Name: "language map",
+			Input: `{"http://example.com/label": [
+				{"@value": "Hello", "@language": "en"},
+				{"@value": "Hallo", "@language": "de"}
+			]}`,
+			InputContext: `{"label": {"@id": "http://example.com/label", "@container": "@language"}}`,
+			Output:       `{"@context":{"label":{"@container":"@language","@id":"http://example.com/label"}},"label":{"de":"Hallo","en":"Hello"}}`,
+		},
+		{
+			Name:         "graph",
+			Input:        `[{"@id": "http://example.com/a", "http://example.com/p": "u"}, {"@id": "http://example.com/b", "http://example.com/p": "v"}]`,
+			InputContext: `{"p": "http://example.com/p"}`,
+			Output:       `{"@context":{"p":"http://example.com/p"},"@graph":[{"@id":"http://example.com/a","p":"u"},{"@id":"http://example.com/b","p":"v"}]}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			compacted, err := Compact(
+				context.Background(),
+				mustParseProcessorTestJSON(t, tc.Input),
+				mustParseProcessorTestJSON(t, tc.InputContext),
+				tc.InputOptions...,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _a, _e := mustMarshalProcessorTestJSON(t, compacted), tc.Output; _a != _e {
+				t.Fatalf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	input := `{
+		"@context": {"knows": "http://xmlns.com/foaf/0.1/knows", "name": "http://xmlns.com/foaf/0.1/name"},
+		"@id": "http://example.com/alice",
+		"name": "Alice",
+		"knows": {"name": "Bob"}
+	}`
+
+	t.Run("without context", func(t *testing.T) {
+		flattened, err := Flatten(context.Background(), mustParseProcessorTestJSON(t, input), nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_a := mustMarshalProcessorTestJSON(t, flattened)
+		_e := `[{"@id":"_:b0","http://xmlns.com/foaf/0.1/name":[{"@value":"Bob"}]},{"@id":"http://example.com/alice","http://xmlns.com/foaf/0.1/knows":[{"@id":"_:b0"}],"http://xmlns.com/foaf/0.1/name":[{"@value":"Alice"}]}]`
+
+		if _a != _e {
+			t.Fatalf("expected %q, got %q", _e, _a)
+		}
+	})
+
+	t.Run("with context", func(t *testing.T) {
+		flattened, err := Flatten(
+			context.Background(),
+			mustParseProcessorTestJSON(t, input),
+			mustParseProcessorTestJSON(t, `{"name": "http://xmlns.com/foaf/0.1/name"}`),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		_a := mustMarshalProcessorTestJSON(t, flattened)
+		_e := `{"@context":{"name":"http://xmlns.com/foaf/0.1/name"},"@graph":[{"@id":"_:b0","name":"Bob"},{"@id":"http://example.com/alice","http://xmlns.com/foaf/0.1/knows":{"@id":"_:b0"},"name":"Alice"}]}`
+
+		if _a != _e {
+			t.Fatalf("expected %q, got %q", _e, _a)
+		}
+	})
+}
+
+func TestFrame(t *testing.T) {
+	input := `{
+		"@context": {
+			"dc11": "http://purl.org/dc/elements/1.1/",
+			"ex": "http://example.org/vocab#",
+			"ex:contains": {"@type": "@id"}
+		},
+		"@graph": [
+			{
+				"@id": "http://example.org/library",
+				"@type": "ex:Library",
+				"ex:contains": "http://example.org/library/the-republic"
+			},
+			{
+				"@id": "http://example.org/library/the-republic",
+				"@type": "ex:Book",
+				"dc11:creator": "Plato",
+				"dc11:title": "The Republic",
+				"ex:contains": "http://example.org/library/the-republic#introduction"
+			},
+			{
+				"@id": "http://example.org/library/the-republic#introduction",
+				"@type": "ex:Chapter",
+				"dc11:description": "An introductory chapter on The Republic.",
+				"dc11:title": "The Introduction"
+			}
+		]
+	}`
+
+	for _, tc := range []struct {
+		Name         string
+		Frame        string
+		InputOptions []ProcessorOption
+		Output       string
+	}{
+		{
+			Name: "embedded types",
+			Frame: `{
+				"@context": {"dc11": "http://purl.org/dc/elements/1.1/", "ex": "http://example.org/vocab#"},
+				"@type": "ex:Library",
+				"ex:contains": {"@type": "ex:Book", "ex:contains": {"@type": "ex:Chapter"}}
+			}`,
+			Output: `{"@context":{"dc11":"http://purl.org/dc/elements/1.1/","ex":"http://example.org/vocab#"},"@id":"http://example.org/library","@type":"ex:Library","ex:contains":{"@id":"http://example.org/library/the-republic","@type":"ex:Book","dc11:creator":"Plato","dc11:title":"The Republic","ex:contains":{"@id":"http://example.org/library/the-republic#introduction","@type":"ex:Chapter","dc11:description":"An introductory chapter on The Republic.","dc11:title":"The Introduction"}}}`,
+		},
+		{
+			Name: "explicit",
+			Frame: `{
+				"@context": {"dc11": "http://purl.org/dc/elements/1.1/", "ex": "http://example.org/vocab#"},
+				"@type": "ex:Book",
+				"@explicit": true,
+				"dc11:title": {}
+			}`,
+			Output: `{"@context":{"dc11":"http://purl.org/dc/elements/1.1/","ex":"http://example.org/vocab#"},"@id":"http://example.org/library/the-republic","@type":"ex:Book","dc11:title":"The Republic"}`,
+		},
+		{
+			Name: "default",
+			Frame: `{
+				"@context": {"dc11": "http://purl.org/dc/elements/1.1/", "ex": "http://example.org/vocab#"},
+				"@type": "ex:Chapter",
+				"dc11:creator": {"@default": "Unknown"}
+			}`,
+			Output: `{"@context":{"dc11":"http://purl.org/dc/elements/1.1/","ex":"http://example.org/vocab#"},"@id":"http://example.org/library/the-republic#introduction","@type":"ex:Chapter","dc11:creator":"Unknown","dc11:description":"An introductory chapter on The Republic.","dc11:title":"The Introduction"}`,
+		},
+		{
+			Name: "embed never",
+			Frame: `{
+				"@context": {"ex": "http://example.org/vocab#"},
+				"@type": "ex:Library",
+				"ex:contains": {"@embed": "@never"}
+			}`,
+			Output: `{"@context":{"ex":"http://example.org/vocab#"},"@id":"http://example.org/library","@type":"ex:Library","ex:contains":{"@id":"http://example.org/library/the-republic"}}`,
+		},
+		{
+			Name: "requireAll",
+			Frame: `{
+				"@context": {"dc11": "http://purl.org/dc/elements/1.1/", "ex": "http://example.org/vocab#"},
+				"@requireAll": true,
+				"dc11:title": {},
+				"dc11:creator": {}
+			}`,
+			InputOptions: []ProcessorOption{
+				ProcessorConfig{}.SetExplicit(true),
+			},
+			Output: `{"@context":{"dc11":"http://purl.org/dc/elements/1.1/","ex":"http://example.org/vocab#"},"@id":"http://example.org/library/the-republic","@type":"ex:Book","dc11:creator":"Plato","dc11:title":"The Republic"}`,
+		},
+		{
+			Name: "omitGraph false",
+			Frame: `{
+				"@context": {"ex": "http://example.org/vocab#"},
+				"@type": "ex:Chapter",
+				"@explicit": true
+			}`,
+			InputOptions: []ProcessorOption{
+				ProcessorConfig{}.SetOmitGraph(false),
+			},
+			Output: `{"@context":{"ex":"http://example.org/vocab#"},"@graph":[{"@id":"http://example.org/library/the-republic#introduction","@type":"ex:Chapter"}]}`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			framed, err := Frame(
+				context.Background(),
+				mustParseProcessorTestJSON(t, input),
+				mustParseProcessorTestJSON(t, tc.Frame),
+				tc.InputOptions...,
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _a, _e := mustMarshalProcessorTestJSON(t, framed), tc.Output; _a != _e {
+				t.Fatalf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestToRDF(t *testing.T) {
+	quads, err := ToRDF(
+		context.Background(),
+		mustParseProcessorTestJSON(t, `{
+			"@context": {"name": "http://xmlns.com/foaf/0.1/name"},
+			"@id": "http://example.com/alice",
+			"name": "Alice"
+		}`),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := len(quads), 1; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	_e := rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/alice"),
+		Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+		Object: rdf.Literal{
+			Datatype:    xsdiri.String_Datatype,
+			LexicalForm: "Alice",
+		},
+	}
+
+	if _a := quads[0].Triple; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestFromRDF(t *testing.T) {
+	listHead := rdf.NewBlankNode()
+	listRest := rdf.NewBlankNode()
+
+	dataset := rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://example.com/Thing"),
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/count"),
+				Object: rdf.Literal{
+					Datatype:    xsdiri.Integer_Datatype,
+					LexicalForm: "5",
+				},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/list"),
+				Object:    listHead,
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   listHead,
+				Predicate: rdfiri.First_Property,
+				Object: rdf.Literal{
+					Datatype:    xsdiri.String_Datatype,
+					LexicalForm: "a",
+				},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   listHead,
+				Predicate: rdfiri.Rest_Property,
+				Object:    listRest,
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   listRest,
+				Predicate: rdfiri.First_Property,
+				Object: rdf.Literal{
+					Datatype:    xsdiri.String_Datatype,
+					LexicalForm: "b",
+				},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   listRest,
+				Predicate: rdfiri.Rest_Property,
+				Object:    rdfiri.Nil_List,
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    rdf.IRI("http://example.com/o"),
+			},
+			GraphName: rdf.IRI("http://example.com/g"),
+		},
+	}
+
+	for _, tc := range []struct {
+		Name         string
+		InputOptions []ProcessorOption
+		Output       string
+	}{
+		{
+			Name:   "default",
+			Output: `[{"@graph":[{"@id":"http://example.com/s","http://example.com/p":[{"@id":"http://example.com/o"}]}],"@id":"http://example.com/g"},{"@id":"http://example.com/s","@type":["http://example.com/Thing"],"http://example.com/count":[{"@type":"http://www.w3.org/2001/XMLSchema#integer","@value":"5"}],"http://example.com/list":[{"@list":[{"@value":"a"},{"@value":"b"}]}]}]`,
+		},
+		{
+			Name: "useNativeTypes",
+			InputOptions: []ProcessorOption{
+				ProcessorConfig{}.SetUseNativeTypes(true),
+			},
+			Output: `[{"@graph":[{"@id":"http://example.com/s","http://example.com/p":[{"@id":"http://example.com/o"}]}],"@id":"http://example.com/g"},{"@id":"http://example.com/s","@type":["http://example.com/Thing"],"http://example.com/count":[{"@value":5}],"http://example.com/list":[{"@list":[{"@value":"a"},{"@value":"b"}]}]}]`,
+		},
+		{
+			Name: "useRdfType",
+			InputOptions: []ProcessorOption{
+				ProcessorConfig{}.SetUseRdfType(true),
+			},
+			Output: `[{"@graph":[{"@id":"http://example.com/s","http://example.com/p":[{"@id":"http://example.com/o"}]}],"@id":"http://example.com/g"},{"@id":"http://example.com/s","http://example.com/count":[{"@type":"http://www.w3.org/2001/XMLSchema#integer","@value":"5"}],"http://example.com/list":[{"@list":[{"@value":"a"},{"@value":"b"}]}],"http://www.w3.org/1999/02/22-rdf-syntax-ns#type":[{"@id":"http://example.com/Thing"}]}]`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			expanded, err := FromRDF(context.Background(), dataset, tc.InputOptions...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _a, _e := mustMarshalProcessorTestJSON(t, expanded), tc.Output; _a != _e {
+				t.Fatalf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package testsuite
+
+import "github.com/dpb587/rdfkit-go/rdf"
+
+type manifestSchema struct {
+	Sequences []struct {
+		ID              rdf.IRI                      `json:"@id"`
+		Type            []string                     `json:"@type"`
+		Input           string                       `json:"input"`
+		Context         string                       `json:"context"`
+		Expect          string                       `json:"expect"`
+		ExpectErrorCode string                       `json:"expectErrorCode"`
+		Option          manifestSchemaSequenceOption `json:"option"`
+	} `json:"sequence"`
+}
+
+type manifestSchemaSequenceOption struct {
+	Base              string `json:"base"`
+	CompactArrays     *bool  `json:"compactArrays"`
+	CompactToRelative *bool  `json:"compactToRelative"`
+	ExpandContext     string `json:"expandContext"`
+	ProcessingMode    string `json:"processingMode"`
+	SpecVersion       string `json:"specVersion"`
+}
@@ -0,0 +1,50 @@
+#!/bin/bash
+
+set -euo pipefail
+
+cd "$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+
+rm -fr testdata/
+
+mkdir testdata/
+
+curl -Lo testdata/compact-manifest.jsonld https://w3c.github.io/json-ld-api/tests/compact-manifest.jsonld
+
+iter() {
+  while read -r p; do
+    mkdir -p "$( dirname "testdata/${p}" )"
+    curl -Lo "testdata/${p}" "https://w3c.github.io/json-ld-api/tests/${p}"
+  done 
+}
+
+iter < <(
+  jq -r '.sequence[].expect | select(.)' testdata/compact-manifest.jsonld
+  jq -r '.sequence[].input | select(.)' testdata/compact-manifest.jsonld
+  jq -r '.sequence[].context | select(.)' testdata/compact-manifest.jsonld
+  jq -r '.sequence[].option.expandContext | select(.)' < testdata/compact-manifest.jsonld
+)
+
+iter < <(
+  find ./testdata/compact -name '*-in.jsonld' -o -name '*-context.jsonld' \
+    | xargs cat \
+    | jq -r \
+      '..
+        | select(. | type == "object") 
+        | [ .["@context"], .["@import"] ][]
+        | select(.) 
+        | if type != "array" then [.] else . end 
+        | map(select(type == "string"))[]
+      ' \
+    | grep '\.jsonld' \
+    | sed "s#^#compact/#" \
+    | sed 's#/../#/#' \
+    || true
+)
+
+cd testdata/
+
+GZIP=-9 tar -czf ../testdata.tar.gz ./
+
+cd ../
+
+rm -fr testdata/
@@ -0,0 +1,217 @@
+package testsuite
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldtype"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/testing/testingarchive"
+)
+
+const manifestPrefix = "https://w3c.github.io/json-ld-api/tests/"
+
+func Test(t *testing.T) {
+	testdata, testdataManifest := requireTestdata(t)
+
+	for _, sequence := range testdataManifest.Sequences {
+		t.Run(string(sequence.ID), func(t *testing.T) {
+			compactAction := func() (map[string]any, error) {
+				popts := []jsonld.ProcessorOption{
+					jsonld.ProcessorConfig{}.
+						SetBase(manifestPrefix + sequence.Input).
+						SetDocumentLoader(jsonldtype.DocumentLoaderFunc(func(ctx context.Context, u string, opts jsonldtype.DocumentLoaderOptions) (jsonldtype.RemoteDocument, error) {
+							if !testdata.HasFile(u) {
+								return jsonldtype.RemoteDocument{}, fmt.Errorf("unknown url: %s", u)
+							}
+
+							doc, err := inspectjson.Parse(testdata.NewFileByteReader(t, u))
+							if err != nil {
+								return jsonldtype.RemoteDocument{}, fmt.Errorf("parse: %v", err)
+							}
+
+							docURL, err := url.Parse(u)
+							if err != nil {
+								return jsonldtype.RemoteDocument{}, fmt.Errorf("parse url: %v", err)
+							}
+
+							return jsonldtype.RemoteDocument{
+								ContentType: "application/ld+json",
+								Document:    doc,
+								DocumentURL: docURL,
+							}, nil
+						})),
+				}
+
+				if len(sequence.Option.Base) > 0 {
+					popts = append(popts, jsonld.ProcessorConfig{}.SetBase(sequence.Option.Base))
+				}
+
+				if len(sequence.Option.ProcessingMode) > 0 {
+					popts = append(popts, jsonld.ProcessorConfig{}.SetProcessingMode(sequence.Option.ProcessingMode))
+				} else if len(sequence.Option.SpecVersion) > 0 {
+					popts = append(popts, jsonld.ProcessorConfig{}.SetProcessingMode(sequence.Option.SpecVersion))
+				}
+
+				if sequence.Option.CompactArrays != nil {
+					popts = append(popts, jsonld.ProcessorConfig{}.SetCompactArrays(*sequence.Option.CompactArrays))
+				}
+
+				if sequence.Option.CompactToRelative != nil {
+					popts = append(popts, jsonld.ProcessorConfig{}.SetCompactToRelative(*sequence.Option.CompactToRelative))
+				}
+
+				if len(sequence.Option.ExpandContext) > 0 {
+					expandContextDoc, err := inspectjson.Parse(testdata.NewFileByteReader(t, manifestPrefix+sequence.Option.ExpandContext))
+					if err != nil {
+						return nil, fmt.Errorf("parse expand context: %v", err)
+					}
+
+					popts = append(popts, jsonld.ProcessorConfig{}.SetExpandContext(expandContextDoc))
+				}
+
+				input, err := inspectjson.Parse(testdata.NewFileByteReader(t, manifestPrefix+sequence.Input))
+				if err != nil {
+					return nil, fmt.Errorf("parse input: %v", err)
+				}
+
+				var localContext inspectjson.Value
+
+				if len(sequence.Context) > 0 {
+					localContext, err = inspectjson.Parse(testdata.NewFileByteReader(t, manifestPrefix+sequence.Context))
+					if err != nil {
+						return nil, fmt.Errorf("parse context: %v", err)
+					}
+				}
+
+				return jsonld.Compact(t.Context(), input, localContext, popts...)
+			}
+
+			if slices.Contains(sequence.Type, "jld:NegativeEvaluationTest") {
+				_, err := compactAction()
+				if err == nil {
+					t.Fatal("expected error, but got none")
+				}
+
+				var errCode jsonldtype.Error
+
+				if errors.As(err, &errCode) && string(errCode.Code) != sequence.ExpectErrorCode {
+					t.Fatalf("expected error code %q, got %q", sequence.ExpectErrorCode, errCode.Code)
+				}
+
+				t.Logf("error (expected): %v", err)
+			} else if slices.Contains(sequence.Type, "jld:PositiveEvaluationTest") {
+				var expected any
+
+				if err := json.Unmarshal(testdata.GetFileBytes(t, manifestPrefix+sequence.Expect), &expected); err != nil {
+					t.Fatalf("setup error: unmarshal expect: %v", err)
+				}
+
+				actual, err := compactAction()
+				if err != nil {
+					t.Fatalf("error: %v", err)
+				}
+
+				if !jsonldEquals(expected, actual, false) {
+					expectedJSON, _ := json.MarshalIndent(expected, "", "  ")
+					actualJSON, _ := json.MarshalIndent(actual, "", "  ")
+
+					t.Fatalf("expected:\n%s\nactual:\n%s", expectedJSON, actualJSON)
+				}
+			} else {
+				t.Fatalf("unsupported test type: %v", sequence.Type)
+			}
+		})
+	}
+}
+
+// jsonldEquals compares values where arrays are unordered, except for the values of @list.
+func jsonldEquals(expected, actual any, ordered bool) bool {
+	switch expectedValue := expected.(type) {
+	case map[string]any:
+		actualMap, ok := actual.(map[string]any)
+		if !ok || len(expectedValue) != len(actualMap) {
+			return false
+		}
+
+		for k, v := range expectedValue {
+			av, ok := actualMap[k]
+			if !ok || !jsonldEquals(v, av, k == "@list") {
+				return false
+			}
+		}
+
+		return true
+	case []any:
+		actualArray, ok := actual.([]any)
+		if !ok || len(expectedValue) != len(actualArray) {
+			return false
+		}
+
+		if ordered {
+			for i := range expectedValue {
+				if !jsonldEquals(expectedValue[i], actualArray[i], false) {
+					return false
+				}
+			}
+
+			return true
+		}
+
+		matched := make([]bool, len(actualArray))
+
+	EXPECTED:
+		for _, ev := range expectedValue {
+			for ai, av := range actualArray {
+				if !matched[ai] && jsonldEquals(ev, av, false) {
+					matched[ai] = true
+
+					continue EXPECTED
+				}
+			}
+
+			return false
+		}
+
+		return true
+	}
+
+	return reflect.DeepEqual(expected, actual)
+}
+
+func requireTestdata(t *testing.T) (testingarchive.Archive, manifestSchema) {
+	if _, err := os.Stat("testdata.tar.gz"); err != nil {
+		t.Skipf("testdata.tar.gz is not available; see testdata.sh")
+	}
+
+	testdata := testingarchive.OpenTarGz(
+		t,
+		"testdata.tar.gz",
+		func(v string) string {
+			return manifestPrefix + strings.TrimPrefix(v, "./")
+		},
+	)
+
+	// avoiding cyclical usage of jsonld for testing
+	var loadedManifest manifestSchema
+
+	if err := json.Unmarshal(testdata.GetFileBytes(t, manifestPrefix+"compact-manifest.jsonld"), &loadedManifest); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	for sequenceIdx, sequence := range loadedManifest.Sequences {
+		loadedManifest.Sequences[sequenceIdx].ID = rdf.IRI(manifestPrefix + "compact-manifest" + sequence.ID)
+	}
+
+	return testdata, loadedManifest
+}
@@ -0,0 +1,22 @@
+package testsuite
+
+import "github.com/dpb587/rdfkit-go/rdf"
+
+type manifestSchema struct {
+	Sequences []struct {
+		ID              rdf.IRI                      `json:"@id"`
+		Type            []string                     `json:"@type"`
+		Input           string                       `json:"input"`
+		Expect          string                       `json:"expect"`
+		ExpectErrorCode string                       `json:"expectErrorCode"`
+		Option          manifestSchemaSequenceOption `json:"option"`
+	} `json:"sequence"`
+}
+
+type manifestSchemaSequenceOption struct {
+	ProcessingMode string `json:"processingMode"`
+	RDFDirection   string `json:"rdfDirection"`
+	SpecVersion    string `json:"specVersion"`
+	UseNativeTypes bool   `json:"useNativeTypes"`
+	UseRdfType     bool   `json:"useRdfType"`
+}
@@ -0,0 +1,31 @@
+#!/bin/bash
+
+set -euo pipefail
+
+cd "$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+
+rm -fr testdata/
+
+mkdir testdata/
+
+curl -Lo testdata/fromRdf-manifest.jsonld https://w3c.github.io/json-ld-api/tests/fromRdf-manifest.jsonld
+
+iter() {
+  while read -r p; do
+    mkdir -p "$( dirname "testdata/${p}" )"
+    curl -Lo "testdata/${p}" "https://w3c.github.io/json-ld-api/tests/${p}"
+  done 
+}
+
+iter < <(
+  jq -r '.sequence[].expect | select(.)' testdata/fromRdf-manifest.jsonld
+  jq -r '.sequence[].input | select(.)' testdata/fromRdf-manifest.jsonld
+)
+
+cd testdata/
+
+GZIP=-9 tar -czf ../testdata.tar.gz ./
+
+cd ../
+
+rm -fr testdata/
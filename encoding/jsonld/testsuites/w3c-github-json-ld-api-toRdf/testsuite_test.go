@@ -1,6 +1,7 @@
 package testsuite
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -211,6 +212,51 @@ func Test(t *testing.T) {
 	}
 }
 
+func TestEncoderRoundTrip(t *testing.T) {
+	testdata, testdataManifest := requireTestdata(t)
+
+	for _, sequence := range testdataManifest.Sequences {
+		if !slices.Contains(sequence.Type, "jld:PositiveEvaluationTest") || sequence.Option.ProduceGeneralizedRdf {
+			continue
+		}
+
+		t.Run(string(sequence.ID), func(t *testing.T) {
+			expectedStatements, err := quads.CollectErr(nquads.NewDecoder(
+				testdata.NewFileByteReader(t, manifestPrefix+sequence.Expect),
+			))
+			if err != nil {
+				t.Fatalf("setup error: decode result: %v", err)
+			}
+
+			buf := &bytes.Buffer{}
+
+			encoder, err := jsonld.NewEncoder(buf, jsonld.EncoderConfig{}.SetBuffered(true))
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+
+			for _, quad := range expectedStatements {
+				err = encoder.AddQuad(t.Context(), quad)
+				if err != nil {
+					t.Fatalf("encode: %v", err)
+				}
+			}
+
+			err = encoder.Close()
+			if err != nil {
+				t.Fatalf("encode: %v", err)
+			}
+
+			actualStatements, err := quads.CollectErr(jsonld.NewDecoder(bytes.NewReader(buf.Bytes())))
+			if err != nil {
+				t.Fatalf("decode: %v\n%s", err, buf.String())
+			}
+
+			testingassert.IsomorphicDatasets(t.Context(), t, expectedStatements, actualStatements)
+		})
+	}
+}
+
 func requireTestdata(t *testing.T) (testingarchive.Archive, manifestSchema) {
 	testdata := testingarchive.OpenTarGz(
 		t,
@@ -0,0 +1,26 @@
+package testsuite
+
+import "github.com/dpb587/rdfkit-go/rdf"
+
+type manifestSchema struct {
+	Sequences []struct {
+		ID              rdf.IRI                      `json:"@id"`
+		Type            []string                     `json:"@type"`
+		Input           string                       `json:"input"`
+		Frame           string                       `json:"frame"`
+		Expect          string                       `json:"expect"`
+		ExpectErrorCode string                       `json:"expectErrorCode"`
+		Option          manifestSchemaSequenceOption `json:"option"`
+	} `json:"sequence"`
+}
+
+type manifestSchemaSequenceOption struct {
+	Base           string `json:"base"`
+	Embed          string `json:"embed"`
+	Explicit       *bool  `json:"explicit"`
+	OmitDefault    *bool  `json:"omitDefault"`
+	OmitGraph      *bool  `json:"omitGraph"`
+	ProcessingMode string `json:"processingMode"`
+	RequireAll     *bool  `json:"requireAll"`
+	SpecVersion    string `json:"specVersion"`
+}
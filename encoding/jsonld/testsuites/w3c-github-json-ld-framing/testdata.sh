@@ -0,0 +1,32 @@
+#!/bin/bash
+
+set -euo pipefail
+
+cd "$( cd -- "$( dirname -- "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+
+rm -fr testdata/
+
+mkdir testdata/
+
+curl -Lo testdata/frame-manifest.jsonld https://w3c.github.io/json-ld-framing/tests/frame-manifest.jsonld
+
+iter() {
+  while read -r p; do
+    mkdir -p "$( dirname "testdata/${p}" )"
+    curl -Lo "testdata/${p}" "https://w3c.github.io/json-ld-framing/tests/${p}"
+  done 
+}
+
+iter < <(
+  jq -r '.sequence[].expect | select(.)' testdata/frame-manifest.jsonld
+  jq -r '.sequence[].input | select(.)' testdata/frame-manifest.jsonld
+  jq -r '.sequence[].frame | select(.)' testdata/frame-manifest.jsonld
+)
+
+cd testdata/
+
+GZIP=-9 tar -czf ../testdata.tar.gz ./
+
+cd ../
+
+rm -fr testdata/
@@ -11,6 +11,7 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
 	"github.com/dpb587/rdfkit-go/encoding/nquads/internal/grammar"
 	"github.com/dpb587/rdfkit-go/encoding/nquads/nquadscontent"
+	"github.com/dpb587/rdfkit-go/iri"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
 )
@@ -24,12 +25,14 @@ type Decoder struct {
 	buf              *cursorioutil.RuneBuffer
 	doc              *cursorio.TextWriter
 	bnStringFactory  blanknodes.StringFactory
+	iriMapper        iri.MapperFunc
 	buildTextOffsets encodingutil.TextOffsetsBuilderFunc
 
 	err error
 
-	currentQuad        rdf.Quad
-	currentTextOffsets encoding.StatementTextOffsets
+	currentQuad                  rdf.Quad
+	currentTextOffsets           encoding.StatementTextOffsets
+	currentTripleTermTextOffsets []any
 }
 
 var _ encoding.QuadsDecoder = &Decoder{}
@@ -108,6 +111,8 @@ func (r *Decoder) Next() bool {
 
 	QUAD_START:
 
+		r.currentTripleTermTextOffsets = r.currentTripleTermTextOffsets[:0]
+
 		subject, subjectRange, err := r.captureSubjectOrGraphValue(grammar.R_subject)
 		if err != nil {
 			if errors.Is(err, io.EOF) {
@@ -124,7 +129,7 @@ func (r *Decoder) Next() bool {
 			return grammar.R_statement.Err(err)
 		}
 
-		object, objectRange, err := r.captureObject()
+		object, objectRange, err := r.captureObject(encoding.ObjectStatementOffsets)
 		if err != nil {
 			return grammar.R_statement.Err(err)
 		}
@@ -200,10 +205,15 @@ func (r *Decoder) Next() bool {
 		}
 
 		r.currentTextOffsets = r.buildTextOffsets(
-			encoding.GraphNameStatementOffsets, graphNameRange,
-			encoding.SubjectStatementOffsets, subjectRange,
-			encoding.PredicateStatementOffsets, predicateRange,
-			encoding.ObjectStatementOffsets, objectRange,
+			append(
+				[]any{
+					encoding.GraphNameStatementOffsets, graphNameRange,
+					encoding.SubjectStatementOffsets, subjectRange,
+					encoding.PredicateStatementOffsets, predicateRange,
+					encoding.ObjectStatementOffsets, objectRange,
+				},
+				r.currentTripleTermTextOffsets...,
+			)...,
 		)
 
 		return nil
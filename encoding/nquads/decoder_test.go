@@ -205,3 +205,91 @@ func TestReader_ScanString(t *testing.T) {
 		})
 	}
 }
+
+func TestReader_TripleTerm(t *testing.T) {
+	for _, tc := range []struct {
+		InputString     string
+		OutputObject    rdf.ObjectValue
+		OutputGraphName rdf.GraphNameValue
+		OutputError     string
+	}{
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <<( _:b2 <http://example.com/p2> "o2" )>> <http://example.com/g> .`,
+			OutputObject: rdf.TripleTerm{
+				Subject:   rdf.BlankNode{},
+				Predicate: rdf.IRI("http://example.com/p2"),
+				Object: rdf.Literal{
+					Datatype:    xsdiri.String_Datatype,
+					LexicalForm: "o2",
+				},
+			},
+			OutputGraphName: rdf.IRI("http://example.com/g"),
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> <<( <http://example.com/s3> <http://example.com/p3> <http://example.com/o3> )>> )>> .`,
+			OutputObject: rdf.TripleTerm{
+				Subject:   rdf.IRI("http://example.com/s2"),
+				Predicate: rdf.IRI("http://example.com/p2"),
+				Object: rdf.TripleTerm{
+					Subject:   rdf.IRI("http://example.com/s3"),
+					Predicate: rdf.IRI("http://example.com/p3"),
+					Object:    rdf.IRI("http://example.com/o3"),
+				},
+			},
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> "o2" )> .`,
+			OutputError: `token (statement): token (object): token (tripleTerm): offset 0x69: unexpected rune (' ')`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <http://example.com/o> <<( <http://example.com/s2> <http://example.com/p2> "o2" )>> .`,
+			OutputError: `token (statement): token (graphLabel): token (IRIREF): offset 0x46: unexpected rune ('<')`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			s, err := NewDecoder(strings.NewReader(tc.InputString))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !s.Next() {
+				if err := s.Err(); err == nil {
+					t.Fatalf("expected quad, but got none")
+				} else if len(tc.OutputError) == 0 || err.Error() != tc.OutputError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			} else if len(tc.OutputError) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			tripleTerm, ok := s.Quad().Triple.Object.(rdf.TripleTerm)
+			if !ok {
+				t.Fatalf("expected rdf.TripleTerm, got %T", s.Quad().Triple.Object)
+			}
+
+			expectedTripleTerm := tc.OutputObject.(rdf.TripleTerm)
+
+			if _, ok := expectedTripleTerm.Subject.(rdf.BlankNode); ok {
+				if _, ok := tripleTerm.Subject.(rdf.BlankNode); !ok {
+					t.Errorf("expected rdf.BlankNode, got %T", tripleTerm.Subject)
+				}
+
+				expectedTripleTerm.Subject = tripleTerm.Subject
+			}
+
+			if _e, _a := expectedTripleTerm, tripleTerm; !_e.TermEquals(_a) {
+				t.Errorf("expected %v, got %v", _e, _a)
+			}
+
+			if tc.OutputGraphName == nil {
+				if _a := s.Quad().GraphName; _a != nil {
+					t.Errorf("expected nil, got %v", _a)
+				}
+			} else if _e, _a := tc.OutputGraphName, s.Quad().GraphName; _a == nil || !_e.TermEquals(_a) {
+				t.Errorf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
@@ -82,6 +82,10 @@ func (w *Encoder) AddQuad(ctx context.Context, t rdf.Quad) error {
 		WriteIRI(w.buf, o, w.ascii)
 	case rdf.Literal:
 		WriteLiteral(w.buf, o, w.ascii)
+	case rdf.TripleTerm:
+		if err := WriteTripleTerm(w.buf, o, w.bnStringProvider, w.ascii); err != nil {
+			return fmt.Errorf("object: %v", err)
+		}
 	default:
 		return fmt.Errorf("object: invalid type: %T", o)
 	}
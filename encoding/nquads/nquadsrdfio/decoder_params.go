@@ -1,22 +1,58 @@
 package nquadsrdfio
 
 import (
+	"maps"
+
 	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
 	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfioutil"
 )
 
 type decoderParams struct {
-	CaptureTextOffsets *bool
+	CaptureTextOffsets   *bool
+	ValidateLiterals     *rdfioutil.LiteralValidation
+	CanonicalizeLiterals *rdfioutil.LiteralCanonicalization
+	ValidateIRIs         *rdfioutil.IRIValidation
+	NormalizeIRIs        *rdfioutil.IRINormalization
+	Skolemize            *rdfioutil.Skolemization
+	Deskolemize          *rdfioutil.Deskolemization
 }
 
 var _ rdfiotypes.Params = &decoderParams{}
 
+func newDecoderParams() *decoderParams {
+	return &decoderParams{
+		ValidateLiterals:     &rdfioutil.LiteralValidation{},
+		CanonicalizeLiterals: &rdfioutil.LiteralCanonicalization{},
+		ValidateIRIs:         &rdfioutil.IRIValidation{},
+		NormalizeIRIs:        &rdfioutil.IRINormalization{},
+		Skolemize:            &rdfioutil.Skolemization{},
+		Deskolemize:          &rdfioutil.Deskolemization{},
+	}
+}
+
 func (f *decoderParams) NewParamsCollection() rdfiotypes.ParamsCollection {
-	return rdfiotypes.ParamsCollection{
+	c := rdfiotypes.ParamsCollection{
 		"captureTextOffsets": kvref.BoolPtr(&f.CaptureTextOffsets, rdfiotypes.ParamMeta{
 			Usage: "Capture the line+column offsets for statement properties",
 		}),
 	}
+
+	maps.Copy(c, f.ValidateLiterals.NewParamsCollection("validateLiterals"))
+	maps.Copy(c, f.CanonicalizeLiterals.NewParamsCollection("canonicalizeLiterals"))
+	maps.Copy(c, f.ValidateIRIs.NewParamsCollection("validateIRIs"))
+	maps.Copy(c, f.NormalizeIRIs.NewParamsCollection("normalizeIRIs"))
+	maps.Copy(c, f.Skolemize.NewParamsCollection("skolemize"))
+	maps.Copy(c, f.Deskolemize.NewParamsCollection("deskolemize"))
+
+	return c
 }
 
-func (f *decoderParams) ApplyDefaults() {}
+func (f *decoderParams) ApplyDefaults() {
+	f.ValidateLiterals.ApplyDefaults()
+	f.CanonicalizeLiterals.ApplyDefaults()
+	f.ValidateIRIs.ApplyDefaults()
+	f.NormalizeIRIs.ApplyDefaults()
+	f.Skolemize.ApplyDefaults()
+	f.Deskolemize.ApplyDefaults()
+}
@@ -100,6 +100,15 @@ func WriteLiteral(w *bytes.Buffer, t rdf.Literal, ascii bool) {
 			w.Write([]byte(langTag.Language))
 		}
 
+		return
+	case rdfiri.DirLangString_Datatype:
+		if langTag, ok := t.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+			w.Write([]byte{'@'})
+			w.Write([]byte(langTag.Language))
+			w.Write([]byte{'-', '-'})
+			w.Write([]byte(langTag.BaseDirection))
+		}
+
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
 	"github.com/dpb587/rdfkit-go/rdf"
 )
@@ -50,3 +51,41 @@ func TestNewStringLiteralQuote(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteLiteral_Tag(t *testing.T) {
+	for _, tc := range []struct {
+		InputLiteral rdf.Literal
+		OutputBytes  []byte
+	}{
+		{
+			InputLiteral: rdf.Literal{
+				Datatype:    rdfiri.LangString_Datatype,
+				LexicalForm: "hello",
+				Tag: rdf.LanguageLiteralTag{
+					Language: "en",
+				},
+			},
+			OutputBytes: []byte(`"hello"@en`),
+		},
+		{
+			InputLiteral: rdf.Literal{
+				Datatype:    rdfiri.DirLangString_Datatype,
+				LexicalForm: "مرحبا",
+				Tag: rdf.DirectionalLanguageLiteralTag{
+					Language:      "ar",
+					BaseDirection: "rtl",
+				},
+			},
+			OutputBytes: []byte(`"مرحبا"@ar--rtl`),
+		},
+	} {
+		t.Run(string(tc.OutputBytes), func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			WriteLiteral(buf, tc.InputLiteral, false)
+			if _e, _a := tc.OutputBytes, buf.Bytes(); !bytes.Equal(_e, _a) {
+				t.Errorf("unexpected output: %s", _a)
+			}
+		})
+	}
+}
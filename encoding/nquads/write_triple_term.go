@@ -0,0 +1,54 @@
+package nquads
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+func WriteTripleTerm(w *bytes.Buffer, t rdf.TripleTerm, bnStringProvider blanknodes.StringProvider, ascii bool) error {
+	w.Write([]byte{'<', '<', '(', ' '})
+
+	switch s := t.Subject.(type) {
+	case rdf.BlankNode:
+		w.Write([]byte{'_', ':'})
+		w.Write([]byte(bnStringProvider.GetBlankNodeString(s)))
+	case rdf.IRI:
+		WriteIRI(w, s, ascii)
+	default:
+		return fmt.Errorf("subject: invalid type: %T", s)
+	}
+
+	w.Write([]byte{' '})
+
+	switch p := t.Predicate.(type) {
+	case rdf.IRI:
+		WriteIRI(w, p, ascii)
+	default:
+		return fmt.Errorf("predicate: invalid type: %T", p)
+	}
+
+	w.Write([]byte{' '})
+
+	switch o := t.Object.(type) {
+	case rdf.BlankNode:
+		w.Write([]byte{'_', ':'})
+		w.Write([]byte(bnStringProvider.GetBlankNodeString(o)))
+	case rdf.IRI:
+		WriteIRI(w, o, ascii)
+	case rdf.Literal:
+		WriteLiteral(w, o, ascii)
+	case rdf.TripleTerm:
+		if err := WriteTripleTerm(w, o, bnStringProvider, ascii); err != nil {
+			return fmt.Errorf("object: %v", err)
+		}
+	default:
+		return fmt.Errorf("object: invalid type: %T", o)
+	}
+
+	w.Write([]byte{' ', ')', '>', '>'})
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
 	"github.com/dpb587/rdfkit-go/encoding/ntriples/internal/grammar"
 	"github.com/dpb587/rdfkit-go/encoding/ntriples/ntriplescontent"
+	"github.com/dpb587/rdfkit-go/iri"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
 )
@@ -24,12 +25,14 @@ type Decoder struct {
 	buf              *cursorioutil.RuneBuffer
 	doc              *cursorio.TextWriter
 	bnStringFactory  blanknodes.StringFactory
+	iriMapper        iri.MapperFunc
 	buildTextOffsets encodingutil.TextOffsetsBuilderFunc
 
 	err error
 
-	currentTriple      rdf.Triple
-	currentTextOffsets encoding.StatementTextOffsets
+	currentTriple                rdf.Triple
+	currentTextOffsets           encoding.StatementTextOffsets
+	currentTripleTermTextOffsets []any
 }
 
 var _ encoding.TriplesDecoder = &Decoder{}
@@ -108,6 +111,8 @@ func (r *Decoder) Next() bool {
 
 	TRIPLE_START:
 
+		r.currentTripleTermTextOffsets = r.currentTripleTermTextOffsets[:0]
+
 		subject, subjectRange, err := r.captureSubject()
 		if err != nil {
 			if errors.Is(err, io.EOF) {
@@ -124,7 +129,7 @@ func (r *Decoder) Next() bool {
 			return grammar.R_triple.Err(err)
 		}
 
-		object, objectRange, err := r.captureObject()
+		object, objectRange, err := r.captureObject(encoding.ObjectStatementOffsets)
 		if err != nil {
 			return grammar.R_triple.Err(err)
 		}
@@ -161,9 +166,14 @@ func (r *Decoder) Next() bool {
 		}
 
 		r.currentTextOffsets = r.buildTextOffsets(
-			encoding.SubjectStatementOffsets, subjectRange,
-			encoding.PredicateStatementOffsets, predicateRange,
-			encoding.ObjectStatementOffsets, objectRange,
+			append(
+				[]any{
+					encoding.SubjectStatementOffsets, subjectRange,
+					encoding.PredicateStatementOffsets, predicateRange,
+					encoding.ObjectStatementOffsets, objectRange,
+				},
+				r.currentTripleTermTextOffsets...,
+			)...,
 		)
 
 		return nil
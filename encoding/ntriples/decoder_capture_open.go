@@ -148,6 +148,15 @@ DONE:
 		}
 	}
 
+	if r.iriMapper != nil {
+		mapped, err := r.iriMapper(urlString)
+		if err != nil {
+			return "", nil, grammar.R_IRIREF.ErrWithTextOffsetRange(err, cr)
+		}
+
+		return rdf.IRI(mapped), cr, nil
+	}
+
 	return rdf.IRI(decoded), cr, nil
 }
 
@@ -238,7 +247,7 @@ END_LEXICAL_FORM:
 
 	switch {
 	case r0.Rune == '@':
-		langtag, langtagRange, err := r.scanOpenLangtag(cursorio.DecodedRuneList{r0})
+		langtag, direction, langtagRange, err := r.scanOpenLangtag(cursorio.DecodedRuneList{r0})
 		if err != nil {
 			return rdf.Literal{}, nil, grammar.R_literal.Err(err)
 		}
@@ -252,6 +261,17 @@ END_LEXICAL_FORM:
 			}
 		}
 
+		if len(direction) > 0 {
+			return rdf.Literal{
+				Datatype:    rdfiri.DirLangString_Datatype,
+				LexicalForm: string(decoded),
+				Tag: rdf.DirectionalLanguageLiteralTag{
+					Language:      langtag,
+					BaseDirection: direction,
+				},
+			}, fullRange, nil
+		}
+
 		return rdf.Literal{
 			Datatype:    rdfiri.LangString_Datatype,
 			LexicalForm: string(decoded),
@@ -306,13 +326,15 @@ END_TOKEN:
 	}, stringRange, nil
 }
 
-func (r *Decoder) scanOpenLangtag(uncommitted cursorio.DecodedRuneList) (string, *cursorio.TextOffsetRange, error) {
+func (r *Decoder) scanOpenLangtag(uncommitted cursorio.DecodedRuneList) (string, string, *cursorio.TextOffsetRange, error) {
 	// assert(len(uncommitted) == 1 && uncommitted[0] == '@')
 
+	var directionIdx int
+
 	for {
 		r0, err := r.buf.NextRune()
 		if err != nil {
-			return "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+			return "", "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
 		}
 
 		switch {
@@ -320,7 +342,7 @@ func (r *Decoder) scanOpenLangtag(uncommitted cursorio.DecodedRuneList) (string,
 			uncommitted = append(uncommitted, r0)
 		case r0.Rune == '-':
 			if len(uncommitted) == 1 {
-				return "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, uncommitted.AsDecodedRunes(), r0.AsDecodedRunes()))
+				return "", "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, uncommitted.AsDecodedRunes(), r0.AsDecodedRunes()))
 			}
 
 			uncommitted = append(uncommitted, r0)
@@ -338,12 +360,38 @@ SECONDARY:
 	for {
 		r0, err := r.buf.NextRune()
 		if err != nil {
-			return "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+			return "", "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
 		}
 
 		switch {
 		case 'a' <= r0.Rune && r0.Rune <= 'z', 'A' <= r0.Rune && r0.Rune <= 'Z', '0' <= r0.Rune && r0.Rune <= '9':
 			uncommitted = append(uncommitted, r0)
+		case r0.Rune == '-':
+			uncommitted = append(uncommitted, r0)
+
+			if uncommitted[len(uncommitted)-2].Rune == '-' {
+				directionIdx = len(uncommitted)
+
+				goto DIRECTION
+			}
+		default:
+			r.buf.BacktrackRunes(r0)
+
+			goto END
+		}
+	}
+
+DIRECTION:
+
+	for {
+		r0, err := r.buf.NextRune()
+		if err != nil {
+			return "", "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+		}
+
+		switch {
+		case 'a' <= r0.Rune && r0.Rune <= 'z', 'A' <= r0.Rune && r0.Rune <= 'Z':
+			uncommitted = append(uncommitted, r0)
 		default:
 			r.buf.BacktrackRunes(r0)
 
@@ -354,7 +402,7 @@ SECONDARY:
 END:
 
 	if uncommitted[len(uncommitted)-1].Rune == '-' {
-		return "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(
+		return "", "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(
 			cursorioutil.UnexpectedRuneError{
 				Rune: uncommitted[len(uncommitted)-1].Rune,
 			},
@@ -363,7 +411,26 @@ END:
 		))
 	}
 
+	if directionIdx == 0 {
+		r.commit(uncommitted[0:1].AsDecodedRunes())
+
+		return string(uncommitted[1:].AsDecodedRunes().Runes), "", r.commitForTextOffsetRange(uncommitted[1:].AsDecodedRunes()), nil
+	}
+
+	direction := string(uncommitted[directionIdx:].AsDecodedRunes().Runes)
+
+	switch direction {
+	case "ltr", "rtl":
+		// valid
+	default:
+		return "", "", nil, grammar.R_LANGTAG.Err(r.newOffsetError(
+			fmt.Errorf("invalid base direction: %s", direction),
+			uncommitted[0:directionIdx].AsDecodedRunes(),
+			uncommitted[directionIdx:].AsDecodedRunes(),
+		))
+	}
+
 	r.commit(uncommitted[0:1].AsDecodedRunes())
 
-	return string(uncommitted[1:].AsDecodedRunes().Runes), r.commitForTextOffsetRange(uncommitted[1:].AsDecodedRunes()), nil
+	return string(uncommitted[1 : directionIdx-2].AsDecodedRunes().Runes), direction, r.commitForTextOffsetRange(uncommitted[1:].AsDecodedRunes()), nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/dpb587/cursorio-go/cursorio"
 	"github.com/dpb587/cursorio-go/x/cursorioutil"
+	"github.com/dpb587/rdfkit-go/encoding"
 	"github.com/dpb587/rdfkit-go/encoding/ntriples/internal/grammar"
 	"github.com/dpb587/rdfkit-go/rdf"
 )
@@ -83,7 +84,7 @@ func (r *Decoder) capturePredicate() (rdf.PredicateValue, *cursorio.TextOffsetRa
 	}
 }
 
-func (r *Decoder) captureObject() (rdf.ObjectValue, *cursorio.TextOffsetRange, error) {
+func (r *Decoder) captureObject(offsetsType encoding.StatementOffsetsType) (rdf.ObjectValue, *cursorio.TextOffsetRange, error) {
 	for {
 		r0, err := r.buf.NextRune()
 		if err != nil {
@@ -92,6 +93,31 @@ func (r *Decoder) captureObject() (rdf.ObjectValue, *cursorio.TextOffsetRange, e
 
 		switch {
 		case r0.Rune == '<':
+			r1, err := r.buf.NextRune()
+			if err != nil {
+				return nil, nil, grammar.R_object.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))
+			}
+
+			if r1.Rune == '<' {
+				r2, err := r.buf.NextRune()
+				if err != nil {
+					return nil, nil, grammar.R_object.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes(), cursorio.DecodedRunes{}))
+				}
+
+				if r2.Rune != '(' {
+					return nil, nil, grammar.R_object.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r2.Rune}, cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes(), r2.AsDecodedRunes()))
+				}
+
+				tripleTerm, tripleTermRange, err := r.captureOpenTripleTerm(cursorio.DecodedRuneList{r0, r1, r2}, offsetsType)
+				if err != nil {
+					return nil, nil, grammar.R_object.Err(err)
+				}
+
+				return tripleTerm, tripleTermRange, nil
+			}
+
+			r.buf.BacktrackRunes(r1)
+
 			iri, iriRange, err := r.captureOpenIRI(cursorio.DecodedRuneList{r0})
 			if err != nil {
 				return nil, nil, grammar.R_object.Err(err)
@@ -134,6 +160,85 @@ func (r *Decoder) captureObject() (rdf.ObjectValue, *cursorio.TextOffsetRange, e
 	}
 }
 
+func (r *Decoder) captureOpenTripleTerm(uncommitted cursorio.DecodedRuneList, offsetsType encoding.StatementOffsetsType) (rdf.TripleTerm, *cursorio.TextOffsetRange, error) {
+	// assert(len(uncommitted) == 3 && uncommitted[0:3] == "<<(")
+
+	openRange := r.commitForTextOffsetRange(uncommitted.AsDecodedRunes())
+
+	subject, subjectRange, err := r.captureSubject()
+	if err != nil {
+		return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	predicate, predicateRange, err := r.capturePredicate()
+	if err != nil {
+		return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	object, objectRange, err := r.captureObject(encoding.TripleTermStatementOffsets(offsetsType, encoding.ObjectStatementOffsets))
+	if err != nil {
+		return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	for {
+		r0, err := r.buf.NextRune()
+		if err != nil {
+			return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{}))
+		}
+
+		switch {
+		case r0.Rune == ')':
+			closing := cursorio.DecodedRuneList{r0}
+
+			for range 2 {
+				rN, err := r.buf.NextRune()
+				if err != nil {
+					return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(r.newOffsetError(err, closing.AsDecodedRunes(), cursorio.DecodedRunes{}))
+				} else if rN.Rune != '>' {
+					return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: rN.Rune}, closing.AsDecodedRunes(), rN.AsDecodedRunes()))
+				}
+
+				closing = append(closing, rN)
+			}
+
+			closeRange := r.commitForTextOffsetRange(closing.AsDecodedRunes())
+
+			if r.doc != nil {
+				r.currentTripleTermTextOffsets = append(
+					r.currentTripleTermTextOffsets,
+					encoding.TripleTermStatementOffsets(offsetsType, encoding.SubjectStatementOffsets), subjectRange,
+					encoding.TripleTermStatementOffsets(offsetsType, encoding.PredicateStatementOffsets), predicateRange,
+					encoding.TripleTermStatementOffsets(offsetsType, encoding.ObjectStatementOffsets), objectRange,
+				)
+			}
+
+			var tripleTermRange *cursorio.TextOffsetRange
+
+			if openRange != nil && closeRange != nil {
+				tripleTermRange = &cursorio.TextOffsetRange{
+					From:  openRange.From,
+					Until: closeRange.Until,
+				}
+			}
+
+			return rdf.TripleTerm{
+				Subject:   subject,
+				Predicate: predicate,
+				Object:    object,
+			}, tripleTermRange, nil
+		case r0.Rune == '#':
+			err = r.drainLine(cursorio.DecodedRuneList{r0})
+			if err != nil {
+				return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(err)
+			}
+		case unicode.IsSpace(r0.Rune):
+			r.commit(r0.AsDecodedRunes())
+		default:
+			return rdf.TripleTerm{}, nil, grammar.R_tripleTerm.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
+		}
+	}
+}
+
 func (r *Decoder) drainLine(uncommitted cursorio.DecodedRuneList) error {
 	for {
 		r0, err := r.buf.NextRune()
@@ -6,6 +6,7 @@ import (
 	"github.com/dpb587/cursorio-go/cursorio"
 	"github.com/dpb587/cursorio-go/x/cursorioutil"
 	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/iri"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
 )
 
@@ -14,6 +15,7 @@ type DecoderConfig struct {
 	initialTextOffset  *cursorio.TextOffset
 
 	bnStringFactory blanknodes.StringFactory
+	iriMapper       iri.MapperFunc
 }
 
 func (b DecoderConfig) SetCaptureTextOffsets(v bool) DecoderConfig {
@@ -37,6 +39,15 @@ func (b DecoderConfig) SetBlankNodeStringFactory(v blanknodes.StringFactory) Dec
 	return b
 }
 
+// SetIRIMapper checks or rewrites the IRIs of IRIREF terms. By default, any absolute IRI matching the IRIREF production
+// is used as-is. For example, use [iri.Validated] to reject IRIs which are not valid according to RFC 3987 or
+// [iri.Normalize] to also normalize them.
+func (b DecoderConfig) SetIRIMapper(v iri.MapperFunc) DecoderConfig {
+	b.iriMapper = v
+
+	return b
+}
+
 func (b DecoderConfig) apply(s *DecoderConfig) {
 	if b.captureTextOffsets != nil {
 		s.captureTextOffsets = b.captureTextOffsets
@@ -49,12 +60,17 @@ func (b DecoderConfig) apply(s *DecoderConfig) {
 	if b.bnStringFactory != nil {
 		s.bnStringFactory = b.bnStringFactory
 	}
+
+	if b.iriMapper != nil {
+		s.iriMapper = b.iriMapper
+	}
 }
 
 func (b DecoderConfig) newDecoder(r io.Reader) (*Decoder, error) {
 	d := &Decoder{
 		buf:              cursorioutil.NewRuneBuffer(r),
 		bnStringFactory:  b.bnStringFactory,
+		iriMapper:        b.iriMapper,
 		buildTextOffsets: encodingutil.BuildTextOffsetsNil,
 	}
 
@@ -5,6 +5,9 @@ import (
 	"testing"
 
 	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
 	"github.com/dpb587/rdfkit-go/rdf"
 )
@@ -168,6 +171,46 @@ func TestDecoder_ScanString(t *testing.T) {
 				LexicalForm: "hello🐛",
 			},
 		},
+		{
+			InputString: `"hello"@en-US-x-foo .`,
+			OutputLiteral: rdf.Literal{
+				Datatype:    rdfiri.LangString_Datatype,
+				LexicalForm: "hello",
+				Tag: rdf.LanguageLiteralTag{
+					Language: "en-US-x-foo",
+				},
+			},
+		},
+		{
+			InputString: `"مرحبا"@ar--rtl .`,
+			OutputLiteral: rdf.Literal{
+				Datatype:    rdfiri.DirLangString_Datatype,
+				LexicalForm: "مرحبا",
+				Tag: rdf.DirectionalLanguageLiteralTag{
+					Language:      "ar",
+					BaseDirection: "rtl",
+				},
+			},
+		},
+		{
+			InputString: `"hello"@en-US--ltr .`,
+			OutputLiteral: rdf.Literal{
+				Datatype:    rdfiri.DirLangString_Datatype,
+				LexicalForm: "hello",
+				Tag: rdf.DirectionalLanguageLiteralTag{
+					Language:      "en-US",
+					BaseDirection: "ltr",
+				},
+			},
+		},
+		{
+			InputString: `"hello"@en--LTR .`,
+			OutputError: `token (literal): token (LANGTAG): offset 0xc: invalid base direction: LTR`,
+		},
+		{
+			InputString: `"hello"@en-- .`,
+			OutputError: `token (literal): token (LANGTAG): offset 0xb: unexpected rune ('-')`,
+		},
 	} {
 		t.Run(string(tc.InputString), func(t *testing.T) {
 			s, err := NewDecoder(strings.NewReader(tc.InputString))
@@ -188,3 +231,159 @@ func TestDecoder_ScanString(t *testing.T) {
 		})
 	}
 }
+
+func TestDecoder_TripleTerm(t *testing.T) {
+	for _, tc := range []struct {
+		InputString  string
+		OutputObject rdf.ObjectValue
+		OutputError  string
+	}{
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> "o2" )>> .`,
+			OutputObject: rdf.TripleTerm{
+				Subject:   rdf.IRI("http://example.com/s2"),
+				Predicate: rdf.IRI("http://example.com/p2"),
+				Object: rdf.Literal{
+					Datatype:    xsdiri.String_Datatype,
+					LexicalForm: "o2",
+				},
+			},
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <<(<http://example.com/s2> <http://example.com/p2> <<( <http://example.com/s3> <http://example.com/p3> <http://example.com/o3> )>>)>> .`,
+			OutputObject: rdf.TripleTerm{
+				Subject:   rdf.IRI("http://example.com/s2"),
+				Predicate: rdf.IRI("http://example.com/p2"),
+				Object: rdf.TripleTerm{
+					Subject:   rdf.IRI("http://example.com/s3"),
+					Predicate: rdf.IRI("http://example.com/p3"),
+					Object:    rdf.IRI("http://example.com/o3"),
+				},
+			},
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> << <http://example.com/s2> <http://example.com/p2> "o2" >> .`,
+			OutputError: `token (triple): token (object): offset 0x30: unexpected rune (' ')`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> "o2" ) .`,
+			OutputError: `token (triple): token (object): token (tripleTerm): offset 0x68: unexpected rune (' ')`,
+		},
+		{
+			InputString: `<<( <http://example.com/s2> <http://example.com/p2> "o2" )>> <http://example.com/p> <http://example.com/o> .`,
+			OutputError: `token (triple): token (subject): token (IRIREF): offset 0x1: unexpected rune ('<')`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			s, err := NewDecoder(strings.NewReader(tc.InputString))
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !s.Next() {
+				if err := s.Err(); err == nil {
+					t.Fatalf("expected triple, but got none")
+				} else if len(tc.OutputError) == 0 || err.Error() != tc.OutputError {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			} else if len(tc.OutputError) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputObject, s.Triple().Object; !_e.TermEquals(_a) {
+				t.Errorf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestDecoder_TripleTermTextOffsets(t *testing.T) {
+	s, err := NewDecoder(
+		strings.NewReader(`<http://example.com/s> <http://example.com/p> <<( _:b1 <http://example.com/p2> <<( _:b2 <http://example.com/p3> "o3" )>> )>> .`),
+		DecoderConfig{}.SetCaptureTextOffsets(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !s.Next() {
+		t.Fatalf("unexpected error: %v", s.Err())
+	}
+
+	offsets := s.StatementTextOffsets()
+
+	for _, tc := range []struct {
+		Type      encoding.StatementOffsetsType
+		FromByte  int64
+		UntilByte int64
+	}{
+		{
+			Type:      encoding.ObjectStatementOffsets,
+			FromByte:  46,
+			UntilByte: 124,
+		},
+		{
+			Type:      encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, encoding.SubjectStatementOffsets),
+			FromByte:  50,
+			UntilByte: 54,
+		},
+		{
+			Type:      encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, encoding.PredicateStatementOffsets),
+			FromByte:  55,
+			UntilByte: 78,
+		},
+		{
+			Type:      encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, encoding.ObjectStatementOffsets),
+			FromByte:  79,
+			UntilByte: 120,
+		},
+		{
+			Type:      encoding.TripleTermStatementOffsets(encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, encoding.ObjectStatementOffsets), encoding.ObjectStatementOffsets),
+			FromByte:  112,
+			UntilByte: 116,
+		},
+	} {
+		t.Run(encoding.StatementOffsetsTypeName(tc.Type), func(t *testing.T) {
+			v, ok := offsets[tc.Type]
+			if !ok {
+				t.Fatalf("expected offsets, but got none")
+			}
+
+			if _e, _a := tc.FromByte, int64(v.From.Byte); _e != _a {
+				t.Errorf("expected %v, got %v", _e, _a)
+			}
+
+			if _e, _a := tc.UntilByte, int64(v.Until.Byte); _e != _a {
+				t.Errorf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestDecoder_IRIMapper(t *testing.T) {
+	input := `<HTTP://Example.com/%7euser> <http://example.com/p> <http://example.com/a/../b> .`
+
+	d, err := NewDecoder(strings.NewReader(input), DecoderConfig{}.SetIRIMapper(iri.Normalize))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if !d.Next() {
+		t.Fatalf("unexpected error: %v", d.Err())
+	}
+
+	if _a, _e := d.Triple().Subject, rdf.IRI("http://example.com/~user"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := d.Triple().Object, rdf.IRI("http://example.com/b"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	d, err = NewDecoder(strings.NewReader(`<http://example.com/s#a#b> <http://example.com/p> "o" .`), DecoderConfig{}.SetIRIMapper(iri.Validated))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if d.Next() {
+		t.Fatalf("expected error")
+	} else if _a, _e := d.Err().Error(), `token (triple): token (subject): token (IRIREF): invalid IRI: unexpected character in fragment: '#'`; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
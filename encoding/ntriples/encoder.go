@@ -80,6 +80,10 @@ func (w *Encoder) AddTriple(ctx context.Context, t rdf.Triple) error {
 		WriteIRI(w.buf, o, w.ascii)
 	case rdf.Literal:
 		WriteLiteral(w.buf, o, w.ascii)
+	case rdf.TripleTerm:
+		if _, err := WriteTripleTerm(w.buf, o, w.bnStringProvider, w.ascii); err != nil {
+			return fmt.Errorf("object: %v", err)
+		}
 	default:
 		return fmt.Errorf("object: invalid type: %T", o)
 	}
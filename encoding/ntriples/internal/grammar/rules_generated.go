@@ -19,13 +19,16 @@ const (
 	// R_predicate ::= IRIREF
 	R_predicate
 
-	// R_object ::= IRIREF | BLANK_NODE_LABEL | literal
+	// R_object ::= IRIREF | BLANK_NODE_LABEL | literal | tripleTerm
 	R_object
 
+	// R_tripleTerm ::= '<<(' subject predicate object ')>>'
+	R_tripleTerm
+
 	// R_literal ::= STRING_LITERAL_QUOTE ( ( ( '^^' IRIREF ) | LANGTAG )? )
 	R_literal
 
-	// R_LANGTAG ::= '@' ( ( [a-z] | [A-Z] )+ ) ( ( '-' ( ( [a-z] | [A-Z] | [0-9] )+ ) )* )
+	// R_LANGTAG ::= '@' ( ( [a-z] | [A-Z] )+ ) ( ( '-' ( ( [a-z] | [A-Z] | [0-9] )+ ) )* ) ( ( '--' ( ( [a-z] | [A-Z] )+ ) )? )
 	R_LANGTAG
 
 	// R_EOL ::= ( #xd | #xa )+
@@ -71,6 +74,8 @@ func (r R) String() string {
 		return "predicate"
 	case R_object:
 		return "object"
+	case R_tripleTerm:
+		return "tripleTerm"
 	case R_literal:
 		return "literal"
 	case R_LANGTAG:
@@ -102,6 +102,15 @@ func WriteLiteral(w *bytes.Buffer, t rdf.Literal, ascii bool) int {
 			return wlen
 		}
 
+		return wlen
+	case rdfiri.DirLangString_Datatype:
+		if langTag, ok := t.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+			wwlen, _ := w.Write([]byte("@" + langTag.Language + "--" + langTag.BaseDirection))
+			wlen += wwlen
+
+			return wlen
+		}
+
 		return wlen
 	}
 
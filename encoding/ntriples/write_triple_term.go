@@ -0,0 +1,60 @@
+package ntriples
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+func WriteTripleTerm(w *bytes.Buffer, t rdf.TripleTerm, bnStringProvider blanknodes.StringProvider, ascii bool) (int, error) {
+	wlen, _ := w.Write([]byte("<<( "))
+
+	switch s := t.Subject.(type) {
+	case rdf.BlankNode:
+		wwlen, _ := w.Write([]byte("_:" + bnStringProvider.GetBlankNodeString(s)))
+		wlen += wwlen
+	case rdf.IRI:
+		wlen += WriteIRI(w, s, ascii)
+	default:
+		return wlen, fmt.Errorf("subject: invalid type: %T", s)
+	}
+
+	wwlen, _ := w.Write([]byte(" "))
+	wlen += wwlen
+
+	switch p := t.Predicate.(type) {
+	case rdf.IRI:
+		wlen += WriteIRI(w, p, ascii)
+	default:
+		return wlen, fmt.Errorf("predicate: invalid type: %T", p)
+	}
+
+	wwlen, _ = w.Write([]byte(" "))
+	wlen += wwlen
+
+	switch o := t.Object.(type) {
+	case rdf.BlankNode:
+		wwlen, _ := w.Write([]byte("_:" + bnStringProvider.GetBlankNodeString(o)))
+		wlen += wwlen
+	case rdf.IRI:
+		wlen += WriteIRI(w, o, ascii)
+	case rdf.Literal:
+		wlen += WriteLiteral(w, o, ascii)
+	case rdf.TripleTerm:
+		wwlen, err := WriteTripleTerm(w, o, bnStringProvider, ascii)
+		wlen += wwlen
+
+		if err != nil {
+			return wlen, fmt.Errorf("object: %v", err)
+		}
+	default:
+		return wlen, fmt.Errorf("object: invalid type: %T", o)
+	}
+
+	wwlen, _ = w.Write([]byte(" )>>"))
+	wlen += wwlen
+
+	return wlen, nil
+}
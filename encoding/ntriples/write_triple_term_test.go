@@ -0,0 +1,78 @@
+package ntriples
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+func TestWriteTripleTerm(t *testing.T) {
+	bnFactory := blanknodes.NewStringFactory()
+
+	for _, tc := range []struct {
+		Name             string
+		InputTripleTerm  rdf.TripleTerm
+		InputOptionASCII bool
+		OutputBytes      []byte
+		OutputError      string
+	}{
+		{
+			Name: "literal",
+			InputTripleTerm: rdf.TripleTerm{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object: rdf.Literal{
+					Datatype:    xsdiri.String_Datatype,
+					LexicalForm: "hello🐛",
+				},
+			},
+			InputOptionASCII: true,
+			OutputBytes:      []byte(`<<( <http://example.com/s> <http://example.com/p> "hello\U0001F41B" )>>`),
+		},
+		{
+			Name: "nested",
+			InputTripleTerm: rdf.TripleTerm{
+				Subject:   bnFactory.NewStringBlankNode("x"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object: rdf.TripleTerm{
+					Subject:   rdf.IRI("http://example.com/s2"),
+					Predicate: rdf.IRI("http://example.com/p2"),
+					Object:    rdf.IRI("http://example.com/o2"),
+				},
+			},
+			OutputBytes: []byte(`<<( _:b0 <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> <http://example.com/o2> )>> )>>`),
+		},
+		{
+			Name: "invalid subject",
+			InputTripleTerm: rdf.TripleTerm{
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    rdf.IRI("http://example.com/o"),
+			},
+			OutputError: "subject: invalid type: <nil>",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+
+			_, err := WriteTripleTerm(buf, tc.InputTripleTerm, blanknodes.NewInt64StringProvider("b%d"), tc.InputOptionASCII)
+			if len(tc.OutputError) > 0 {
+				if err == nil {
+					t.Fatalf("expected error, but got nil")
+				} else if _e, _a := tc.OutputError, err.Error(); _e != _a {
+					t.Fatalf("expected %v, got %v", _e, _a)
+				}
+
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if _e, _a := tc.OutputBytes, buf.Bytes(); !bytes.Equal(_e, _a) {
+				t.Errorf("unexpected output: %s", _a)
+			}
+		})
+	}
+}
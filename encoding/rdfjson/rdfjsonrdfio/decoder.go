@@ -23,11 +23,11 @@ func (decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
 }
 
 func (e decoder) NewDecoderParams() rdfiotypes.Params {
-	return &decoderParams{}
+	return newDecoderParams()
 }
 
 func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions) (*rdfiotypes.DecoderHandle, error) {
-	params := &decoderParams{}
+	params := newDecoderParams()
 
 	err := rdfiotypes.LoadAndApplyParams(params, opts.Params...)
 	if err != nil {
@@ -53,9 +53,27 @@ func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions
 		return nil, err
 	}
 
-	return &rdfiotypes.DecoderHandle{
+	h := &rdfiotypes.DecoderHandle{
 		Reader:            rr,
 		Decoder:           decoder,
 		DecoderBlankNodes: bnFactory,
-	}, nil
+	}
+
+	err = params.ValidateLiterals.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	err = params.ValidateIRIs.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	params.CanonicalizeLiterals.ResolveDecoderHandle(h)
+	params.NormalizeIRIs.ResolveDecoderHandle(h)
+
+	params.Deskolemize.ResolveDecoderHandle(h)
+	params.Skolemize.ResolveDecoderHandle(h)
+
+	return h, nil
 }
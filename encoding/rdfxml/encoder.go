@@ -0,0 +1,688 @@
+package rdfxml
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/rdfxml/internal"
+	"github.com/dpb587/rdfkit-go/encoding/rdfxml/rdfxmlcontent"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/iriutil"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdfdescription"
+	"github.com/dpb587/rdfkit-go/rdfdescription/rdfdescriptionutil"
+)
+
+type EncoderOption interface {
+	apply(s *EncoderConfig)
+	newEncoder(w io.Writer) (*Encoder, error)
+}
+
+// Encoder writes RDF/XML. Triples are written as rdf:Description elements, sharing the element of the previous triple
+// when it has the same subject. Resources are written in the abbreviated syntax with typed node elements, nested
+// descriptions, and rdf:parseType="Resource" or "Collection" property elements.
+//
+// Predicates are written as qualified names, so an error is returned for a predicate IRI which does not end with a
+// valid NCName (e.g. "http://example.com/123"). Triple terms and directional language-tagged strings are not supported.
+type Encoder struct {
+	w                io.Writer
+	base             *iri.BaseIRI
+	prefixMapper     iri.PrefixMapper
+	prefixes         *iriutil.UsagePrefixMapper
+	bnStringProvider blanknodes.StringProvider
+
+	err              error
+	buffered         bool
+	bufferedSort     bool
+	bufferedSections [][]byte
+
+	// declaredPrefixes are the prefixes declared by the root element when unbuffered. Any other namespaces are
+	// declared on the elements which use them.
+	declaredPrefixes map[string]struct{}
+
+	// generatedNamespaces are prefixes for namespaces of element names which no configured prefix could compact.
+	generatedNamespaces map[string]string
+	generatedPrefixes   iri.PrefixMappingList
+
+	// pending is the rdf:Description of the most recent triple which remains open so that subsequent triples about the
+	// same subject may be written within it.
+	pending *pendingDescription
+}
+
+type pendingDescription struct {
+	buf     *bytes.Buffer
+	subject rdf.SubjectValue
+}
+
+var _ encoding.TriplesEncoder = &Encoder{}
+var _ rdfdescriptionutil.ResourceEncoder = &Encoder{}
+
+func NewEncoder(w io.Writer, opts ...EncoderOption) (*Encoder, error) {
+	compiledOpts := EncoderConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&compiledOpts)
+	}
+
+	return compiledOpts.newEncoder(w)
+}
+
+func (e *Encoder) GetContentMetadata() encoding.ContentMetadata {
+	return rdfxmlcontent.DefaultMetadata
+}
+
+func (e *Encoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return rdfxmlcontent.TypeIdentifier
+}
+
+func (w *Encoder) Close() error {
+	if w.err != nil {
+		if errors.Is(w.err, io.ErrClosedPipe) {
+			return nil
+		}
+
+		return w.err
+	}
+
+	if err := w.flushPending(); err != nil {
+		return err
+	}
+
+	if w.buffered {
+		if w.bufferedSort {
+			slices.SortFunc(w.bufferedSections, func(i, j []byte) int {
+				return bytes.Compare(i, j)
+			})
+		}
+
+		var prefixMappings iri.PrefixMappingList
+
+		for _, prefix := range w.prefixes.GetUsedPrefixes() {
+			if expanded, ok := w.prefixes.ExpandPrefix(iri.PrefixReference{Prefix: prefix}); ok {
+				prefixMappings = append(prefixMappings, iri.PrefixMapping{
+					Prefix:   prefix,
+					Expanded: expanded,
+				})
+			}
+		}
+
+		prefixMappings = append(prefixMappings, w.generatedPrefixes...)
+
+		err := w.writeRootStart(prefixMappings)
+		if err != nil {
+			return fmt.Errorf("write header: %v", err)
+		}
+
+		for _, section := range w.bufferedSections {
+			_, err := w.w.Write(section)
+			if err != nil {
+				return fmt.Errorf("write: %v", err)
+			}
+		}
+	}
+
+	_, err := w.w.Write([]byte("</rdf:RDF>\n"))
+	if err != nil {
+		return fmt.Errorf("write: %v", err)
+	}
+
+	w.err = io.ErrClosedPipe
+
+	return nil
+}
+
+func (w *Encoder) AddTriple(ctx context.Context, t rdf.Triple) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	if w.pending != nil && !w.pending.subject.TermEquals(t.Subject) {
+		if err := w.flushPending(); err != nil {
+			return err
+		}
+	}
+
+	buf := &bytes.Buffer{}
+
+	err := w.writePropertyElement(buf, "\t\t", t.Predicate, t.Object)
+	if err != nil {
+		return err
+	}
+
+	if w.pending == nil {
+		subjectAttr, err := w.formatSubjectAttr(t.Subject)
+		if err != nil {
+			return fmt.Errorf("subject: %v", err)
+		}
+
+		w.pending = &pendingDescription{
+			buf:     bytes.NewBufferString("\t<rdf:Description" + subjectAttr + ">\n"),
+			subject: t.Subject,
+		}
+	}
+
+	buf.WriteTo(w.pending.buf)
+
+	return nil
+}
+
+func (w *Encoder) flushPending() error {
+	if w.pending == nil {
+		return nil
+	}
+
+	buf := w.pending.buf
+	buf.WriteString("\t</rdf:Description>\n")
+
+	w.pending = nil
+
+	return w.writeSection(buf)
+}
+
+func (w *Encoder) AddResource(ctx context.Context, r rdfdescription.Resource) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	statements := r.GetResourceStatements()
+
+	if len(statements) == 0 {
+		return nil
+	}
+
+	if err := w.flushPending(); err != nil {
+		return err
+	}
+
+	buf := &bytes.Buffer{}
+
+	err := w.writeNodeElement(buf, "\t", r.GetResourceSubject(), statements)
+	if err != nil {
+		return fmt.Errorf("resource: %v", err)
+	}
+
+	return w.writeSection(buf)
+}
+
+func (w *Encoder) writeSection(buf *bytes.Buffer) error {
+	if w.buffered {
+		w.bufferedSections = append(w.bufferedSections, buf.Bytes())
+
+		return nil
+	}
+
+	_, err := buf.WriteTo(w.w)
+	if err != nil {
+		return fmt.Errorf("write: %v", err)
+	}
+
+	return nil
+}
+
+func (w *Encoder) writeRootStart(prefixMappings iri.PrefixMappingList) error {
+	buf := &bytes.Buffer{}
+	buf.WriteString("<?xml version=\"1.0\" encoding=\"utf-8\"?>\n")
+	buf.WriteString("<rdf:RDF xmlns:rdf=\"" + internal.Space + "\"")
+
+	prefixMappings = slices.Clone(prefixMappings)
+	slices.SortFunc(prefixMappings, iri.ComparePrefixMappingByPrefix)
+
+	for _, mapping := range prefixMappings {
+		if mapping.Prefix == "rdf" {
+			continue
+		}
+
+		expanded, err := formatXMLText(mapping.Expanded, true)
+		if err != nil {
+			return fmt.Errorf("prefix %s: %v", mapping.Prefix, err)
+		}
+
+		buf.WriteString("\n\txmlns:" + mapping.Prefix + "=\"" + expanded + "\"")
+	}
+
+	if w.base != nil {
+		base, err := formatXMLText(w.base.String(), true)
+		if err != nil {
+			return fmt.Errorf("base: %v", err)
+		}
+
+		buf.WriteString("\n\txml:base=\"" + base + "\"")
+	}
+
+	buf.WriteString(">\n")
+
+	_, err := buf.WriteTo(w.w)
+
+	return err
+}
+
+// writeNodeElement writes a description of the subject, or of a blank node if subject is nil. The first rdf:type which
+// can be written as a qualified name is used as the element name.
+func (w *Encoder) writeNodeElement(buf *bytes.Buffer, linePrefix string, subject rdf.SubjectValue, statements rdfdescription.StatementList) error {
+	var subjectAttr string
+
+	if subject != nil {
+		var err error
+
+		subjectAttr, err = w.formatSubjectAttr(subject)
+		if err != nil {
+			return fmt.Errorf("subject: %v", err)
+		}
+	}
+
+	elementName := "rdf:Description"
+	var elementDecl string
+
+	{
+		var typeIRIs []string
+
+		for _, statement := range statements {
+			if os, ok := statement.(rdfdescription.ObjectStatement); ok && os.Predicate == rdfiri.Type_Property {
+				if typeIRI, ok := os.Object.(rdf.IRI); ok {
+					typeIRIs = append(typeIRIs, string(typeIRI))
+				}
+			}
+		}
+
+		if len(typeIRIs) > 0 {
+			slices.Sort(typeIRIs)
+
+			if name, decl, err := w.formatQName(typeIRIs[0]); err == nil {
+				elementName = name
+				elementDecl = decl
+
+				statements = slices.DeleteFunc(slices.Clone(statements), func(statement rdfdescription.Statement) bool {
+					os, ok := statement.(rdfdescription.ObjectStatement)
+
+					return ok && os.Predicate == rdfiri.Type_Property && os.Object == rdf.IRI(typeIRIs[0])
+				})
+			}
+		}
+	}
+
+	buf.WriteString(linePrefix + "<" + elementName + elementDecl + subjectAttr)
+
+	if len(statements) == 0 {
+		buf.WriteString("/>\n")
+
+		return nil
+	}
+
+	buf.WriteString(">\n")
+
+	err := w.writePropertyElements(buf, linePrefix+"\t", statements)
+	if err != nil {
+		return err
+	}
+
+	buf.WriteString(linePrefix + "</" + elementName + ">\n")
+
+	return nil
+}
+
+func (w *Encoder) writePropertyElements(buf *bytes.Buffer, linePrefix string, statements rdfdescription.StatementList) error {
+	statementsByPredicate := statements.GroupByPredicate()
+
+	var predicateList rdf.PredicateValueList
+
+	{
+		raw := statementsByPredicate.GetPredicateList()
+		slices.SortFunc(raw, func(a, b rdf.PredicateValue) int {
+			return strings.Compare(string(a.(rdf.IRI)), string(b.(rdf.IRI)))
+		})
+
+		for _, p := range raw {
+			if p == rdfiri.Type_Property {
+				predicateList = append([]rdf.PredicateValue{p}, predicateList...)
+			} else {
+				predicateList = append(predicateList, p)
+			}
+		}
+	}
+
+	for _, p := range predicateList {
+		for _, statement := range statementsByPredicate[p] {
+			switch statementT := statement.(type) {
+			case rdfdescription.ObjectStatement:
+				err := w.writePropertyElement(buf, linePrefix, p, statementT.Object)
+				if err != nil {
+					return err
+				}
+			case rdfdescription.AnonResourceStatement:
+				err := w.writeAnonResourcePropertyElement(buf, linePrefix, p, statementT.AnonResource)
+				if err != nil {
+					return err
+				}
+			default:
+				return fmt.Errorf("statement: invalid type: %T", statement)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (w *Encoder) writeAnonResourcePropertyElement(buf *bytes.Buffer, linePrefix string, p rdf.PredicateValue, resource rdfdescription.AnonResource) error {
+	name, decl, err := w.formatPredicateQName(p)
+	if err != nil {
+		return err
+	}
+
+	statements := resource.GetResourceStatements()
+
+	if len(statements) == 0 {
+		buf.WriteString(linePrefix + "<" + name + decl + " rdf:parseType=\"Resource\"/>\n")
+
+		return nil
+	}
+
+	if entries, ok := w.normalizedListSyntax(resource); ok && isNodeStatementList(entries) {
+		buf.WriteString(linePrefix + "<" + name + decl + " rdf:parseType=\"Collection\">\n")
+
+		for _, entry := range entries {
+			switch entryT := entry.(type) {
+			case rdfdescription.ObjectStatement:
+				subjectAttr, err := w.formatSubjectAttr(entryT.Object.(rdf.SubjectValue))
+				if err != nil {
+					return fmt.Errorf("collection: %v", err)
+				}
+
+				buf.WriteString(linePrefix + "\t<rdf:Description" + subjectAttr + "/>\n")
+			case rdfdescription.AnonResourceStatement:
+				err := w.writeNodeElement(buf, linePrefix+"\t", nil, entryT.AnonResource.Statements)
+				if err != nil {
+					return fmt.Errorf("collection: %v", err)
+				}
+			}
+		}
+
+		buf.WriteString(linePrefix + "</" + name + ">\n")
+
+		return nil
+	}
+
+	for _, statement := range statements {
+		if os, ok := statement.(rdfdescription.ObjectStatement); ok && os.Predicate == rdfiri.Type_Property {
+			buf.WriteString(linePrefix + "<" + name + decl + ">\n")
+
+			err := w.writeNodeElement(buf, linePrefix+"\t", nil, statements)
+			if err != nil {
+				return err
+			}
+
+			buf.WriteString(linePrefix + "</" + name + ">\n")
+
+			return nil
+		}
+	}
+
+	buf.WriteString(linePrefix + "<" + name + decl + " rdf:parseType=\"Resource\">\n")
+
+	err = w.writePropertyElements(buf, linePrefix+"\t", statements)
+	if err != nil {
+		return err
+	}
+
+	buf.WriteString(linePrefix + "</" + name + ">\n")
+
+	return nil
+}
+
+func (w *Encoder) writePropertyElement(buf *bytes.Buffer, linePrefix string, p rdf.PredicateValue, o rdf.ObjectValue) error {
+	name, decl, err := w.formatPredicateQName(p)
+	if err != nil {
+		return err
+	}
+
+	switch oT := o.(type) {
+	case rdf.IRI:
+		attr, err := w.formatIRIAttr(string(oT))
+		if err != nil {
+			return fmt.Errorf("object: %v", err)
+		}
+
+		buf.WriteString(linePrefix + "<" + name + decl + " rdf:resource=\"" + attr + "\"/>\n")
+
+		return nil
+	case rdf.BlankNode:
+		buf.WriteString(linePrefix + "<" + name + decl + " rdf:nodeID=\"" + w.bnStringProvider.GetBlankNodeString(oT) + "\"/>\n")
+
+		return nil
+	case rdf.Literal:
+		var literalAttr string
+
+		switch oT.Datatype {
+		case xsdiri.String_Datatype:
+			// default
+		case rdfiri.LangString_Datatype:
+			langTag, ok := oT.Tag.(rdf.LanguageLiteralTag)
+			if !ok {
+				return fmt.Errorf("object: language tag: invalid type: %T", oT.Tag)
+			}
+
+			attr, err := formatXMLText(langTag.Language, true)
+			if err != nil {
+				return fmt.Errorf("object: language tag: %v", err)
+			}
+
+			literalAttr = " xml:lang=\"" + attr + "\""
+		case rdfiri.DirLangString_Datatype:
+			return errors.New("object: directional language-tagged strings are not supported")
+		default:
+			attr, err := w.formatIRIAttr(string(oT.Datatype))
+			if err != nil {
+				return fmt.Errorf("object: datatype: %v", err)
+			}
+
+			literalAttr = " rdf:datatype=\"" + attr + "\""
+		}
+
+		text, err := formatXMLText(oT.LexicalForm, false)
+		if err != nil {
+			return fmt.Errorf("object: lexical form: %v", err)
+		}
+
+		buf.WriteString(linePrefix + "<" + name + decl + literalAttr + ">" + text + "</" + name + ">\n")
+
+		return nil
+	case rdf.TripleTerm:
+		return errors.New("object: triple terms are not supported")
+	}
+
+	return fmt.Errorf("object: invalid type: %T", o)
+}
+
+func (w *Encoder) formatSubjectAttr(v rdf.SubjectValue) (string, error) {
+	switch s := v.(type) {
+	case rdf.BlankNode:
+		return " rdf:nodeID=\"" + w.bnStringProvider.GetBlankNodeString(s) + "\"", nil
+	case rdf.IRI:
+		attr, err := w.formatIRIAttr(string(s))
+		if err != nil {
+			return "", err
+		}
+
+		return " rdf:about=\"" + attr + "\"", nil
+	}
+
+	return "", fmt.Errorf("invalid type: %T", v)
+}
+
+func (w *Encoder) formatIRIAttr(v string) (string, error) {
+	if w.base != nil {
+		if relativized, ok := w.base.RelativizeIRI(v); ok {
+			v = relativized
+		}
+	}
+
+	return formatXMLText(v, true)
+}
+
+func (w *Encoder) formatPredicateQName(p rdf.PredicateValue) (string, string, error) {
+	pIRI, ok := p.(rdf.IRI)
+	if !ok {
+		return "", "", fmt.Errorf("predicate: invalid type: %T", p)
+	}
+
+	name, decl, err := w.formatQName(string(pIRI))
+	if err != nil {
+		return "", "", fmt.Errorf("predicate: %v", err)
+	}
+
+	return name, decl, nil
+}
+
+// formatQName returns the qualified name of an element for the IRI along with any namespace declaration which must be
+// written on the element itself. Namespaces without a configured prefix use a generated prefix such as "ns.1".
+func (w *Encoder) formatQName(v string) (string, string, error) {
+	if pr, ok := w.prefixes.CompactPrefix(v); ok && len(pr.Prefix) > 0 && isNCName(pr.Reference) {
+		if w.buffered {
+			return pr.Prefix + ":" + pr.Reference, "", nil
+		} else if _, declared := w.declaredPrefixes[pr.Prefix]; declared {
+			return pr.Prefix + ":" + pr.Reference, "", nil
+		}
+
+		expanded, err := formatXMLText(v[:len(v)-len(pr.Reference)], true)
+		if err != nil {
+			return "", "", err
+		}
+
+		return pr.Prefix + ":" + pr.Reference, " xmlns:" + pr.Prefix + "=\"" + expanded + "\"", nil
+	}
+
+	namespace, local, ok := splitQName(v)
+	if !ok {
+		return "", "", fmt.Errorf("cannot be written as a qualified name: %s", v)
+	}
+
+	prefix, known := w.generatedNamespaces[namespace]
+	if !known {
+		for i := len(w.generatedPrefixes) + 1; ; i++ {
+			prefix = "ns." + strconv.Itoa(i)
+
+			if _, taken := w.prefixMapper.ExpandPrefix(iri.PrefixReference{Prefix: prefix}); !taken {
+				break
+			}
+		}
+
+		w.generatedNamespaces[namespace] = prefix
+		w.generatedPrefixes = append(w.generatedPrefixes, iri.PrefixMapping{
+			Prefix:   prefix,
+			Expanded: namespace,
+		})
+	}
+
+	if w.buffered {
+		return prefix + ":" + local, "", nil
+	}
+
+	expanded, err := formatXMLText(namespace, true)
+	if err != nil {
+		return "", "", err
+	}
+
+	return prefix + ":" + local, " xmlns:" + prefix + "=\"" + expanded + "\"", nil
+}
+
+func (e *Encoder) normalizedListSyntax(resource rdfdescription.AnonResource) (rdfdescription.StatementList, bool) {
+	statements := resource.GetResourceStatements()
+	if len(statements) == 0 {
+		return nil, false
+	}
+
+	var entries rdfdescription.StatementList
+	nextStatements := resource.GetResourceStatements()
+
+	for {
+		statementsByPredicate := nextStatements.GroupByPredicate()
+
+		var hasFirst rdfdescription.Statement
+		var hasRest rdfdescription.Statement
+
+		for predicate, statements := range statementsByPredicate {
+			switch predicate {
+			case rdfiri.Type_Property:
+				if len(statements) != 1 {
+					return nil, false
+				}
+
+				s0, ok := statements[0].(rdfdescription.ObjectStatement)
+				if !ok {
+					return nil, false
+				} else if s0.Object != rdfiri.List_Class {
+					return nil, false
+				}
+			case rdfiri.First_Property:
+				if len(statements) != 1 {
+					return nil, false
+				}
+
+				hasFirst = statements[0]
+			case rdfiri.Rest_Property:
+				if len(statements) != 1 {
+					return nil, false
+				}
+
+				hasRest = statements[0]
+			default:
+				return nil, false
+			}
+		}
+
+		if hasFirst == nil || hasRest == nil {
+			return nil, false
+		}
+
+		entries = append(entries, hasFirst)
+
+		switch restStmt := hasRest.(type) {
+		case rdfdescription.ObjectStatement:
+			switch oT := restStmt.Object.(type) {
+			case rdf.IRI:
+				if oT == rdfiri.Nil_List {
+					return entries, true
+				}
+			}
+
+			return nil, false
+		case rdfdescription.AnonResourceStatement:
+			nextStatements = restStmt.AnonResource.GetResourceStatements()
+		default:
+			panic(fmt.Errorf("invalid type: %T", restStmt))
+		}
+	}
+}
+
+//
+
+// isNodeStatementList reports whether every statement is a resource, as required for the items of
+// rdf:parseType="Collection".
+func isNodeStatementList(statements rdfdescription.StatementList) bool {
+	for _, statement := range statements {
+		switch statementT := statement.(type) {
+		case rdfdescription.ObjectStatement:
+			switch statementT.Object.(type) {
+			case rdf.IRI, rdf.BlankNode:
+				// good
+			default:
+				return false
+			}
+		case rdfdescription.AnonResourceStatement:
+			// good
+		default:
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,163 @@
+package rdfxml
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding/rdfxml/internal"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/iriutil"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+type EncoderConfig struct {
+	base     *string
+	prefixes iri.PrefixMappingList
+
+	autoPrefixes *bool
+
+	bnStringProvider blanknodes.StringProvider
+
+	buffered     *bool
+	bufferedSort *bool
+}
+
+// SetBase writes xml:base on the root element and prefers relative IRIs in attributes.
+func (s EncoderConfig) SetBase(v string) EncoderConfig {
+	s.base = &v
+
+	return s
+}
+
+// SetPrefixes declares namespaces for the qualified names of elements. The "rdf" prefix is always used for the RDF
+// namespace, and any prefixes which are reserved or not a valid NCName are ignored.
+func (s EncoderConfig) SetPrefixes(v iri.PrefixMappingList) EncoderConfig {
+	s.prefixes = v
+
+	return s
+}
+
+// SetAutoPrefixes creates prefixes for the namespaces of element names which are not covered by the configured
+// prefixes. See [iriutil.AutoPrefixMapper].
+func (s EncoderConfig) SetAutoPrefixes(v bool) EncoderConfig {
+	s.autoPrefixes = &v
+
+	return s
+}
+
+func (s EncoderConfig) SetBlankNodeStringProvider(v blanknodes.StringProvider) EncoderConfig {
+	s.bnStringProvider = v
+
+	return s
+}
+
+// SetBuffered writes the root element once all statements are known so that it only declares the namespaces which
+// are used. Otherwise, namespaces which were not configured are declared on each element that uses them.
+func (s EncoderConfig) SetBuffered(v bool) EncoderConfig {
+	s.buffered = &v
+
+	return s
+}
+
+func (s EncoderConfig) SetBufferedSort(v bool) EncoderConfig {
+	s.bufferedSort = &v
+
+	return s
+}
+
+func (s EncoderConfig) apply(d *EncoderConfig) {
+	if s.base != nil {
+		d.base = s.base
+	}
+
+	if s.prefixes != nil {
+		d.prefixes = s.prefixes
+	}
+
+	if s.autoPrefixes != nil {
+		d.autoPrefixes = s.autoPrefixes
+	}
+
+	if s.bnStringProvider != nil {
+		d.bnStringProvider = s.bnStringProvider
+	}
+
+	if s.buffered != nil {
+		d.buffered = s.buffered
+	}
+
+	if s.bufferedSort != nil {
+		d.bufferedSort = s.bufferedSort
+	}
+}
+
+func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
+	prefixMappings := iri.PrefixMappingList{
+		{
+			Prefix:   "rdf",
+			Expanded: internal.Space,
+		},
+	}
+
+	for _, mapping := range s.prefixes {
+		if !isNCName(mapping.Prefix) || strings.HasPrefix(strings.ToLower(mapping.Prefix), "xml") || mapping.Prefix == "rdf" {
+			continue
+		}
+
+		prefixMappings = append(prefixMappings, mapping)
+	}
+
+	prefixManager := iri.NewPrefixManager(prefixMappings)
+
+	e := &Encoder{
+		w:                   w,
+		bnStringProvider:    s.bnStringProvider,
+		generatedNamespaces: map[string]string{},
+	}
+
+	if s.autoPrefixes != nil && *s.autoPrefixes {
+		e.prefixMapper = iriutil.NewAutoPrefixMapper(prefixManager, iriutil.AutoPrefixMapperOptions{})
+	} else {
+		e.prefixMapper = prefixManager
+	}
+
+	e.prefixes = iriutil.NewUsagePrefixMapper(e.prefixMapper)
+
+	if s.base != nil {
+		baseIRI, err := iri.ParseBaseIRI(string(*s.base))
+		if err != nil {
+			return nil, fmt.Errorf("parse base: %v", err)
+		}
+
+		e.base = baseIRI
+	}
+
+	if s.buffered != nil && *s.buffered {
+		e.buffered = *s.buffered
+		e.bufferedSort = e.buffered
+	}
+
+	if s.bufferedSort != nil {
+		e.bufferedSort = *s.bufferedSort
+	}
+
+	if e.bnStringProvider == nil {
+		e.bnStringProvider = blanknodes.NewInt64StringProvider("b%d")
+	}
+
+	if !e.buffered {
+		e.declaredPrefixes = map[string]struct{}{}
+
+		for _, mapping := range prefixManager.GetPrefixMappings() {
+			e.declaredPrefixes[mapping.Prefix] = struct{}{}
+		}
+
+		err := e.writeRootStart(prefixManager.GetPrefixMappings())
+		if err != nil {
+			return nil, fmt.Errorf("write header: %v", err)
+		}
+	}
+
+	return e, nil
+}
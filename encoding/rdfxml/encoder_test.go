@@ -0,0 +1,157 @@
+package rdfxml
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdfdescription"
+)
+
+func TestEncoder_Unbuffered_Triples(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetPrefixes(iri.PrefixMappingList{
+			{
+				Prefix:   "ex",
+				Expanded: "http://example.com/",
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/name"),
+		Object: rdf.Literal{
+			Datatype:    rdfiri.LangString_Datatype,
+			LexicalForm: "a & b",
+			Tag: rdf.LanguageLiteralTag{
+				Language: "en",
+			},
+		},
+	})
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/knows"),
+		Object:    rdf.IRI("http://example.com/o"),
+	})
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/o"),
+		Predicate: rdf.IRI("http://example.org/vocab#label"),
+		Object:    rdf.NewBlankNode(),
+	})
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `<?xml version="1.0" encoding="utf-8"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmlns:ex="http://example.com/">
+	<rdf:Description rdf:about="http://example.com/s">
+		<ex:name xml:lang="en">a &amp; b</ex:name>
+		<ex:knows rdf:resource="http://example.com/o"/>
+	</rdf:Description>
+	<rdf:Description rdf:about="http://example.com/o">
+		<ns.1:label xmlns:ns.1="http://example.org/vocab#" rdf:nodeID="b0"/>
+	</rdf:Description>
+</rdf:RDF>
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_Buffered_Resources(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetBuffered(true).
+		SetAutoPrefixes(true).
+		SetBase("http://example.com/"),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = e.AddResource(ctx, rdfdescription.SubjectResource{
+		Subject: rdf.IRI("http://example.com/s"),
+		Statements: rdfdescription.StatementList{
+			rdfdescription.ObjectStatement{
+				Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+				Object:    rdf.Literal{Datatype: rdf.IRI("http://example.com/custom"), LexicalForm: "custom"},
+			},
+			rdfdescription.ObjectStatement{
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://xmlns.com/foaf/0.1/Person"),
+			},
+			rdfdescription.AnonResourceStatement{
+				Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/knows"),
+				AnonResource: rdfdescription.AnonResource{
+					Statements: rdfdescription.StatementList{
+						rdfdescription.ObjectStatement{
+							Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+							Object:    rdf.Literal{Datatype: rdf.IRI("http://www.w3.org/2001/XMLSchema#string"), LexicalForm: "other"},
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `<?xml version="1.0" encoding="utf-8"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmlns:foaf="http://xmlns.com/foaf/0.1/"
+	xml:base="http://example.com/">
+	<foaf:Person rdf:about="s">
+		<foaf:knows rdf:parseType="Resource">
+			<foaf:name>other</foaf:name>
+		</foaf:knows>
+		<foaf:name rdf:datatype="custom">custom</foaf:name>
+	</foaf:Person>
+</rdf:RDF>
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_PredicateNotQName(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	err = e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/123"),
+		Object:    rdf.IRI("http://example.com/o"),
+	})
+	if err == nil {
+		err = e.Close()
+	}
+
+	if err == nil {
+		t.Fatalf("expected error, got nil")
+	}
+}
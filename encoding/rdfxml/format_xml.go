@@ -0,0 +1,115 @@
+package rdfxml
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// formatXMLText escapes a value for character data or, when attr is true, a double-quoted attribute value. Whitespace
+// which would otherwise be normalized by parsers is written as a character reference. An error is returned for
+// characters which are not allowed in XML 1.0.
+func formatXMLText(v string, attr bool) (string, error) {
+	var sb strings.Builder
+
+	for i, r := range v {
+		if r == utf8.RuneError {
+			if _, size := utf8.DecodeRuneInString(v[i:]); size == 1 {
+				return "", fmt.Errorf("invalid utf-8 at offset %d", i)
+			}
+		}
+
+		switch {
+		case r == '&':
+			sb.WriteString("&amp;")
+		case r == '<':
+			sb.WriteString("&lt;")
+		case r == '>':
+			sb.WriteString("&gt;")
+		case r == '"' && attr:
+			sb.WriteString("&quot;")
+		case r == '\r':
+			sb.WriteString("&#xD;")
+		case r == '\n' && attr:
+			sb.WriteString("&#xA;")
+		case r == '\t' && attr:
+			sb.WriteString("&#x9;")
+		case isXMLCharRune(r):
+			sb.WriteRune(r)
+		default:
+			return "", fmt.Errorf("character not allowed in XML: %U", r)
+		}
+	}
+
+	return sb.String(), nil
+}
+
+// isXMLCharRune is the Char production of XML 1.0.
+func isXMLCharRune(r rune) bool {
+	return r == 0x9 || r == 0xA || r == 0xD ||
+		(r >= 0x20 && r <= 0xD7FF) ||
+		(r >= 0xE000 && r <= 0xFFFD) ||
+		(r >= 0x10000 && r <= 0x10FFFF)
+}
+
+// isNCName reports whether v is an XML name without a colon, such as the prefix or local part of a qualified name.
+func isNCName(v string) bool {
+	return reXmlNamespaceName.MatchString(v) && !strings.Contains(v, ":")
+}
+
+// splitQName splits an IRI into a namespace and the longest suffix which is a valid NCName. False is returned if no
+// suffix is valid, in which case the IRI cannot be used as the name of an element.
+func splitQName(v string) (string, string, bool) {
+	localIdx := len(v)
+
+	for localIdx > 0 {
+		r, size := utf8.DecodeLastRuneInString(v[:localIdx])
+		if !isNCNameRune(r) {
+			break
+		}
+
+		localIdx -= size
+	}
+
+	for localIdx < len(v) {
+		r, size := utf8.DecodeRuneInString(v[localIdx:])
+		if isNCNameStartRune(r) {
+			break
+		}
+
+		localIdx += size
+	}
+
+	if localIdx == 0 || localIdx == len(v) {
+		return "", "", false
+	}
+
+	return v[:localIdx], v[localIdx:], true
+}
+
+func isNCNameStartRune(r rune) bool {
+	return r == '_' ||
+		(r >= 'A' && r <= 'Z') ||
+		(r >= 'a' && r <= 'z') ||
+		(r >= 0xC0 && r <= 0xD6) ||
+		(r >= 0xD8 && r <= 0xF6) ||
+		(r >= 0xF8 && r <= 0x2FF) ||
+		(r >= 0x370 && r <= 0x37D) ||
+		(r >= 0x37F && r <= 0x1FFF) ||
+		(r >= 0x200C && r <= 0x200D) ||
+		(r >= 0x2070 && r <= 0x218F) ||
+		(r >= 0x2C00 && r <= 0x2FEF) ||
+		(r >= 0x3001 && r <= 0xD7FF) ||
+		(r >= 0xF900 && r <= 0xFDCF) ||
+		(r >= 0xFDF0 && r <= 0xFFFD) ||
+		(r >= 0x10000 && r <= 0xEFFFF)
+}
+
+func isNCNameRune(r rune) bool {
+	return isNCNameStartRune(r) ||
+		r == '-' || r == '.' ||
+		(r >= '0' && r <= '9') ||
+		r == 0xB7 ||
+		(r >= 0x300 && r <= 0x36F) ||
+		(r >= 0x203F && r <= 0x2040)
+}
@@ -0,0 +1,120 @@
+package rdfxmlrdfio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/rdfxml"
+	"github.com/dpb587/rdfkit-go/encoding/rdfxml/rdfxmlcontent"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/rdfacontext"
+	"github.com/dpb587/rdfkit-go/rdfdescription"
+	"github.com/dpb587/rdfkit-go/rdfdescription/rdfdescriptionutil"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type encoder struct{}
+
+var _ rdfiotypes.EncoderManager = encoder{}
+
+func NewEncoder() rdfiotypes.EncoderManager {
+	return encoder{}
+}
+
+func (encoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return rdfxmlcontent.TypeIdentifier
+}
+
+func (e encoder) NewEncoderParams() rdfiotypes.Params {
+	return &encoderParams{}
+}
+
+func (e encoder) NewEncoder(ww rdfiotypes.Writer, opts rdfiotypes.EncoderOptions) (*rdfiotypes.EncoderHandle, error) {
+	params := &encoderParams{}
+
+	err := rdfiotypes.LoadAndApplyParams(params, opts.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	options := rdfxml.EncoderConfig{}
+
+	if bnStringProvider := rdfiotypes.PropagateDecoderPipeBlankNodeStringProvider(opts.DecoderPipe); bnStringProvider != nil {
+		options = options.SetBlankNodeStringProvider(bnStringProvider)
+	}
+
+	if *params.Buffered {
+		options = options.SetBuffered(true)
+	}
+
+	if *params.IrisUseBase && len(opts.BaseIRI) > 0 {
+		options = options.SetBase(string(opts.BaseIRI))
+	}
+
+	{
+		var prefixes iri.PrefixMappingList
+		var autoPrefixes bool
+
+		for _, prefix := range params.IrisUsePrefixes {
+			if prefix == "rdfa-context" {
+				prefixes = rdfacontext.AppendWidelyUsedInitialContext(prefixes)
+
+				continue
+			} else if prefix == "auto" {
+				autoPrefixes = true
+
+				continue
+			} else if prefix == "none" {
+				prefixes = nil
+				autoPrefixes = false
+
+				continue
+			}
+
+			prefixSplit := strings.SplitN(prefix, ":", 2)
+			if len(prefixSplit) != 2 {
+				return nil, fmt.Errorf("flag[prefixes]: invalid prefix format")
+			}
+
+			prefixes = append(prefixes, iri.PrefixMapping{
+				Prefix:   prefixSplit[0],
+				Expanded: prefixSplit[1],
+			})
+		}
+
+		if len(prefixes) > 0 {
+			options = options.SetPrefixes(prefixes)
+		}
+
+		if autoPrefixes {
+			options = options.SetAutoPrefixes(true)
+		}
+	}
+
+	allOptions, err := rdfiotypes.PatchGenericOptions([]rdfxml.EncoderOption{options}, opts.Patcher)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, err := rdfxml.NewEncoder(ww, allOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrappedEncoder encoding.Encoder = encoder
+
+	if *params.Resources {
+		wrappedEncoder = rdfdescriptionutil.NewBufferedTriplesEncoder(
+			context.Background(),
+			encoder,
+			rdfdescription.DefaultExportResourceOptions,
+		)
+	}
+
+	return &rdfiotypes.EncoderHandle{
+		Writer:  ww,
+		Encoder: wrappedEncoder,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package testsuite
 
 import (
+	"bytes"
 	"fmt"
 	"strings"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/ntriples"
 	"github.com/dpb587/rdfkit-go/encoding/rdfxml"
 	"github.com/dpb587/rdfkit-go/encoding/turtle"
+	"github.com/dpb587/rdfkit-go/iri/rdfacontext"
 	"github.com/dpb587/rdfkit-go/ontology/earl/earliri"
 	"github.com/dpb587/rdfkit-go/ontology/earl/earltesting"
 	"github.com/dpb587/rdfkit-go/ontology/foaf/foafiri"
@@ -121,6 +123,73 @@ func Test(t *testing.T) {
 	}
 }
 
+// TestEncoderRoundTrip re-encodes the result of each evaluation test, both as triples and as resources, and checks
+// the decoded output is isomorphic.
+func TestEncoderRoundTrip(t *testing.T) {
+	testdata, testdataManifest := requireTestdata(t)
+
+	for _, entry := range testdataManifest.Entries {
+		if entry.Type != "http://www.w3.org/ns/rdftest#TestXMLEval" {
+			continue
+		}
+
+		t.Run(string(entry.ID), func(t *testing.T) {
+			expectedStatements, err := triples.CollectErr(rdfxml.NewDecoder(
+				testdata.NewFileByteReader(t, string(entry.Action)),
+				rdfxml.DecoderConfig{}.
+					SetDefaultBase(string(entry.Action)),
+			))
+			if err != nil {
+				t.Fatalf("setup error: decode action: %v", err)
+			}
+
+			for _, resources := range []bool{false, true} {
+				t.Run(fmt.Sprintf("resources=%v", resources), func(t *testing.T) {
+					buf := &bytes.Buffer{}
+
+					encoder, err := rdfxml.NewEncoder(buf, rdfxml.EncoderConfig{}.
+						SetBase(string(entry.Action)).
+						SetPrefixes(rdfacontext.AppendWidelyUsedInitialContext(nil)),
+					)
+					if err != nil {
+						t.Fatalf("setup error: %v", err)
+					}
+
+					if resources {
+						builder := rdfdescription.NewResourceListBuilder()
+						builder.Add(expectedStatements...)
+
+						err = builder.ToResourceWriter(t.Context(), encoder, rdfdescription.DefaultExportResourceOptions)
+					} else {
+						for _, triple := range expectedStatements {
+							if err = encoder.AddTriple(t.Context(), triple); err != nil {
+								break
+							}
+						}
+					}
+
+					if err != nil {
+						t.Fatalf("encode: %v", err)
+					} else if err = encoder.Close(); err != nil {
+						t.Fatalf("encode: %v", err)
+					}
+
+					actualStatements, err := triples.CollectErr(rdfxml.NewDecoder(
+						bytes.NewReader(buf.Bytes()),
+						rdfxml.DecoderConfig{}.
+							SetDefaultBase(string(entry.Action)),
+					))
+					if err != nil {
+						t.Fatalf("decode: %v\n%s", err, buf.String())
+					}
+
+					testingassert.IsomorphicGraphs(t.Context(), t, expectedStatements, actualStatements)
+				})
+			}
+		})
+	}
+}
+
 func requireTestdata(t *testing.T) (testingarchive.Archive, *Manifest) {
 	testdata := testingarchive.OpenTarGz(
 		t,
@@ -13,7 +13,28 @@ const (
 	ObjectStatementOffsets
 )
 
+// TripleTermStatementOffsets returns the type used for a property of a triple term which was decoded at the position
+// of parent. The property should be one of [SubjectStatementOffsets], [PredicateStatementOffsets], or
+// [ObjectStatementOffsets], and parent may itself be a type returned by this function for deeper nesting.
+func TripleTermStatementOffsets(parent, property StatementOffsetsType) StatementOffsetsType {
+	return (parent+1)*4 + property
+}
+
+// TripleTermStatementOffsetsParent returns the parent and property of a type created by [TripleTermStatementOffsets].
+// If t is a top-level type, false is returned.
+func TripleTermStatementOffsetsParent(t StatementOffsetsType) (StatementOffsetsType, StatementOffsetsType, bool) {
+	if t < 4 {
+		return 0, 0, false
+	}
+
+	return t/4 - 1, t % 4, true
+}
+
 func StatementOffsetsTypeName(t StatementOffsetsType) string {
+	if parent, property, ok := TripleTermStatementOffsetsParent(t); ok {
+		return StatementOffsetsTypeName(parent) + "." + StatementOffsetsTypeName(property)
+	}
+
 	switch t {
 	case GraphNameStatementOffsets:
 		return "graphName"
@@ -20,3 +20,12 @@ type DecoderEvent_PrefixDirective_Data struct {
 	Expanded        string
 	ExpandedOffsets *cursorio.TextOffsetRange
 }
+
+//
+
+type DecoderEvent_VersionDirective_ListenerFunc func(data DecoderEvent_VersionDirective_Data)
+
+type DecoderEvent_VersionDirective_Data struct {
+	Value        string
+	ValueOffsets *cursorio.TextOffsetRange
+}
@@ -2,6 +2,7 @@ package trig
 
 import (
 	"errors"
+	"fmt"
 	"io"
 
 	"github.com/dpb587/cursorio-go/cursorio"
@@ -12,15 +13,19 @@ import (
 type tokenLANGTAG struct {
 	Offsets *cursorio.TextOffsetRange
 	Decoded string
+
+	// Direction is the base direction, if present. It is either "ltr" or "rtl".
+	Direction string
 }
 
-// LANGTAG ::= '@' [a-zA-Z]+ ('-' [a-zA-Z0-9]+)*
+// LANGTAG ::= '@' [a-zA-Z]+ ('-' [a-zA-Z0-9]+)* ('--' [a-zA-Z]+)?
 func (r *Decoder) produceLANGTAG(r0 cursorio.DecodedRune) (*tokenLANGTAG, error) {
 	if r0.Rune != '@' {
 		return nil, grammar.R_LANGTAG.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
 	}
 
 	var uncommitted = cursorio.DecodedRuneList{r0}
+	var directionIdx int
 
 	for {
 		r0, err := r.buf.NextRune()
@@ -67,6 +72,36 @@ PRIMARY_DELIMITER_DONE:
 		switch {
 		case 'a' <= r0.Rune && r0.Rune <= 'z', 'A' <= r0.Rune && r0.Rune <= 'Z', '0' <= r0.Rune && r0.Rune <= '9':
 			uncommitted = append(uncommitted, r0)
+		case r0.Rune == '-':
+			uncommitted = append(uncommitted, r0)
+
+			if uncommitted[len(uncommitted)-2].Rune == '-' {
+				directionIdx = len(uncommitted)
+
+				goto DIRECTION_DELIMITER_DONE
+			}
+		default:
+			r.buf.BacktrackRunes(r0)
+
+			goto DONE
+		}
+	}
+
+DIRECTION_DELIMITER_DONE:
+
+	for {
+		r0, err := r.buf.NextRune()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				goto DONE
+			}
+
+			return nil, grammar.R_LANGTAG.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+		}
+
+		switch {
+		case 'a' <= r0.Rune && r0.Rune <= 'z', 'A' <= r0.Rune && r0.Rune <= 'Z':
+			uncommitted = append(uncommitted, r0)
 		default:
 			r.buf.BacktrackRunes(r0)
 
@@ -86,12 +121,35 @@ DONE:
 		))
 	}
 
-	r.commit(uncommitted[0:1].AsDecodedRunes())
+	if directionIdx == 0 {
+		r.commit(uncommitted[0:1].AsDecodedRunes())
 
-	valueUncommitted := uncommitted[1:]
+		valueUncommitted := uncommitted[1:]
+
+		return &tokenLANGTAG{
+			Offsets: r.commitForTextOffsetRange(valueUncommitted.AsDecodedRunes()),
+			Decoded: valueUncommitted.AsDecodedRunes().String(),
+		}, nil
+	}
+
+	direction := uncommitted[directionIdx:].AsDecodedRunes().String()
+
+	switch direction {
+	case "ltr", "rtl":
+		// valid
+	default:
+		return nil, grammar.R_LANGTAG.Err(r.newOffsetError(
+			fmt.Errorf("invalid base direction: %s", direction),
+			uncommitted[:directionIdx].AsDecodedRunes(),
+			uncommitted[directionIdx:].AsDecodedRunes(),
+		))
+	}
+
+	r.commit(uncommitted[0:1].AsDecodedRunes())
 
 	return &tokenLANGTAG{
-		Offsets: r.commitForTextOffsetRange(valueUncommitted.AsDecodedRunes()),
-		Decoded: valueUncommitted.AsDecodedRunes().String(),
+		Offsets:   r.commitForTextOffsetRange(uncommitted[1:].AsDecodedRunes()),
+		Decoded:   uncommitted[1 : directionIdx-2].AsDecodedRunes().String(),
+		Direction: direction,
 	}, nil
 }
@@ -10,7 +10,9 @@ import (
 
 func reader_scan_collection(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, openSubject rdf.SubjectValue, openSubjectRange *cursorio.TextOffsetRange) (readerStack, error) {
 	if r0.Rune == ')' {
-		return r.emit(statement{
+		nilRange := r.commitForTextOffsetRange(r0.AsDecodedRunes())
+
+		r.emit(statement{
 			quad: rdf.Quad{
 				Triple: rdf.Triple{
 					Subject:   ectx.CurSubject,
@@ -23,9 +25,11 @@ func reader_scan_collection(r *Decoder, ectx evaluationContext, r0 cursorio.Deco
 				encoding.GraphNameStatementOffsets, ectx.CurGraphNameLocation,
 				encoding.SubjectStatementOffsets, ectx.CurSubjectLocation,
 				encoding.PredicateStatementOffsets, ectx.CurPredicateLocation,
-				encoding.ObjectStatementOffsets, r.commitForTextOffsetRange(r0.AsDecodedRunes()),
+				encoding.ObjectStatementOffsets, nilRange,
 			),
 		})
+
+		return ectx.annotationState(rdfiri.Nil_List, nilRange), nil
 	}
 
 	r.buf.BacktrackRunes(r0)
@@ -35,6 +39,11 @@ func reader_scan_collection(r *Decoder, ectx evaluationContext, r0 cursorio.Deco
 	nectx.CurSubjectLocation = openSubjectRange
 	nectx.CurPredicate = rdfiri.First_Property
 	nectx.CurPredicateLocation = nil
+	nectx.InCollection = true
+
+	if annotationState := ectx.annotationState(openSubject, openSubjectRange); annotationState.fn != nil {
+		r.pushState(annotationState.ectx, annotationState.fn)
+	}
 
 	r.pushState(nectx, reader_scan_collection_Continue)
 
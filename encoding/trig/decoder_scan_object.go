@@ -19,6 +19,72 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 
 	switch {
 	case r0.Rune == '<':
+		r1, err := r.buf.NextRune()
+		if err != nil {
+			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))
+		} else if r1.Rune == '<' {
+			r2, err := r.buf.NextRune()
+			if err != nil {
+				return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes(), cursorio.DecodedRunes{}))
+			} else if r2.Rune == '(' {
+				token, err := r.produceTripleTerm(ectx, cursorio.DecodedRuneList{r0, r1, r2}, encoding.ObjectStatementOffsets)
+				if err != nil {
+					return readerStack{}, grammar.R_object.Err(err)
+				}
+
+				r.emit(statement{
+					quad: rdf.Quad{
+						Triple: rdf.Triple{
+							Subject:   ectx.CurSubject,
+							Predicate: ectx.CurPredicate,
+							Object:    token.Decoded,
+						},
+						GraphName: ectx.CurGraphName,
+					},
+					textOffsets: r.buildTextOffsets(append(
+						[]any{
+							encoding.GraphNameStatementOffsets, ectx.CurGraphNameLocation,
+							encoding.SubjectStatementOffsets, ectx.CurSubjectLocation,
+							encoding.PredicateStatementOffsets, ectx.CurPredicateLocation,
+							encoding.ObjectStatementOffsets, token.Offsets,
+						},
+						token.NestedOffsets...,
+					)...),
+				})
+
+				return ectx.annotationState(token.Decoded, token.Offsets), nil
+			}
+
+			r.buf.BacktrackRunes(r2)
+
+			token, err := r.produceReifiedTriple(ectx, cursorio.DecodedRuneList{r0, r1})
+			if err != nil {
+				return readerStack{}, grammar.R_object.Err(err)
+			}
+
+			r.emit(statement{
+				quad: rdf.Quad{
+					Triple: rdf.Triple{
+						Subject:   ectx.CurSubject,
+						Predicate: ectx.CurPredicate,
+						Object:    token.Decoded,
+					},
+					GraphName: ectx.CurGraphName,
+				},
+				textOffsets: r.buildTextOffsets(
+					encoding.GraphNameStatementOffsets, ectx.CurGraphNameLocation,
+					encoding.SubjectStatementOffsets, ectx.CurSubjectLocation,
+					encoding.PredicateStatementOffsets, ectx.CurPredicateLocation,
+					encoding.ObjectStatementOffsets, token.Offsets,
+				),
+			})
+			r.emit(token.Statements...)
+
+			return ectx.annotationState(token.Decoded, token.Offsets), nil
+		}
+
+		r.buf.BacktrackRunes(r1)
+
 		token, err := r.produceIRIREF(r0)
 		if err != nil {
 			return readerStack{}, grammar.R_object.Err(err)
@@ -29,7 +95,7 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 			return readerStack{}, grammar.R_object.Err(grammar.R_IRIREF.ErrWithTextOffsetRange(err, token.Offsets))
 		}
 
-		return r.emit(statement{
+		r.emit(statement{
 			quad: rdf.Quad{
 				Triple: rdf.Triple{
 					Subject:   ectx.CurSubject,
@@ -45,6 +111,8 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 				encoding.ObjectStatementOffsets, token.Offsets,
 			),
 		})
+
+		return ectx.annotationState(resolvedIRI, token.Offsets), nil
 	case r0.Rune == '_':
 		token, err := r.produceBlankNode(r0)
 		if err != nil {
@@ -53,7 +121,7 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 
 		blankNode := ectx.Global.BlankNodeStringFactory.NewStringBlankNode(token.Decoded)
 
-		return r.emit(statement{
+		r.emit(statement{
 			quad: rdf.Quad{
 				Triple: rdf.Triple{
 					Subject:   ectx.CurSubject,
@@ -69,6 +137,8 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 				encoding.ObjectStatementOffsets, token.Offsets,
 			),
 		})
+
+		return ectx.annotationState(blankNode, token.Offsets), nil
 	case r0.Rune == '(':
 		cursor := r.commitForTextOffsetRange(r0.AsDecodedRunes())
 
@@ -90,6 +160,11 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 		nectx.CurSubjectLocation = blankNodeRange
 		nectx.CurPredicate = nil
 		nectx.CurPredicateLocation = nil
+		nectx.InCollection = false
+
+		if annotationState := ectx.annotationState(blankNode, blankNodeRange); annotationState.fn != nil {
+			r.pushState(annotationState.ectx, annotationState.fn)
+		}
 
 		r.pushState(nectx, reader_scan_blankNodePropertyList_End)
 		r.pushState(nectx, reader_scan_PredicateObjectList_Continue)
@@ -112,82 +187,12 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 			),
 		})
 	case r0.Rune == '"', r0.Rune == '\'':
-		token, err := r.produceString(r0)
+		literal, literalRange, err := r.produceRDFLiteral(ectx, r0)
 		if err != nil {
-			return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err)))
-		}
-
-		literal := rdf.Literal{
-			Datatype:    xsdiri.String_Datatype,
-			LexicalForm: token.Decoded,
-		}
-
-		{
-			r0, err := r.buf.NextRune()
-			if err != nil {
-				return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{}))))
-			}
-
-			switch r0.Rune {
-			case '@':
-				langtagToken, err := r.produceLANGTAG(r0)
-				if err != nil {
-					return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err)))
-				}
-
-				literal.Datatype = rdfiri.LangString_Datatype
-				literal.Tag = rdf.LanguageLiteralTag{
-					Language: langtagToken.Decoded,
-				}
-			case '^':
-				r1, err := r.buf.NextRune()
-				if err != nil {
-					return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))))
-				} else if r1.Rune != '^' {
-					return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r1.Rune}, r0.AsDecodedRunes(), r1.AsDecodedRunes()))))
-				}
-
-				r2, err := r.buf.NextRune()
-				if err != nil {
-					return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes(), cursorio.DecodedRunes{}))))
-				}
-
-				r.commit(cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes())
-
-				if r2.Rune == '<' {
-					datatypeToken, err := r.produceIRIREF(r2)
-					if err != nil {
-						return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err)))
-					}
-
-					resolvedIRI, err := ectx.ResolveIRI(datatypeToken.Decoded)
-					if err != nil {
-						return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(grammar.R_IRIREF.ErrWithTextOffsetRange(err, datatypeToken.Offsets))))
-					}
-
-					literal.Datatype = resolvedIRI
-				} else {
-					datatypeToken, err := r.producePrefixedName(r2)
-					if err != nil {
-						return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err)))
-					}
-
-					expanded, ok := ectx.Global.Prefixes.ExpandPrefix(iri.PrefixReference{
-						Prefix:    datatypeToken.NamespaceDecoded,
-						Reference: datatypeToken.LocalDecoded,
-					})
-					if !ok {
-						return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_RDFLiteral.Err(grammar.R_PrefixedName.ErrWithTextOffsetRange(iri.NewUnknownPrefixError(datatypeToken.NamespaceDecoded), datatypeToken.Offsets))))
-					}
-
-					literal.Datatype = rdf.IRI(expanded)
-				}
-			default:
-				r.buf.BacktrackRunes(r0)
-			}
+			return readerStack{}, grammar.R_object.Err(err)
 		}
 
-		return r.emit(statement{
+		r.emit(statement{
 			quad: rdf.Quad{
 				Triple: rdf.Triple{
 					Subject:   ectx.CurSubject,
@@ -200,11 +205,12 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 				encoding.GraphNameStatementOffsets, ectx.CurGraphNameLocation,
 				encoding.SubjectStatementOffsets, ectx.CurSubjectLocation,
 				encoding.PredicateStatementOffsets, ectx.CurPredicateLocation,
-				encoding.ObjectStatementOffsets, token.Offsets,
+				encoding.ObjectStatementOffsets, literalRange,
 			),
 		})
-	case r0.Rune == '+', r0.Rune == '-', '0' <= r0.Rune && r0.Rune <= '9',
-		r0.Rune == '.':
+
+		return ectx.annotationState(literal, literalRange), nil
+	case r0.Rune == '+', r0.Rune == '-', '0' <= r0.Rune && r0.Rune <= '9', r0.Rune == '.':
 
 		if r0.Rune == '.' {
 			r1, err := r.buf.NextRune()
@@ -219,27 +225,12 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 			r.buf.BacktrackRunes(r1)
 		}
 
-		token, err := r.produceNumericLiteral(r0)
+		literal, literalRange, err := r.produceNumericLiteralValue(r0)
 		if err != nil {
-			return readerStack{}, grammar.R_object.Err(grammar.R_literal.Err(grammar.R_NumericLiteral.Err(err)))
-		}
-
-		literal := rdf.Literal{
-			LexicalForm: token.Decoded,
-		}
-
-		switch token.GrammarRule {
-		case grammar.R_INTEGER:
-			literal.Datatype = xsdiri.Integer_Datatype
-		case grammar.R_DECIMAL:
-			literal.Datatype = xsdiri.Decimal_Datatype
-		case grammar.R_DOUBLE:
-			literal.Datatype = xsdiri.Double_Datatype
-		default:
-			panic("unreachable")
+			return readerStack{}, grammar.R_object.Err(err)
 		}
 
-		return r.emit(statement{
+		r.emit(statement{
 			quad: rdf.Quad{
 				Triple: rdf.Triple{
 					Subject:   ectx.CurSubject,
@@ -252,48 +243,25 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 				encoding.GraphNameStatementOffsets, ectx.CurGraphNameLocation,
 				encoding.SubjectStatementOffsets, ectx.CurSubjectLocation,
 				encoding.PredicateStatementOffsets, ectx.CurPredicateLocation,
-				encoding.ObjectStatementOffsets, token.Offsets,
+				encoding.ObjectStatementOffsets, literalRange,
 			),
 		})
-	case r0.Rune == 't':
-		r1, err := r.buf.NextRune()
-		if err != nil {
-			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))
-		} else if r1.Rune != 'r' {
-			r.buf.BacktrackRunes(r0, r1)
 
-			return readerStack{ectx, reader_scan_object_PrefixedName}, nil
-		}
-
-		r2, err := r.buf.NextRune()
+		return ectx.annotationState(literal, literalRange), nil
+	case r0.Rune == 't', r0.Rune == 'f':
+		literal, literalRange, ok, err := r.produceBooleanLiteral(r0)
 		if err != nil {
-			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes(), cursorio.DecodedRunes{}))
-		} else if r2.Rune != 'u' {
-			r.buf.BacktrackRunes(r0, r1, r2)
-
-			return readerStack{ectx, reader_scan_object_PrefixedName}, nil
-		}
-
-		r3, err := r.buf.NextRune()
-		if err != nil {
-			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1, r2}.AsDecodedRunes(), cursorio.DecodedRunes{}))
-		} else if r3.Rune != 'e' {
-			r.buf.BacktrackRunes(r0, r1, r2, r3)
-
+			return readerStack{}, grammar.R_object.Err(err)
+		} else if !ok {
 			return readerStack{ectx, reader_scan_object_PrefixedName}, nil
 		}
 
-		// TODO verify next rune? avoid trueprefix:localname; need to figure out delimiters?
-
-		return r.emit(statement{
+		r.emit(statement{
 			quad: rdf.Quad{
 				Triple: rdf.Triple{
 					Subject:   ectx.CurSubject,
 					Predicate: ectx.CurPredicate,
-					Object: rdf.Literal{
-						Datatype:    xsdiri.Boolean_Datatype,
-						LexicalForm: "true",
-					},
+					Object:    literal,
 				},
 				GraphName: ectx.CurGraphName,
 			},
@@ -301,74 +269,18 @@ func reader_scan_Object(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedR
 				encoding.GraphNameStatementOffsets, ectx.CurGraphNameLocation,
 				encoding.SubjectStatementOffsets, ectx.CurSubjectLocation,
 				encoding.PredicateStatementOffsets, ectx.CurPredicateLocation,
-				encoding.ObjectStatementOffsets, r.commitForTextOffsetRange(cursorio.DecodedRuneList{r0, r1, r2, r3}.AsDecodedRunes()),
+				encoding.ObjectStatementOffsets, literalRange,
 			),
 		})
-	case r0.Rune == 'f':
-		r1, err := r.buf.NextRune()
-		if err != nil {
-			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))
-		} else if r1.Rune != 'a' {
-			r.buf.BacktrackRunes(r0, r1)
-
-			return readerStack{ectx, reader_scan_object_PrefixedName}, nil
-		}
-
-		r2, err := r.buf.NextRune()
-		if err != nil {
-			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes(), cursorio.DecodedRunes{}))
-		} else if r2.Rune != 'l' {
-			r.buf.BacktrackRunes(r0, r1, r2)
-
-			return readerStack{ectx, reader_scan_object_PrefixedName}, nil
-		}
 
-		r3, err := r.buf.NextRune()
-		if err != nil {
-			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1, r2}.AsDecodedRunes(), cursorio.DecodedRunes{}))
-		} else if r3.Rune != 's' {
-			r.buf.BacktrackRunes(r0, r1, r2, r3)
-
-			return readerStack{ectx, reader_scan_object_PrefixedName}, nil
-		}
-
-		r4, err := r.buf.NextRune()
-		if err != nil {
-			return readerStack{}, grammar.R_object.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1, r2, r3}.AsDecodedRunes(), cursorio.DecodedRunes{}))
-		} else if r4.Rune != 'e' {
-			r.buf.BacktrackRunes(r0, r1, r2, r3, r4)
-
-			return readerStack{ectx, reader_scan_object_PrefixedName}, nil
-		}
-
-		// TODO verify next rune? avoid trueprefix:localname; need to figure out delimiters?
-
-		return r.emit(statement{
-			quad: rdf.Quad{
-				Triple: rdf.Triple{
-					Subject:   ectx.CurSubject,
-					Predicate: ectx.CurPredicate,
-					Object: rdf.Literal{
-						Datatype:    xsdiri.Boolean_Datatype,
-						LexicalForm: "false",
-					},
-				},
-				GraphName: ectx.CurGraphName,
-			},
-			textOffsets: r.buildTextOffsets(
-				encoding.GraphNameStatementOffsets, ectx.CurGraphNameLocation,
-				encoding.SubjectStatementOffsets, ectx.CurSubjectLocation,
-				encoding.PredicateStatementOffsets, ectx.CurPredicateLocation,
-				encoding.ObjectStatementOffsets, r.commitForTextOffsetRange(cursorio.DecodedRuneList{r0, r1, r2, r3, r4}.AsDecodedRunes()),
-			),
-		})
+		return ectx.annotationState(literal, literalRange), nil
 	case internal.IsRune_PN_CHARS_BASE(r0.Rune), r0.Rune == ':':
 		r.buf.BacktrackRunes(r0)
 
 		return readerStack{ectx, reader_scan_object_PrefixedName}, nil
 	}
 
-	return readerStack{}, grammar.R_object.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
+	return readerStack{}, grammar.R_object.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, cursorio.DecodedRuneList{r0}.AsDecodedRunes()))
 }
 
 func reader_scan_object_PrefixedName(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, err error) (readerStack, error) {
@@ -389,7 +301,7 @@ func reader_scan_object_PrefixedName(r *Decoder, ectx evaluationContext, r0 curs
 		return readerStack{}, grammar.R_object.Err(grammar.R_PrefixedName.ErrWithTextOffsetRange(iri.NewUnknownPrefixError(token.NamespaceDecoded), token.Offsets))
 	}
 
-	return r.emit(statement{
+	r.emit(statement{
 		quad: rdf.Quad{
 			Triple: rdf.Triple{
 				Subject:   ectx.CurSubject,
@@ -405,4 +317,165 @@ func reader_scan_object_PrefixedName(r *Decoder, ectx evaluationContext, r0 curs
 			encoding.ObjectStatementOffsets, token.Offsets,
 		),
 	})
+
+	return ectx.annotationState(rdf.IRI(expanded), token.Offsets), nil
+}
+
+// RDFLiteral ::= String (LANGTAG | '^^' iri)?
+func (r *Decoder) produceRDFLiteral(ectx evaluationContext, r0 cursorio.DecodedRune) (rdf.Literal, *cursorio.TextOffsetRange, error) {
+	token, err := r.produceString(r0)
+	if err != nil {
+		return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err))
+	}
+
+	literal := rdf.Literal{
+		Datatype:    xsdiri.String_Datatype,
+		LexicalForm: token.Decoded,
+	}
+
+	r0, err = r.buf.NextRune()
+	if err != nil {
+		return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{})))
+	}
+
+	switch r0.Rune {
+	case '@':
+		langtagToken, err := r.produceLANGTAG(r0)
+		if err != nil {
+			return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err))
+		}
+
+		if r.languageTagMapper != nil {
+			langtagToken.Decoded, err = r.languageTagMapper(langtagToken.Decoded)
+			if err != nil {
+				return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(grammar.R_LANGTAG.ErrWithTextOffsetRange(err, langtagToken.Offsets)))
+			}
+		}
+
+		if len(langtagToken.Direction) > 0 {
+			literal.Datatype = rdfiri.DirLangString_Datatype
+			literal.Tag = rdf.DirectionalLanguageLiteralTag{
+				Language:      langtagToken.Decoded,
+				BaseDirection: langtagToken.Direction,
+			}
+		} else {
+			literal.Datatype = rdfiri.LangString_Datatype
+			literal.Tag = rdf.LanguageLiteralTag{
+				Language: langtagToken.Decoded,
+			}
+		}
+	case '^':
+		r1, err := r.buf.NextRune()
+		if err != nil {
+			return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{})))
+		} else if r1.Rune != '^' {
+			return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r1.Rune}, r0.AsDecodedRunes(), r1.AsDecodedRunes())))
+		}
+
+		r2, err := r.buf.NextRune()
+		if err != nil {
+			return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(r.newOffsetError(err, cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes(), cursorio.DecodedRunes{})))
+		}
+
+		r.commit(cursorio.DecodedRuneList{r0, r1}.AsDecodedRunes())
+
+		if r2.Rune == '<' {
+			datatypeToken, err := r.produceIRIREF(r2)
+			if err != nil {
+				return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err))
+			}
+
+			resolvedIRI, err := ectx.ResolveIRI(datatypeToken.Decoded)
+			if err != nil {
+				return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(grammar.R_IRIREF.ErrWithTextOffsetRange(err, datatypeToken.Offsets)))
+			}
+
+			literal.Datatype = resolvedIRI
+		} else {
+			datatypeToken, err := r.producePrefixedName(r2)
+			if err != nil {
+				return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(err))
+			}
+
+			expanded, ok := ectx.Global.Prefixes.ExpandPrefix(iri.PrefixReference{
+				Prefix:    datatypeToken.NamespaceDecoded,
+				Reference: datatypeToken.LocalDecoded,
+			})
+			if !ok {
+				return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_RDFLiteral.Err(grammar.R_PrefixedName.ErrWithTextOffsetRange(iri.NewUnknownPrefixError(datatypeToken.NamespaceDecoded), datatypeToken.Offsets)))
+			}
+
+			literal.Datatype = rdf.IRI(expanded)
+		}
+	default:
+		r.buf.BacktrackRunes(r0)
+	}
+
+	return literal, token.Offsets, nil
+}
+
+func (r *Decoder) produceNumericLiteralValue(r0 cursorio.DecodedRune) (rdf.Literal, *cursorio.TextOffsetRange, error) {
+	token, err := r.produceNumericLiteral(r0)
+	if err != nil {
+		return rdf.Literal{}, nil, grammar.R_literal.Err(grammar.R_NumericLiteral.Err(err))
+	}
+
+	literal := rdf.Literal{
+		LexicalForm: token.Decoded,
+	}
+
+	switch token.GrammarRule {
+	case grammar.R_INTEGER:
+		literal.Datatype = xsdiri.Integer_Datatype
+	case grammar.R_DECIMAL:
+		literal.Datatype = xsdiri.Decimal_Datatype
+	case grammar.R_DOUBLE:
+		literal.Datatype = xsdiri.Double_Datatype
+	default:
+		panic("unreachable")
+	}
+
+	return literal, token.Offsets, nil
+}
+
+// BooleanLiteral ::= 'true' | 'false'
+//
+// If the runes do not match, they are backtracked and false is returned so they may be decoded as a PrefixedName.
+func (r *Decoder) produceBooleanLiteral(r0 cursorio.DecodedRune) (rdf.Literal, *cursorio.TextOffsetRange, bool, error) {
+	var expected string
+
+	switch r0.Rune {
+	case 't':
+		expected = "true"
+	case 'f':
+		expected = "false"
+	default:
+		r.buf.BacktrackRunes(r0)
+
+		return rdf.Literal{}, nil, false, nil
+	}
+
+	uncommitted := cursorio.DecodedRuneList{r0}
+
+	for _, expectedRune := range expected[1:] {
+		rN, err := r.buf.NextRune()
+		if err != nil {
+			return rdf.Literal{}, nil, false, r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{})
+		}
+
+		uncommitted = append(uncommitted, rN)
+
+		if rN.Rune != expectedRune {
+			r.buf.BacktrackRunes(uncommitted...)
+
+			return rdf.Literal{}, nil, false, nil
+		}
+	}
+
+	// TODO verify next rune? avoid trueprefix:localname; need to figure out delimiters?
+
+	return rdf.Literal{
+		Datatype:    xsdiri.Boolean_Datatype,
+		LexicalForm: expected,
+	}, r.commitForTextOffsetRange(uncommitted.AsDecodedRunes()), true, nil
 }
@@ -202,6 +202,58 @@ func reader_scan_trigDoc(r *Decoder, ectx evaluationContext, r0 cursorio.Decoded
 					}, nil
 				}),
 			}, nil
+		// @version directive
+		case 'v':
+			uncommitted := cursorio.DecodedRuneList{r0, r1}
+
+			for _, expected := range "ersion" {
+				rN, err := r.buf.NextRune()
+				if err != nil {
+					return readerStack{}, grammar.R_directive.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+				} else if rN.Rune != expected {
+					return readerStack{}, grammar.R_directive.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: rN.Rune}, uncommitted.AsDecodedRunes(), rN.AsDecodedRunes()))
+				}
+
+				uncommitted = append(uncommitted, rN)
+			}
+
+			r.commit(uncommitted.AsDecodedRunes())
+
+			return readerStack{
+				ectx,
+				scanFunc(func(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, err error) (readerStack, error) {
+					if err != nil {
+						return readerStack{}, grammar.R_directive.Err(grammar.R_version.Err(err))
+					}
+
+					versionToken, err := r.produceVersionSpecifier(r0)
+					if err != nil {
+						return readerStack{}, grammar.R_directive.Err(grammar.R_version.Err(err))
+					}
+
+					return readerStack{
+						ectx,
+						scanFunc(func(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, err error) (readerStack, error) {
+							if err != nil {
+								return readerStack{}, grammar.R_directive.Err(grammar.R_version.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{})))
+							} else if r0.Rune != '.' {
+								return readerStack{}, grammar.R_directive.Err(grammar.R_version.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes())))
+							}
+
+							r.commit(r0.AsDecodedRunes())
+
+							if r.versionDirectiveListener != nil {
+								r.versionDirectiveListener(DecoderEvent_VersionDirective_Data{
+									Value:        versionToken.Decoded,
+									ValueOffsets: versionToken.Offsets,
+								})
+							}
+
+							return readerStack{ectx, reader_scan_trigDoc}, nil
+						}),
+					}, nil
+				}),
+			}, nil
 		default:
 			return readerStack{}, grammar.R_directive.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r1.Rune}, r0.AsDecodedRunes(), r1.AsDecodedRunes()))
 		}
@@ -386,6 +438,60 @@ func reader_scan_trigDoc(r *Decoder, ectx evaluationContext, r0 cursorio.Decoded
 			}),
 		}, nil
 
+	// VERSION directive
+	case 'V', 'v':
+		uncommitted := cursorio.DecodedRuneList{r0}
+
+		for _, expected := range "ERSION" {
+			rN, err := r.buf.NextRune()
+			if err != nil {
+				return readerStack{}, grammar.R_block.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+			} else if unicode.ToUpper(rN.Rune) != expected {
+				r.buf.BacktrackRunes(append(uncommitted[1:], rN)...)
+
+				return reader_scan_triplesOrGraph_labelOrSubject_PrefixedName(r, ectx, r0, nil)
+			}
+
+			uncommitted = append(uncommitted, rN)
+		}
+
+		rN, err := r.buf.NextRune()
+		if err != nil {
+			return readerStack{}, grammar.R_block.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+		} else if rN.Rune == '"' || rN.Rune == '\'' {
+			r.commit(uncommitted.AsDecodedRunes())
+			r.buf.BacktrackRunes(rN)
+		} else if !unicode.IsSpace(rN.Rune) { // TODO IsRune_WS
+			r.buf.BacktrackRunes(append(uncommitted[1:], rN)...)
+
+			return reader_scan_triplesOrGraph_labelOrSubject_PrefixedName(r, ectx, r0, nil)
+		} else {
+			r.commit(append(uncommitted, rN).AsDecodedRunes())
+		}
+
+		return readerStack{
+			ectx,
+			scanFunc(func(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, err error) (readerStack, error) {
+				if err != nil {
+					return readerStack{}, grammar.R_block.Err(grammar.R_sparqlVersion.Err(err))
+				}
+
+				versionToken, err := r.produceVersionSpecifier(r0)
+				if err != nil {
+					return readerStack{}, grammar.R_block.Err(grammar.R_sparqlVersion.Err(err))
+				}
+
+				if r.versionDirectiveListener != nil {
+					r.versionDirectiveListener(DecoderEvent_VersionDirective_Data{
+						Value:        versionToken.Decoded,
+						ValueOffsets: versionToken.Offsets,
+					})
+				}
+
+				return readerStack{ectx, reader_scan_trigDoc}, nil
+			}),
+		}, nil
+
 	// GRAPH directive
 	case 'G', 'g':
 		r1, err := r.buf.NextRune()
@@ -519,6 +625,17 @@ func reader_scan_trigDoc(r *Decoder, ectx evaluationContext, r0 cursorio.Decoded
 	case '{':
 		return reader_scan_wrappedGraph(r, ectx, r0, nil)
 	case '<':
+		r1, err := r.buf.NextRune()
+		if err == nil {
+			if r1.Rune == '<' {
+				r.pushState(ectx, reader_scan_triples_End)
+
+				return reader_scan_triples2_ReifiedTriple(r, ectx, cursorio.DecodedRuneList{r0, r1})
+			}
+
+			r.buf.BacktrackRunes(r1)
+		}
+
 		return reader_scan_triplesOrGraph_labelOrSubject_IRIREF(r, ectx, r0, nil)
 	case '_':
 		return reader_scan_triplesOrGraph_labelOrSubject_BlankNode(r, ectx, r0, nil)
@@ -594,3 +711,20 @@ func reader_scan_trigDoc(r *Decoder, ectx evaluationContext, r0 cursorio.Decoded
 
 	return readerStack{}, grammar.R_block.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
 }
+
+// VersionSpecifier ::= STRING_LITERAL_QUOTE | STRING_LITERAL_SINGLE_QUOTE
+func (r *Decoder) produceVersionSpecifier(r0 cursorio.DecodedRune) (*tokenString, error) {
+	token, err := r.produceString(r0)
+	if err != nil {
+		return nil, grammar.R_VersionSpecifier.Err(err)
+	}
+
+	switch token.GrammarRule {
+	case grammar.R_STRING_LITERAL_QUOTE, grammar.R_STRING_LITERAL_SINGLE_QUOTE:
+		// valid
+	default:
+		return nil, grammar.R_VersionSpecifier.ErrWithTextOffsetRange(errors.New("long string not allowed"), token.Offsets)
+	}
+
+	return token, nil
+}
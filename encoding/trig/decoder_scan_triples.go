@@ -17,6 +17,15 @@ func reader_scan_triples(r *Decoder, ectx evaluationContext, r0 cursorio.Decoded
 
 	switch r0.Rune {
 	case '<':
+		r1, err := r.buf.NextRune()
+		if err == nil {
+			if r1.Rune == '<' {
+				return reader_scan_triples_subject_ReifiedTriple(r, ectx, cursorio.DecodedRuneList{r0, r1})
+			}
+
+			r.buf.BacktrackRunes(r1)
+		}
+
 		r.buf.BacktrackRunes(r0)
 
 		return readerStack{ectx, reader_scan_triples_subject_IRIREF}, nil
@@ -172,3 +181,18 @@ func reader_scan_triples_subject_PrefixedName(r *Decoder, ectx evaluationContext
 
 	return readerStack{ectx, reader_scan_PredicateObjectList_Required}, nil
 }
+
+func reader_scan_triples_subject_ReifiedTriple(r *Decoder, ectx evaluationContext, uncommitted cursorio.DecodedRuneList) (readerStack, error) {
+	token, err := r.produceReifiedTriple(ectx, uncommitted)
+	if err != nil {
+		return readerStack{}, grammar.R_triples.Err(err)
+	}
+
+	ectx.CurSubject = token.Decoded
+	ectx.CurSubjectLocation = token.Offsets
+
+	r.pushState(ectx, reader_scan_PredicateObjectList_Continue)
+	r.emit(token.Statements...)
+
+	return readerStack{ectx, reader_scan_PredicateObjectList}, nil
+}
@@ -26,3 +26,18 @@ func reader_triples2_blankNodePropertyList(r *Decoder, ectx evaluationContext, r
 
 	return readerStack{ectx, reader_scan_PredicateObjectList}, nil
 }
+
+func reader_scan_triples2_ReifiedTriple(r *Decoder, ectx evaluationContext, uncommitted cursorio.DecodedRuneList) (readerStack, error) {
+	token, err := r.produceReifiedTriple(ectx, uncommitted)
+	if err != nil {
+		return readerStack{}, grammar.R_block.Err(grammar.R_triples2.Err(err))
+	}
+
+	ectx.CurSubject = token.Decoded
+	ectx.CurSubjectLocation = token.Offsets
+
+	r.pushState(ectx, reader_scan_PredicateObjectList_Continue)
+	r.emit(token.Statements...)
+
+	return readerStack{ectx, reader_scan_PredicateObjectList}, nil
+}
@@ -1,10 +1,14 @@
 package trig
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"strings"
 	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding/nquads"
 )
 
 func TestDecoder(t *testing.T) {
@@ -39,3 +43,86 @@ func TestDecoder(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestDecoder_RDF12(t *testing.T) {
+	for _, tc := range []struct {
+		InputString  string
+		OutputNQuads string
+		OutputError  string
+	}{
+		{
+			InputString: `PREFIX : <http://example.com/>
+:g {
+	:s :p :o ~ :r {| :source :src |} .
+	<< :s :p :o2 >> :q :z
+}`,
+			OutputNQuads: `<http://example.com/s> <http://example.com/p> <http://example.com/o> <http://example.com/g> .
+<http://example.com/r> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> <http://example.com/g> .
+<http://example.com/r> <http://example.com/source> <http://example.com/src> <http://example.com/g> .
+_:b0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o2> )>> <http://example.com/g> .
+_:b0 <http://example.com/q> <http://example.com/z> <http://example.com/g> .
+`,
+		},
+		{
+			InputString: `VERSION "1.2"
+@version "1.2" .
+@prefix : <http://example.com/> .
+<< :s :p <<( :s2 :p2 :o2 )>> ~ :r >> :q :z .
+:s :p ( :a ) {| :q :z |} .`,
+			OutputNQuads: `<http://example.com/r> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> <http://example.com/o2> )>> )>> .
+<http://example.com/r> <http://example.com/q> <http://example.com/z> .
+<http://example.com/s> <http://example.com/p> _:b0 .
+_:b0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <http://example.com/a> .
+_:b0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> _:b0 )>> .
+_:b1 <http://example.com/q> <http://example.com/z> .
+`,
+		},
+		{
+			InputString: `VERSION """1.2"""`,
+			OutputError: `token (block): token (sparqlVersion): token (VersionSpecifier): long string not allowed`,
+		},
+		{
+			InputString: `<http://example.com/g> { <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> <http://example.com/p> <http://example.com/o> }`,
+			OutputError: `token (triples): token (reifiedTriple): token (rtSubject): offset 0x1b: unexpected rune ('(')`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			r, err := NewDecoder(strings.NewReader(tc.InputString))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			buf := &bytes.Buffer{}
+
+			e, err := nquads.NewEncoder(buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for r.Next() {
+				if err := e.AddQuad(context.Background(), r.Quad()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if err := r.Err(); err != nil {
+				if len(tc.OutputError) == 0 || err.Error() != tc.OutputError {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.OutputError) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputNQuads, buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package trig
+
+import (
+	"io"
+
+	"github.com/dpb587/rdfkit-go/encoding/turtle"
+)
+
+// DirectiveMode configures how base and prefix directives are written. TriG shares the directives of Turtle.
+type DirectiveMode = turtle.DirectiveMode
+
+const (
+	// DirectiveMode_At uses `@base` and `@prefix` (default).
+	DirectiveMode_At = turtle.DirectiveMode_At
+
+	// DirectiveMode_SPARQL uses `BASE` and `PREFIX`.
+	DirectiveMode_SPARQL = turtle.DirectiveMode_SPARQL
+
+	// DirectiveMode_Disabled disables directive output.
+	DirectiveMode_Disabled = turtle.DirectiveMode_Disabled
+)
+
+type WriteDirectivesOptions = turtle.WriteDirectivesOptions
+
+func WriteDirectives(w io.Writer, opts WriteDirectivesOptions) (int64, error) {
+	return turtle.WriteDirectives(w, opts)
+}
@@ -0,0 +1,799 @@
+package trig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/trig/trigcontent"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/iriutil"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/terms"
+	"github.com/dpb587/rdfkit-go/rdfdescription"
+	"github.com/dpb587/rdfkit-go/rdfdescription/rdfdescriptionutil"
+)
+
+type EncoderOption interface {
+	apply(s *EncoderConfig)
+	newEncoder(w io.Writer) (*Encoder, error)
+}
+
+type Encoder struct {
+	w                io.Writer
+	base             *iri.BaseIRI
+	prefixes         *iriutil.UsagePrefixMapper
+	bnStringProvider blanknodes.StringProvider
+
+	// terms formats IRIs and literals, which are the same in TriG as in Turtle.
+	terms terms.Formatter
+
+	err                error
+	buffered           bool
+	bufferedSort       bool
+	bufferedGraphs     map[rdf.GraphNameValue]*bufferedGraph
+	bufferedGraphsList []*bufferedGraph
+
+	baseDirectiveMode   DirectiveMode
+	prefixDirectiveMode DirectiveMode
+
+	// autoPrefixes is non-nil when prefixes are created for discovered namespaces; autoPrefixesWritten is the number
+	// of its prefixes which have been written as directives when unbuffered.
+	autoPrefixes        *iriutil.AutoPrefixMapper
+	autoPrefixesWritten int
+
+	// openGraph is the named graph of the block which is currently open when unbuffered.
+	openGraph rdf.GraphNameValue
+
+	// annotations is true when rdf:reifies statements may be written with reifier and annotation syntax; pending is
+	// then the most recent quad which has not yet been terminated so that subsequent statements about it may be
+	// included.
+	annotations bool
+	pending     *pendingQuad
+}
+
+type bufferedGraph struct {
+	graphName rdf.GraphNameValue
+	label     string
+	sections  [][]byte
+}
+
+type pendingQuad struct {
+	buf            *bytes.Buffer
+	quad           rdf.Quad
+	reifier        rdf.SubjectValue
+	annotationOpen bool
+}
+
+var _ encoding.QuadsEncoder = &Encoder{}
+var _ rdfdescriptionutil.DatasetResourceEncoder = &Encoder{}
+
+// NewEncoder creates an encoder which writes to w. Unless buffered, each quad is written once it is added. When
+// annotations are enabled (see [EncoderConfig.SetAnnotations]), the most recent quad is held back until the next
+// statement or Close, so Close must be called to write it.
+func NewEncoder(w io.Writer, opts ...EncoderOption) (*Encoder, error) {
+	compiledOpts := EncoderConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&compiledOpts)
+	}
+
+	return compiledOpts.newEncoder(w)
+}
+
+func (e *Encoder) GetContentMetadata() encoding.ContentMetadata {
+	return trigcontent.DefaultMetadata
+}
+
+func (e *Encoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return trigcontent.TypeIdentifier
+}
+
+func (w *Encoder) Close() error {
+	if w.err != nil {
+		if errors.Is(w.err, io.ErrClosedPipe) {
+			return nil
+		}
+
+		return w.err
+	}
+
+	if err := w.flushPending(); err != nil {
+		return err
+	}
+
+	if w.buffered && len(w.bufferedGraphsList) > 0 {
+		graphs := slices.Clone(w.bufferedGraphsList)
+
+		if w.bufferedSort {
+			for _, graph := range graphs {
+				slices.SortFunc(graph.sections, func(i, j []byte) int {
+					return bytes.Compare(i, j)
+				})
+			}
+
+			slices.SortStableFunc(graphs, func(i, j *bufferedGraph) int {
+				if i.graphName == nil {
+					return -1
+				} else if j.graphName == nil {
+					return 1
+				}
+
+				return strings.Compare(i.label, j.label)
+			})
+		}
+
+		if prefixes := w.prefixes.GetUsedPrefixes(); w.base != nil || len(prefixes) > 0 {
+			slices.SortFunc(prefixes, strings.Compare)
+
+			var prefixMappings iri.PrefixMappingList
+
+			for _, prefix := range prefixes {
+				if expanded, ok := w.prefixes.ExpandPrefix(iri.PrefixReference{Prefix: prefix}); ok {
+					prefixMappings = append(prefixMappings, iri.PrefixMapping{
+						Prefix:   prefix,
+						Expanded: expanded,
+					})
+				}
+			}
+
+			var baseString string
+
+			if w.base != nil {
+				baseString = w.base.String()
+			}
+
+			written, err := WriteDirectives(w.w, WriteDirectivesOptions{
+				Base:       baseString,
+				Prefixes:   prefixMappings,
+				BaseMode:   w.baseDirectiveMode,
+				PrefixMode: w.prefixDirectiveMode,
+			})
+			if err != nil {
+				return err
+			}
+
+			if written > 0 {
+				_, err = w.w.Write([]byte("\n"))
+				if err != nil {
+					return fmt.Errorf("write header: %v", err)
+				}
+			}
+		}
+
+		for graphIdx, graph := range graphs {
+			buf := &bytes.Buffer{}
+
+			if graphIdx > 0 {
+				buf.WriteString("\n")
+			}
+
+			if graph.graphName != nil {
+				buf.WriteString(graph.label + " {\n")
+			}
+
+			for _, section := range graph.sections {
+				buf.Write(section)
+			}
+
+			if graph.graphName != nil {
+				buf.WriteString("}\n")
+			}
+
+			_, err := buf.WriteTo(w.w)
+			if err != nil {
+				return fmt.Errorf("write: %v", err)
+			}
+		}
+	} else if !w.buffered {
+		if err := w.closeGraph(); err != nil {
+			return err
+		}
+	}
+
+	w.err = io.ErrClosedPipe
+
+	return nil
+}
+
+func (w *Encoder) AddDatasetResource(ctx context.Context, r rdfdescription.DatasetResource) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	subject := r.Resource.GetResourceSubject()
+	statements := r.Resource.GetResourceStatements()
+
+	if len(statements) == 0 {
+		return nil
+	}
+
+	if err := w.flushPending(); err != nil {
+		return err
+	}
+
+	linePrefix := graphLinePrefix(r.GraphName)
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(linePrefix)
+
+	if subject == nil {
+		buf.WriteString("[]")
+	} else {
+		err := w.writeSubjectValue(buf, subject)
+		if err != nil {
+			return fmt.Errorf("subject: %v", err)
+		}
+	}
+
+	_, err := w.putResourceStatements(ctx, buf, linePrefix, statements)
+	if err != nil {
+		return fmt.Errorf("resource: %v", err)
+	}
+
+	buf.WriteString(" .\n")
+
+	return w.writeSection(r.GraphName, buf)
+}
+
+func (w *Encoder) putResourceStatements(ctx context.Context, buf *bytes.Buffer, linePrefix string, statements rdfdescription.StatementList) (bool, error) {
+	statementsByPredicate := statements.GroupByPredicate()
+
+	var predicateList rdf.PredicateValueList
+
+	{
+		raw := statementsByPredicate.GetPredicateList()
+		slices.SortFunc(raw, func(a, b rdf.PredicateValue) int {
+			return strings.Compare(string(a.(rdf.IRI)), string(b.(rdf.IRI)))
+		})
+
+		for _, p := range raw {
+			if p == rdfiri.Type_Property {
+				predicateList = append([]rdf.PredicateValue{p}, predicateList...)
+			} else {
+				predicateList = append(predicateList, p)
+			}
+		}
+	}
+
+	var multiline bool
+
+	if len(predicateList) > 1 {
+		multiline = true
+		linePrefix += "\t"
+	}
+
+	for pIdx, p := range predicateList {
+		if pIdx > 0 {
+			buf.WriteString(" ;")
+		}
+
+		if multiline {
+			buf.WriteString("\n" + linePrefix)
+		} else {
+			buf.WriteString(" ")
+		}
+
+		pIRI, ok := p.(rdf.IRI)
+		if !ok {
+			return false, fmt.Errorf("predicate: invalid type: %T", p)
+		}
+
+		if pIRI == rdfiri.Type_Property {
+			buf.WriteString("a")
+		} else {
+			w.writeIRI(buf, string(pIRI))
+		}
+
+		pStatements := statementsByPredicate[p]
+		pMultiline := false
+		pLinePrefix := linePrefix
+
+		if len(pStatements) > 1 {
+			pMultiline = true
+			pLinePrefix += "\t"
+		}
+
+		for statementIdx, statement := range pStatements {
+			if statementIdx > 0 {
+				buf.WriteString(" ,")
+			}
+
+			if pMultiline {
+				buf.WriteString("\n" + pLinePrefix)
+			} else {
+				buf.WriteString(" ")
+			}
+
+			mm, err := w.writeResourceStatement(ctx, buf, pLinePrefix, statement)
+			if err != nil {
+				return false, fmt.Errorf("statement: %v", err)
+			} else if mm {
+				multiline = true
+			}
+		}
+
+		multiline = multiline || pMultiline
+	}
+
+	return multiline, nil
+}
+
+func (w *Encoder) writeResourceStatement(ctx context.Context, buf *bytes.Buffer, linePrefix string, statement rdfdescription.Statement) (bool, error) {
+	var multiline bool
+
+	switch statementT := statement.(type) {
+	case rdfdescription.ObjectStatement:
+		w.writeObjectValue(buf, statementT.Object)
+	case rdfdescription.AnonResourceStatement:
+		if len(statementT.AnonResource.Statements) == 0 {
+			buf.WriteString("[]")
+		} else if entries, ok := w.normalizedListSyntax(statementT.AnonResource); ok {
+			mm, err := w.writeResourceList(ctx, buf, linePrefix, entries)
+			if err != nil {
+				return false, fmt.Errorf("list: %v", err)
+			} else if mm {
+				multiline = true
+			}
+		} else {
+			buf.WriteString("[")
+
+			mm, err := w.putResourceStatements(ctx, buf, linePrefix, statementT.AnonResource.Statements)
+			if err != nil {
+				return false, fmt.Errorf("resource: %v", err)
+			} else if mm {
+				multiline = true
+
+				buf.WriteString("\n" + linePrefix + "]")
+			} else {
+				buf.WriteString(" ]")
+			}
+		}
+	default:
+		return false, fmt.Errorf("object: invalid type: %T", statement)
+	}
+
+	return multiline, nil
+}
+
+func (w *Encoder) AddQuad(ctx context.Context, q rdf.Quad) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	if w.pending != nil {
+		// annotations are only possible within the same graph
+		sameGraph := graphNameEquals(q.GraphName, w.pending.quad.GraphName)
+
+		if tripleTerm, ok := q.Triple.Object.(rdf.TripleTerm); ok && sameGraph && q.Triple.Predicate == rdfiri.Reifies_Property && tripleTerm.TermEquals(rdf.TripleTerm(w.pending.quad.Triple)) {
+			if w.pending.annotationOpen {
+				w.pending.buf.WriteString(" |}")
+				w.pending.annotationOpen = false
+			}
+
+			w.pending.buf.WriteString(" ~ ")
+
+			err := w.writeSubjectValue(w.pending.buf, q.Triple.Subject)
+			if err != nil {
+				return fmt.Errorf("subject: %v", err)
+			}
+
+			w.pending.reifier = q.Triple.Subject
+
+			return nil
+		} else if sameGraph && w.pending.reifier != nil && q.Triple.Predicate != rdfiri.Reifies_Property && q.Triple.Subject.TermEquals(w.pending.reifier) {
+			if w.pending.annotationOpen {
+				w.pending.buf.WriteString(" ;")
+			} else {
+				w.pending.buf.WriteString(" {|")
+				w.pending.annotationOpen = true
+			}
+
+			w.pending.buf.WriteString(" ")
+
+			err := w.writePredicateValue(w.pending.buf, q.Triple.Predicate)
+			if err != nil {
+				return fmt.Errorf("predicate: %v", err)
+			}
+
+			w.pending.buf.WriteString(" ")
+
+			err = w.writeObjectValue(w.pending.buf, q.Triple.Object)
+			if err != nil {
+				return fmt.Errorf("object: %v", err)
+			}
+
+			return nil
+		}
+
+		if err := w.flushPending(); err != nil {
+			return err
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	buf.WriteString(graphLinePrefix(q.GraphName))
+
+	err := w.writeSubjectValue(buf, q.Triple.Subject)
+	if err != nil {
+		return fmt.Errorf("subject: %v", err)
+	}
+
+	buf.WriteString(" ")
+
+	err = w.writePredicateValue(buf, q.Triple.Predicate)
+	if err != nil {
+		return fmt.Errorf("predicate: %v", err)
+	}
+
+	buf.WriteString(" ")
+
+	err = w.writeObjectValue(buf, q.Triple.Object)
+	if err != nil {
+		return fmt.Errorf("object: %v", err)
+	}
+
+	if !w.annotations {
+		buf.WriteString(" .\n")
+
+		return w.writeSection(q.GraphName, buf)
+	}
+
+	// the statement is terminated once it is known no annotations follow
+	w.pending = &pendingQuad{
+		buf:  buf,
+		quad: q,
+	}
+
+	return nil
+}
+
+func (w *Encoder) flushPending() error {
+	if w.pending == nil {
+		return nil
+	}
+
+	buf := w.pending.buf
+
+	if w.pending.annotationOpen {
+		buf.WriteString(" |}")
+	}
+
+	buf.WriteString(" .\n")
+
+	graphName := w.pending.quad.GraphName
+
+	w.pending = nil
+
+	return w.writeSection(graphName, buf)
+}
+
+// writeSection writes the statements of a graph. When unbuffered, graph blocks are opened and closed as the graph
+// changes, and are closed before any newly-created prefixes since directives are only allowed outside of blocks.
+func (w *Encoder) writeSection(graphName rdf.GraphNameValue, buf *bytes.Buffer) error {
+	if w.buffered {
+		graph, ok := w.bufferedGraphs[graphName]
+		if !ok {
+			graph = &bufferedGraph{
+				graphName: graphName,
+			}
+
+			if graphName != nil {
+				label, err := w.formatGraphName(graphName)
+				if err != nil {
+					return fmt.Errorf("graph name: %v", err)
+				}
+
+				graph.label = label
+			}
+
+			w.bufferedGraphs[graphName] = graph
+			w.bufferedGraphsList = append(w.bufferedGraphsList, graph)
+		}
+
+		graph.sections = append(graph.sections, buf.Bytes())
+
+		return nil
+	}
+
+	var label string
+
+	if graphName != nil {
+		var err error
+
+		label, err = w.formatGraphName(graphName)
+		if err != nil {
+			return fmt.Errorf("graph name: %v", err)
+		}
+	}
+
+	if w.autoPrefixes != nil && len(w.autoPrefixes.GetPrefixMappings()) > w.autoPrefixesWritten {
+		if err := w.closeGraph(); err != nil {
+			return err
+		}
+
+		if err := w.writeAutoPrefixDirectives(); err != nil {
+			return err
+		}
+	}
+
+	if w.openGraph != nil && !graphNameEquals(graphName, w.openGraph) {
+		if err := w.closeGraph(); err != nil {
+			return err
+		}
+	}
+
+	if graphName != nil && w.openGraph == nil {
+		_, err := w.w.Write([]byte(label + " {\n"))
+		if err != nil {
+			return fmt.Errorf("write: %v", err)
+		}
+
+		w.openGraph = graphName
+	}
+
+	_, err := buf.WriteTo(w.w)
+	if err != nil {
+		return fmt.Errorf("write: %v", err)
+	}
+
+	return nil
+}
+
+func (w *Encoder) closeGraph() error {
+	if w.openGraph == nil {
+		return nil
+	}
+
+	_, err := w.w.Write([]byte("}\n"))
+	if err != nil {
+		return fmt.Errorf("write: %v", err)
+	}
+
+	w.openGraph = nil
+
+	return nil
+}
+
+// writeAutoPrefixDirectives writes any prefixes which were created since the last statement was written.
+func (w *Encoder) writeAutoPrefixDirectives() error {
+	created := w.autoPrefixes.GetPrefixMappings()
+	if len(created) == w.autoPrefixesWritten {
+		return nil
+	}
+
+	_, err := WriteDirectives(w.w, WriteDirectivesOptions{
+		Prefixes:   created[w.autoPrefixesWritten:],
+		PrefixMode: w.prefixDirectiveMode,
+	})
+	if err != nil {
+		return fmt.Errorf("write prefixes: %v", err)
+	}
+
+	w.autoPrefixesWritten = len(created)
+
+	return nil
+}
+
+func (w *Encoder) formatGraphName(v rdf.GraphNameValue) (string, error) {
+	switch g := v.(type) {
+	case rdf.BlankNode:
+		return "_:" + w.bnStringProvider.GetBlankNodeString(g), nil
+	case rdf.IRI:
+		buf := &bytes.Buffer{}
+		w.writeIRI(buf, string(g))
+
+		return buf.String(), nil
+	}
+
+	return "", fmt.Errorf("invalid type: %T", v)
+}
+
+func (w *Encoder) writeSubjectValue(buf *bytes.Buffer, v rdf.SubjectValue) error {
+	switch s := v.(type) {
+	case rdf.BlankNode:
+		buf.WriteString("_:" + w.bnStringProvider.GetBlankNodeString(s))
+
+		return nil
+	case rdf.IRI:
+		w.writeIRI(buf, string(s))
+
+		return nil
+	}
+
+	return fmt.Errorf("invalid type: %T", v)
+}
+
+func (w *Encoder) writePredicateValue(buf *bytes.Buffer, v rdf.PredicateValue) error {
+	switch p := v.(type) {
+	case rdf.IRI:
+		if p == rdfiri.Type_Property {
+			buf.WriteString("a")
+		} else {
+			w.writeIRI(buf, string(p))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("invalid type: %T", v)
+}
+
+func (w *Encoder) writeIRI(buffered *bytes.Buffer, v string) {
+	buffered.WriteString(w.terms.FormatTerm(rdf.IRI(v)))
+}
+
+func (e *Encoder) writeObjectValue(w *bytes.Buffer, v rdf.ObjectValue) error {
+	var literal rdf.Literal
+
+	switch o := v.(type) {
+	case rdf.BlankNode:
+		label := e.bnStringProvider.GetBlankNodeString(o)
+
+		w.WriteString("_:" + label)
+
+		return nil
+	case rdf.IRI:
+		e.writeIRI(w, string(o))
+
+		return nil
+	case rdf.Literal:
+		literal = o
+
+		switch literal.Datatype {
+		case xsdiri.Boolean_Datatype, xsdiri.Decimal_Datatype, xsdiri.Double_Datatype, xsdiri.Integer_Datatype, xsdiri.Long_Datatype:
+			w.Write([]byte(literal.LexicalForm))
+
+			return nil
+		}
+
+		w.WriteString(e.terms.FormatTerm(literal))
+
+		return nil
+	case rdf.TripleTerm:
+		w.WriteString("<<( ")
+
+		if err := e.writeSubjectValue(w, o.Subject); err != nil {
+			return fmt.Errorf("triple term: subject: %v", err)
+		}
+
+		w.WriteString(" ")
+
+		if err := e.writePredicateValue(w, o.Predicate); err != nil {
+			return fmt.Errorf("triple term: predicate: %v", err)
+		}
+
+		w.WriteString(" ")
+
+		if err := e.writeObjectValue(w, o.Object); err != nil {
+			return fmt.Errorf("triple term: object: %v", err)
+		}
+
+		w.WriteString(" )>>")
+
+		return nil
+	}
+
+	return fmt.Errorf("invalid type: %T", v)
+}
+
+func (e *Encoder) normalizedListSyntax(resource rdfdescription.AnonResource) (rdfdescription.StatementList, bool) {
+	statements := resource.GetResourceStatements()
+	if len(statements) == 0 {
+		return nil, false
+	}
+
+	var entries rdfdescription.StatementList
+	nextStatements := resource.GetResourceStatements()
+
+	for {
+		statementsByPredicate := nextStatements.GroupByPredicate()
+
+		var hasFirst rdfdescription.Statement
+		var hasRest rdfdescription.Statement
+
+		for predicate, statements := range statementsByPredicate {
+			switch predicate {
+			case rdfiri.Type_Property:
+				if len(statements) != 1 {
+					return nil, false
+				}
+
+				s0, ok := statements[0].(rdfdescription.ObjectStatement)
+				if !ok {
+					return nil, false
+				} else if s0.Object != rdfiri.List_Class {
+					return nil, false
+				}
+			case rdfiri.First_Property:
+				if len(statements) != 1 {
+					return nil, false
+				}
+
+				hasFirst = statements[0]
+			case rdfiri.Rest_Property:
+				if len(statements) != 1 {
+					return nil, false
+				}
+
+				hasRest = statements[0]
+			default:
+				return nil, false
+			}
+		}
+
+		if hasFirst == nil || hasRest == nil {
+			return nil, false
+		}
+
+		entries = append(entries, hasFirst)
+
+		switch restStmt := hasRest.(type) {
+		case rdfdescription.ObjectStatement:
+			switch oT := restStmt.Object.(type) {
+			case rdf.IRI:
+				if oT == rdfiri.Nil_List {
+					return entries, true
+				}
+			}
+
+			return nil, false
+		case rdfdescription.AnonResourceStatement:
+			nextStatements = restStmt.AnonResource.GetResourceStatements()
+		default:
+			panic(fmt.Errorf("invalid type: %T", restStmt))
+		}
+	}
+}
+
+func (e *Encoder) writeResourceList(ctx context.Context, buf *bytes.Buffer, linePrefix string, entries rdfdescription.StatementList) (bool, error) {
+	if len(entries) == 0 {
+		buf.WriteString("()")
+
+		return false, nil
+	}
+
+	buf.WriteString("(")
+
+	itemLinePrefix := linePrefix + "\t"
+
+	for _, statement := range entries {
+		buf.WriteString("\n" + itemLinePrefix)
+
+		_, err := e.writeResourceStatement(ctx, buf, itemLinePrefix, statement)
+		if err != nil {
+			return false, fmt.Errorf("statement: %v", err)
+		}
+	}
+
+	buf.WriteString("\n" + linePrefix)
+	buf.WriteString(")")
+
+	return true, nil
+}
+
+//
+
+func graphLinePrefix(graphName rdf.GraphNameValue) string {
+	if graphName == nil {
+		return ""
+	}
+
+	return "\t"
+}
+
+func graphNameEquals(a, b rdf.GraphNameValue) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.TermEquals(b)
+}
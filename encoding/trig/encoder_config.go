@@ -0,0 +1,238 @@
+package trig
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/dpb587/rdfkit-go/encoding/turtle"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/iriutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+)
+
+type EncoderConfig struct {
+	base     *string
+	prefixes iri.PrefixMappingList
+
+	autoPrefixes *bool
+
+	bnStringProvider blanknodes.StringProvider
+
+	buffered     *bool
+	bufferedSort *bool
+
+	annotations *bool
+
+	baseDirectiveMode   *DirectiveMode
+	prefixDirectiveMode *DirectiveMode
+}
+
+func (s EncoderConfig) SetBase(v string) EncoderConfig {
+	s.base = &v
+
+	return s
+}
+
+func (s EncoderConfig) SetPrefixes(v iri.PrefixMappingList) EncoderConfig {
+	s.prefixes = v
+
+	return s
+}
+
+// SetAutoPrefixes creates prefixes for the namespaces of IRIs which are not covered by the configured prefixes. See
+// [iriutil.AutoPrefixMapper]. When unbuffered, directives for created prefixes are written before the statement which
+// first uses them.
+func (s EncoderConfig) SetAutoPrefixes(v bool) EncoderConfig {
+	s.autoPrefixes = &v
+
+	return s
+}
+
+func (s EncoderConfig) SetBlankNodeStringProvider(v blanknodes.StringProvider) EncoderConfig {
+	s.bnStringProvider = v
+
+	return s
+}
+
+// SetBuffered holds all statements in memory until Close so they may be grouped by graph, sorted, and written after
+// the directives of all used prefixes. It also enables annotations unless [EncoderConfig.SetAnnotations] is used.
+func (s EncoderConfig) SetBuffered(v bool) EncoderConfig {
+	s.buffered = &v
+
+	return s
+}
+
+// SetBufferedSort sorts the statements within each graph, and the named graphs by their name, when buffered.
+func (s EncoderConfig) SetBufferedSort(v bool) EncoderConfig {
+	s.bufferedSort = &v
+
+	return s
+}
+
+// SetAnnotations writes rdf:reifies statements, and statements about their reifier, which immediately follow the
+// quad they reify in the same graph using reifier and annotation syntax. When unbuffered, the most recent quad is held
+// back until the next statement or Close. The default is the buffered setting.
+func (s EncoderConfig) SetAnnotations(v bool) EncoderConfig {
+	s.annotations = &v
+
+	return s
+}
+
+func (s EncoderConfig) SetBaseDirectiveMode(v DirectiveMode) EncoderConfig {
+	s.baseDirectiveMode = &v
+
+	return s
+}
+
+func (s EncoderConfig) SetPrefixDirectiveMode(v DirectiveMode) EncoderConfig {
+	s.prefixDirectiveMode = &v
+
+	return s
+}
+
+func (s EncoderConfig) SetDirectiveMode(v DirectiveMode) EncoderConfig {
+	s.baseDirectiveMode = &v
+	s.prefixDirectiveMode = &v
+
+	return s
+}
+
+func (s EncoderConfig) apply(d *EncoderConfig) {
+	if s.base != nil {
+		d.base = s.base
+	}
+
+	if s.prefixes != nil {
+		d.prefixes = s.prefixes
+	}
+
+	if s.autoPrefixes != nil {
+		d.autoPrefixes = s.autoPrefixes
+	}
+
+	if s.bnStringProvider != nil {
+		d.bnStringProvider = s.bnStringProvider
+	}
+
+	if s.buffered != nil {
+		d.buffered = s.buffered
+	}
+
+	if s.bufferedSort != nil {
+		d.bufferedSort = s.bufferedSort
+	}
+
+	if s.annotations != nil {
+		d.annotations = s.annotations
+	}
+
+	if s.baseDirectiveMode != nil {
+		d.baseDirectiveMode = s.baseDirectiveMode
+	}
+
+	if s.prefixDirectiveMode != nil {
+		d.prefixDirectiveMode = s.prefixDirectiveMode
+	}
+}
+
+func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
+	prefixManager := iri.NewPrefixManager(s.prefixes)
+
+	e := &Encoder{
+		w:                   w,
+		bnStringProvider:    s.bnStringProvider,
+		baseDirectiveMode:   DirectiveMode_At,
+		prefixDirectiveMode: DirectiveMode_At,
+	}
+
+	if s.base != nil {
+		baseIRI, err := iri.ParseBaseIRI(string(*s.base))
+		if err != nil {
+			return nil, fmt.Errorf("parse base: %v", err)
+		}
+
+		e.base = baseIRI
+	}
+
+	if s.autoPrefixes != nil && *s.autoPrefixes {
+		var autoOptions iriutil.AutoPrefixMapperOptions
+
+		if e.base != nil {
+			// prefer relative references
+			autoOptions.Exclude = func(v string) bool {
+				_, ok := e.base.RelativizeIRI(v)
+
+				return ok
+			}
+		}
+
+		e.autoPrefixes = iriutil.NewAutoPrefixMapper(prefixManager, autoOptions)
+		e.prefixes = iriutil.NewUsagePrefixMapper(e.autoPrefixes)
+	} else {
+		e.prefixes = iriutil.NewUsagePrefixMapper(prefixManager)
+	}
+
+	if s.buffered != nil && *s.buffered {
+		e.buffered = *s.buffered
+		e.bufferedSort = e.buffered
+		e.bufferedGraphs = map[rdf.GraphNameValue]*bufferedGraph{}
+	}
+
+	if s.bufferedSort != nil {
+		e.bufferedSort = *s.bufferedSort
+	}
+
+	if s.annotations != nil {
+		e.annotations = *s.annotations
+	} else {
+		e.annotations = e.buffered
+	}
+
+	if e.bnStringProvider == nil {
+		e.bnStringProvider = blanknodes.NewInt64StringProvider("b%d")
+	}
+
+	e.terms = turtle.NewTermFormatter(turtle.TermFormatterOptions{
+		Base:                    e.base,
+		Prefixes:                e.prefixes,
+		BlankNodeStringProvider: e.bnStringProvider,
+	})
+
+	if s.baseDirectiveMode != nil {
+		e.baseDirectiveMode = *s.baseDirectiveMode
+	}
+
+	if s.prefixDirectiveMode != nil {
+		e.prefixDirectiveMode = *s.prefixDirectiveMode
+	}
+
+	if !e.buffered && (e.base != nil || len(s.prefixes) > 0) {
+		prefixMappings := prefixManager.GetPrefixMappings()
+		slices.SortFunc(prefixMappings, iri.ComparePrefixMappingByPrefix)
+
+		var baseString string
+
+		if e.base != nil {
+			baseString = e.base.String()
+		}
+
+		written, err := WriteDirectives(e.w, WriteDirectivesOptions{
+			Base:       baseString,
+			Prefixes:   prefixMappings,
+			BaseMode:   e.baseDirectiveMode,
+			PrefixMode: e.prefixDirectiveMode,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("write header: %v", err)
+		} else if written > 0 {
+			_, err = e.w.Write([]byte("\n"))
+			if err != nil {
+				return nil, fmt.Errorf("write header: %v", err)
+			}
+		}
+	}
+
+	return e, nil
+}
@@ -0,0 +1,325 @@
+package trig
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdfdescription"
+)
+
+func TestEncoder_Resources(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+	}{
+		{
+			name: "default graph",
+			content: `<http://example.com/test> a <http://example.com/Thing> .
+`,
+		},
+		{
+			name: "named graph",
+			content: `<http://example.com/graph> {
+	<http://example.com/test> a <http://example.com/Thing> .
+}
+`,
+		},
+		{
+			name: "default and named graphs",
+			content: `<http://example.com/test> a <http://example.com/Thing> .
+
+<http://example.com/graph1> {
+	<http://example.com/test> <http://example.com/predicate> [
+		a <http://example.com/Thing2> ;
+		<http://example.com/hasValue> "value"
+	] .
+}
+
+<http://example.com/graph2> {
+	<http://example.com/test> <http://example.com/hasItems> (
+		"item1"
+		"item2"
+	) .
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+
+			var buf bytes.Buffer
+
+			decoder, err := NewDecoder(strings.NewReader(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			encoder, err := NewEncoder(&buf, EncoderConfig{}.SetBuffered(true))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resources := rdfdescription.NewDatasetResourceListBuilder()
+
+			for decoder.Next() {
+				resources.AddQuad(ctx, decoder.Quad())
+			}
+
+			if err := decoder.Err(); err != nil {
+				t.Fatal(err)
+			}
+
+			err = resources.ToDatasetResourceWriter(ctx, encoder, rdfdescription.DefaultExportResourceOptions)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = encoder.Close()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := buf.String()
+			if got != tt.content {
+				t.Errorf("Expected:\n%s\nGot:\n%s", tt.content, got)
+			}
+		})
+	}
+}
+
+func TestEncoder_Unbuffered_Graphs(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetPrefixes(iri.PrefixMappingList{
+			{
+				Prefix:   "ex",
+				Expanded: "http://example.com/",
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, q := range (rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s1"),
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://example.com/Thing"),
+			},
+			GraphName: rdf.IRI("http://example.com/g1"),
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s2"),
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://example.com/Thing"),
+			},
+			GraphName: rdf.IRI("http://example.com/g1"),
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s3"),
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://example.com/Thing"),
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s4"),
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://example.com/Thing"),
+			},
+			GraphName: rdf.IRI("http://example.com/g2"),
+		},
+	}) {
+		err = e.AddQuad(ctx, q)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `@prefix ex: <http://example.com/> .
+
+ex:g1 {
+	ex:s1 a ex:Thing .
+	ex:s2 a ex:Thing .
+}
+ex:s3 a ex:Thing .
+ex:g2 {
+	ex:s4 a ex:Thing .
+}
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_Unbuffered_AutoPrefixes(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.SetAutoPrefixes(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, q := range (rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/things/s1"),
+				Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+				Object:    rdf.IRI("http://example.com/things/o1"),
+			},
+			GraphName: rdf.IRI("http://example.com/things/g"),
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/things/s1"),
+				Predicate: rdfiri.Type_Property,
+				Object:    rdf.IRI("http://schema.org/Person"),
+			},
+			GraphName: rdf.IRI("http://example.com/things/g"),
+		},
+	}) {
+		err = e.AddQuad(ctx, q)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `@prefix things: <http://example.com/things/> .
+@prefix foaf: <http://xmlns.com/foaf/0.1/> .
+things:g {
+	things:s1 foaf:name things:o1 .
+}
+@prefix schema: <http://schema.org/> .
+things:g {
+	things:s1 a schema:Person .
+}
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_Annotation(t *testing.T) {
+	ctx := t.Context()
+
+	triple := rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object:    rdf.IRI("http://example.com/o"),
+	}
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.SetAnnotations(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, q := range (rdf.QuadList{
+		{
+			Triple:    triple,
+			GraphName: rdf.IRI("http://example.com/g"),
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/r"),
+				Predicate: rdfiri.Reifies_Property,
+				Object:    rdf.TripleTerm(triple),
+			},
+			GraphName: rdf.IRI("http://example.com/g"),
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/r"),
+				Predicate: rdf.IRI("http://example.com/source"),
+				Object:    rdf.IRI("http://example.com/doc"),
+			},
+			GraphName: rdf.IRI("http://example.com/g"),
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/r"),
+				Predicate: rdf.IRI("http://example.com/source"),
+				Object:    rdf.IRI("http://example.com/other"),
+			},
+		},
+	}) {
+		err = e.AddQuad(ctx, q)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `<http://example.com/g> {
+	<http://example.com/s> <http://example.com/p> <http://example.com/o> ~ <http://example.com/r> {| <http://example.com/source> <http://example.com/doc> |} .
+}
+<http://example.com/r> <http://example.com/source> <http://example.com/other> .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_UnbufferedWithoutClose(t *testing.T) {
+	ctx := t.Context()
+
+	triple := rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object:    rdf.IRI("http://example.com/o"),
+	}
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, q := range (rdf.QuadList{
+		{
+			Triple: triple,
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/r"),
+				Predicate: rdfiri.Reifies_Property,
+				Object:    rdf.TripleTerm(triple),
+			},
+		},
+	}) {
+		err = e.AddQuad(ctx, q)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if _a, _e := buf.String(), `<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+<http://example.com/r> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
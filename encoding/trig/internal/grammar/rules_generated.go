@@ -16,7 +16,7 @@ const (
 	// R_triplesOrGraph ::= labelOrSubject ( wrappedGraph | ( predicateObjectList '.' ) )
 	R_triplesOrGraph
 
-	// R_triples2 ::= ( blankNodePropertyList predicateObjectList? '.' ) | ( collection predicateObjectList '.' )
+	// R_triples2 ::= ( blankNodePropertyList predicateObjectList? '.' ) | ( collection predicateObjectList '.' ) | ( reifiedTriple predicateObjectList? '.' )
 	R_triples2
 
 	// R_wrappedGraph ::= '{' triplesBlock? '}'
@@ -28,7 +28,7 @@ const (
 	// R_labelOrSubject ::= iri | BlankNode
 	R_labelOrSubject
 
-	// R_directive ::= prefixID | base | sparqlPrefix | sparqlBase
+	// R_directive ::= prefixID | base | version | sparqlPrefix | sparqlBase | sparqlVersion
 	R_directive
 
 	// R_prefixID ::= '@prefix' PNAME_NS IRIREF '.'
@@ -37,19 +37,28 @@ const (
 	// R_base ::= '@base' IRIREF '.'
 	R_base
 
+	// R_version ::= '@version' VersionSpecifier '.'
+	R_version
+
 	// R_sparqlPrefix ::= 'PREFIX' PNAME_NS IRIREF
 	R_sparqlPrefix
 
 	// R_sparqlBase ::= 'BASE' IRIREF
 	R_sparqlBase
 
-	// R_triples ::= ( subject predicateObjectList ) | ( blankNodePropertyList predicateObjectList? )
+	// R_sparqlVersion ::= 'VERSION' VersionSpecifier
+	R_sparqlVersion
+
+	// R_VersionSpecifier ::= STRING_LITERAL_QUOTE | STRING_LITERAL_SINGLE_QUOTE
+	R_VersionSpecifier
+
+	// R_triples ::= ( subject predicateObjectList ) | ( blankNodePropertyList predicateObjectList? ) | ( reifiedTriple predicateObjectList? )
 	R_triples
 
 	// R_predicateObjectList ::= verb objectList ( ( ';' ( ( verb objectList )? ) )* )
 	R_predicateObjectList
 
-	// R_objectList ::= object ( ( ',' object )* )
+	// R_objectList ::= object annotation ( ( ',' object annotation )* )
 	R_objectList
 
 	// R_verb ::= predicate | 'a'
@@ -61,7 +70,7 @@ const (
 	// R_predicate ::= iri
 	R_predicate
 
-	// R_object ::= iri | blank | blankNodePropertyList | literal
+	// R_object ::= iri | blank | blankNodePropertyList | literal | tripleTerm | reifiedTriple
 	R_object
 
 	// R_literal ::= RDFLiteral | NumericLiteral | BooleanLiteral
@@ -76,6 +85,33 @@ const (
 	// R_collection ::= '(' object* ')'
 	R_collection
 
+	// R_reifiedTriple ::= '<<' rtSubject verb rtObject reifier? '>>'
+	R_reifiedTriple
+
+	// R_rtSubject ::= iri | BlankNode | reifiedTriple
+	R_rtSubject
+
+	// R_rtObject ::= iri | BlankNode | literal | tripleTerm | reifiedTriple
+	R_rtObject
+
+	// R_tripleTerm ::= '<<(' ttSubject verb ttObject ')>>'
+	R_tripleTerm
+
+	// R_ttSubject ::= iri | BlankNode
+	R_ttSubject
+
+	// R_ttObject ::= iri | BlankNode | literal | tripleTerm
+	R_ttObject
+
+	// R_annotation ::= ( reifier | annotationBlock )*
+	R_annotation
+
+	// R_annotationBlock ::= '{|' predicateObjectList '|}'
+	R_annotationBlock
+
+	// R_reifier ::= '~' ( ( iri | BlankNode )? )
+	R_reifier
+
 	// R_NumericLiteral ::= INTEGER | DECIMAL | DOUBLE
 	R_NumericLiteral
 
@@ -109,7 +145,7 @@ const (
 	// R_BLANK_NODE_LABEL ::= '_:' ( PN_CHARS_U | [0-9] ) ( ( ( ( PN_CHARS | '.' )* ) PN_CHARS )? )
 	R_BLANK_NODE_LABEL
 
-	// R_LANGTAG ::= '@' ( ( [a-z] | [A-Z] )+ ) ( ( '-' ( ( [a-z] | [A-Z] | [0-9] )+ ) )* )
+	// R_LANGTAG ::= '@' ( ( [a-z] | [A-Z] )+ ) ( ( '-' ( ( [a-z] | [A-Z] | [0-9] )+ ) )* ) ( ( '--' ( ( [a-z] | [A-Z] )+ ) )? )
 	R_LANGTAG
 
 	// R_INTEGER ::= ( ( '+' | '-' )? ) [0-9]+
@@ -201,10 +237,16 @@ func (r R) String() string {
 		return "prefixID"
 	case R_base:
 		return "base"
+	case R_version:
+		return "version"
 	case R_sparqlPrefix:
 		return "sparqlPrefix"
 	case R_sparqlBase:
 		return "sparqlBase"
+	case R_sparqlVersion:
+		return "sparqlVersion"
+	case R_VersionSpecifier:
+		return "VersionSpecifier"
 	case R_triples:
 		return "triples"
 	case R_predicateObjectList:
@@ -227,6 +269,24 @@ func (r R) String() string {
 		return "blankNodePropertyList"
 	case R_collection:
 		return "collection"
+	case R_reifiedTriple:
+		return "reifiedTriple"
+	case R_rtSubject:
+		return "rtSubject"
+	case R_rtObject:
+		return "rtObject"
+	case R_tripleTerm:
+		return "tripleTerm"
+	case R_ttSubject:
+		return "ttSubject"
+	case R_ttObject:
+		return "ttObject"
+	case R_annotation:
+		return "annotation"
+	case R_annotationBlock:
+		return "annotationBlock"
+	case R_reifier:
+		return "reifier"
 	case R_NumericLiteral:
 		return "NumericLiteral"
 	case R_RDFLiteral:
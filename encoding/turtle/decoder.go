@@ -11,6 +11,7 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
 	"github.com/dpb587/rdfkit-go/encoding/turtle/turtlecontent"
 	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 )
 
 type DecoderOption interface {
@@ -27,9 +28,11 @@ type Decoder struct {
 	buf *cursorioutil.RuneBuffer
 	doc *cursorio.TextWriter
 
-	baseDirectiveListener   DecoderEvent_BaseDirective_ListenerFunc
-	prefixDirectiveListener DecoderEvent_PrefixDirective_ListenerFunc
-	buildTextOffsets        encodingutil.TextOffsetsBuilderFunc
+	baseDirectiveListener    DecoderEvent_BaseDirective_ListenerFunc
+	prefixDirectiveListener  DecoderEvent_PrefixDirective_ListenerFunc
+	versionDirectiveListener DecoderEvent_VersionDirective_ListenerFunc
+	languageTagMapper        langtags.MapperFunc
+	buildTextOffsets         encodingutil.TextOffsetsBuilderFunc
 
 	stack []readerStack
 
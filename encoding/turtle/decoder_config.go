@@ -9,6 +9,7 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
 	"github.com/dpb587/rdfkit-go/iri"
 	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 )
 
 type DecoderConfig struct {
@@ -17,11 +18,15 @@ type DecoderConfig struct {
 
 	bnStringFactory blanknodes.StringFactory
 
+	iriMapper         iri.MapperFunc
+	languageTagMapper langtags.MapperFunc
+
 	captureTextOffsets *bool
 	initialTextOffset  *cursorio.TextOffset
 
-	baseDirectiveListener   DecoderEvent_BaseDirective_ListenerFunc
-	prefixDirectiveListener DecoderEvent_PrefixDirective_ListenerFunc
+	baseDirectiveListener    DecoderEvent_BaseDirective_ListenerFunc
+	prefixDirectiveListener  DecoderEvent_PrefixDirective_ListenerFunc
+	versionDirectiveListener DecoderEvent_VersionDirective_ListenerFunc
 }
 
 func (b DecoderConfig) SetDefaultBase(v string) DecoderConfig {
@@ -42,6 +47,24 @@ func (b DecoderConfig) SetBlankNodeStringFactory(v blanknodes.StringFactory) Dec
 	return b
 }
 
+// SetIRIMapper checks or rewrites the IRIs of IRIREF terms after they are resolved against the base. By default, any
+// IRI matching the IRIREF production is used as-is. For example, use [iri.Validated] to reject IRIs which are not valid
+// according to RFC 3987 or [iri.Normalize] to also normalize them.
+func (b DecoderConfig) SetIRIMapper(v iri.MapperFunc) DecoderConfig {
+	b.iriMapper = v
+
+	return b
+}
+
+// SetLanguageTagMapper checks or rewrites the language tags of literals. By default, any tag matching the LANGTAG
+// production is used as-is, even if it is not a well-formed BCP 47 tag. For example, use [langtags.Validated] to
+// reject malformed tags or [langtags.Normalize] to also normalize their case.
+func (b DecoderConfig) SetLanguageTagMapper(v langtags.MapperFunc) DecoderConfig {
+	b.languageTagMapper = v
+
+	return b
+}
+
 func (b DecoderConfig) SetCaptureTextOffsets(v bool) DecoderConfig {
 	b.captureTextOffsets = &v
 
@@ -69,6 +92,12 @@ func (b DecoderConfig) SetPrefixDirectiveListener(v DecoderEvent_PrefixDirective
 	return b
 }
 
+func (b DecoderConfig) SetVersionDirectiveListener(v DecoderEvent_VersionDirective_ListenerFunc) DecoderConfig {
+	b.versionDirectiveListener = v
+
+	return b
+}
+
 func (o DecoderConfig) apply(s *DecoderConfig) {
 	if o.defaultBase != nil {
 		s.defaultBase = o.defaultBase
@@ -82,6 +111,14 @@ func (o DecoderConfig) apply(s *DecoderConfig) {
 		s.bnStringFactory = o.bnStringFactory
 	}
 
+	if o.iriMapper != nil {
+		s.iriMapper = o.iriMapper
+	}
+
+	if o.languageTagMapper != nil {
+		s.languageTagMapper = o.languageTagMapper
+	}
+
 	if o.captureTextOffsets != nil {
 		s.captureTextOffsets = o.captureTextOffsets
 	}
@@ -97,6 +134,10 @@ func (o DecoderConfig) apply(s *DecoderConfig) {
 	if o.prefixDirectiveListener != nil {
 		s.prefixDirectiveListener = o.prefixDirectiveListener
 	}
+
+	if o.versionDirectiveListener != nil {
+		s.versionDirectiveListener = o.versionDirectiveListener
+	}
 }
 
 func (o DecoderConfig) newDecoder(r io.Reader) (*Decoder, error) {
@@ -118,10 +159,12 @@ func (o DecoderConfig) newDecoder(r io.Reader) (*Decoder, error) {
 	}
 
 	d := &Decoder{
-		buf:                     cursorioutil.NewRuneBuffer(r),
-		baseDirectiveListener:   o.baseDirectiveListener,
-		prefixDirectiveListener: o.prefixDirectiveListener,
-		buildTextOffsets:        encodingutil.BuildTextOffsetsNil,
+		buf:                      cursorioutil.NewRuneBuffer(r),
+		baseDirectiveListener:    o.baseDirectiveListener,
+		prefixDirectiveListener:  o.prefixDirectiveListener,
+		versionDirectiveListener: o.versionDirectiveListener,
+		languageTagMapper:        o.languageTagMapper,
+		buildTextOffsets:         encodingutil.BuildTextOffsetsNil,
 		stack: []readerStack{
 			{
 				ectx: evaluationContext{
@@ -129,6 +172,7 @@ func (o DecoderConfig) newDecoder(r io.Reader) (*Decoder, error) {
 						Base:                   defaultBase,
 						Prefixes:               iri.NewPrefixManager(o.defaultPrefixes),
 						BlankNodeStringFactory: bnStringFactory,
+						IRIMapper:              o.iriMapper,
 					},
 				},
 				fn: reader_scanStatement,
@@ -16,6 +16,15 @@ type evaluationContext struct {
 	CurPredicate         rdf.PredicateValue
 	CurPredicateLocation *cursorio.TextOffsetRange
 
+	CurObject         rdf.ObjectValue
+	CurObjectLocation *cursorio.TextOffsetRange
+
+	CurReifier         rdf.SubjectValue
+	CurReifierLocation *cursorio.TextOffsetRange
+
+	// InCollection is true while decoding the items of a collection, where annotations are not permitted.
+	InCollection bool
+
 	Global *globalEvaluationContext
 }
 
@@ -28,20 +37,30 @@ func (ectx evaluationContext) ResolveURL(v string) (*iri.ParsedIRI, error) {
 }
 
 func (ectx evaluationContext) ResolveIRI(v string) (rdf.IRI, error) {
-	if ectx.Global.Base == nil {
-		return rdf.IRI(v), nil
+	if ectx.Global.Base != nil {
+		u, err := ectx.Global.Base.Parse(v)
+		if err != nil {
+			return "", fmt.Errorf("resolve iri: %v", err)
+		}
+
+		v = u.String()
 	}
 
-	u, err := ectx.Global.Base.Parse(v)
-	if err != nil {
-		return "", fmt.Errorf("resolve iri: %v", err)
+	if ectx.Global.IRIMapper != nil {
+		mapped, err := ectx.Global.IRIMapper(v)
+		if err != nil {
+			return "", err
+		}
+
+		v = mapped
 	}
 
-	return rdf.IRI(u.String()), nil
+	return rdf.IRI(v), nil
 }
 
 type globalEvaluationContext struct {
 	Base                   *iri.ParsedIRI
 	Prefixes               *iri.PrefixManager
 	BlankNodeStringFactory blanknodes.StringFactory
+	IRIMapper              iri.MapperFunc
 }
@@ -9,9 +9,10 @@ import (
 
 func TestDecoder_CaptureLANGTAG(t *testing.T) {
 	for _, tc := range []struct {
-		InputString     string
-		OutputLangValue string
-		Error           string
+		InputString          string
+		OutputLangValue      string
+		OutputDirectionValue string
+		Error                string
 	}{
 		{
 			InputString:     `@en`,
@@ -25,6 +26,28 @@ func TestDecoder_CaptureLANGTAG(t *testing.T) {
 			InputString:     `@fr-be`,
 			OutputLangValue: `fr-be`,
 		},
+		{
+			InputString:     `@en-US-x-foo`,
+			OutputLangValue: `en-US-x-foo`,
+		},
+		{
+			InputString:          `@ar--rtl`,
+			OutputLangValue:      `ar`,
+			OutputDirectionValue: `rtl`,
+		},
+		{
+			InputString:          `@en-US--ltr .`,
+			OutputLangValue:      `en-US`,
+			OutputDirectionValue: `ltr`,
+		},
+		{
+			InputString: `@en--LTR`,
+			Error:       "token (LANGTAG): offset 0x5: invalid base direction: LTR",
+		},
+		{
+			InputString: `@en--`,
+			Error:       "token (LANGTAG): offset 0x4: unexpected rune ('-')",
+		},
 		{
 			InputString: `@`,
 			Error:       "token (LANGTAG): offset 0x1: EOF",
@@ -49,6 +72,8 @@ func TestDecoder_CaptureLANGTAG(t *testing.T) {
 				}
 			} else if _e, _a := tc.OutputLangValue, token.Decoded; _e != _a {
 				t.Errorf("expected [%v], but got: %v", _e, _a)
+			} else if _e, _a := tc.OutputDirectionValue, token.Direction; _e != _a {
+				t.Errorf("expected direction [%v], but got: %v", _e, _a)
 			}
 		})
 	}
@@ -0,0 +1,530 @@
+package turtle
+
+import (
+	"errors"
+	"io"
+	"unicode"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/cursorio-go/x/cursorioutil"
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/turtle/internal"
+	"github.com/dpb587/rdfkit-go/encoding/turtle/internal/grammar"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+type tokenANON struct {
+	Offsets *cursorio.TextOffsetRange
+}
+
+type tokenTripleTerm struct {
+	Offsets       *cursorio.TextOffsetRange
+	Decoded       rdf.TripleTerm
+	NestedOffsets []any
+}
+
+type tokenReifiedTriple struct {
+	Offsets    *cursorio.TextOffsetRange
+	Decoded    rdf.SubjectValue
+	Statements []statement
+}
+
+type tokenTerm struct {
+	Offsets       *cursorio.TextOffsetRange
+	Decoded       rdf.Term
+	NestedOffsets []any
+	Statements    []statement
+}
+
+// nextTokenRune returns the next rune after any whitespace or comments, similar to the skipping done before each scan
+// state. Skipped runes are committed.
+func (r *Decoder) nextTokenRune() (cursorio.DecodedRune, error) {
+	var uncommitted cursorio.DecodedRuneList
+
+	for {
+		r0, err := r.buf.NextRune()
+		if err != nil {
+			return r0, r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{})
+		}
+
+		switch r0.Rune {
+		case '#':
+			uncommitted = append(uncommitted, r0)
+
+			for {
+				r1, err := r.buf.NextRune()
+				if err != nil {
+					return r1, r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{})
+				}
+
+				uncommitted = append(uncommitted, r1)
+
+				if r1.Rune == '\n' {
+					break
+				}
+			}
+		case 0x20, 0x09, 0x0A, 0x0D:
+			uncommitted = append(uncommitted, r0)
+		default:
+			if unicode.IsSpace(r0.Rune) {
+				uncommitted = append(uncommitted, r0)
+
+				continue
+			}
+
+			r.commit(uncommitted.AsDecodedRunes())
+
+			return r0, nil
+		}
+	}
+}
+
+// ANON ::= '[' WS* ']'
+func (r *Decoder) produceANON(r0 cursorio.DecodedRune) (*tokenANON, error) {
+	if r0.Rune != '[' {
+		return nil, grammar.R_ANON.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
+	}
+
+	uncommitted := cursorio.DecodedRuneList{r0}
+
+	for {
+		rN, err := r.buf.NextRune()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				err = io.ErrUnexpectedEOF
+			}
+
+			return nil, grammar.R_ANON.Err(r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{}))
+		}
+
+		switch rN.Rune {
+		case 0x20, 0x09, 0x0A, 0x0D:
+			uncommitted = append(uncommitted, rN)
+		case ']':
+			return &tokenANON{
+				Offsets: r.commitForTextOffsetRange(append(uncommitted, rN).AsDecodedRunes()),
+			}, nil
+		default:
+			return nil, grammar.R_ANON.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: rN.Rune}, uncommitted.AsDecodedRunes(), rN.AsDecodedRunes()))
+		}
+	}
+}
+
+// verb ::= predicate | 'a'
+func (r *Decoder) produceVerb(ectx evaluationContext, r0 cursorio.DecodedRune) (rdf.PredicateValue, *cursorio.TextOffsetRange, error) {
+	switch {
+	case r0.Rune == '<':
+		token, err := r.produceIRIREF(r0)
+		if err != nil {
+			return nil, nil, grammar.R_verb.Err(err)
+		}
+
+		resolvedIRI, err := ectx.ResolveIRI(token.Decoded)
+		if err != nil {
+			return nil, nil, grammar.R_verb.Err(grammar.R_IRIREF.ErrWithTextOffsetRange(err, token.Offsets))
+		}
+
+		return resolvedIRI, token.Offsets, nil
+	case r0.Rune == 'a':
+		r1, err := r.buf.NextRune()
+		if err != nil {
+			return nil, nil, grammar.R_verb.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))
+		}
+
+		r.buf.BacktrackRunes(r1)
+
+		if unicode.IsSpace(r1.Rune) {
+			return rdfiri.Type_Property, r.commitForTextOffsetRange(r0.AsDecodedRunes()), nil
+		}
+	case r0.Rune == ':' || internal.IsRune_PN_CHARS_BASE(r0.Rune):
+		// prefixed name
+	default:
+		return nil, nil, grammar.R_verb.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
+	}
+
+	token, err := r.producePrefixedName(r0)
+	if err != nil {
+		return nil, nil, grammar.R_verb.Err(err)
+	}
+
+	expanded, ok := ectx.Global.Prefixes.ExpandPrefix(iri.PrefixReference{
+		Prefix:    token.NamespaceDecoded,
+		Reference: token.LocalDecoded,
+	})
+	if !ok {
+		return nil, nil, grammar.R_verb.Err(grammar.R_PrefixedName.ErrWithTextOffsetRange(iri.NewUnknownPrefixError(token.NamespaceDecoded), token.Offsets))
+	}
+
+	return rdf.IRI(expanded), token.Offsets, nil
+}
+
+// produceTerm decodes a term which is nested within a triple term or reified triple. The rule determines the kinds of
+// terms that are permitted; any rule other than the following only permits iri or BlankNode (such as reifier).
+//
+// ttSubject ::= iri | BlankNode
+// ttObject  ::= iri | BlankNode | literal | tripleTerm
+// rtSubject ::= iri | BlankNode | reifiedTriple
+// rtObject  ::= iri | BlankNode | literal | tripleTerm | reifiedTriple
+func (r *Decoder) produceTerm(ectx evaluationContext, r0 cursorio.DecodedRune, rule grammar.R, offsetsType encoding.StatementOffsetsType) (*tokenTerm, error) {
+	allowLiteral := rule == grammar.R_ttObject || rule == grammar.R_rtObject
+	allowTripleTerm := allowLiteral
+	allowReifiedTriple := rule == grammar.R_rtSubject || rule == grammar.R_rtObject
+
+	switch {
+	case r0.Rune == '<':
+		r1, err := r.buf.NextRune()
+		if err != nil {
+			return nil, rule.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))
+		} else if r1.Rune == '<' {
+			r2, err := r.buf.NextRune()
+			if err != nil {
+				return nil, rule.Err(r.newOffsetError(err, cursorio.NewDecodedRunes(r0, r1), cursorio.DecodedRunes{}))
+			} else if r2.Rune == '(' {
+				if !allowTripleTerm {
+					return nil, rule.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r2.Rune}, cursorio.NewDecodedRunes(r0, r1), r2.AsDecodedRunes()))
+				}
+
+				token, err := r.produceTripleTerm(ectx, cursorio.DecodedRuneList{r0, r1, r2}, offsetsType)
+				if err != nil {
+					return nil, rule.Err(err)
+				}
+
+				return &tokenTerm{
+					Offsets:       token.Offsets,
+					Decoded:       token.Decoded,
+					NestedOffsets: token.NestedOffsets,
+				}, nil
+			} else if !allowReifiedTriple {
+				return nil, rule.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r1.Rune}, r0.AsDecodedRunes(), r1.AsDecodedRunes()))
+			}
+
+			r.buf.BacktrackRunes(r2)
+
+			token, err := r.produceReifiedTriple(ectx, cursorio.DecodedRuneList{r0, r1})
+			if err != nil {
+				return nil, rule.Err(err)
+			}
+
+			return &tokenTerm{
+				Offsets:    token.Offsets,
+				Decoded:    token.Decoded,
+				Statements: token.Statements,
+			}, nil
+		}
+
+		r.buf.BacktrackRunes(r1)
+
+		token, err := r.produceIRIREF(r0)
+		if err != nil {
+			return nil, rule.Err(err)
+		}
+
+		resolvedIRI, err := ectx.ResolveIRI(token.Decoded)
+		if err != nil {
+			return nil, rule.Err(grammar.R_IRIREF.ErrWithTextOffsetRange(err, token.Offsets))
+		}
+
+		return &tokenTerm{
+			Offsets: token.Offsets,
+			Decoded: resolvedIRI,
+		}, nil
+	case r0.Rune == '_':
+		token, err := r.produceBlankNode(r0)
+		if err != nil {
+			return nil, rule.Err(err)
+		}
+
+		return &tokenTerm{
+			Offsets: token.Offsets,
+			Decoded: ectx.Global.BlankNodeStringFactory.NewStringBlankNode(token.Decoded),
+		}, nil
+	case r0.Rune == '[':
+		token, err := r.produceANON(r0)
+		if err != nil {
+			return nil, rule.Err(err)
+		}
+
+		return &tokenTerm{
+			Offsets: token.Offsets,
+			Decoded: ectx.Global.BlankNodeStringFactory.NewBlankNode(),
+		}, nil
+	case allowLiteral && (r0.Rune == '"' || r0.Rune == '\''):
+		literal, literalRange, err := r.produceRDFLiteral(ectx, r0)
+		if err != nil {
+			return nil, rule.Err(err)
+		}
+
+		return &tokenTerm{
+			Offsets: literalRange,
+			Decoded: literal,
+		}, nil
+	case allowLiteral && (r0.Rune == '+' || r0.Rune == '-' || r0.Rune == '.' || ('0' <= r0.Rune && r0.Rune <= '9')):
+		literal, literalRange, err := r.produceNumericLiteralValue(r0)
+		if err != nil {
+			return nil, rule.Err(err)
+		}
+
+		return &tokenTerm{
+			Offsets: literalRange,
+			Decoded: literal,
+		}, nil
+	case allowLiteral && (r0.Rune == 't' || r0.Rune == 'f'):
+		literal, literalRange, ok, err := r.produceBooleanLiteral(r0)
+		if err != nil {
+			return nil, rule.Err(err)
+		} else if ok {
+			return &tokenTerm{
+				Offsets: literalRange,
+				Decoded: literal,
+			}, nil
+		}
+
+		r0, err = r.buf.NextRune()
+		if err != nil {
+			return nil, rule.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{}))
+		}
+	case r0.Rune == ':' || internal.IsRune_PN_CHARS_BASE(r0.Rune):
+		// prefixed name
+	default:
+		return nil, rule.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
+	}
+
+	token, err := r.producePrefixedName(r0)
+	if err != nil {
+		return nil, rule.Err(err)
+	}
+
+	expanded, ok := ectx.Global.Prefixes.ExpandPrefix(iri.PrefixReference{
+		Prefix:    token.NamespaceDecoded,
+		Reference: token.LocalDecoded,
+	})
+	if !ok {
+		return nil, rule.Err(grammar.R_PrefixedName.ErrWithTextOffsetRange(iri.NewUnknownPrefixError(token.NamespaceDecoded), token.Offsets))
+	}
+
+	return &tokenTerm{
+		Offsets: token.Offsets,
+		Decoded: rdf.IRI(expanded),
+	}, nil
+}
+
+// tripleTerm ::= '<<(' ttSubject verb ttObject ')>>'
+//
+// The opening runes must already be read and are given as uncommitted.
+func (r *Decoder) produceTripleTerm(ectx evaluationContext, uncommitted cursorio.DecodedRuneList, offsetsType encoding.StatementOffsetsType) (*tokenTripleTerm, error) {
+	openRange := r.commitForTextOffsetRange(uncommitted.AsDecodedRunes())
+
+	r0, err := r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	subject, err := r.produceTerm(ectx, r0, grammar.R_ttSubject, encoding.TripleTermStatementOffsets(offsetsType, encoding.SubjectStatementOffsets))
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	r0, err = r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	predicate, predicateRange, err := r.produceVerb(ectx, r0)
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	r0, err = r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	object, err := r.produceTerm(ectx, r0, grammar.R_ttObject, encoding.TripleTermStatementOffsets(offsetsType, encoding.ObjectStatementOffsets))
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	r0, err = r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	closeRange, err := r.produceClosingDelimiter(r0, ')', '>', '>')
+	if err != nil {
+		return nil, grammar.R_tripleTerm.Err(err)
+	}
+
+	nestedOffsets := append(
+		[]any{
+			encoding.TripleTermStatementOffsets(offsetsType, encoding.SubjectStatementOffsets), subject.Offsets,
+			encoding.TripleTermStatementOffsets(offsetsType, encoding.PredicateStatementOffsets), predicateRange,
+			encoding.TripleTermStatementOffsets(offsetsType, encoding.ObjectStatementOffsets), object.Offsets,
+		},
+		object.NestedOffsets...,
+	)
+
+	return &tokenTripleTerm{
+		Offsets: joinTextOffsetRange(openRange, closeRange),
+		Decoded: rdf.TripleTerm{
+			Subject:   subject.Decoded.(rdf.SubjectValue),
+			Predicate: predicate,
+			Object:    object.Decoded.(rdf.ObjectValue),
+		},
+		NestedOffsets: nestedOffsets,
+	}, nil
+}
+
+// reifiedTriple ::= '<<' rtSubject verb rtObject reifier? '>>'
+//
+// The opening runes must already be read and are given as uncommitted. The decoded value is the reifier, and the
+// statements include the rdf:reifies statement for the triple along with any statements from nested reified triples.
+func (r *Decoder) produceReifiedTriple(ectx evaluationContext, uncommitted cursorio.DecodedRuneList) (*tokenReifiedTriple, error) {
+	openRange := r.commitForTextOffsetRange(uncommitted.AsDecodedRunes())
+
+	reifiesObjectOffsets := func(property encoding.StatementOffsetsType) encoding.StatementOffsetsType {
+		return encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, property)
+	}
+
+	r0, err := r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	subject, err := r.produceTerm(ectx, r0, grammar.R_rtSubject, reifiesObjectOffsets(encoding.SubjectStatementOffsets))
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	r0, err = r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	predicate, predicateRange, err := r.produceVerb(ectx, r0)
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	r0, err = r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	object, err := r.produceTerm(ectx, r0, grammar.R_rtObject, reifiesObjectOffsets(encoding.ObjectStatementOffsets))
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	r0, err = r.nextTokenRune()
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	var reifier rdf.SubjectValue
+	var reifierRange *cursorio.TextOffsetRange
+
+	if r0.Rune == '~' {
+		r.commit(r0.AsDecodedRunes())
+
+		r0, err = r.nextTokenRune()
+		if err != nil {
+			return nil, grammar.R_reifiedTriple.Err(grammar.R_reifier.Err(err))
+		}
+
+		if r0.Rune != '>' {
+			token, err := r.produceTerm(ectx, r0, grammar.R_reifier, encoding.SubjectStatementOffsets)
+			if err != nil {
+				return nil, grammar.R_reifiedTriple.Err(err)
+			}
+
+			reifier = token.Decoded.(rdf.SubjectValue)
+			reifierRange = token.Offsets
+
+			r0, err = r.nextTokenRune()
+			if err != nil {
+				return nil, grammar.R_reifiedTriple.Err(err)
+			}
+		}
+	}
+
+	closeRange, err := r.produceClosingDelimiter(r0, '>', '>')
+	if err != nil {
+		return nil, grammar.R_reifiedTriple.Err(err)
+	}
+
+	reifiedTripleRange := joinTextOffsetRange(openRange, closeRange)
+
+	if reifier == nil {
+		reifier = ectx.Global.BlankNodeStringFactory.NewBlankNode()
+		reifierRange = reifiedTripleRange
+	}
+
+	var statements []statement
+
+	statements = append(statements, subject.Statements...)
+	statements = append(statements, object.Statements...)
+	statements = append(statements, statement{
+		triple: rdf.Triple{
+			Subject:   reifier,
+			Predicate: rdfiri.Reifies_Property,
+			Object: rdf.TripleTerm{
+				Subject:   subject.Decoded.(rdf.SubjectValue),
+				Predicate: predicate,
+				Object:    object.Decoded.(rdf.ObjectValue),
+			},
+		},
+		textOffsets: r.buildTextOffsets(append(
+			[]any{
+				encoding.SubjectStatementOffsets, reifierRange,
+				encoding.ObjectStatementOffsets, reifiedTripleRange,
+				reifiesObjectOffsets(encoding.SubjectStatementOffsets), subject.Offsets,
+				reifiesObjectOffsets(encoding.PredicateStatementOffsets), predicateRange,
+				reifiesObjectOffsets(encoding.ObjectStatementOffsets), object.Offsets,
+			},
+			object.NestedOffsets...,
+		)...),
+	})
+
+	return &tokenReifiedTriple{
+		Offsets:    reifiedTripleRange,
+		Decoded:    reifier,
+		Statements: statements,
+	}, nil
+}
+
+// produceClosingDelimiter requires the expected runes to be read contiguously, starting with r0.
+func (r *Decoder) produceClosingDelimiter(r0 cursorio.DecodedRune, expected ...rune) (*cursorio.TextOffsetRange, error) {
+	uncommitted := cursorio.DecodedRuneList{}
+
+	for idx, expectedRune := range expected {
+		if idx > 0 {
+			var err error
+
+			r0, err = r.buf.NextRune()
+			if err != nil {
+				return nil, r.newOffsetError(err, uncommitted.AsDecodedRunes(), cursorio.DecodedRunes{})
+			}
+		}
+
+		if r0.Rune != expectedRune {
+			return nil, r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, uncommitted.AsDecodedRunes(), r0.AsDecodedRunes())
+		}
+
+		uncommitted = append(uncommitted, r0)
+	}
+
+	return r.commitForTextOffsetRange(uncommitted.AsDecodedRunes()), nil
+}
+
+func joinTextOffsetRange(from, until *cursorio.TextOffsetRange) *cursorio.TextOffsetRange {
+	if from == nil || until == nil {
+		return nil
+	}
+
+	return &cursorio.TextOffsetRange{
+		From:  from.From,
+		Until: until.Until,
+	}
+}
@@ -10,8 +10,9 @@ import (
 )
 
 type tokenString struct {
-	Offsets *cursorio.TextOffsetRange
-	Decoded string
+	Offsets     *cursorio.TextOffsetRange
+	GrammarRule grammar.R
+	Decoded     string
 }
 
 // String                           ::= STRING_LITERAL_QUOTE | STRING_LITERAL_SINGLE_QUOTE | STRING_LITERAL_LONG_SINGLE_QUOTE | STRING_LITERAL_LONG_QUOTE
@@ -67,8 +68,9 @@ func (r *Decoder) produceString(r0 cursorio.DecodedRune) (*tokenString, error) {
 		r.buf.BacktrackRunes(r1)
 
 		return &tokenString{
-			Offsets: r.commitForTextOffsetRange(append(uncommitted, r0, r1).AsDecodedRunes()),
-			Decoded: "",
+			Offsets:     r.commitForTextOffsetRange(append(uncommitted, r0, r1).AsDecodedRunes()),
+			GrammarRule: grammarRule,
+			Decoded:     "",
 		}, nil
 	} else {
 		r.buf.BacktrackRunes(r0)
@@ -171,7 +173,8 @@ START_DELIMITER_DONE:
 DONE:
 
 	return &tokenString{
-		Offsets: r.commitForTextOffsetRange(uncommitted.AsDecodedRunes()),
-		Decoded: string(decoded),
+		Offsets:     r.commitForTextOffsetRange(uncommitted.AsDecodedRunes()),
+		GrammarRule: grammarRule,
+		Decoded:     string(decoded),
 	}, nil
 }
@@ -0,0 +1,196 @@
+package turtle
+
+import (
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/cursorio-go/x/cursorioutil"
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/turtle/internal"
+	"github.com/dpb587/rdfkit-go/encoding/turtle/internal/grammar"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// annotationState returns the state for an optional annotation of the object which was just decoded. Annotations are
+// only permitted for objects of an objectList, so collection items and subject collections will not be annotated.
+func (ectx evaluationContext) annotationState(object rdf.ObjectValue, objectLocation *cursorio.TextOffsetRange) readerStack {
+	if ectx.InCollection || ectx.CurSubject == nil {
+		return readerStack{}
+	}
+
+	ectx.CurObject = object
+	ectx.CurObjectLocation = objectLocation
+	ectx.CurReifier = nil
+	ectx.CurReifierLocation = nil
+
+	return readerStack{ectx, reader_scan_Annotation}
+}
+
+func (r *Decoder) newReifiesStatement(ectx evaluationContext, reifier rdf.SubjectValue, reifierLocation *cursorio.TextOffsetRange) statement {
+	return statement{
+		triple: rdf.Triple{
+			Subject:   reifier,
+			Predicate: rdfiri.Reifies_Property,
+			Object: rdf.TripleTerm{
+				Subject:   ectx.CurSubject,
+				Predicate: ectx.CurPredicate,
+				Object:    ectx.CurObject,
+			},
+		},
+		textOffsets: r.buildTextOffsets(
+			encoding.SubjectStatementOffsets, reifierLocation,
+			encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, encoding.SubjectStatementOffsets), ectx.CurSubjectLocation,
+			encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, encoding.PredicateStatementOffsets), ectx.CurPredicateLocation,
+			encoding.TripleTermStatementOffsets(encoding.ObjectStatementOffsets, encoding.ObjectStatementOffsets), ectx.CurObjectLocation,
+		),
+	}
+}
+
+func reader_scan_Annotation(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, err error) (readerStack, error) {
+	if err != nil {
+		return readerStack{}, grammar.R_annotation.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{}))
+	}
+
+	switch r0.Rune {
+	case '~':
+		r.commit(r0.AsDecodedRunes())
+
+		return readerStack{ectx, reader_scan_Reifier}, nil
+	case '{':
+		r1, err := r.buf.NextRune()
+		if err != nil {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_annotationBlock.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{})))
+		} else if r1.Rune != '|' {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_annotationBlock.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r1.Rune}, r0.AsDecodedRunes(), r1.AsDecodedRunes())))
+		}
+
+		r.commit(cursorio.NewDecodedRunes(r0, r1))
+
+		var statements []statement
+
+		nectx := ectx
+		nectx.CurPredicate = nil
+		nectx.CurPredicateLocation = nil
+		nectx.CurObject = nil
+		nectx.CurObjectLocation = nil
+		nectx.CurReifier = nil
+		nectx.CurReifierLocation = nil
+		nectx.InCollection = false
+
+		if ectx.CurReifier != nil {
+			nectx.CurSubject = ectx.CurReifier
+			nectx.CurSubjectLocation = ectx.CurReifierLocation
+		} else {
+			reifier := ectx.Global.BlankNodeStringFactory.NewBlankNode()
+
+			nectx.CurSubject = reifier
+			nectx.CurSubjectLocation = nil
+
+			statements = append(statements, r.newReifiesStatement(ectx, reifier, nil))
+		}
+
+		// a following annotation block without its own reifier must use a new reifier
+		ectx.CurReifier = nil
+		ectx.CurReifierLocation = nil
+
+		r.pushState(ectx, reader_scan_Annotation)
+		r.pushState(nectx, reader_scan_AnnotationBlock_End)
+		r.pushState(nectx, reader_scan_PredicateObjectList_Continue)
+
+		r.emit(statements...)
+
+		return readerStack{nectx, reader_scan_PredicateObjectList_Required}, nil
+	}
+
+	r.buf.BacktrackRunes(r0)
+
+	return readerStack{}, nil
+}
+
+func reader_scan_AnnotationBlock_End(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, err error) (readerStack, error) {
+	if err != nil {
+		return readerStack{}, grammar.R_annotationBlock.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{}))
+	} else if r0.Rune != '|' {
+		return readerStack{}, grammar.R_annotationBlock.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r0.Rune}, cursorio.DecodedRunes{}, r0.AsDecodedRunes()))
+	}
+
+	r1, err := r.buf.NextRune()
+	if err != nil {
+		return readerStack{}, grammar.R_annotationBlock.Err(r.newOffsetError(err, r0.AsDecodedRunes(), cursorio.DecodedRunes{}))
+	} else if r1.Rune != '}' {
+		return readerStack{}, grammar.R_annotationBlock.Err(r.newOffsetError(cursorioutil.UnexpectedRuneError{Rune: r1.Rune}, r0.AsDecodedRunes(), r1.AsDecodedRunes()))
+	}
+
+	r.commit(cursorio.NewDecodedRunes(r0, r1))
+
+	return readerStack{}, nil
+}
+
+// reifier ::= '~' (iri | BlankNode)?
+func reader_scan_Reifier(r *Decoder, ectx evaluationContext, r0 cursorio.DecodedRune, err error) (readerStack, error) {
+	if err != nil {
+		return readerStack{}, grammar.R_annotation.Err(grammar.R_reifier.Err(r.newOffsetError(err, cursorio.DecodedRunes{}, cursorio.DecodedRunes{})))
+	}
+
+	var reifier rdf.SubjectValue
+	var reifierLocation *cursorio.TextOffsetRange
+
+	switch {
+	case r0.Rune == '<':
+		token, err := r.produceIRIREF(r0)
+		if err != nil {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_reifier.Err(err))
+		}
+
+		resolvedIRI, err := ectx.ResolveIRI(token.Decoded)
+		if err != nil {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_reifier.Err(grammar.R_IRIREF.ErrWithTextOffsetRange(err, token.Offsets)))
+		}
+
+		reifier = resolvedIRI
+		reifierLocation = token.Offsets
+	case r0.Rune == '_':
+		token, err := r.produceBlankNode(r0)
+		if err != nil {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_reifier.Err(err))
+		}
+
+		reifier = ectx.Global.BlankNodeStringFactory.NewStringBlankNode(token.Decoded)
+		reifierLocation = token.Offsets
+	case r0.Rune == '[':
+		token, err := r.produceANON(r0)
+		if err != nil {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_reifier.Err(err))
+		}
+
+		reifier = ectx.Global.BlankNodeStringFactory.NewBlankNode()
+		reifierLocation = token.Offsets
+	case r0.Rune == ':' || internal.IsRune_PN_CHARS_BASE(r0.Rune):
+		token, err := r.producePrefixedName(r0)
+		if err != nil {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_reifier.Err(err))
+		}
+
+		expanded, ok := ectx.Global.Prefixes.ExpandPrefix(iri.PrefixReference{
+			Prefix:    token.NamespaceDecoded,
+			Reference: token.LocalDecoded,
+		})
+		if !ok {
+			return readerStack{}, grammar.R_annotation.Err(grammar.R_reifier.Err(grammar.R_PrefixedName.ErrWithTextOffsetRange(iri.NewUnknownPrefixError(token.NamespaceDecoded), token.Offsets)))
+		}
+
+		reifier = rdf.IRI(expanded)
+		reifierLocation = token.Offsets
+	default:
+		r.buf.BacktrackRunes(r0)
+
+		reifier = ectx.Global.BlankNodeStringFactory.NewBlankNode()
+	}
+
+	ectx.CurReifier = reifier
+	ectx.CurReifierLocation = reifierLocation
+
+	r.emit(r.newReifiesStatement(ectx, reifier, reifierLocation))
+
+	return readerStack{ectx, reader_scan_Annotation}, nil
+}
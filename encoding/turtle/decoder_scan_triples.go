@@ -86,3 +86,18 @@ func reader_scan_Triples_Subject_BlankNode(r *Decoder, ectx evaluationContext, r
 
 	return readerStack{ectx, reader_scan_PredicateObjectList_Required}, nil
 }
+
+func reader_scan_Triples_Subject_ReifiedTriple(r *Decoder, ectx evaluationContext, uncommitted cursorio.DecodedRuneList) (readerStack, error) {
+	token, err := r.produceReifiedTriple(ectx, uncommitted)
+	if err != nil {
+		return readerStack{}, grammar.R_triples.Err(err)
+	}
+
+	ectx.CurSubject = token.Decoded
+	ectx.CurSubjectLocation = token.Offsets
+
+	r.pushState(ectx, reader_scan_PredicateObjectList_Continue)
+	r.emit(token.Statements...)
+
+	return readerStack{ectx, reader_scan_PredicateObjectList}, nil
+}
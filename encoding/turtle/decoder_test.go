@@ -1,10 +1,16 @@
 package turtle
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"os"
 	"strings"
 	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding/ntriples"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 )
 
 func TestDecoder(t *testing.T) {
@@ -39,3 +45,268 @@ func TestDecoder(t *testing.T) {
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestDecoder_RDF12(t *testing.T) {
+	for _, tc := range []struct {
+		InputString    string
+		OutputNTriples string
+		OutputError    string
+	}{
+		{
+			InputString: `@prefix : <http://example.com/> .
+:s :p :o ~:r {| :source :src |} .`,
+			OutputNTriples: `<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+<http://example.com/r> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> .
+<http://example.com/r> <http://example.com/source> <http://example.com/src> .
+`,
+		},
+		{
+			InputString: `@prefix : <http://example.com/> .
+:s :p :o {| :a :b |} ~ {| :c :d |}, :o2 ~_:x .`,
+			OutputNTriples: `<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+_:b0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> .
+_:b0 <http://example.com/a> <http://example.com/b> .
+_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> .
+_:b1 <http://example.com/c> <http://example.com/d> .
+<http://example.com/s> <http://example.com/p> <http://example.com/o2> .
+_:b2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o2> )>> .
+`,
+		},
+		{
+			InputString: `PREFIX : <http://example.com/>
+<< :s :p :o >> :q :z .
+:a :b << _:s :p << :s2 :p2 "x"@en ~ :r2 >> ~ :r1 >> .
+<< :s a [] >> .`,
+			OutputNTriples: `_:b0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> .
+_:b0 <http://example.com/q> <http://example.com/z> .
+<http://example.com/a> <http://example.com/b> <http://example.com/r1> .
+<http://example.com/r2> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s2> <http://example.com/p2> "x"@en )>> .
+<http://example.com/r1> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( _:b1 <http://example.com/p> <http://example.com/r2> )>> .
+_:b2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> _:b3 )>> .
+`,
+		},
+		{
+			InputString: `@prefix : <http://example.com/> .
+:s :p <<( :s2 :p2 <<( :s3 :p3 42 )>> )>> {| :q true |} .
+:s :p ( :a ) {| :q :z |} .
+:s :p [ :x :y ] ~ :r .
+:s :p () ~ :r .`,
+			OutputNTriples: `<http://example.com/s> <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> <<( <http://example.com/s3> <http://example.com/p3> "42"^^<http://www.w3.org/2001/XMLSchema#integer> )>> )>> .
+_:b0 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <<( <http://example.com/s2> <http://example.com/p2> <<( <http://example.com/s3> <http://example.com/p3> "42"^^<http://www.w3.org/2001/XMLSchema#integer> )>> )>> )>> .
+_:b0 <http://example.com/q> "true"^^<http://www.w3.org/2001/XMLSchema#boolean> .
+<http://example.com/s> <http://example.com/p> _:b1 .
+_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#first> <http://example.com/a> .
+_:b1 <http://www.w3.org/1999/02/22-rdf-syntax-ns#rest> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+_:b2 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> _:b1 )>> .
+_:b2 <http://example.com/q> <http://example.com/z> .
+<http://example.com/s> <http://example.com/p> _:b3 .
+_:b3 <http://example.com/x> <http://example.com/y> .
+<http://example.com/r> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> _:b3 )>> .
+<http://example.com/s> <http://example.com/p> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> .
+<http://example.com/r> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://www.w3.org/1999/02/22-rdf-syntax-ns#nil> )>> .
+`,
+		},
+		{
+			InputString: `VERSION "1.2"
+@version '1.2' .
+@prefix version: <http://example.com/> .
+version:s version:p version:o .`,
+			OutputNTriples: `<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+`,
+		},
+		{
+			InputString: `VERSION """1.2"""`,
+			OutputError: `token (statement): token (sparqlVersion): token (VersionSpecifier): long string not allowed`,
+		},
+		{
+			InputString: `<<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> <http://example.com/p> <http://example.com/o> .`,
+			OutputError: `token (triples): token (reifiedTriple): token (rtSubject): offset 0x2: unexpected rune ('(')`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> ( <http://example.com/o> {| <http://example.com/p> <http://example.com/o> |} ) .`,
+			OutputError: `token (object): offset 0x47: unexpected rune ('{')`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> ~ <http://example.com/r> )>> .`,
+			OutputError: `token (object): token (tripleTerm): offset 0x77: unexpected rune ('~')`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> << <http://example.com/s> <http://example.com/p> <http://example.com/o> > .`,
+			OutputError: `token (object): token (reifiedTriple): offset 0x77: unexpected rune (' ')`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			r, err := NewDecoder(strings.NewReader(tc.InputString))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			buf := &bytes.Buffer{}
+
+			e, err := ntriples.NewEncoder(buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for r.Next() {
+				if err := e.AddTriple(context.Background(), r.Triple()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if err := r.Err(); err != nil {
+				if len(tc.OutputError) == 0 || err.Error() != tc.OutputError {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.OutputError) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputNTriples, buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestDecoder_LanguageTagMapper(t *testing.T) {
+	for _, tc := range []struct {
+		InputString    string
+		Mapper         langtags.MapperFunc
+		OutputNTriples string
+		OutputError    string
+	}{
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> "x"@en-us, "y"@ZH-HANT-tw--rtl .`,
+			Mapper:      langtags.Normalize,
+			OutputNTriples: `<http://example.com/s> <http://example.com/p> "x"@en-US .
+<http://example.com/s> <http://example.com/p> "y"@zh-Hant-TW--rtl .
+`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> "x"@en-us .`,
+			Mapper:      langtags.Validated,
+			OutputNTriples: `<http://example.com/s> <http://example.com/p> "x"@en-us .
+`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> "x"@en-toolongsubtag .`,
+			Mapper:      langtags.Validated,
+			OutputError: `token (object): token (literal): token (RDFLiteral): token (LANGTAG; offset=L1C51:L1C67;0x32:0x42): language tag not well-formed: subtag too long: "toolongsubtag"`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			r, err := NewDecoder(strings.NewReader(tc.InputString), DecoderConfig{}.
+				SetCaptureTextOffsets(true).
+				SetLanguageTagMapper(tc.Mapper),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			buf := &bytes.Buffer{}
+
+			e, err := ntriples.NewEncoder(buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for r.Next() {
+				if err := e.AddTriple(context.Background(), r.Triple()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if err := r.Err(); err != nil {
+				if len(tc.OutputError) == 0 || err.Error() != tc.OutputError {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.OutputError) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputNTriples, buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestDecoder_IRIMapper(t *testing.T) {
+	for _, tc := range []struct {
+		InputString    string
+		Mapper         iri.MapperFunc
+		OutputNTriples string
+		OutputError    string
+	}{
+		{
+			InputString: `@base <HTTP://Example.com/a/> . <%7euser> <../p> <http://example.com:80/o> .`,
+			Mapper:      iri.Normalize,
+			OutputNTriples: `<http://example.com/a/~user> <http://example.com/p> <http://example.com/o> .
+`,
+		},
+		{
+			InputString: `<http://example.com/s> <http://example.com/p> <http://example.com/o#a#b> .`,
+			Mapper:      iri.Validated,
+			OutputError: `token (object): token (IRIREF; offset=L1C47:L1C73;0x2e:0x48): invalid IRI: unexpected character in fragment: '#'`,
+		},
+		{
+			InputString: `<s> <http://example.com/p> <http://example.com/o> .`,
+			Mapper:      iri.Validated,
+			OutputError: `token (triples): token (subject): token (IRIREF; offset=L1C1:L1C4;0x0:0x3): invalid IRI: missing scheme`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			r, err := NewDecoder(strings.NewReader(tc.InputString), DecoderConfig{}.
+				SetCaptureTextOffsets(true).
+				SetIRIMapper(tc.Mapper),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			buf := &bytes.Buffer{}
+
+			e, err := ntriples.NewEncoder(buf)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for r.Next() {
+				if err := e.AddTriple(context.Background(), r.Triple()); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if err := r.Err(); err != nil {
+				if len(tc.OutputError) == 0 || err.Error() != tc.OutputError {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.OutputError) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if err := e.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if _e, _a := tc.OutputNTriples, buf.String(); _e != _a {
+				t.Errorf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
@@ -39,11 +39,32 @@ type Encoder struct {
 
 	baseDirectiveMode   DirectiveMode
 	prefixDirectiveMode DirectiveMode
+
+	// autoPrefixes is non-nil when prefixes are created for discovered namespaces; autoPrefixesWritten is the number
+	// of its prefixes which have been written as directives when unbuffered.
+	autoPrefixes        *iriutil.AutoPrefixMapper
+	autoPrefixesWritten int
+
+	// annotations is true when rdf:reifies statements may be written with reifier and annotation syntax; pending is
+	// then the most recent triple which has not yet been terminated so that subsequent statements about it may be
+	// included.
+	annotations bool
+	pending     *pendingTriple
+}
+
+type pendingTriple struct {
+	buf            *bytes.Buffer
+	triple         rdf.Triple
+	reifier        rdf.SubjectValue
+	annotationOpen bool
 }
 
 var _ encoding.TriplesEncoder = &Encoder{}
 var _ rdfdescriptionutil.ResourceEncoder = &Encoder{}
 
+// NewEncoder creates an encoder which writes to w. Unless buffered, each triple is written once it is added. When
+// annotations are enabled (see [EncoderConfig.SetAnnotations]), the most recent triple is held back until the next
+// statement or Close, so Close must be called to write it.
 func NewEncoder(w io.Writer, opts ...EncoderOption) (*Encoder, error) {
 	compiledOpts := EncoderConfig{}
 
@@ -72,6 +93,10 @@ func (w *Encoder) Close() error {
 		return w.err
 	}
 
+	if err := w.flushPending(); err != nil {
+		return err
+	}
+
 	if w.buffered && len(w.bufferedSections) > 0 {
 		if w.bufferedSort {
 			slices.SortFunc(w.bufferedSections, func(i, j []byte) int {
@@ -142,6 +167,10 @@ func (w *Encoder) AddResource(ctx context.Context, r rdfdescription.Resource) er
 		return nil
 	}
 
+	if err := w.flushPending(); err != nil {
+		return err
+	}
+
 	buf := &bytes.Buffer{}
 
 	if subject == nil {
@@ -163,6 +192,11 @@ func (w *Encoder) AddResource(ctx context.Context, r rdfdescription.Resource) er
 	if w.buffered {
 		w.bufferedSections = append(w.bufferedSections, buf.Bytes())
 	} else {
+		err = w.writeAutoPrefixDirectives()
+		if err != nil {
+			return err
+		}
+
 		_, err = buf.WriteTo(w.w)
 		if err != nil {
 			return fmt.Errorf("write: %v", err)
@@ -297,6 +331,53 @@ func (w *Encoder) AddTriple(ctx context.Context, t rdf.Triple) error {
 		return w.err
 	}
 
+	if w.pending != nil {
+		if tripleTerm, ok := t.Object.(rdf.TripleTerm); ok && t.Predicate == rdfiri.Reifies_Property && tripleTerm.TermEquals(rdf.TripleTerm(w.pending.triple)) {
+			if w.pending.annotationOpen {
+				w.pending.buf.WriteString(" |}")
+				w.pending.annotationOpen = false
+			}
+
+			w.pending.buf.WriteString(" ~ ")
+
+			err := w.writeSubjectValue(w.pending.buf, t.Subject)
+			if err != nil {
+				return fmt.Errorf("subject: %v", err)
+			}
+
+			w.pending.reifier = t.Subject
+
+			return nil
+		} else if w.pending.reifier != nil && t.Predicate != rdfiri.Reifies_Property && t.Subject.TermEquals(w.pending.reifier) {
+			if w.pending.annotationOpen {
+				w.pending.buf.WriteString(" ;")
+			} else {
+				w.pending.buf.WriteString(" {|")
+				w.pending.annotationOpen = true
+			}
+
+			w.pending.buf.WriteString(" ")
+
+			err := w.writePredicateValue(w.pending.buf, t.Predicate)
+			if err != nil {
+				return fmt.Errorf("predicate: %v", err)
+			}
+
+			w.pending.buf.WriteString(" ")
+
+			err = w.writeObjectValue(w.pending.buf, t.Object)
+			if err != nil {
+				return fmt.Errorf("object: %v", err)
+			}
+
+			return nil
+		}
+
+		if err := w.flushPending(); err != nil {
+			return err
+		}
+	}
+
 	buf := &bytes.Buffer{}
 
 	err := w.writeSubjectValue(buf, t.Subject)
@@ -306,26 +387,61 @@ func (w *Encoder) AddTriple(ctx context.Context, t rdf.Triple) error {
 
 	buf.WriteString(" ")
 
-	switch p := t.Predicate.(type) {
-	case rdf.IRI:
-		if p == rdfiri.Type_Property {
-			buf.WriteString("a")
-		} else {
-			w.writeIRI(buf, string(p))
-		}
-	default:
-		return fmt.Errorf("predicate: invalid type: %T", p)
+	err = w.writePredicateValue(buf, t.Predicate)
+	if err != nil {
+		return fmt.Errorf("predicate: %v", err)
 	}
 
 	buf.WriteString(" ")
 
-	w.writeObjectValue(buf, t.Object)
+	err = w.writeObjectValue(buf, t.Object)
+	if err != nil {
+		return fmt.Errorf("object: %v", err)
+	}
+
+	if !w.annotations {
+		buf.WriteString(" .\n")
+
+		return w.writeStatement(buf)
+	}
+
+	// the statement is terminated once it is known no annotations follow
+	w.pending = &pendingTriple{
+		buf:    buf,
+		triple: t,
+	}
+
+	return nil
+}
+
+func (w *Encoder) flushPending() error {
+	if w.pending == nil {
+		return nil
+	}
+
+	buf := w.pending.buf
+
+	if w.pending.annotationOpen {
+		buf.WriteString(" |}")
+	}
 
 	buf.WriteString(" .\n")
 
+	w.pending = nil
+
+	return w.writeStatement(buf)
+}
+
+// writeStatement writes or buffers a terminated statement.
+func (w *Encoder) writeStatement(buf *bytes.Buffer) error {
 	if w.buffered {
 		w.bufferedSections = append(w.bufferedSections, buf.Bytes())
 	} else {
+		err := w.writeAutoPrefixDirectives()
+		if err != nil {
+			return err
+		}
+
 		_, err = buf.WriteTo(w.w)
 		if err != nil {
 			return fmt.Errorf("write: %v", err)
@@ -335,6 +451,30 @@ func (w *Encoder) AddTriple(ctx context.Context, t rdf.Triple) error {
 	return nil
 }
 
+// writeAutoPrefixDirectives writes any prefixes which were created since the last statement was written.
+func (w *Encoder) writeAutoPrefixDirectives() error {
+	if w.autoPrefixes == nil {
+		return nil
+	}
+
+	created := w.autoPrefixes.GetPrefixMappings()
+	if len(created) == w.autoPrefixesWritten {
+		return nil
+	}
+
+	_, err := WriteDirectives(w.w, WriteDirectivesOptions{
+		Prefixes:   created[w.autoPrefixesWritten:],
+		PrefixMode: w.prefixDirectiveMode,
+	})
+	if err != nil {
+		return fmt.Errorf("write prefixes: %v", err)
+	}
+
+	w.autoPrefixesWritten = len(created)
+
+	return nil
+}
+
 func (w *Encoder) writeSubjectValue(buf *bytes.Buffer, v rdf.SubjectValue) error {
 	switch s := v.(type) {
 	case rdf.BlankNode:
@@ -350,6 +490,21 @@ func (w *Encoder) writeSubjectValue(buf *bytes.Buffer, v rdf.SubjectValue) error
 	return fmt.Errorf("invalid type: %T", v)
 }
 
+func (w *Encoder) writePredicateValue(buf *bytes.Buffer, v rdf.PredicateValue) error {
+	switch p := v.(type) {
+	case rdf.IRI:
+		if p == rdfiri.Type_Property {
+			buf.WriteString("a")
+		} else {
+			w.writeIRI(buf, string(p))
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("invalid type: %T", v)
+}
+
 func (w *Encoder) writeIRI(buffered *bytes.Buffer, v string) {
 	pr, ok := w.prefixes.CompactPrefix(v)
 	if ok {
@@ -398,6 +553,14 @@ func (e *Encoder) writeObjectValue(w *bytes.Buffer, v rdf.ObjectValue) error {
 				fmt.Fprintf(w, "@%s", langTag.Language)
 			}
 
+			return nil
+		case rdfiri.DirLangString_Datatype:
+			w.WriteString(formatLiteralLexicalForm(literal.LexicalForm, false))
+
+			if langTag, ok := literal.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+				fmt.Fprintf(w, "@%s--%s", langTag.Language, langTag.BaseDirection)
+			}
+
 			return nil
 		}
 
@@ -408,6 +571,28 @@ func (e *Encoder) writeObjectValue(w *bytes.Buffer, v rdf.ObjectValue) error {
 			e.writeIRI(w, string(literal.Datatype))
 		}
 
+		return nil
+	case rdf.TripleTerm:
+		w.WriteString("<<( ")
+
+		if err := e.writeSubjectValue(w, o.Subject); err != nil {
+			return fmt.Errorf("triple term: subject: %v", err)
+		}
+
+		w.WriteString(" ")
+
+		if err := e.writePredicateValue(w, o.Predicate); err != nil {
+			return fmt.Errorf("triple term: predicate: %v", err)
+		}
+
+		w.WriteString(" ")
+
+		if err := e.writeObjectValue(w, o.Object); err != nil {
+			return fmt.Errorf("triple term: object: %v", err)
+		}
+
+		w.WriteString(" )>>")
+
 		return nil
 	}
 
@@ -14,11 +14,15 @@ type EncoderConfig struct {
 	base     *string
 	prefixes iri.PrefixMappingList
 
+	autoPrefixes *bool
+
 	bnStringProvider blanknodes.StringProvider
 
 	buffered     *bool
 	bufferedSort *bool
 
+	annotations *bool
+
 	baseDirectiveMode   *DirectiveMode
 	prefixDirectiveMode *DirectiveMode
 }
@@ -35,12 +39,23 @@ func (s EncoderConfig) SetPrefixes(v iri.PrefixMappingList) EncoderConfig {
 	return s
 }
 
+// SetAutoPrefixes creates prefixes for the namespaces of IRIs which are not covered by the configured prefixes. See
+// [iriutil.AutoPrefixMapper]. When unbuffered, directives for created prefixes are written before the statement which
+// first uses them.
+func (s EncoderConfig) SetAutoPrefixes(v bool) EncoderConfig {
+	s.autoPrefixes = &v
+
+	return s
+}
+
 func (s EncoderConfig) SetBlankNodeStringProvider(v blanknodes.StringProvider) EncoderConfig {
 	s.bnStringProvider = v
 
 	return s
 }
 
+// SetBuffered holds all statements in memory until Close so they may be sorted and written after the directives of
+// all used prefixes. It also enables annotations unless [EncoderConfig.SetAnnotations] is used.
 func (s EncoderConfig) SetBuffered(v bool) EncoderConfig {
 	s.buffered = &v
 
@@ -53,6 +68,15 @@ func (s EncoderConfig) SetBufferedSort(v bool) EncoderConfig {
 	return s
 }
 
+// SetAnnotations writes rdf:reifies statements, and statements about their reifier, which immediately follow the
+// triple they reify using reifier and annotation syntax. When unbuffered, the most recent triple is held back until
+// the next statement or Close. The default is the buffered setting.
+func (s EncoderConfig) SetAnnotations(v bool) EncoderConfig {
+	s.annotations = &v
+
+	return s
+}
+
 func (s EncoderConfig) SetBaseDirectiveMode(v DirectiveMode) EncoderConfig {
 	s.baseDirectiveMode = &v
 
@@ -81,6 +105,10 @@ func (s EncoderConfig) apply(d *EncoderConfig) {
 		d.prefixes = s.prefixes
 	}
 
+	if s.autoPrefixes != nil {
+		d.autoPrefixes = s.autoPrefixes
+	}
+
 	if s.bnStringProvider != nil {
 		d.bnStringProvider = s.bnStringProvider
 	}
@@ -93,6 +121,10 @@ func (s EncoderConfig) apply(d *EncoderConfig) {
 		d.bufferedSort = s.bufferedSort
 	}
 
+	if s.annotations != nil {
+		d.annotations = s.annotations
+	}
+
 	if s.baseDirectiveMode != nil {
 		d.baseDirectiveMode = s.baseDirectiveMode
 	}
@@ -107,7 +139,6 @@ func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
 
 	e := &Encoder{
 		w:                   w,
-		prefixes:            iriutil.NewUsagePrefixMapper(prefixManager),
 		bnStringProvider:    s.bnStringProvider,
 		baseDirectiveMode:   DirectiveMode_At,
 		prefixDirectiveMode: DirectiveMode_At,
@@ -122,6 +153,24 @@ func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
 		e.base = baseIRI
 	}
 
+	if s.autoPrefixes != nil && *s.autoPrefixes {
+		var autoOptions iriutil.AutoPrefixMapperOptions
+
+		if e.base != nil {
+			// prefer relative references
+			autoOptions.Exclude = func(v string) bool {
+				_, ok := e.base.RelativizeIRI(v)
+
+				return ok
+			}
+		}
+
+		e.autoPrefixes = iriutil.NewAutoPrefixMapper(prefixManager, autoOptions)
+		e.prefixes = iriutil.NewUsagePrefixMapper(e.autoPrefixes)
+	} else {
+		e.prefixes = iriutil.NewUsagePrefixMapper(prefixManager)
+	}
+
 	if s.buffered != nil && *s.buffered {
 		e.buffered = *s.buffered
 		e.bufferedSort = e.buffered
@@ -131,6 +180,12 @@ func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
 		e.bufferedSort = *s.bufferedSort
 	}
 
+	if s.annotations != nil {
+		e.annotations = *s.annotations
+	} else {
+		e.annotations = e.buffered
+	}
+
 	if e.bnStringProvider == nil {
 		e.bnStringProvider = blanknodes.NewInt64StringProvider("b%d")
 	}
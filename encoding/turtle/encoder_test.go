@@ -114,6 +114,80 @@ ex:subject a <http://www.w3.org/1999/02/22-rdf-syntax-ns#Property> .
 	}
 }
 
+func TestEncoder_Buffered_AutoPrefixes(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetBuffered(true).
+		SetBase("http://example.com/path/").
+		SetAutoPrefixes(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/path/subject"),
+		Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+		Object:    rdf.IRI("http://example.org/vocab#Thing"),
+	})
+
+	err = e.Close()
+
+	if _a, _e := buf.String(), `@base <http://example.com/path/> .
+@prefix foaf: <http://xmlns.com/foaf/0.1/> .
+@prefix vocab: <http://example.org/vocab#> .
+
+<subject> foaf:name vocab:Thing .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_Unbuffered_AutoPrefixes(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetPrefixes(iri.PrefixMappingList{
+			{
+				Prefix:   "ex",
+				Expanded: "http://example.com/path/",
+			},
+		}).
+		SetAutoPrefixes(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/path/subject"),
+		Predicate: rdfiri.Type_Property,
+		Object:    rdfiri.Property_Class,
+	})
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/path/subject"),
+		Predicate: rdf.IRI("http://xmlns.com/foaf/0.1/name"),
+		Object:    rdf.IRI("http://example.org/vocab#Thing"),
+	})
+
+	err = e.Close()
+
+	if _a, _e := buf.String(), `@prefix ex: <http://example.com/path/> .
+
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+ex:subject a rdf:Property .
+@prefix foaf: <http://xmlns.com/foaf/0.1/> .
+@prefix vocab: <http://example.org/vocab#> .
+ex:subject foaf:name vocab:Thing .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
 func TestEncoder_Prefix_LocalNameEscapes(t *testing.T) {
 	ctx := t.Context()
 
@@ -410,3 +484,203 @@ schema:weight_Property sh:property [
 		t.Fatalf("expected %q, got %q", _e, _a)
 	}
 }
+
+func TestEncoder_TripleTerm(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetPrefixes(iri.PrefixMappingList{
+			{
+				Prefix:   "ex",
+				Expanded: "http://example.com/",
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object: rdf.TripleTerm{
+			Subject:   rdf.IRI("http://example.com/s2"),
+			Predicate: rdfiri.Type_Property,
+			Object:    rdf.IRI("http://example.com/o2"),
+		},
+	})
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `@prefix ex: <http://example.com/> .
+
+ex:s ex:p <<( ex:s2 a ex:o2 )>> .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_DirLangString(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetPrefixes(iri.PrefixMappingList{
+			{
+				Prefix:   "ex",
+				Expanded: "http://example.com/",
+			},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	e.AddTriple(ctx, rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object: rdf.Literal{
+			Datatype:    rdfiri.DirLangString_Datatype,
+			LexicalForm: "مرحبا",
+			Tag: rdf.DirectionalLanguageLiteralTag{
+				Language:      "ar",
+				BaseDirection: "rtl",
+			},
+		},
+	})
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `@prefix ex: <http://example.com/> .
+
+ex:s ex:p "مرحبا"@ar--rtl .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_Annotation(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf, EncoderConfig{}.
+		SetPrefixes(iri.PrefixMappingList{
+			{
+				Prefix:   "ex",
+				Expanded: "http://example.com/",
+			},
+		}).
+		SetAnnotations(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	asserted := rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object:    rdf.IRI("http://example.com/o"),
+	}
+
+	for _, triple := range []rdf.Triple{
+		asserted,
+		{
+			Subject:   rdf.IRI("http://example.com/r1"),
+			Predicate: rdfiri.Reifies_Property,
+			Object:    rdf.TripleTerm(asserted),
+		},
+		{
+			Subject:   rdf.IRI("http://example.com/r1"),
+			Predicate: rdf.IRI("http://example.com/source"),
+			Object:    rdf.IRI("http://example.com/a"),
+		},
+		{
+			Subject:   rdf.IRI("http://example.com/r1"),
+			Predicate: rdf.IRI("http://example.com/source"),
+			Object:    rdf.IRI("http://example.com/b"),
+		},
+		{
+			Subject:   rdf.IRI("http://example.com/r2"),
+			Predicate: rdfiri.Reifies_Property,
+			Object:    rdf.TripleTerm(asserted),
+		},
+		{
+			Subject:   rdf.IRI("http://example.com/r3"),
+			Predicate: rdfiri.Reifies_Property,
+			Object:    rdf.TripleTerm(asserted),
+		},
+		{
+			Subject:   rdf.IRI("http://example.com/r3"),
+			Predicate: rdf.IRI("http://example.com/source"),
+			Object:    rdf.IRI("http://example.com/c"),
+		},
+		{
+			Subject:   rdf.IRI("http://example.com/r3"),
+			Predicate: rdfiri.Reifies_Property,
+			Object: rdf.TripleTerm{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    rdf.IRI("http://example.com/other"),
+			},
+		},
+	} {
+		if err := e.AddTriple(ctx, triple); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `@prefix ex: <http://example.com/> .
+
+ex:s ex:p ex:o ~ ex:r1 {| ex:source ex:a ; ex:source ex:b |} ~ ex:r2 ~ ex:r3 {| ex:source ex:c |} .
+ex:r3 <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( ex:s ex:p ex:other )>> .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_UnbufferedWithoutClose(t *testing.T) {
+	ctx := t.Context()
+
+	buf := &bytes.Buffer{}
+	e, err := NewEncoder(buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	asserted := rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/s"),
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object:    rdf.IRI("http://example.com/o"),
+	}
+
+	for _, triple := range []rdf.Triple{
+		asserted,
+		{
+			Subject:   rdf.IRI("http://example.com/r1"),
+			Predicate: rdfiri.Reifies_Property,
+			Object:    rdf.TripleTerm(asserted),
+		},
+	} {
+		if err := e.AddTriple(ctx, triple); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if _a, _e := buf.String(), `<http://example.com/s> <http://example.com/p> <http://example.com/o> .
+<http://example.com/r1> <http://www.w3.org/1999/02/22-rdf-syntax-ns#reifies> <<( <http://example.com/s> <http://example.com/p> <http://example.com/o> )>> .
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
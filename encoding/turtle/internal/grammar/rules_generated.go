@@ -13,7 +13,7 @@ const (
 	// R_statement ::= directive | ( triples L_PERIOD )
 	R_statement R = iota
 
-	// R_directive ::= prefixID | base | sparqlPrefix | sparqlBase
+	// R_directive ::= prefixID | base | version | sparqlPrefix | sparqlBase | sparqlVersion
 	R_directive R = iota
 
 	// R_prefixID ::= '@prefix' PNAME_NS IRIREF L_PERIOD
@@ -22,19 +22,28 @@ const (
 	// R_base ::= '@base' IRIREF L_PERIOD
 	R_base R = iota
 
+	// R_version ::= '@version' VersionSpecifier L_PERIOD
+	R_version
+
 	// R_sparqlBase ::= ~'BASE' IRIREF
 	R_sparqlBase R = iota
 
 	// R_sparqlPrefix ::= ~'PREFIX' PNAME_NS IRIREF
 	R_sparqlPrefix R = iota
 
-	// R_triples ::= ( subject predicateObjectList ) | ( blankNodePropertyList predicateObjectList? )
+	// R_sparqlVersion ::= ~'VERSION' VersionSpecifier
+	R_sparqlVersion
+
+	// R_VersionSpecifier ::= STRING_LITERAL_QUOTE | STRING_LITERAL_SINGLE_QUOTE
+	R_VersionSpecifier
+
+	// R_triples ::= ( subject predicateObjectList ) | ( blankNodePropertyList predicateObjectList? ) | ( reifiedTriple predicateObjectList? )
 	R_triples R = iota
 
 	// R_predicateObjectList ::= verb objectList ( ( L_SEMICOLON ( ( verb objectList )? ) )* )
 	R_predicateObjectList R = iota
 
-	// R_objectList ::= object ( ( L_COMMA object )* )
+	// R_objectList ::= object annotation ( ( L_COMMA object annotation )* )
 	R_objectList R = iota
 
 	// R_verb ::= predicate | 'a'
@@ -46,7 +55,7 @@ const (
 	// R_predicate ::= iri
 	R_predicate R = iota
 
-	// R_object ::= iri | BlankNode | collection | blankNodePropertyList | literal
+	// R_object ::= iri | BlankNode | collection | blankNodePropertyList | literal | tripleTerm | reifiedTriple
 	R_object R = iota
 
 	// R_literal ::= RDFLiteral | NumericLiteral | BooleanLiteral
@@ -58,6 +67,33 @@ const (
 	// R_collection ::= L_OPEN_PAREN object* L_CLOSE_PAREN
 	R_collection R = iota
 
+	// R_reifiedTriple ::= '<<' rtSubject verb rtObject reifier? '>>'
+	R_reifiedTriple
+
+	// R_rtSubject ::= iri | BlankNode | reifiedTriple
+	R_rtSubject
+
+	// R_rtObject ::= iri | BlankNode | literal | tripleTerm | reifiedTriple
+	R_rtObject
+
+	// R_tripleTerm ::= '<<(' ttSubject verb ttObject ')>>'
+	R_tripleTerm
+
+	// R_ttSubject ::= iri | BlankNode
+	R_ttSubject
+
+	// R_ttObject ::= iri | BlankNode | literal | tripleTerm
+	R_ttObject
+
+	// R_annotation ::= ( reifier | annotationBlock )*
+	R_annotation
+
+	// R_annotationBlock ::= '{|' predicateObjectList '|}'
+	R_annotationBlock
+
+	// R_reifier ::= '~' ( ( iri | BlankNode )? )
+	R_reifier
+
 	// R_NumericLiteral ::= INTEGER | DECIMAL | DOUBLE
 	R_NumericLiteral R = iota
 
@@ -91,7 +127,7 @@ const (
 	// R_BLANK_NODE_LABEL ::= '_:' ( PN_CHARS_U | [0-9] ) ( ( ( ( PN_CHARS | L_PERIOD )* ) PN_CHARS )? )
 	R_BLANK_NODE_LABEL R = iota
 
-	// R_LANGTAG ::= '@' ( ( [a-z] | [A-Z] )+ ) ( ( '-' ( ( [a-z] | [A-Z] | [0-9] )+ ) )* )
+	// R_LANGTAG ::= '@' ( ( [a-z] | [A-Z] )+ ) ( ( '-' ( ( [a-z] | [A-Z] | [0-9] )+ ) )* ) ( ( '--' ( ( [a-z] | [A-Z] )+ ) )? )
 	R_LANGTAG R = iota
 
 	// R_INTEGER ::= [+-]? [0-9]+
@@ -209,10 +245,16 @@ func (r R) String() string {
 		return "prefixID"
 	case R_base:
 		return "base"
+	case R_version:
+		return "version"
 	case R_sparqlBase:
 		return "sparqlBase"
 	case R_sparqlPrefix:
 		return "sparqlPrefix"
+	case R_sparqlVersion:
+		return "sparqlVersion"
+	case R_VersionSpecifier:
+		return "VersionSpecifier"
 	case R_triples:
 		return "triples"
 	case R_predicateObjectList:
@@ -233,6 +275,24 @@ func (r R) String() string {
 		return "blankNodePropertyList"
 	case R_collection:
 		return "collection"
+	case R_reifiedTriple:
+		return "reifiedTriple"
+	case R_rtSubject:
+		return "rtSubject"
+	case R_rtObject:
+		return "rtObject"
+	case R_tripleTerm:
+		return "tripleTerm"
+	case R_ttSubject:
+		return "ttSubject"
+	case R_ttObject:
+		return "ttObject"
+	case R_annotation:
+		return "annotation"
+	case R_annotationBlock:
+		return "annotationBlock"
+	case R_reifier:
+		return "reifier"
 	case R_NumericLiteral:
 		return "NumericLiteral"
 	case R_RDFLiteral:
@@ -74,12 +74,26 @@ func (tf *termFormatter) FormatTerm(t rdf.Term) string {
 				sb.WriteString("@")
 				sb.WriteString(langTag.Language)
 			}
+		} else if t.Datatype == rdfiri.DirLangString_Datatype {
+			if langTag, ok := t.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+				sb.WriteString("@")
+				sb.WriteString(langTag.Language)
+				sb.WriteString("--")
+				sb.WriteString(langTag.BaseDirection)
+			}
 		} else {
 			sb.WriteString("^^")
 			sb.WriteString(tf.FormatTerm(t.Datatype))
 		}
 
 		return sb.String()
+	case rdf.TripleTerm:
+		predicate := tf.FormatTerm(t.Predicate)
+		if t.Predicate == rdfiri.Type_Property {
+			predicate = "a"
+		}
+
+		return "<<( " + tf.FormatTerm(t.Subject) + " " + predicate + " " + tf.FormatTerm(t.Object) + " )>>"
 	}
 
 	panic(fmt.Errorf("unknown term type %T", t))
@@ -55,14 +55,20 @@ func (e encoder) NewEncoder(ww rdfiotypes.Writer, opts rdfiotypes.EncoderOptions
 
 	{
 		var prefixes iri.PrefixMappingList
+		var autoPrefixes bool
 
 		for _, prefix := range params.IrisUsePrefixes {
 			if prefix == "rdfa-context" {
 				prefixes = rdfacontext.AppendWidelyUsedInitialContext(prefixes)
 
+				continue
+			} else if prefix == "auto" {
+				autoPrefixes = true
+
 				continue
 			} else if prefix == "none" {
 				prefixes = nil
+				autoPrefixes = false
 
 				continue
 			}
@@ -81,6 +87,10 @@ func (e encoder) NewEncoder(ww rdfiotypes.Writer, opts rdfiotypes.EncoderOptions
 		if len(prefixes) > 0 {
 			options = options.SetPrefixes(prefixes)
 		}
+
+		if autoPrefixes {
+			options = options.SetAutoPrefixes(true)
+		}
 	}
 
 	allOptions, err := rdfiotypes.PatchGenericOptions([]turtle.EncoderOption{options}, opts.Patcher)
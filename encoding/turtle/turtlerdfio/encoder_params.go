@@ -24,7 +24,7 @@ func (f *encoderParams) NewParamsCollection() rdfiotypes.ParamsCollection {
 			Usage: "Prefer IRIs relative to the resource IRI",
 		}),
 		"iris.usePrefix": kvref.StringList(&f.IrisUsePrefixes, rdfiotypes.ParamMeta{
-			Usage: "Prefer IRIs using a prefix. Use the syntax of \"{prefix}:{iri}\", \"rdfa-context\", or \"none\"",
+			Usage: "Prefer IRIs using a prefix. Use the syntax of \"{prefix}:{iri}\", \"rdfa-context\", \"auto\" (discover namespaces of written IRIs), or \"none\"",
 		}),
 		"resources": kvref.BoolPtr(&f.Resources, rdfiotypes.ParamMeta{
 			Usage: "Write nested statements and resource descriptions (implies buffered=true)",
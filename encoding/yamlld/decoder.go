@@ -0,0 +1,113 @@
+package yamlld
+
+import (
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld/yamlldcontent"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+type DecoderOption interface {
+	apply(s *DecoderConfig)
+	newDecoder(r io.Reader) (*Decoder, error)
+}
+
+// Decoder converts YAML-LD documents to the internal representation of JSON and decodes them with [jsonld.Decoder].
+// A stream of multiple YAML documents is decoded as a top-level array of them.
+type Decoder struct {
+	r io.Reader
+
+	captureTextOffsets bool
+	decoderOptions     []jsonld.DecoderOption
+
+	decoder *jsonld.Decoder
+
+	err error
+}
+
+var _ encoding.QuadsDecoder = &Decoder{}
+var _ encoding.StatementTextOffsetsProvider = &Decoder{}
+
+func NewDecoder(r io.Reader, opts ...DecoderOption) (*Decoder, error) {
+	compiledOpts := DecoderConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&compiledOpts)
+	}
+
+	return compiledOpts.newDecoder(r)
+}
+
+func (r *Decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return yamlldcontent.TypeIdentifier
+}
+
+func (r *Decoder) Close() error {
+	return nil
+}
+
+func (r *Decoder) Err() error {
+	return r.err
+}
+
+func (r *Decoder) Next() bool {
+	if r.err != nil {
+		return false
+	} else if r.decoder == nil {
+		r.err = r.parseRoot()
+		if r.err != nil {
+			return false
+		}
+	}
+
+	if r.decoder.Next() {
+		return true
+	}
+
+	r.err = r.decoder.Err()
+
+	return false
+}
+
+func (r *Decoder) Quad() rdf.Quad {
+	return r.decoder.Quad()
+}
+
+func (r *Decoder) Statement() rdf.Statement {
+	return r.decoder.Statement()
+}
+
+func (r *Decoder) StatementTextOffsets() encoding.StatementTextOffsets {
+	return r.decoder.StatementTextOffsets()
+}
+
+func (r *Decoder) parseRoot() error {
+	buf, err := io.ReadAll(r.r)
+	if err != nil {
+		return fmt.Errorf("read: %w", err)
+	}
+
+	value, err := parseStream(buf, r.captureTextOffsets)
+	if err != nil {
+		return fmt.Errorf("parse: %w", err)
+	}
+
+	r.decoder, err = jsonld.NewValueDecoder(
+		value,
+		slices.Concat(
+			r.decoderOptions,
+			[]jsonld.DecoderOption{
+				jsonld.DecoderConfig{}.SetCaptureTextOffsets(r.captureTextOffsets),
+			},
+		)...,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package yamlld
+
+import (
+	"io"
+	"slices"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+)
+
+type DecoderConfig struct {
+	captureTextOffsets *bool
+	decoderOptions     []jsonld.DecoderOption
+}
+
+var _ DecoderOption = DecoderConfig{}
+
+// SetCaptureTextOffsets maps statement properties to the line and column offsets of their YAML nodes.
+func (b DecoderConfig) SetCaptureTextOffsets(v bool) DecoderConfig {
+	b.captureTextOffsets = &v
+
+	return b
+}
+
+// SetDecoderOptions configures the JSON-LD processing of the documents, such as the base and document loader. Parser
+// and streaming options of the JSON-LD decoder do not apply.
+func (b DecoderConfig) SetDecoderOptions(v ...jsonld.DecoderOption) DecoderConfig {
+	b.decoderOptions = v
+
+	return b
+}
+
+func (b DecoderConfig) AddDecoderOptions(v ...jsonld.DecoderOption) DecoderConfig {
+	b.decoderOptions = slices.Concat(b.decoderOptions, v)
+
+	return b
+}
+
+func (b DecoderConfig) apply(s *DecoderConfig) {
+	if b.captureTextOffsets != nil {
+		s.captureTextOffsets = b.captureTextOffsets
+	}
+
+	if b.decoderOptions != nil {
+		s.decoderOptions = append(s.decoderOptions, b.decoderOptions...)
+	}
+}
+
+func (b DecoderConfig) newDecoder(r io.Reader) (*Decoder, error) {
+	d := &Decoder{
+		r:              r,
+		decoderOptions: b.decoderOptions,
+	}
+
+	if b.captureTextOffsets != nil {
+		d.captureTextOffsets = *b.captureTextOffsets
+	}
+
+	return d, nil
+}
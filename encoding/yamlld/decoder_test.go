@@ -0,0 +1,192 @@
+package yamlld
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+)
+
+func TestDecoder(t *testing.T) {
+	statements, err := quads.CollectErr(NewDecoder(strings.NewReader(`%YAML 1.2
+---
+"@context":
+  "@vocab": http://schema.org/
+  id: "@id"
+id: http://example.com/a
+name: &name Alice
+alternateName: *name
+birthDate: 2000-01-01
+description: |
+  multi
+  line
+---
+"@context": {"@vocab": "http://schema.org/"}
+"@id": http://example.com/b
+age: 30
+`)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://schema.org/alternateName"),
+				Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "Alice"},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://schema.org/birthDate"),
+				Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "2000-01-01"},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://schema.org/description"),
+				Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "multi\nline\n"},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://schema.org/name"),
+				Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "Alice"},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/b"),
+				Predicate: rdf.IRI("http://schema.org/age"),
+				Object:    rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "30"},
+			},
+		},
+	}
+
+	if _a, _e := len(statements), len(expected); _a != _e {
+		t.Fatalf("expected %d statements, got %d: %v", _e, _a, statements)
+	}
+
+	for idx, quad := range statements {
+		if _a, _e := quad, expected[idx]; _a != _e {
+			t.Fatalf("statement %d: expected %v, got %v", idx, _e, _a)
+		}
+	}
+}
+
+func TestDecoder_TextOffsets(t *testing.T) {
+	s, err := NewDecoder(
+		strings.NewReader(`"@context": {"@vocab": "http://schema.org/"}
+"@id": http://example.com/a
+knows:
+  - "@id": http://example.com/b
+    name: 'Bob ''B'''
+`),
+		DecoderConfig{}.SetCaptureTextOffsets(true),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var offsets encoding.StatementTextOffsets
+
+	for s.Next() {
+		if s.Quad().Triple.Predicate == rdf.IRI("http://schema.org/name") {
+			offsets = s.StatementTextOffsets()
+		}
+	}
+
+	if err := s.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if offsets == nil {
+		t.Fatalf("expected statement")
+	}
+
+	for _, tc := range []struct {
+		Type     encoding.StatementOffsetsType
+		Expected string
+	}{
+		{
+			Type:     encoding.SubjectStatementOffsets,
+			Expected: "L4C12:L4C32;0x5b:0x6f",
+		},
+		{
+			Type:     encoding.PredicateStatementOffsets,
+			Expected: "L5C5:L5C9;0x74:0x78",
+		},
+		{
+			Type:     encoding.ObjectStatementOffsets,
+			Expected: "L5C11:L5C22;0x7a:0x85",
+		},
+	} {
+		t.Run(encoding.StatementOffsetsTypeName(tc.Type), func(t *testing.T) {
+			offset, ok := offsets[tc.Type]
+			if !ok {
+				t.Fatalf("expected offsets")
+			}
+
+			if _a, _e := offset.OffsetRangeString(), tc.Expected; _a != _e {
+				t.Fatalf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestDecoder_Errors(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "CustomTag",
+			Input:    "\"@id\": !custom http://example.com/a\n",
+			Expected: "parse: line 1 column 8: unsupported tag for scalar: !custom",
+		},
+		{
+			Name:     "Infinity",
+			Input:    "http://example.com/p: .inf\n",
+			Expected: "parse: line 1 column 23: unsupported number: .inf",
+		},
+		{
+			Name:     "MappingKey",
+			Input:    "? [a]\n: b\n",
+			Expected: "parse: line 1 column 3: unsupported key: expected scalar",
+		},
+		{
+			Name:     "AliasExpansion",
+			Input:    buildAliasExpansionInput(),
+			Expected: "parse: aliases exceed the allowed expansion of the document",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := quads.CollectErr(NewDecoder(strings.NewReader(tc.Input)))
+			if err == nil {
+				t.Fatalf("expected error")
+			} else if _a, _e := err.Error(), tc.Expected; _a != _e {
+				t.Fatalf("expected %q, got %q", _e, _a)
+			}
+		})
+	}
+}
+
+// buildAliasExpansionInput nests aliases which expand to 10^6 values.
+func buildAliasExpansionInput() string {
+	var sb strings.Builder
+
+	sb.WriteString("a0: &a0 [" + strings.Repeat("x, ", 9) + "x]\n")
+
+	for i := 1; i < 6; i++ {
+		fmt.Fprintf(&sb, "a%d: &a%d [%s*a%d]\n", i, i, strings.Repeat(fmt.Sprintf("*a%d, ", i-1), 9), i-1)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,500 @@
+package yamlld
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/dpb587/cursorio-go/cursorio"
+	"github.com/dpb587/inspectjson-go/inspectjson"
+	"go.yaml.in/yaml/v3"
+)
+
+// aliasExpansionMinimum is the number of values which may always be created through aliases. Beyond it, aliases may
+// create at most aliasExpansionRatio times the number of values in the document, which protects against documents
+// exponentially expanding in size.
+const (
+	aliasExpansionMinimum = 10000
+	aliasExpansionRatio   = 10
+)
+
+var reYAMLDirective12 = regexp.MustCompile(`(?m)^%YAML[ \t]+1\.2([ \t]|$)`)
+
+// parseStream converts every document of a YAML stream to the internal representation of JSON. Multiple documents
+// result in an array of them.
+func parseStream(buf []byte, captureTextOffsets bool) (inspectjson.Value, error) {
+	c := &valueConverter{
+		anchors: map[*yaml.Node]*anchorValue{},
+	}
+
+	if captureTextOffsets {
+		c.source = newTextSource(buf)
+		c.untils = map[*yaml.Node]int{}
+	}
+
+	var documents []inspectjson.Value
+
+	// [dpb] YAML-LD requires YAML 1.2, but the parser rejects any version directive other than 1.1; the documents are
+	// still parsed with the JSON schema below, and rewriting it in-place keeps the byte offsets of the text
+
+	yd := yaml.NewDecoder(bytes.NewReader(reYAMLDirective12.ReplaceAllFunc(buf, func(v []byte) []byte {
+		return bytes.Replace(v, []byte("1.2"), []byte("1.1"), 1)
+	})))
+
+	for {
+		var node yaml.Node
+
+		err := yd.Decode(&node)
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+
+			return nil, err
+		}
+
+		value, _, err := c.convert(&node)
+		if err != nil {
+			return nil, err
+		} else if value == nil {
+			continue
+		}
+
+		documents = append(documents, value)
+	}
+
+	if c.aliasValues > aliasExpansionMinimum && c.aliasValues > aliasExpansionRatio*c.documentValues {
+		return nil, fmt.Errorf("aliases exceed the allowed expansion of the document")
+	}
+
+	switch len(documents) {
+	case 0:
+		return nil, nil
+	case 1:
+		return documents[0], nil
+	}
+
+	array := inspectjson.ArrayValue{
+		Values: documents,
+	}
+
+	if c.source != nil {
+		array.BeginToken.SourceOffsets = c.source.emptyRange(documents[0].GetSourceOffsets().From)
+		array.EndToken.SourceOffsets = c.source.emptyRange(documents[len(documents)-1].GetSourceOffsets().Until)
+	}
+
+	return array, nil
+}
+
+type anchorValue struct {
+	value  inspectjson.Value
+	size   int
+	active bool
+}
+
+type valueConverter struct {
+	source  *textSource
+	untils  map[*yaml.Node]int
+	anchors map[*yaml.Node]*anchorValue
+
+	documentValues int
+	aliasValues    int
+}
+
+// convert returns the value of the node and the number of values it contains.
+func (c *valueConverter) convert(node *yaml.Node) (inspectjson.Value, int, error) {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, 0, nil
+		}
+
+		return c.convert(node.Content[0])
+	case yaml.AliasNode:
+		anchor, ok := c.anchors[node.Alias]
+		if !ok {
+			return nil, 0, c.newNodeError(node, fmt.Errorf("unknown anchor: %s", node.Value))
+		} else if anchor.active {
+			return nil, 0, c.newNodeError(node, fmt.Errorf("alias of anchor within itself: %s", node.Value))
+		}
+
+		c.aliasValues += anchor.size
+
+		if c.source != nil {
+			// [dpb] the values keep the offsets of the anchored node
+
+			c.untils[node] = c.source.scalarUntil(node, c.source.byteOffset(node.Line, node.Column))
+		}
+
+		return anchor.value, anchor.size, nil
+	}
+
+	var anchor *anchorValue
+
+	if len(node.Anchor) > 0 {
+		anchor = &anchorValue{
+			active: true,
+		}
+
+		c.anchors[node] = anchor
+	}
+
+	value, size, err := c.convertNode(node)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	c.documentValues++
+
+	if anchor != nil {
+		anchor.value = value
+		anchor.size = size
+		anchor.active = false
+	}
+
+	return value, size, nil
+}
+
+func (c *valueConverter) convertNode(node *yaml.Node) (inspectjson.Value, int, error) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		if tag := node.ShortTag(); tag != "!!map" {
+			return nil, 0, c.newNodeError(node, fmt.Errorf("unsupported tag for mapping: %s", tag))
+		}
+
+		value := inspectjson.ObjectValue{
+			Members: map[string]inspectjson.ObjectMember{},
+		}
+
+		size := 1
+
+		for idx := 0; idx+1 < len(node.Content); idx += 2 {
+			keyNode := node.Content[idx]
+			if keyNode.Kind == yaml.AliasNode {
+				keyNode = keyNode.Alias
+			}
+
+			if keyNode.Kind != yaml.ScalarNode {
+				return nil, 0, c.newNodeError(node.Content[idx], errors.New("unsupported key: expected scalar"))
+			}
+
+			memberValue, memberSize, err := c.convert(node.Content[idx+1])
+			if err != nil {
+				return nil, 0, err
+			}
+
+			size += memberSize
+
+			// [spec // 4] keys are always strings in JSON; the content of other scalars is used as-is
+
+			// [dpb] duplicate keys keep the last value, same as the default of inspectjson.Parse
+
+			value.Members[keyNode.Value] = inspectjson.ObjectMember{
+				Name: inspectjson.StringValue{
+					SourceOffsets: c.scalarOffsets(node.Content[idx]),
+					Value:         keyNode.Value,
+				},
+				Value: memberValue,
+			}
+		}
+
+		if c.source != nil {
+			value.BeginToken.SourceOffsets, value.EndToken.SourceOffsets = c.collectionOffsets(node, '{', '}')
+		}
+
+		return value, size, nil
+	case yaml.SequenceNode:
+		if tag := node.ShortTag(); tag != "!!seq" {
+			return nil, 0, c.newNodeError(node, fmt.Errorf("unsupported tag for sequence: %s", tag))
+		}
+
+		value := inspectjson.ArrayValue{}
+
+		size := 1
+
+		for _, itemNode := range node.Content {
+			itemValue, itemSize, err := c.convert(itemNode)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			size += itemSize
+
+			value.Values = append(value.Values, itemValue)
+		}
+
+		if c.source != nil {
+			value.BeginToken.SourceOffsets, value.EndToken.SourceOffsets = c.collectionOffsets(node, '[', ']')
+		}
+
+		return value, size, nil
+	case yaml.ScalarNode:
+		value, err := c.convertScalar(node)
+		if err != nil {
+			return nil, 0, c.newNodeError(node, err)
+		}
+
+		return value, 1, nil
+	}
+
+	return nil, 0, c.newNodeError(node, fmt.Errorf("unsupported node kind: %v", node.Kind))
+}
+
+func (c *valueConverter) convertScalar(node *yaml.Node) (inspectjson.Value, error) {
+	offsets := c.scalarOffsets(node)
+	tag := node.ShortTag()
+
+	if node.Style&yaml.TaggedStyle == 0 {
+		switch tag {
+		case "!!timestamp", "!!merge":
+			// [spec // 4] only the JSON schema is supported; other implicit types of YAML 1.1 remain strings
+
+			tag = "!!str"
+		}
+	}
+
+	switch tag {
+	case "!!str":
+		return inspectjson.StringValue{
+			SourceOffsets: offsets,
+			Value:         node.Value,
+		}, nil
+	case "!!null":
+		return inspectjson.NullValue{
+			SourceOffsets: offsets,
+		}, nil
+	case "!!bool":
+		var v bool
+
+		err := node.Decode(&v)
+		if err != nil {
+			return nil, err
+		}
+
+		return inspectjson.BooleanValue{
+			SourceOffsets: offsets,
+			Value:         v,
+		}, nil
+	case "!!int", "!!float":
+		var v float64
+
+		err := node.Decode(&v)
+		if err != nil {
+			return nil, err
+		} else if math.IsInf(v, 0) || math.IsNaN(v) {
+			return nil, fmt.Errorf("unsupported number: %s", node.Value)
+		}
+
+		return inspectjson.NumberValue{
+			SourceOffsets: offsets,
+			Value:         v,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unsupported tag for scalar: %s", tag)
+}
+
+func (c *valueConverter) newNodeError(node *yaml.Node, err error) error {
+	return fmt.Errorf("line %d column %d: %w", node.Line, node.Column, err)
+}
+
+func (c *valueConverter) scalarOffsets(node *yaml.Node) *cursorio.TextOffsetRange {
+	if c.source == nil {
+		return nil
+	}
+
+	from := c.source.byteOffset(node.Line, node.Column)
+	until := c.source.scalarUntil(node, from)
+
+	c.untils[node] = until
+
+	return &cursorio.TextOffsetRange{
+		From:  c.source.textOffset(from),
+		Until: c.source.textOffset(until),
+	}
+}
+
+// collectionOffsets returns the ranges of the begin and end tokens of a mapping or sequence. Block collections have no
+// such tokens, so empty ranges from the first entry until the end of the last value are used.
+func (c *valueConverter) collectionOffsets(node *yaml.Node, begin, end byte) (*cursorio.TextOffsetRange, *cursorio.TextOffsetRange) {
+	from := c.source.byteOffset(node.Line, node.Column)
+	until := from
+
+	if len(node.Content) > 0 {
+		until = c.untils[node.Content[len(node.Content)-1]]
+	}
+
+	if node.Style&yaml.FlowStyle == 0 {
+		c.untils[node] = until
+
+		return c.source.emptyRange(c.source.textOffset(from)), c.source.emptyRange(c.source.textOffset(until))
+	}
+
+	if idx := bytes.IndexByte(c.source.buf[from:], begin); idx >= 0 {
+		from += idx
+	}
+
+	if len(node.Content) == 0 {
+		until = from + 1
+	}
+
+	until = c.source.flowEnd(until, end)
+
+	c.untils[node] = until
+
+	return &cursorio.TextOffsetRange{
+		From:  c.source.textOffset(from),
+		Until: c.source.textOffset(from + 1),
+	}, &cursorio.TextOffsetRange{
+		From:  c.source.textOffset(until - 1),
+		Until: c.source.textOffset(until),
+	}
+}
+
+// textSource maps the line and column positions of YAML nodes to byte offsets of the original text.
+type textSource struct {
+	buf        []byte
+	lineStarts []int
+}
+
+func newTextSource(buf []byte) *textSource {
+	s := &textSource{
+		buf:        buf,
+		lineStarts: []int{0},
+	}
+
+	for idx, b := range buf {
+		if b == '\n' {
+			s.lineStarts = append(s.lineStarts, idx+1)
+		}
+	}
+
+	return s
+}
+
+func (s *textSource) emptyRange(v cursorio.TextOffset) *cursorio.TextOffsetRange {
+	return &cursorio.TextOffsetRange{
+		From:  v,
+		Until: v,
+	}
+}
+
+// byteOffset converts the 1-based line and column of a node, where columns are counted in characters.
+func (s *textSource) byteOffset(line, column int) int {
+	if line < 1 || line > len(s.lineStarts) {
+		return len(s.buf)
+	}
+
+	offset := s.lineStarts[line-1]
+
+	for ; column > 1 && offset < len(s.buf); column-- {
+		_, size := utf8.DecodeRune(s.buf[offset:])
+		offset += size
+	}
+
+	return offset
+}
+
+func (s *textSource) textOffset(offset int) cursorio.TextOffset {
+	line := sort.Search(len(s.lineStarts), func(i int) bool {
+		return s.lineStarts[i] > offset
+	}) - 1
+
+	return cursorio.TextOffset{
+		Byte: cursorio.ByteOffset(offset),
+		LineColumn: cursorio.TextLineColumn{
+			int64(line),
+			int64(utf8.RuneCount(s.buf[s.lineStarts[line]:offset])),
+		},
+	}
+}
+
+// scalarUntil finds the end of a scalar starting at from, which includes any tag and anchor properties.
+func (s *textSource) scalarUntil(node *yaml.Node, from int) int {
+	switch {
+	case node.Kind == yaml.AliasNode:
+		return min(from+1+len(node.Value), len(s.buf))
+	case node.Style&yaml.DoubleQuotedStyle != 0:
+		idx := bytes.IndexByte(s.buf[from:], '"')
+		if idx < 0 {
+			break
+		}
+
+		for offset := from + idx + 1; offset < len(s.buf); offset++ {
+			switch s.buf[offset] {
+			case '\\':
+				offset++
+			case '"':
+				return offset + 1
+			}
+		}
+	case node.Style&yaml.SingleQuotedStyle != 0:
+		idx := bytes.IndexByte(s.buf[from:], '\'')
+		if idx < 0 {
+			break
+		}
+
+		for offset := from + idx + 1; offset < len(s.buf); offset++ {
+			if s.buf[offset] != '\'' {
+				continue
+			} else if offset+1 < len(s.buf) && s.buf[offset+1] == '\'' {
+				offset++
+
+				continue
+			}
+
+			return offset + 1
+		}
+	case node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) == 0 && node.Style&yaml.TaggedStyle == 0 && len(node.Anchor) == 0:
+		if bytes.HasPrefix(s.buf[from:], []byte(node.Value)) {
+			return from + len(node.Value)
+		}
+	}
+
+	// [dpb] otherwise, such as block and multi-line scalars, the content is folded or indented; find the last of its
+	// words in order, which is exact unless the scalar ends in escape sequences
+
+	until := from
+
+	for _, field := range strings.Fields(node.Value) {
+		idx := bytes.Index(s.buf[until:], []byte(field))
+		if idx < 0 {
+			break
+		}
+
+		until += idx + len(field)
+	}
+
+	if until == from && node.Style&(yaml.LiteralStyle|yaml.FoldedStyle) != 0 {
+		// the indicator of an empty block scalar
+
+		until = min(from+1, len(s.buf))
+	}
+
+	return until
+}
+
+// flowEnd finds the end of a flow collection after its last value, skipping separators and comments.
+func (s *textSource) flowEnd(from int, end byte) int {
+	for offset := from; offset < len(s.buf); offset++ {
+		switch s.buf[offset] {
+		case end:
+			return offset + 1
+		case '#':
+			idx := bytes.IndexByte(s.buf[offset:], '\n')
+			if idx < 0 {
+				return len(s.buf)
+			}
+
+			offset += idx
+		}
+	}
+
+	return len(s.buf)
+}
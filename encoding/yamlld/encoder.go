@@ -0,0 +1,200 @@
+package yamlld
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld/yamlldcontent"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdfdescription"
+	"github.com/dpb587/rdfkit-go/rdfdescription/rdfdescriptionutil"
+	"go.yaml.in/yaml/v3"
+)
+
+type EncoderOption interface {
+	apply(s *EncoderConfig)
+	newEncoder(w io.Writer) (*Encoder, error)
+}
+
+// Encoder writes the output of [jsonld.Encoder] as YAML once it is closed. Entries keep the order of the JSON-LD output.
+type Encoder struct {
+	w      io.Writer
+	indent int
+
+	buf     *bytes.Buffer
+	encoder *jsonld.Encoder
+}
+
+var _ encoding.QuadsEncoder = &Encoder{}
+var _ rdfdescriptionutil.DatasetResourceEncoder = &Encoder{}
+
+func NewEncoder(w io.Writer, opts ...EncoderOption) (*Encoder, error) {
+	compiledOpts := EncoderConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&compiledOpts)
+	}
+
+	return compiledOpts.newEncoder(w)
+}
+
+func (e *Encoder) GetContentMetadata() encoding.ContentMetadata {
+	return yamlldcontent.DefaultMetadata
+}
+
+func (e *Encoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return yamlldcontent.TypeIdentifier
+}
+
+func (e *Encoder) Close() error {
+	err := e.encoder.Close()
+	if err != nil {
+		return err
+	}
+
+	jd := json.NewDecoder(e.buf)
+	jd.UseNumber()
+
+	node, err := buildNode(jd)
+	if err != nil {
+		return fmt.Errorf("convert: %v", err)
+	}
+
+	e.buf.Reset()
+
+	ye := yaml.NewEncoder(e.w)
+	ye.SetIndent(e.indent)
+
+	err = ye.Encode(node)
+	if err != nil {
+		return fmt.Errorf("encode: %v", err)
+	}
+
+	err = ye.Close()
+	if err != nil {
+		return fmt.Errorf("encode: %v", err)
+	}
+
+	return nil
+}
+
+func (e *Encoder) AddQuad(ctx context.Context, t rdf.Quad) error {
+	return e.encoder.AddQuad(ctx, t)
+}
+
+func (e *Encoder) AddDatasetResource(ctx context.Context, resource rdfdescription.DatasetResource) error {
+	return e.encoder.AddDatasetResource(ctx, resource)
+}
+
+// buildNode converts the next JSON value to a YAML node, keeping the order of object members.
+func buildNode(jd *json.Decoder) (*yaml.Node, error) {
+	token, err := jd.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch tokenT := token.(type) {
+	case json.Delim:
+		switch tokenT {
+		case '{':
+			node := &yaml.Node{
+				Kind: yaml.MappingNode,
+				Tag:  "!!map",
+			}
+
+			for jd.More() {
+				keyToken, err := jd.Token()
+				if err != nil {
+					return nil, err
+				}
+
+				valueNode, err := buildNode(jd)
+				if err != nil {
+					return nil, err
+				}
+
+				node.Content = append(
+					node.Content,
+					&yaml.Node{
+						Kind:  yaml.ScalarNode,
+						Tag:   "!!str",
+						Value: keyToken.(string),
+					},
+					valueNode,
+				)
+			}
+
+			_, err = jd.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			return node, nil
+		case '[':
+			node := &yaml.Node{
+				Kind: yaml.SequenceNode,
+				Tag:  "!!seq",
+			}
+
+			for jd.More() {
+				itemNode, err := buildNode(jd)
+				if err != nil {
+					return nil, err
+				}
+
+				node.Content = append(node.Content, itemNode)
+			}
+
+			_, err = jd.Token()
+			if err != nil {
+				return nil, err
+			}
+
+			return node, nil
+		}
+	case string:
+		return &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!str",
+			Value: tokenT,
+		}, nil
+	case json.Number:
+		tag := "!!int"
+
+		if strings.ContainsAny(tokenT.String(), ".eE") {
+			tag = "!!float"
+		}
+
+		return &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   tag,
+			Value: tokenT.String(),
+		}, nil
+	case bool:
+		value := "false"
+
+		if tokenT {
+			value = "true"
+		}
+
+		return &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!bool",
+			Value: value,
+		}, nil
+	case nil:
+		return &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!null",
+			Value: "null",
+		}, nil
+	}
+
+	return nil, fmt.Errorf("unexpected token: %v", token)
+}
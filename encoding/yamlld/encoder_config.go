@@ -0,0 +1,73 @@
+package yamlld
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+)
+
+type EncoderConfig struct {
+	indent         *int
+	encoderOptions []jsonld.EncoderOption
+}
+
+var _ EncoderOption = EncoderConfig{}
+
+// SetIndent configures the number of spaces for nested YAML nodes. By default, 2.
+func (s EncoderConfig) SetIndent(v int) EncoderConfig {
+	s.indent = &v
+
+	return s
+}
+
+// SetEncoderOptions configures the JSON-LD output which is written as YAML, such as the prefixes and frame. Options
+// for the JSON syntax, such as indentation, do not apply.
+func (s EncoderConfig) SetEncoderOptions(v ...jsonld.EncoderOption) EncoderConfig {
+	s.encoderOptions = v
+
+	return s
+}
+
+func (s EncoderConfig) AddEncoderOptions(v ...jsonld.EncoderOption) EncoderConfig {
+	s.encoderOptions = slices.Concat(s.encoderOptions, v)
+
+	return s
+}
+
+func (s EncoderConfig) apply(d *EncoderConfig) {
+	if s.indent != nil {
+		d.indent = s.indent
+	}
+
+	if s.encoderOptions != nil {
+		d.encoderOptions = append(d.encoderOptions, s.encoderOptions...)
+	}
+}
+
+func (s EncoderConfig) newEncoder(w io.Writer) (*Encoder, error) {
+	e := &Encoder{
+		w:      w,
+		indent: 2,
+		buf:    &bytes.Buffer{},
+	}
+
+	if s.indent != nil {
+		if *s.indent < 1 {
+			return nil, fmt.Errorf("indent: invalid value: %d", *s.indent)
+		}
+
+		e.indent = *s.indent
+	}
+
+	encoder, err := jsonld.NewEncoder(e.buf, s.encoderOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	e.encoder = encoder
+
+	return e, nil
+}
@@ -0,0 +1,142 @@
+package yamlld
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+)
+
+func TestEncoder(t *testing.T) {
+	buf := &bytes.Buffer{}
+
+	e, err := NewEncoder(
+		buf,
+		EncoderConfig{}.SetEncoderOptions(
+			jsonld.EncoderConfig{}.
+				SetPrefixes(iri.PrefixMappingList{
+					{Prefix: "schema", Expanded: "http://schema.org/"},
+				}).
+				SetUseNativeTypes(true),
+		),
+	)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, quad := range (rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://schema.org/name"),
+				Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "true"},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://schema.org/description"),
+				Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "multi\nline"},
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://schema.org/age"),
+				Object:    rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "30"},
+			},
+		},
+	}) {
+		err = e.AddQuad(context.Background(), quad)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := buf.String(), `'@context':
+  schema: http://schema.org/
+'@id': http://example.com/a
+schema:age: 30
+schema:description: |-
+  multi
+  line
+schema:name: "true"
+`; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestEncoder_RoundTrip(t *testing.T) {
+	input := rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "1.5E0"},
+			},
+			GraphName: rdf.IRI("http://example.com/g"),
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/a"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "@id: #1"},
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+
+	e, err := NewEncoder(buf)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, quad := range input {
+		err = e.AddQuad(context.Background(), quad)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	err = e.Close()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	output, err := quads.CollectErr(NewDecoder(strings.NewReader(buf.String())))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _a, _e := len(output), len(input); _a != _e {
+		t.Fatalf("expected %d statements, got %d: %s", _e, _a, buf.String())
+	}
+
+	for _, quad := range input {
+		var found bool
+
+		for _, outputQuad := range output {
+			if outputQuad == quad {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			t.Fatalf("expected %v: %s", quad, buf.String())
+		}
+	}
+}
@@ -0,0 +1,2 @@
+// see https://json-ld.github.io/yaml-ld/spec/
+package yamlld
@@ -0,0 +1,25 @@
+package yamlldcontent
+
+import (
+	"regexp"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+)
+
+const TypeIdentifier encoding.ContentTypeIdentifier = "org.json-ld.yaml-ld"
+
+var DefaultMetadata = encoding.ContentMetadata{
+	FileExt: ".yamlld",
+	MediaType: encoding.ContentMediaType{
+		Type:    "application",
+		Subtype: "ld+yaml",
+	},
+}
+
+var reMatchContext = regexp.MustCompile(`^(\s*(#[^\n]*)?\n)*(%YAML[^\n]*\n)?(---[ \t]*\n)?(\s*(#[^\n]*)?\n)*(- )?["']?@context["']?[ \t]*:`)
+
+// MatchBytes reports whether the buffer appears to start with a YAML mapping of a top-level @context entry. Documents
+// starting with other entries are not detected.
+func MatchBytes(buf []byte) bool {
+	return reMatchContext.Match(buf)
+}
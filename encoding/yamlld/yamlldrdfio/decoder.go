@@ -0,0 +1,89 @@
+package yamlldrdfio
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld/yamlldcontent"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type decoder struct{}
+
+var _ rdfiotypes.DecoderManager = decoder{}
+
+func NewDecoder() rdfiotypes.DecoderManager {
+	return decoder{}
+}
+
+func (decoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return yamlldcontent.TypeIdentifier
+}
+
+func (e decoder) NewDecoderParams() rdfiotypes.Params {
+	return newDecoderParams()
+}
+
+func (e decoder) NewDecoder(rr rdfiotypes.Reader, opts rdfiotypes.DecoderOptions) (*rdfiotypes.DecoderHandle, error) {
+	params := newDecoderParams()
+
+	err := rdfiotypes.LoadAndApplyParams(params, opts.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	bnFactory := blanknodes.NewStringFactory()
+
+	jsonldOptions := jsonld.DecoderConfig{}.
+		SetBlankNodeStringFactory(bnFactory).
+		SetDefaultBase(string(opts.BaseIRI))
+
+	jsonldOptions, err = params.DocumentLoader.ApplyDecoderConfig(jsonldOptions)
+	if err != nil {
+		return nil, fmt.Errorf("params: documentLoader: %v", err)
+	}
+
+	options := yamlld.DecoderConfig{}.
+		SetDecoderOptions(jsonldOptions)
+
+	if params.CaptureTextOffsets != nil {
+		options = options.SetCaptureTextOffsets(*params.CaptureTextOffsets)
+	}
+
+	allOptions, err := rdfiotypes.PatchGenericOptions([]yamlld.DecoderOption{options}, opts.Patcher)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder, err := yamlld.NewDecoder(rr, allOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	h := &rdfiotypes.DecoderHandle{
+		Reader:            rr,
+		Decoder:           decoder,
+		DecoderBlankNodes: bnFactory,
+	}
+
+	err = params.ValidateLiterals.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	err = params.ValidateIRIs.ResolveDecoderHandle(h, opts)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	params.CanonicalizeLiterals.ResolveDecoderHandle(h)
+	params.NormalizeIRIs.ResolveDecoderHandle(h)
+
+	params.Deskolemize.ResolveDecoderHandle(h)
+	params.Skolemize.ResolveDecoderHandle(h)
+
+	return h, nil
+}
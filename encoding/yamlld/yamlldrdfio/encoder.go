@@ -0,0 +1,62 @@
+package yamlldrdfio
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld/yamlldcontent"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type encoder struct{}
+
+var _ rdfiotypes.EncoderManager = encoder{}
+
+func NewEncoder() rdfiotypes.EncoderManager {
+	return encoder{}
+}
+
+func (encoder) GetContentTypeIdentifier() encoding.ContentTypeIdentifier {
+	return yamlldcontent.TypeIdentifier
+}
+
+func (e encoder) NewEncoderParams() rdfiotypes.Params {
+	return newEncoderParams()
+}
+
+func (e encoder) NewEncoder(ww rdfiotypes.Writer, opts rdfiotypes.EncoderOptions) (*rdfiotypes.EncoderHandle, error) {
+	params := newEncoderParams()
+
+	err := rdfiotypes.LoadAndApplyParams(params, opts.Params...)
+	if err != nil {
+		return nil, fmt.Errorf("params: %v", err)
+	}
+
+	jsonldOptions, err := params.Document.NewEncoderConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	options := yamlld.EncoderConfig{}.
+		SetEncoderOptions(jsonldOptions)
+
+	if params.Indent != nil {
+		options = options.SetIndent(*params.Indent)
+	}
+
+	allOptions, err := rdfiotypes.PatchGenericOptions([]yamlld.EncoderOption{options}, opts.Patcher)
+	if err != nil {
+		return nil, err
+	}
+
+	encoder, err := yamlld.NewEncoder(ww, allOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdfiotypes.EncoderHandle{
+		Writer:  ww,
+		Encoder: encoder,
+	}, nil
+}
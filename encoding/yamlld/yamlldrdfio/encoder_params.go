@@ -0,0 +1,38 @@
+package yamlldrdfio
+
+import (
+	"maps"
+
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/encoding/jsonld/jsonldrdfio"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type encoderParams struct {
+	Document *jsonldrdfio.EncoderDocumentParams
+	Indent   *int
+}
+
+var _ rdfiotypes.Params = &encoderParams{}
+
+func newEncoderParams() *encoderParams {
+	return &encoderParams{
+		Document: &jsonldrdfio.EncoderDocumentParams{},
+	}
+}
+
+func (f *encoderParams) NewParamsCollection() rdfiotypes.ParamsCollection {
+	c := rdfiotypes.ParamsCollection{
+		"indent": kvref.IntPtr(&f.Indent, rdfiotypes.ParamMeta{
+			Usage: "Number of spaces for indenting nested nodes (default 2)",
+		}),
+	}
+
+	maps.Copy(c, f.Document.NewParamsCollection())
+
+	return c
+}
+
+func (f *encoderParams) ApplyDefaults() {
+	f.Document.ApplyDefaults()
+}
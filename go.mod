@@ -11,6 +11,7 @@ require (
 	github.com/dpb587/kvstrings-go v0.0.0-20260105164922-00f00f4a51f0
 	github.com/google/uuid v1.6.0
 	github.com/tomnomnom/linkheader v0.0.0-20250811210735-e5fe3b51442e
+	go.yaml.in/yaml/v3 v3.0.4
 	golang.org/x/net v0.54.0
 )
 
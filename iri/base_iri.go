@@ -78,7 +78,7 @@ func (rb *BaseIRI) ResolveReference(ref *ParsedIRI) *ParsedIRI {
 }
 
 func (rb *BaseIRI) RelativizeIRI(v string) (string, bool) {
-	if len(v) > len(rb.original) {
+	if len(v) > len(rb.original) && v[:len(rb.original)] == rb.original {
 		if rb.fragmentIndex == -1 && v[len(rb.original)] == '#' {
 			return v[len(rb.original):], true
 		} else if rb.queryIndex == -1 && v[len(rb.original)] == '?' {
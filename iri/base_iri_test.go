@@ -151,6 +151,42 @@ func TestBaseIRI_Relativize(t *testing.T) {
 	}
 }
 
+func TestBaseIRI_RelativizeDifferentPrefix(t *testing.T) {
+	tests := []struct {
+		Base  string
+		Input string
+	}{
+		// past bug (fragment of a different IRI with the same length)
+		{
+			Base:  "http://example.com/path/",
+			Input: "http://example.org/vocab#Thing",
+		},
+		// past bug (query of a different IRI with the same length)
+		{
+			Base:  "http://example.com/path/",
+			Input: "http://example.org/vocab?thing",
+		},
+		{
+			Base:  "http://example.com/path/subpath",
+			Input: "urn:example:path:subpath#fragment",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.Input), func(t *testing.T) {
+			rb, err := ParseBaseIRI(tt.Base)
+			if err != nil {
+				t.Fatal("setup failed")
+			}
+
+			relativized, ok := rb.RelativizeIRI(tt.Input)
+			if ok {
+				t.Fatalf("ok: expected false, got %v", relativized)
+			}
+		})
+	}
+}
+
 func TestBaseIRI_RelativizeWithQueryFragment(t *testing.T) {
 	tests := []struct {
 		Base                string
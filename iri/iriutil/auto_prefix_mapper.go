@@ -0,0 +1,217 @@
+package iriutil
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/wellknownprefixes"
+)
+
+type AutoPrefixMapperOptions struct {
+	// LookupExpanded returns the preferred prefix of a namespace. By default, [wellknownprefixes.LookupExpanded] is
+	// used.
+	LookupExpanded func(expanded string) (iri.PrefixMapping, bool)
+
+	// Exclude prevents a prefix from being created for an IRI, such as when it would be written relative to a base.
+	Exclude func(v string) bool
+}
+
+// AutoPrefixMapper wraps a mapper to create prefixes for the namespaces of IRIs which it cannot compact. The namespace
+// of an IRI ends with its last slash or number sign. Well-known namespaces use their conventional prefix, and any
+// others use a prefix derived from their IRI, such as "vocab" for "http://example.com/vocab#". If a prefix is already
+// used, a number is appended to it, such as "vocab1".
+//
+// Prefixes are created in the order IRIs are compacted, so the same sequence of IRIs always results in the same
+// prefixes.
+type AutoPrefixMapper struct {
+	pm             iri.PrefixMapper
+	lookupExpanded func(expanded string) (iri.PrefixMapping, bool)
+	exclude        func(v string) bool
+
+	created           iri.PrefixMappingList
+	createdByPrefix   map[string]string
+	createdByExpanded map[string]string
+}
+
+var _ iri.PrefixMapper = (*AutoPrefixMapper)(nil)
+
+func NewAutoPrefixMapper(pm iri.PrefixMapper, opts AutoPrefixMapperOptions) *AutoPrefixMapper {
+	p := &AutoPrefixMapper{
+		pm:                pm,
+		lookupExpanded:    opts.LookupExpanded,
+		exclude:           opts.Exclude,
+		createdByPrefix:   map[string]string{},
+		createdByExpanded: map[string]string{},
+	}
+
+	if p.lookupExpanded == nil {
+		p.lookupExpanded = wellknownprefixes.LookupExpanded
+	}
+
+	return p
+}
+
+// GetPrefixMappings returns the created prefixes in the order they were created.
+func (p *AutoPrefixMapper) GetPrefixMappings() iri.PrefixMappingList {
+	return slices.Clone(p.created)
+}
+
+func (p *AutoPrefixMapper) CompactPrefix(v string) (iri.PrefixReference, bool) {
+	if pr, ok := p.pm.CompactPrefix(v); ok {
+		return pr, true
+	}
+
+	expanded, reference, ok := SplitNamespace(v)
+	if !ok {
+		return iri.PrefixReference{}, false
+	}
+
+	if prefix, known := p.createdByExpanded[expanded]; known {
+		return iri.PrefixReference{
+			Prefix:    prefix,
+			Reference: reference,
+		}, true
+	} else if p.exclude != nil && p.exclude(v) {
+		return iri.PrefixReference{}, false
+	}
+
+	var candidate string
+
+	if mapping, ok := p.lookupExpanded(expanded); ok {
+		candidate = mapping.Prefix
+	} else {
+		candidate = derivePrefix(expanded)
+	}
+
+	prefix := candidate
+
+	if !p.isPrefixAvailable(prefix) {
+		// numbered from the candidate without any trailing digits so "v1" continues as "v2" rather than "v12"
+		base := strings.TrimRight(candidate, "0123456789")
+
+		for i := 1; !p.isPrefixAvailable(prefix); i++ {
+			prefix = base + strconv.Itoa(i)
+		}
+	}
+
+	p.created = append(p.created, iri.PrefixMapping{
+		Prefix:   prefix,
+		Expanded: expanded,
+	})
+	p.createdByPrefix[prefix] = expanded
+	p.createdByExpanded[expanded] = prefix
+
+	return iri.PrefixReference{
+		Prefix:    prefix,
+		Reference: reference,
+	}, true
+}
+
+func (p *AutoPrefixMapper) ExpandPrefix(pr iri.PrefixReference) (string, bool) {
+	if expanded, ok := p.pm.ExpandPrefix(pr); ok {
+		return expanded, true
+	}
+
+	expanded, ok := p.createdByPrefix[pr.Prefix]
+	if !ok {
+		return "", false
+	}
+
+	return expanded + pr.Reference, true
+}
+
+func (p *AutoPrefixMapper) isPrefixAvailable(prefix string) bool {
+	if _, ok := p.createdByPrefix[prefix]; ok {
+		return false
+	} else if _, ok := p.pm.ExpandPrefix(iri.PrefixReference{Prefix: prefix}); ok {
+		return false
+	}
+
+	return true
+}
+
+//
+
+// SplitNamespace splits an IRI after its last slash or number sign. False is returned if the IRI has a query, if the
+// reference would be empty, or if the separator is not after the authority of the IRI.
+func SplitNamespace(v string) (string, string, bool) {
+	if strings.ContainsRune(v, '?') {
+		return "", "", false
+	}
+
+	i := strings.LastIndexAny(v, "/#")
+	if i < 0 || i == len(v)-1 {
+		return "", "", false
+	} else if authorityIdx := strings.Index(v, "://"); authorityIdx >= 0 && i < authorityIdx+3 {
+		return "", "", false
+	}
+
+	return v[:i+1], v[i+1:], true
+}
+
+// derivePrefix uses the last path segment of a namespace which contains a letter, or otherwise the most significant
+// label of its host. For example, "http://example.com/ns/vocab/1.0/" becomes "vocab" and "https://www.example.com/#"
+// becomes "example".
+func derivePrefix(expanded string) string {
+	_, rest, ok := strings.Cut(expanded, "://")
+	if !ok {
+		// such as urn:example:; use the last non-empty segment
+		_, rest, _ = strings.Cut(expanded, ":")
+		rest = strings.ReplaceAll(rest, ":", "/")
+	}
+
+	rest = strings.TrimRight(rest, "/#")
+
+	var host string
+
+	if ok {
+		host, rest, _ = strings.Cut(rest, "/")
+	}
+
+	segments := strings.Split(rest, "/")
+
+	for i := len(segments) - 1; i >= 0; i-- {
+		if prefix := sanitizePrefix(segments[i]); len(prefix) > 0 {
+			return prefix
+		}
+	}
+
+	if len(host) > 0 {
+		host, _, _ = strings.Cut(host, ":")
+		labels := strings.Split(host, ".")
+
+		if len(labels) > 1 {
+			labels = labels[:len(labels)-1]
+		}
+
+		for i := len(labels) - 1; i >= 0; i-- {
+			if labels[i] == "www" {
+				continue
+			} else if prefix := sanitizePrefix(labels[i]); len(prefix) > 0 {
+				return prefix
+			}
+		}
+	}
+
+	return "ns"
+}
+
+// sanitizePrefix lowercases a name and removes any characters other than ASCII letters and digits. An empty string is
+// returned if the result would not start with a letter.
+func sanitizePrefix(v string) string {
+	var sb strings.Builder
+
+	for _, r := range strings.ToLower(v) {
+		if 'a' <= r && r <= 'z' || sb.Len() > 0 && '0' <= r && r <= '9' {
+			sb.WriteRune(r)
+		}
+	}
+
+	if sb.Len() > 12 {
+		return sb.String()[:12]
+	}
+
+	return sb.String()
+}
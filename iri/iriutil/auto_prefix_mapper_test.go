@@ -0,0 +1,70 @@
+package iriutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/iri"
+)
+
+func TestAutoPrefixMapper(t *testing.T) {
+	p := NewAutoPrefixMapper(iri.NewPrefixManager(iri.PrefixMappingList{
+		{Prefix: "ex", Expanded: "http://example.com/explicit/"},
+		{Prefix: "vocab", Expanded: "http://example.com/other/"},
+	}), AutoPrefixMapperOptions{
+		Exclude: func(v string) bool {
+			return strings.HasPrefix(v, "http://example.net/excluded/")
+		},
+	})
+
+	for _, tc := range []struct {
+		Input     string
+		Prefix    string
+		Reference string
+		Ok        bool
+	}{
+		{"http://example.com/explicit/a", "ex", "a", true},
+		{"http://xmlns.com/foaf/0.1/name", "foaf", "name", true},
+		{"http://purl.org/dc/terms/title", "dcterms", "title", true},
+		{"https://schema.org/Person", "schema", "Person", true},
+		{"http://schema.org/Person", "schema1", "Person", true},
+		{"http://example.com/vocab#Thing", "vocab1", "Thing", true},
+		{"http://example.com/vocab#other", "vocab1", "other", true},
+		{"http://example.com/ns/1.0/a", "ns", "a", true},
+		{"https://www.example.org/a", "example", "a", true},
+		{"urn:example:things#b", "things", "b", true},
+		{"http://example.com/v1/a", "v1", "a", true},
+		{"http://example.org/v1/b", "v2", "b", true},
+		{"http://example.net/v1/c", "v3", "c", true},
+		{"http://example.net/excluded/a", "", "", false},
+		{"http://example.net/excluded/b", "", "", false},
+		{"http://example.com/search?q=1", "", "", false},
+		{"http://example.com/trailing/", "", "", false},
+		{"http://example.com", "", "", false},
+		{"urn:isbn:0451450523", "", "", false},
+	} {
+		t.Run(tc.Input, func(t *testing.T) {
+			pr, ok := p.CompactPrefix(tc.Input)
+			if _a, _e := ok, tc.Ok; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			} else if !ok {
+				return
+			} else if _a, _e := pr.Prefix, tc.Prefix; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			} else if _a, _e := pr.Reference, tc.Reference; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+
+			expanded, ok := p.ExpandPrefix(pr)
+			if !ok {
+				t.Fatalf("expected expanded prefix")
+			} else if _a, _e := expanded, tc.Input; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+
+	if _a, _e := len(p.GetPrefixMappings()), 11; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
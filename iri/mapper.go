@@ -0,0 +1,17 @@
+package iri
+
+// MapperFunc checks or rewrites an IRI. It is used by decoders which accept IRIs from documents; [Validated] rejects
+// invalid IRIs and [Normalize] additionally normalizes them.
+type MapperFunc func(v string) (string, error)
+
+var _ MapperFunc = Validated
+var _ MapperFunc = Normalize
+
+// Validated returns the IRI as-is if it is a valid, absolute IRI.
+func Validated(v string) (string, error) {
+	if err := ValidateIRI(v); err != nil {
+		return "", err
+	}
+
+	return v, nil
+}
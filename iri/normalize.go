@@ -0,0 +1,224 @@
+package iri
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var defaultPorts = map[string]string{
+	"ftp":   "21",
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+}
+
+// Normalize applies the syntax-based and scheme-based normalizations of [RFC3987], Section 5.3 so that equivalent IRIs
+// compare equal as strings. An error is returned if the string is not a valid IRI reference.
+//
+//   - scheme and host are lowercased
+//   - percent-encoded octets use uppercase hex digits; those of unreserved characters, including non-ASCII
+//     characters permitted in IRIs, are decoded (e.g. "%7E" to "~")
+//   - dot-segments are removed from the path
+//   - the port is removed if it is empty or the default of the scheme
+//   - the empty path of an http(s) IRI with an authority becomes "/"
+//   - punycode labels of internationalized domain names are converted to their Unicode form
+//
+// Character normalization (e.g. Unicode NFC) is not applied.
+//
+// [RFC3987]: https://www.rfc-editor.org/rfc/rfc3987
+func Normalize(s string) (string, error) {
+	c, err := validateIRIReference(s)
+	if err != nil {
+		return "", err
+	}
+
+	c.scheme = strings.ToLower(c.scheme)
+
+	if c.hasAuthority {
+		c.userinfo = normalizePercentEncoding(c.userinfo)
+
+		if strings.HasPrefix(c.host, "[") {
+			c.host = strings.ToLower(c.host)
+		} else {
+			c.host = normalizeHost(c.host)
+		}
+
+		if c.hasPort && (len(c.port) == 0 || defaultPorts[c.scheme] == c.port) {
+			c.hasPort = false
+			c.port = ""
+		}
+	}
+
+	c.path = normalizePercentEncoding(c.path)
+
+	if c.hasScheme || c.hasAuthority || strings.HasPrefix(c.path, "/") {
+		c.path = removeDotSegments(c.path)
+	}
+
+	if len(c.path) == 0 && c.hasAuthority && (c.scheme == "http" || c.scheme == "https") {
+		c.path = "/"
+	}
+
+	c.query = normalizePercentEncoding(c.query)
+	c.fragment = normalizePercentEncoding(c.fragment)
+
+	return c.String(), nil
+}
+
+func normalizeHost(s string) string {
+	s = lowerExceptPercentEncoding(normalizePercentEncoding(s))
+
+	if strings.IndexByte(s, '%') >= 0 || !strings.Contains(s, "xn--") {
+		return s
+	}
+
+	labels := strings.Split(s, ".")
+
+	for i, label := range labels {
+		encoded, ok := strings.CutPrefix(label, "xn--")
+		if !ok {
+			continue
+		}
+
+		decoded, err := decodePunycode(encoded)
+		if err != nil || validateRunes("host", decoded, isRegNameRune) != nil {
+			// not an A-label; leave as-is
+			continue
+		}
+
+		labels[i] = strings.ToLower(decoded)
+	}
+
+	return strings.Join(labels, ".")
+}
+
+// normalizePercentEncoding uppercases the hex digits of percent-encoded octets and decodes any octets of iunreserved
+// characters. Sequences must already be validated.
+func normalizePercentEncoding(s string) string {
+	if strings.IndexByte(s, '%') < 0 {
+		return s
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); {
+		if s[i] != '%' {
+			sb.WriteByte(s[i])
+			i++
+
+			continue
+		}
+
+		// collect the sequence of encoded octets so multi-byte characters may be decoded together
+		var octets []byte
+
+		for j := i; j+2 < len(s) && s[j] == '%' && len(octets) < utf8.UTFMax; j += 3 {
+			octets = append(octets, unhex(s[j+1])<<4|unhex(s[j+2]))
+		}
+
+		r, size := utf8.DecodeRune(octets)
+		if r != utf8.RuneError && isIUnreservedRune(r) {
+			sb.WriteRune(r)
+			i += size * 3
+
+			continue
+		}
+
+		sb.WriteByte('%')
+		sb.WriteByte(toUpperHex(s[i+1]))
+		sb.WriteByte(toUpperHex(s[i+2]))
+		i += 3
+	}
+
+	return sb.String()
+}
+
+// lowerExceptPercentEncoding lowercases all characters except the hex digits of percent-encoded octets, which are
+// conventionally uppercase.
+func lowerExceptPercentEncoding(s string) string {
+	var sb strings.Builder
+
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '%')
+		if i < 0 || i+2 >= len(s) {
+			sb.WriteString(strings.ToLower(s))
+
+			break
+		}
+
+		sb.WriteString(strings.ToLower(s[:i]))
+		sb.WriteString(s[i : i+3])
+		s = s[i+3:]
+	}
+
+	return sb.String()
+}
+
+// removeDotSegments is the algorithm of RFC 3986, Section 5.2.4.
+func removeDotSegments(input string) string {
+	if !strings.Contains(input, ".") {
+		return input
+	}
+
+	var output []string
+
+	for len(input) > 0 {
+		switch {
+		case strings.HasPrefix(input, "../"):
+			input = input[3:]
+		case strings.HasPrefix(input, "./"):
+			input = input[2:]
+		case strings.HasPrefix(input, "/./"):
+			input = input[2:]
+		case input == "/.":
+			input = "/"
+		case strings.HasPrefix(input, "/../"):
+			input = input[3:]
+
+			if len(output) > 0 {
+				output = output[:len(output)-1]
+			}
+		case input == "/..":
+			input = "/"
+
+			if len(output) > 0 {
+				output = output[:len(output)-1]
+			}
+		case input == "." || input == "..":
+			input = ""
+		default:
+			// move the first segment, including any initial "/", to the output
+			end := strings.IndexByte(input[1:], '/')
+			if end < 0 {
+				end = len(input)
+			} else {
+				end++
+			}
+
+			output = append(output, input[:end])
+			input = input[end:]
+		}
+	}
+
+	return strings.Join(output, "")
+}
+
+func unhex(b byte) byte {
+	switch {
+	case '0' <= b && b <= '9':
+		return b - '0'
+	case 'a' <= b && b <= 'f':
+		return b - 'a' + 10
+	}
+
+	return b - 'A' + 10
+}
+
+func toUpperHex(b byte) byte {
+	if 'a' <= b && b <= 'f' {
+		return b - ('a' - 'A')
+	}
+
+	return b
+}
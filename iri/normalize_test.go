@@ -0,0 +1,81 @@
+package iri
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		Input    string
+		Expected string
+	}{
+		{"http://example.com/", "http://example.com/"},
+		{"HTTP://Example.COM/Path", "http://example.com/Path"},
+		{"http://example.com/%7Euser", "http://example.com/~user"},
+		{"http://example.com/%7euser", "http://example.com/~user"},
+		{"http://example.com/a%2fb", "http://example.com/a%2Fb"},
+		{"http://example.com/%41%42c", "http://example.com/ABc"},
+		{"http://example.com/D%C3%BCrst", "http://example.com/Dürst"},
+		{"http://example.com/%ef%bf%be", "http://example.com/%EF%BF%BE"},
+		{"http://example.com/%C3", "http://example.com/%C3"},
+		{"http://example.com/a/./b/../c", "http://example.com/a/c"},
+		{"http://example.com/a/b/..", "http://example.com/a/"},
+		{"http://example.com/../a", "http://example.com/a"},
+		{"http://example.com/%2E%2E/a", "http://example.com/a"},
+		{"http://example.com:80/", "http://example.com/"},
+		{"https://example.com:443/", "https://example.com/"},
+		{"http://example.com:/", "http://example.com/"},
+		{"http://example.com:8080/", "http://example.com:8080/"},
+		{"https://example.com:80/", "https://example.com:80/"},
+		{"http://example.com", "http://example.com/"},
+		{"http://example.com?q", "http://example.com/?q"},
+		{"ftp://example.com", "ftp://example.com"},
+		{"http://User@Example.com/", "http://User@example.com/"},
+		{"http://EXAMPLE.com%2Fa/", "http://example.com%2Fa/"},
+		{"http://[FE80::1]/", "http://[fe80::1]/"},
+		{"http://xn--r8jz45g.xn--zckzah/", "http://例え.テスト/"},
+		{"http://XN--R8JZ45G.example/", "http://例え.example/"},
+		{"http://xn--a.example/", "http://xn--a.example/"},
+		{"http://example.com/?%7e=%7E#%7e", "http://example.com/?~=~#~"},
+		{"urn:ex:a/./b", "urn:ex:a/b"},
+		{"URN:ex:ABC", "urn:ex:ABC"},
+		{"relative/./path", "relative/./path"},
+		{"/a/../b", "/b"},
+		{"#frag", "#frag"},
+	} {
+		t.Run(tc.Input, func(t *testing.T) {
+			_a, err := Normalize(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if _e := tc.Expected; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestNormalize_Invalid(t *testing.T) {
+	_, err := Normalize("http://example.com/a b")
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestDecodePunycode(t *testing.T) {
+	for _, tc := range []struct {
+		Input    string
+		Expected string
+	}{
+		{"r8jz45g", "例え"},
+		{"zckzah", "テスト"},
+		{"mnchen-3ya", "münchen"},
+		{"bcher-kva", "bücher"},
+	} {
+		t.Run(tc.Input, func(t *testing.T) {
+			_a, err := decodePunycode(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if _e := tc.Expected; _a != _e {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
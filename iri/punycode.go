@@ -0,0 +1,117 @@
+package iri
+
+import (
+	"errors"
+	"math"
+	"strings"
+)
+
+var errInvalidPunycode = errors.New("invalid punycode")
+
+// decodePunycode decodes a label without its "xn--" prefix according to the algorithm of RFC 3492, Section 6.2.
+func decodePunycode(s string) (string, error) {
+	const (
+		base        = 36
+		tMin        = 1
+		tMax        = 26
+		skew        = 38
+		damp        = 700
+		initialBias = 72
+		initialN    = 128
+	)
+
+	var output []rune
+
+	if i := strings.LastIndexByte(s, '-'); i >= 0 {
+		for j := 0; j < i; j++ {
+			if s[j] >= 0x80 {
+				return "", errInvalidPunycode
+			}
+
+			output = append(output, rune(s[j]))
+		}
+
+		s = s[i+1:]
+	}
+
+	n, bias, i := initialN, initialBias, 0
+
+	for len(s) > 0 {
+		oldi, w := i, 1
+
+		for k := base; ; k += base {
+			if len(s) == 0 {
+				return "", errInvalidPunycode
+			}
+
+			var digit int
+
+			switch c := s[0]; {
+			case 'a' <= c && c <= 'z':
+				digit = int(c - 'a')
+			case 'A' <= c && c <= 'Z':
+				digit = int(c - 'A')
+			case '0' <= c && c <= '9':
+				digit = int(c-'0') + 26
+			default:
+				return "", errInvalidPunycode
+			}
+
+			s = s[1:]
+
+			if digit > (math.MaxInt32-i)/w {
+				return "", errInvalidPunycode
+			}
+
+			i += digit * w
+
+			t := k - bias
+			if t < tMin {
+				t = tMin
+			} else if t > tMax {
+				t = tMax
+			}
+
+			if digit < t {
+				break
+			}
+
+			w *= base - t
+		}
+
+		// adapt
+		delta, numPoints := i-oldi, len(output)+1
+
+		if oldi == 0 {
+			delta /= damp
+		} else {
+			delta /= 2
+		}
+
+		delta += delta / numPoints
+
+		k := 0
+
+		for delta > ((base-tMin)*tMax)/2 {
+			delta /= base - tMin
+			k += base
+		}
+
+		bias = k + (base-tMin+1)*delta/(delta+skew)
+
+		n += i / numPoints
+		i %= numPoints
+
+		if n > math.MaxInt32 {
+			return "", errInvalidPunycode
+		}
+
+		output = append(output, 0)
+		copy(output[i+1:], output[i:])
+		output[i] = rune(n)
+
+		i++
+	}
+
+	return string(output), nil
+}
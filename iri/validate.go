@@ -0,0 +1,368 @@
+package iri
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"strings"
+	"unicode/utf8"
+)
+
+var ErrInvalidIRI = errors.New("invalid IRI")
+
+// ValidateIRI returns an error if the string is not an absolute IRI, including an optional fragment, according to the
+// syntax of [RFC3987], Section 2.2.
+//
+// [RFC3987]: https://www.rfc-editor.org/rfc/rfc3987
+func ValidateIRI(s string) error {
+	c, err := validateIRIReference(s)
+	if err != nil {
+		return err
+	} else if len(c.scheme) == 0 {
+		return fmt.Errorf("%w: missing scheme", ErrInvalidIRI)
+	}
+
+	return nil
+}
+
+// ValidateIRIReference returns an error if the string is neither an IRI nor a relative reference according to the
+// syntax of RFC 3987, Section 2.2.
+func ValidateIRIReference(s string) error {
+	_, err := validateIRIReference(s)
+
+	return err
+}
+
+// IsValidIRI reports whether the string is an absolute IRI.
+func IsValidIRI(s string) bool {
+	return ValidateIRI(s) == nil
+}
+
+func validateIRIReference(s string) (iriComponents, error) {
+	if !utf8.ValidString(s) {
+		return iriComponents{}, fmt.Errorf("%w: invalid UTF-8", ErrInvalidIRI)
+	}
+
+	c := splitIRIReference(s)
+
+	if c.hasScheme && !isScheme(c.scheme) {
+		return iriComponents{}, fmt.Errorf("%w: invalid scheme: %q", ErrInvalidIRI, c.scheme)
+	}
+
+	if c.hasAuthority {
+		if err := validateAuthority(c); err != nil {
+			return iriComponents{}, err
+		}
+	}
+
+	for _, component := range []struct {
+		name  string
+		value string
+		valid func(r rune) bool
+	}{
+		{"path", c.path, isPathRune},
+		{"query", c.query, isQueryRune},
+		{"fragment", c.fragment, isFragmentRune},
+	} {
+		if err := validateRunes(component.name, component.value, component.valid); err != nil {
+			return iriComponents{}, err
+		}
+	}
+
+	if !c.hasScheme && !c.hasAuthority {
+		// ipath-noscheme; a colon in the first segment would have been parsed as a scheme
+		if firstSegment, _, _ := strings.Cut(c.path, "/"); strings.ContainsRune(firstSegment, ':') {
+			return iriComponents{}, fmt.Errorf("%w: colon in first path segment of relative reference", ErrInvalidIRI)
+		}
+	}
+
+	return c, nil
+}
+
+func validateAuthority(c iriComponents) error {
+	if c.hasUserinfo {
+		if err := validateRunes("userinfo", c.userinfo, isUserinfoRune); err != nil {
+			return err
+		}
+	}
+
+	if c.hasPort {
+		for i := 0; i < len(c.port); i++ {
+			if c.port[i] < '0' || c.port[i] > '9' {
+				return fmt.Errorf("%w: invalid port: %q", ErrInvalidIRI, c.port)
+			}
+		}
+	}
+
+	if strings.HasPrefix(c.host, "[") {
+		return validateIPLiteral(c.host)
+	}
+
+	return validateRunes("host", c.host, isRegNameRune)
+}
+
+// IP-literal = "[" ( IPv6address / IPvFuture  ) "]"
+func validateIPLiteral(s string) error {
+	if !strings.HasSuffix(s, "]") {
+		return fmt.Errorf("%w: unterminated IP literal: %q", ErrInvalidIRI, s)
+	}
+
+	literal := s[1 : len(s)-1]
+
+	if len(literal) > 0 && (literal[0] == 'v' || literal[0] == 'V') {
+		// IPvFuture = "v" 1*HEXDIG "." 1*( unreserved / sub-delims / ":" )
+		version, address, ok := strings.Cut(literal[1:], ".")
+		if !ok || len(version) == 0 || len(address) == 0 || !isHexString(version) {
+			return fmt.Errorf("%w: invalid IP literal: %q", ErrInvalidIRI, s)
+		}
+
+		for _, r := range address {
+			if !(r < utf8.RuneSelf && (isUnreservedByte(byte(r)) || isSubDelimByte(byte(r)) || r == ':')) {
+				return fmt.Errorf("%w: invalid IP literal: %q", ErrInvalidIRI, s)
+			}
+		}
+
+		return nil
+	}
+
+	// zone identifiers are not permitted by RFC 3987
+	if addr, err := netip.ParseAddr(literal); err != nil || !addr.Is6() || len(addr.Zone()) > 0 {
+		return fmt.Errorf("%w: invalid IP literal: %q", ErrInvalidIRI, s)
+	}
+
+	return nil
+}
+
+func validateRunes(name, s string, valid func(r rune) bool) error {
+	for i, r := range s {
+		if r == '%' {
+			if i+2 >= len(s) || !isHexByte(s[i+1]) || !isHexByte(s[i+2]) {
+				return fmt.Errorf("%w: invalid percent-encoding in %s at offset %d", ErrInvalidIRI, name, i)
+			}
+
+			continue
+		} else if !valid(r) {
+			return fmt.Errorf("%w: unexpected character in %s: %q", ErrInvalidIRI, name, r)
+		}
+	}
+
+	return nil
+}
+
+//
+
+type iriComponents struct {
+	hasScheme bool
+	scheme    string
+
+	hasAuthority bool
+	hasUserinfo  bool
+	userinfo     string
+	host         string
+	hasPort      bool
+	port         string
+
+	path string
+
+	hasQuery bool
+	query    string
+
+	hasFragment bool
+	fragment    string
+}
+
+// splitIRIReference separates the components of a reference as described by RFC 3986, Appendix B. Components are not
+// validated.
+func splitIRIReference(s string) iriComponents {
+	var c iriComponents
+
+	if i := strings.IndexAny(s, ":/?#"); i > 0 && s[i] == ':' {
+		c.hasScheme = true
+		c.scheme = s[:i]
+		s = s[i+1:]
+	}
+
+	if i := strings.IndexByte(s, '#'); i >= 0 {
+		c.hasFragment = true
+		c.fragment = s[i+1:]
+		s = s[:i]
+	}
+
+	if i := strings.IndexByte(s, '?'); i >= 0 {
+		c.hasQuery = true
+		c.query = s[i+1:]
+		s = s[:i]
+	}
+
+	if authority, ok := strings.CutPrefix(s, "//"); ok {
+		c.hasAuthority = true
+
+		if i := strings.IndexByte(authority, '/'); i >= 0 {
+			c.path = authority[i:]
+			authority = authority[:i]
+		}
+
+		if i := strings.IndexByte(authority, '@'); i >= 0 {
+			c.hasUserinfo = true
+			c.userinfo = authority[:i]
+			authority = authority[i+1:]
+		}
+
+		// the last colon precedes the port, unless it is within an IP literal
+		if i := strings.LastIndexByte(authority, ':'); i >= 0 && i > strings.LastIndexByte(authority, ']') {
+			c.hasPort = true
+			c.port = authority[i+1:]
+			authority = authority[:i]
+		}
+
+		c.host = authority
+	} else {
+		c.path = s
+	}
+
+	return c
+}
+
+func (c iriComponents) String() string {
+	var sb strings.Builder
+
+	if c.hasScheme {
+		sb.WriteString(c.scheme)
+		sb.WriteByte(':')
+	}
+
+	if c.hasAuthority {
+		sb.WriteString("//")
+
+		if c.hasUserinfo {
+			sb.WriteString(c.userinfo)
+			sb.WriteByte('@')
+		}
+
+		sb.WriteString(c.host)
+
+		if c.hasPort {
+			sb.WriteByte(':')
+			sb.WriteString(c.port)
+		}
+	}
+
+	sb.WriteString(c.path)
+
+	if c.hasQuery {
+		sb.WriteByte('?')
+		sb.WriteString(c.query)
+	}
+
+	if c.hasFragment {
+		sb.WriteByte('#')
+		sb.WriteString(c.fragment)
+	}
+
+	return sb.String()
+}
+
+//
+
+// scheme = ALPHA *( ALPHA / DIGIT / "+" / "-" / "." )
+func isScheme(s string) bool {
+	if len(s) == 0 || !isAlphaByte(s[0]) {
+		return false
+	}
+
+	for i := 1; i < len(s); i++ {
+		if !isAlphaByte(s[i]) && !isDigitByte(s[i]) && s[i] != '+' && s[i] != '-' && s[i] != '.' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// iunreserved = ALPHA / DIGIT / "-" / "." / "_" / "~" / ucschar
+func isIUnreservedRune(r rune) bool {
+	if r < utf8.RuneSelf {
+		return isUnreservedByte(byte(r))
+	}
+
+	return isUCSChar(r)
+}
+
+// ipchar = iunreserved / pct-encoded / sub-delims / ":" / "@"
+func isPChar(r rune) bool {
+	return isIUnreservedRune(r) || r < utf8.RuneSelf && isSubDelimByte(byte(r)) || r == ':' || r == '@'
+}
+
+func isPathRune(r rune) bool {
+	return isPChar(r) || r == '/'
+}
+
+// iquery = *( ipchar / iprivate / "/" / "?" )
+func isQueryRune(r rune) bool {
+	return isPChar(r) || isIPrivate(r) || r == '/' || r == '?'
+}
+
+// ifragment = *( ipchar / "/" / "?" )
+func isFragmentRune(r rune) bool {
+	return isPChar(r) || r == '/' || r == '?'
+}
+
+// iuserinfo = *( iunreserved / pct-encoded / sub-delims / ":" )
+func isUserinfoRune(r rune) bool {
+	return isIUnreservedRune(r) || r < utf8.RuneSelf && isSubDelimByte(byte(r)) || r == ':'
+}
+
+// ireg-name = *( iunreserved / pct-encoded / sub-delims )
+func isRegNameRune(r rune) bool {
+	return isIUnreservedRune(r) || r < utf8.RuneSelf && isSubDelimByte(byte(r))
+}
+
+func isUCSChar(r rune) bool {
+	switch {
+	case 0xA0 <= r && r <= 0xD7FF,
+		0xF900 <= r && r <= 0xFDCF,
+		0xFDF0 <= r && r <= 0xFFEF:
+		return true
+	case 0x10000 <= r && r <= 0xEFFFD:
+		// each plane excludes its last two code points
+		return r&0xFFFF <= 0xFFFD
+	}
+
+	return false
+}
+
+func isIPrivate(r rune) bool {
+	return 0xE000 <= r && r <= 0xF8FF || 0xF0000 <= r && r <= 0xFFFFD || 0x100000 <= r && r <= 0x10FFFD
+}
+
+// unreserved = ALPHA / DIGIT / "-" / "." / "_" / "~"
+func isUnreservedByte(b byte) bool {
+	return isAlphaByte(b) || isDigitByte(b) || b == '-' || b == '.' || b == '_' || b == '~'
+}
+
+// sub-delims = "!" / "$" / "&" / "'" / "(" / ")" / "*" / "+" / "," / ";" / "="
+func isSubDelimByte(b byte) bool {
+	return strings.IndexByte("!$&'()*+,;=", b) >= 0
+}
+
+func isAlphaByte(b byte) bool {
+	return 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+func isDigitByte(b byte) bool {
+	return '0' <= b && b <= '9'
+}
+
+func isHexByte(b byte) bool {
+	return isDigitByte(b) || 'a' <= b && b <= 'f' || 'A' <= b && b <= 'F'
+}
+
+func isHexString(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isHexByte(s[i]) {
+			return false
+		}
+	}
+
+	return true
+}
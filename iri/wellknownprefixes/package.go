@@ -0,0 +1,3 @@
+// Package wellknownprefixes is a registry of conventional prefixes for commonly used vocabularies. It includes the
+// widely used initial context of RDFa and other popular vocabularies, such as those listed by https://prefix.cc.
+package wellknownprefixes
@@ -0,0 +1,72 @@
+package wellknownprefixes
+
+import (
+	"github.com/dpb587/rdfkit-go/iri"
+	"github.com/dpb587/rdfkit-go/iri/rdfacontext"
+)
+
+var prefixMappings = rdfacontext.AppendWidelyUsedInitialContext(iri.PrefixMappingList{
+	{Prefix: "acl", Expanded: "http://www.w3.org/ns/auth/acl#"},
+	{Prefix: "adms", Expanded: "http://www.w3.org/ns/adms#"},
+	{Prefix: "bf", Expanded: "http://id.loc.gov/ontologies/bibframe/"},
+	{Prefix: "bibo", Expanded: "http://purl.org/ontology/bibo/"},
+	{Prefix: "cito", Expanded: "http://purl.org/spar/cito/"},
+	{Prefix: "dbo", Expanded: "http://dbpedia.org/ontology/"},
+	{Prefix: "dbp", Expanded: "http://dbpedia.org/property/"},
+	{Prefix: "dbr", Expanded: "http://dbpedia.org/resource/"},
+	{Prefix: "dcam", Expanded: "http://purl.org/dc/dcam/"},
+	{Prefix: "dcmitype", Expanded: "http://purl.org/dc/dcmitype/"},
+	{Prefix: "dcterms", Expanded: "http://purl.org/dc/terms/"},
+	{Prefix: "doap", Expanded: "http://usefulinc.com/ns/doap#"},
+	{Prefix: "earl", Expanded: "http://www.w3.org/ns/earl#"},
+	{Prefix: "event", Expanded: "http://purl.org/NET/c4dm/event.owl#"},
+	{Prefix: "fabio", Expanded: "http://purl.org/spar/fabio/"},
+	{Prefix: "frbr", Expanded: "http://purl.org/vocab/frbr/core#"},
+	{Prefix: "geo", Expanded: "http://www.w3.org/2003/01/geo/wgs84_pos#"},
+	{Prefix: "geosparql", Expanded: "http://www.opengis.net/ont/geosparql#"},
+	{Prefix: "gn", Expanded: "https://www.geonames.org/ontology#"},
+	{Prefix: "hydra", Expanded: "http://www.w3.org/ns/hydra/core#"},
+	{Prefix: "locn", Expanded: "http://www.w3.org/ns/locn#"},
+	{Prefix: "mo", Expanded: "http://purl.org/ontology/mo/"},
+	{Prefix: "obo", Expanded: "http://purl.obolibrary.org/obo/"},
+	{Prefix: "ontolex", Expanded: "http://www.w3.org/ns/lemon/ontolex#"},
+	{Prefix: "qudt", Expanded: "http://qudt.org/schema/qudt/"},
+	{Prefix: "schema", Expanded: "https://schema.org/"},
+	{Prefix: "sh", Expanded: "http://www.w3.org/ns/shacl#"},
+	{Prefix: "unit", Expanded: "http://qudt.org/vocab/unit/"},
+	{Prefix: "vann", Expanded: "http://purl.org/vocab/vann/"},
+	{Prefix: "vs", Expanded: "http://www.w3.org/2003/06/sw-vocab-status/ns#"},
+	{Prefix: "wd", Expanded: "http://www.wikidata.org/entity/"},
+	{Prefix: "wdt", Expanded: "http://www.wikidata.org/prop/direct/"},
+})
+
+var prefixMappingByExpanded = func() map[string]iri.PrefixMapping {
+	m := map[string]iri.PrefixMapping{}
+
+	for _, mapping := range prefixMappings {
+		// the first is preferred, such as dcterms rather than dc
+		if _, known := m[mapping.Expanded]; !known {
+			m[mapping.Expanded] = mapping
+		}
+	}
+
+	return m
+}()
+
+// NewPrefixManager returns a manager of all well-known prefixes. Some namespaces, such as schema.org, are known by
+// both their http and https IRIs; the latter is only available from [LookupExpanded].
+func NewPrefixManager() *iri.PrefixManager {
+	return iri.NewPrefixManager(prefixMappings)
+}
+
+// AppendPrefixMappings appends all well-known prefixes to base.
+func AppendPrefixMappings(base iri.PrefixMappingList) iri.PrefixMappingList {
+	return append(base, prefixMappings...)
+}
+
+// LookupExpanded returns the conventional prefix of a namespace IRI, such as "foaf" for "http://xmlns.com/foaf/0.1/".
+func LookupExpanded(expanded string) (iri.PrefixMapping, bool) {
+	m, ok := prefixMappingByExpanded[expanded]
+
+	return m, ok
+}
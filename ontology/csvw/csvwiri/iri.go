@@ -0,0 +1,23 @@
+package csvwiri
+
+import "github.com/dpb587/rdfkit-go/rdf"
+
+// See: https://www.w3.org/ns/csvw
+
+const (
+	Base rdf.IRI = "http://www.w3.org/ns/csvw#"
+
+	Row_Class        = Base + "Row"
+	Table_Class      = Base + "Table"
+	TableGroup_Class = Base + "TableGroup"
+
+	JSON_Datatype = Base + "JSON"
+
+	Describes_Property = Base + "describes"
+	Note_Property      = Base + "note"
+	Row_Property       = Base + "row"
+	Rownum_Property    = Base + "rownum"
+	Table_Property     = Base + "table"
+	Title_Property     = Base + "title"
+	Url_Property       = Base + "url"
+)
@@ -2,3 +2,11 @@
 package rdfiri
 
 //go:generate go run github.com/dpb587/rdfkit-go/cmd/rdfkit export-go-iri -i https://www.w3.org/1999/02/22-rdf-syntax-ns.ttl -o iri_generated.go
+
+const (
+	// The datatype of directional language-tagged string values. Introduced by RDF 1.2.
+	DirLangString_Datatype = Base + "dirLangString"
+
+	// The subject is a reifier of the triple term in the object. Introduced by RDF 1.2.
+	Reifies_Property = Base + "reifies"
+)
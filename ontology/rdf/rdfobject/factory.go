@@ -5,6 +5,14 @@ import (
 	"github.com/dpb587/rdfkit-go/rdf"
 )
 
+func DirLangString(lang, dir, v string) rdf.ObjectValue {
+	return rdftype.DirLangString{
+		Lang:   lang,
+		Dir:    dir,
+		String: v,
+	}.AsObjectValue()
+}
+
 func HTML(v string) rdf.ObjectValue {
 	return rdftype.HTML(v).AsObjectValue()
 }
@@ -0,0 +1,64 @@
+package rdftype
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+type DirLangString struct {
+	Lang   string
+	Dir    string
+	String string
+}
+
+var _ objecttypes.Value = DirLangString{}
+
+func MapDirLangString(lexicalForm string, lang string, dir string) (DirLangString, error) {
+	if err := langtags.Validate(lang); err != nil {
+		return DirLangString{}, fmt.Errorf("%w: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+	} else if dir != "ltr" && dir != "rtl" {
+		return DirLangString{}, fmt.Errorf("%w: invalid base direction: %q", rdf.ErrLiteralLexicalFormNotValid, dir)
+	}
+
+	return DirLangString{
+		Lang:   lang,
+		Dir:    dir,
+		String: lexicalForm,
+	}, nil
+}
+
+func (v DirLangString) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    rdfiri.DirLangString_Datatype,
+		LexicalForm: v.String,
+		Tag: rdf.DirectionalLanguageLiteralTag{
+			Language:      v.Lang,
+			BaseDirection: v.Dir,
+		},
+	}
+}
+
+func (DirLangString) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v DirLangString) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != rdfiri.DirLangString_Datatype {
+		return false
+	} else if tLiteral.LexicalForm != v.String {
+		return false
+	}
+
+	if langTag, ok := tLiteral.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+		return langTag.Language == v.Lang && langTag.BaseDirection == v.Dir
+	}
+
+	return false
+}
@@ -10,6 +10,11 @@ type HTML string
 
 var _ objecttypes.Value = HTML("")
 
+// MapHTML accepts any lexical form since HTML fragment parsing recovers from all errors.
+func MapHTML(lexicalForm string) (HTML, error) {
+	return HTML(lexicalForm), nil
+}
+
 func (v HTML) AsObjectValue() rdf.ObjectValue {
 	return rdf.Literal{
 		Datatype:    rdfiri.HTML_Datatype,
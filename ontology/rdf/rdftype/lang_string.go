@@ -1,8 +1,11 @@
 package rdftype
 
 import (
+	"fmt"
+
 	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
 	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
 	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
 )
 
@@ -13,6 +16,17 @@ type LangString struct {
 
 var _ objecttypes.Value = LangString{}
 
+func MapLangString(lexicalForm string, lang string) (LangString, error) {
+	if err := langtags.Validate(lang); err != nil {
+		return LangString{}, fmt.Errorf("%w: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+	}
+
+	return LangString{
+		Lang:   lang,
+		String: lexicalForm,
+	}, nil
+}
+
 func (v LangString) AsObjectValue() rdf.ObjectValue {
 	return rdf.Literal{
 		Datatype:    rdfiri.LangString_Datatype,
@@ -7,6 +7,7 @@ import "github.com/dpb587/rdfkit-go/rdf"
 const (
 	Base rdf.IRI = "http://www.w3.org/2001/XMLSchema#"
 
+	AnyAtomicType_Datatype      = Base + "anyAtomicType"      // VALUE
 	AnyURI_Datatype             = Base + "anyURI"             // VALUE
 	Base64Binary_Datatype       = Base + "base64Binary"       // VALUE
 	Boolean_Datatype            = Base + "boolean"            // VALUE
@@ -14,7 +15,7 @@ const (
 	Date_Datatype               = Base + "date"               // VALUE
 	DateTime_Datatype           = Base + "dateTime"           // VALUE
 	DateTimeStamp_Datatype      = Base + "dateTimeStamp"      // VALUE
-	DayTimeDuration_Datatype    = Base + "dayTimeDuration"    //
+	DayTimeDuration_Datatype    = Base + "dayTimeDuration"    // VALUE
 	Decimal_Datatype            = Base + "decimal"            // VALUE
 	Double_Datatype             = Base + "double"             // VALUE
 	Duration_Datatype           = Base + "duration"           //
@@ -30,25 +31,25 @@ const (
 	IDREF_Datatype              = Base + "IDREF"              //
 	Int_Datatype                = Base + "int"                //
 	Integer_Datatype            = Base + "integer"            // VALUE
-	Language_Datatype           = Base + "language"           //
+	Language_Datatype           = Base + "language"           // VALUE
 	Long_Datatype               = Base + "long"               // VALUE
-	Name_Datatype               = Base + "Name"               //
-	NCName_Datatype             = Base + "NCName"             //
-	NegativeInteger_Datatype    = Base + "negativeInteger"    //
-	NMTOKEN_Datatype            = Base + "NMTOKEN"            //
-	NonNegativeInteger_Datatype = Base + "nonNegativeInteger" //
-	NonPositiveInteger_Datatype = Base + "nonPositiveInteger" //
-	NormalizedString_Datatype   = Base + "normalizedString"   //
+	Name_Datatype               = Base + "Name"               // VALUE
+	NCName_Datatype             = Base + "NCName"             // VALUE
+	NegativeInteger_Datatype    = Base + "negativeInteger"    // VALUE
+	NMTOKEN_Datatype            = Base + "NMTOKEN"            // VALUE
+	NonNegativeInteger_Datatype = Base + "nonNegativeInteger" // VALUE
+	NonPositiveInteger_Datatype = Base + "nonPositiveInteger" // VALUE
+	NormalizedString_Datatype   = Base + "normalizedString"   // VALUE
 	NOTATION_Datatype           = Base + "NOTATION"           //
-	PositiveInteger_Datatype    = Base + "positiveInteger"    //
+	PositiveInteger_Datatype    = Base + "positiveInteger"    // VALUE
 	QName_Datatype              = Base + "QName"              //
 	Short_Datatype              = Base + "short"              // VALUE
 	String_Datatype             = Base + "string"             // VALUE
 	Time_Datatype               = Base + "time"               // VALUE
-	Token_Datatype              = Base + "token"              //
+	Token_Datatype              = Base + "token"              // VALUE
 	UnsignedByte_Datatype       = Base + "unsignedByte"       //
 	UnsignedInt_Datatype        = Base + "unsignedInt"        //
 	UnsignedLong_Datatype       = Base + "unsignedLong"       //
 	UnsignedShort_Datatype      = Base + "unsignedShort"      //
-	YearMonthDuration_Datatype  = Base + "yearMonthDuration"  //
+	YearMonthDuration_Datatype  = Base + "yearMonthDuration"  // VALUE
 )
@@ -1,16 +1,29 @@
 package xsdobject
 
 import (
+	"math/big"
 	"time"
 
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdtype"
 	"github.com/dpb587/rdfkit-go/rdf"
 )
 
+func AnyAtomicType(v string) rdf.ObjectValue {
+	return xsdtype.AnyAtomicType(v).AsObjectValue()
+}
+
 func AnyURI(v string) rdf.ObjectValue {
 	return xsdtype.AnyURI(v).AsObjectValue()
 }
 
+func BigDecimal(v *big.Rat) rdf.ObjectValue {
+	return xsdtype.BigDecimal{Rat: v}.AsObjectValue()
+}
+
+func BigInteger(v *big.Int) rdf.ObjectValue {
+	return xsdtype.BigInteger{Int: v}.AsObjectValue()
+}
+
 func Base64Binary(v []byte) rdf.ObjectValue {
 	return xsdtype.Base64Binary(v).AsObjectValue()
 }
@@ -44,6 +57,10 @@ func Date(layout string, value time.Time) rdf.ObjectValue {
 	}.AsObjectValue()
 }
 
+func DayTimeDuration(v xsdtype.DayTimeDuration) rdf.ObjectValue {
+	return v.AsObjectValue()
+}
+
 // TODO Duration
 
 func Decimal(v float64) rdf.ObjectValue {
@@ -105,10 +122,46 @@ func Integer(v int64) rdf.ObjectValue {
 	return xsdtype.Integer(v).AsObjectValue()
 }
 
+func Language(v string) rdf.ObjectValue {
+	return xsdtype.Language(v).AsObjectValue()
+}
+
 func Long(v int64) rdf.ObjectValue {
 	return xsdtype.Long(v).AsObjectValue()
 }
 
+func Name(v string) rdf.ObjectValue {
+	return xsdtype.Name(v).AsObjectValue()
+}
+
+func NCName(v string) rdf.ObjectValue {
+	return xsdtype.NCName(v).AsObjectValue()
+}
+
+func NegativeInteger(v *big.Int) rdf.ObjectValue {
+	return xsdtype.NegativeInteger{Int: v}.AsObjectValue()
+}
+
+func NMTOKEN(v string) rdf.ObjectValue {
+	return xsdtype.NMTOKEN(v).AsObjectValue()
+}
+
+func NonNegativeInteger(v *big.Int) rdf.ObjectValue {
+	return xsdtype.NonNegativeInteger{Int: v}.AsObjectValue()
+}
+
+func NonPositiveInteger(v *big.Int) rdf.ObjectValue {
+	return xsdtype.NonPositiveInteger{Int: v}.AsObjectValue()
+}
+
+func NormalizedString(v string) rdf.ObjectValue {
+	return xsdtype.NormalizedString(v).AsObjectValue()
+}
+
+func PositiveInteger(v *big.Int) rdf.ObjectValue {
+	return xsdtype.PositiveInteger{Int: v}.AsObjectValue()
+}
+
 func Short(v int16) rdf.ObjectValue {
 	return xsdtype.Short(v).AsObjectValue()
 }
@@ -124,6 +177,10 @@ func Time(layout string, value time.Time) rdf.ObjectValue {
 	}.AsObjectValue()
 }
 
+func Token(v string) rdf.ObjectValue {
+	return xsdtype.Token(v).AsObjectValue()
+}
+
 func UnsignedByte(v uint8) rdf.ObjectValue {
 	return xsdtype.UnsignedByte(v).AsObjectValue()
 }
@@ -139,3 +196,7 @@ func UnsignedLong(v uint64) rdf.ObjectValue {
 func UnsignedShort(v uint16) rdf.ObjectValue {
 	return xsdtype.UnsignedShort(v).AsObjectValue()
 }
+
+func YearMonthDuration(v xsdtype.YearMonthDuration) rdf.ObjectValue {
+	return v.AsObjectValue()
+}
@@ -5,6 +5,15 @@ import (
 	"github.com/dpb587/rdfkit-go/rdf"
 )
 
+func MapAnyAtomicType(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapAnyAtomicType(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
 func MapAnyURI(lexicalForm string) (rdf.ObjectValue, error) {
 	v, err := xsdtype.MapAnyURI(lexicalForm)
 	if err != nil {
@@ -68,6 +77,15 @@ func MapDate(lexicalForm string) (rdf.ObjectValue, error) {
 	return v.AsObjectValue(), nil
 }
 
+func MapDayTimeDuration(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapDayTimeDuration(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
 func MapDuration(lexicalForm string) (rdf.ObjectValue, error) {
 	v, err := xsdtype.MapDuration(lexicalForm)
 	if err != nil {
@@ -78,7 +96,7 @@ func MapDuration(lexicalForm string) (rdf.ObjectValue, error) {
 }
 
 func MapDecimal(lexicalForm string) (rdf.ObjectValue, error) {
-	v, err := xsdtype.MapDecimal(lexicalForm)
+	v, err := xsdtype.MapBigDecimal(lexicalForm)
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +186,16 @@ func MapInt(lexicalForm string) (rdf.ObjectValue, error) {
 }
 
 func MapInteger(lexicalForm string) (rdf.ObjectValue, error) {
-	v, err := xsdtype.MapInteger(lexicalForm)
+	v, err := xsdtype.MapBigInteger(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapLanguage(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapLanguage(lexicalForm)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +212,78 @@ func MapLong(lexicalForm string) (rdf.ObjectValue, error) {
 	return v.AsObjectValue(), nil
 }
 
+func MapName(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapName(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapNCName(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapNCName(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapNegativeInteger(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapNegativeInteger(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapNMTOKEN(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapNMTOKEN(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapNonNegativeInteger(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapNonNegativeInteger(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapNonPositiveInteger(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapNonPositiveInteger(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapNormalizedString(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapNormalizedString(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
+func MapPositiveInteger(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapPositiveInteger(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
 func MapShort(lexicalForm string) (rdf.ObjectValue, error) {
 	v, err := xsdtype.MapShort(lexicalForm)
 	if err != nil {
@@ -212,6 +311,15 @@ func MapTime(lexicalForm string) (rdf.ObjectValue, error) {
 	return v.AsObjectValue(), nil
 }
 
+func MapToken(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapToken(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
+
 func MapUnsignedByte(lexicalForm string) (rdf.ObjectValue, error) {
 	v, err := xsdtype.MapUnsignedByte(lexicalForm)
 	if err != nil {
@@ -247,3 +355,12 @@ func MapUnsignedShort(lexicalForm string) (rdf.ObjectValue, error) {
 
 	return v.AsObjectValue(), nil
 }
+
+func MapYearMonthDuration(lexicalForm string) (rdf.ObjectValue, error) {
+	v, err := xsdtype.MapYearMonthDuration(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.AsObjectValue(), nil
+}
@@ -0,0 +1,39 @@
+package xsdtype
+
+import (
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// AnyAtomicType is the base type of all primitive datatypes. Its lexical space is the union of theirs, so any string
+// is accepted and preserved as-is; prefer a more specific type when the primitive datatype is known.
+type AnyAtomicType string
+
+var _ objecttypes.Value = AnyAtomicType("")
+
+func MapAnyAtomicType(lexicalForm string) (AnyAtomicType, error) {
+	return AnyAtomicType(lexicalForm), nil
+}
+
+func (v AnyAtomicType) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.AnyAtomicType_Datatype,
+		LexicalForm: string(v),
+	}
+}
+
+func (AnyAtomicType) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v AnyAtomicType) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.AnyAtomicType_Datatype {
+		return false
+	}
+
+	return tLiteral.LexicalForm == string(v)
+}
@@ -0,0 +1,140 @@
+package xsdtype
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// BigDecimalMaxFractionDigits is the number of fraction digits used when a [BigDecimal] does not have a finite decimal
+// representation (e.g. 1/3). Values produced by [MapBigDecimal] are always finite.
+const BigDecimalMaxFractionDigits = 32
+
+// BigDecimal is an arbitrary-precision alternative to [Decimal]. A nil Rat is equivalent to zero.
+type BigDecimal struct {
+	Rat *big.Rat
+}
+
+var _ objecttypes.Value = BigDecimal{}
+
+func MapBigDecimal(lexicalForm string) (BigDecimal, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	if !isDecimalLexicalForm(lexicalForm) {
+		return BigDecimal{}, fmt.Errorf("%w: invalid decimal: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	vRat, ok := new(big.Rat).SetString(lexicalForm)
+	if !ok {
+		return BigDecimal{}, fmt.Errorf("%w: invalid decimal: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	return BigDecimal{
+		Rat: vRat,
+	}, nil
+}
+
+// CanonicalLexicalForm returns the form without leading or trailing zeros or a positive sign. Integral values do not
+// include a decimal point, per XML Schema 1.1.
+func (v BigDecimal) CanonicalLexicalForm() string {
+	if v.Rat == nil {
+		return "0"
+	} else if v.Rat.IsInt() {
+		return v.Rat.Num().String()
+	}
+
+	fractionDigits, exact := decimalFractionDigits(v.Rat.Denom())
+	if !exact {
+		fractionDigits = BigDecimalMaxFractionDigits
+	}
+
+	formatted := v.Rat.FloatString(fractionDigits)
+
+	if !exact {
+		formatted = strings.TrimRight(formatted, "0")
+		formatted = strings.TrimSuffix(formatted, ".")
+
+		if formatted == "-0" {
+			formatted = "0"
+		}
+	}
+
+	return formatted
+}
+
+func (v BigDecimal) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.Decimal_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (BigDecimal) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v BigDecimal) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.Decimal_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
+
+// decimalFractionDigits returns the number of fraction digits needed to exactly represent a value with the denominator.
+// It is only exact when the denominator has no prime factors other than 2 and 5.
+func decimalFractionDigits(denom *big.Int) (int, bool) {
+	var twos, fives int
+
+	remaining := new(big.Int).Set(denom)
+	remainder := new(big.Int)
+	five := big.NewInt(5)
+
+	for remaining.Sign() > 0 && remaining.Bit(0) == 0 {
+		remaining.Rsh(remaining, 1)
+		twos++
+	}
+
+	for {
+		quotient, _ := new(big.Int).QuoRem(remaining, five, remainder)
+		if remainder.Sign() != 0 {
+			break
+		}
+
+		remaining = quotient
+		fives++
+	}
+
+	return max(twos, fives), remaining.Cmp(big.NewInt(1)) == 0
+}
+
+// isDecimalLexicalForm matches `(\+|-)?([0-9]+(\.[0-9]*)?|\.[0-9]+)`.
+func isDecimalLexicalForm(v string) bool {
+	if len(v) > 0 && (v[0] == '-' || v[0] == '+') {
+		v = v[1:]
+	}
+
+	var digits int
+	var foundPoint bool
+
+	for i := 0; i < len(v); i++ {
+		switch {
+		case v[i] >= '0' && v[i] <= '9':
+			digits++
+		case v[i] == '.' && !foundPoint:
+			foundPoint = true
+		default:
+			return false
+		}
+	}
+
+	return digits > 0
+}
@@ -0,0 +1,74 @@
+package xsdtype
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestMapBigDecimal(t *testing.T) {
+	for _, tc := range []struct {
+		InputString  string
+		OutputString string
+		Error        string
+	}{
+		{
+			InputString:  "12345678901234567890.12345678901234567890",
+			OutputString: "12345678901234567890.1234567890123456789",
+		},
+		{
+			InputString:  "+0100.500",
+			OutputString: "100.5",
+		},
+		{
+			InputString:  "-.25",
+			OutputString: "-0.25",
+		},
+		{
+			InputString:  "3.",
+			OutputString: "3",
+		},
+		{
+			InputString:  "-0.0",
+			OutputString: "0",
+		},
+		{
+			InputString: "1e5",
+			Error:       `literal lexical form not valid: invalid decimal: "1e5"`,
+		},
+		{
+			InputString: "1/3",
+			Error:       `literal lexical form not valid: invalid decimal: "1/3"`,
+		},
+		{
+			InputString: ".",
+			Error:       `literal lexical form not valid: invalid decimal: "."`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			v, err := MapBigDecimal(tc.InputString)
+			if err != nil {
+				if len(tc.Error) == 0 || err.Error() != tc.Error {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.Error) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputString, v.CanonicalLexicalForm(); _e != _a {
+				t.Fatalf("expected %q, but got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestBigDecimal_CanonicalLexicalForm_Inexact(t *testing.T) {
+	if _e, _a := "0.33333333333333333333333333333333", (BigDecimal{Rat: big.NewRat(1, 3)}).CanonicalLexicalForm(); _e != _a {
+		t.Fatalf("expected %q, but got %q", _e, _a)
+	}
+
+	if _e, _a := "-0.125", (BigDecimal{Rat: big.NewRat(-1, 8)}).CanonicalLexicalForm(); _e != _a {
+		t.Fatalf("expected %q, but got %q", _e, _a)
+	}
+}
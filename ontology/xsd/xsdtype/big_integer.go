@@ -0,0 +1,104 @@
+package xsdtype
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// BigInteger is an arbitrary-precision alternative to [Integer]. A nil Int is equivalent to zero.
+type BigInteger struct {
+	Int *big.Int
+}
+
+var _ objecttypes.Value = BigInteger{}
+
+func MapBigInteger(lexicalForm string) (BigInteger, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	if !isIntegerLexicalForm(lexicalForm) {
+		return BigInteger{}, fmt.Errorf("%w: invalid integer: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	vInt, ok := new(big.Int).SetString(lexicalForm, 10)
+	if !ok {
+		return BigInteger{}, fmt.Errorf("%w: invalid integer: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	return BigInteger{
+		Int: vInt,
+	}, nil
+}
+
+// CanonicalLexicalForm returns the form without leading zeros or a positive sign.
+func (v BigInteger) CanonicalLexicalForm() string {
+	return canonicalBigIntegerLexicalForm(v.Int)
+}
+
+func (v BigInteger) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.Integer_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (BigInteger) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v BigInteger) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.Integer_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
+
+// isIntegerLexicalForm matches `[\-+]?[0-9]+`.
+func isIntegerLexicalForm(v string) bool {
+	if len(v) > 0 && (v[0] == '-' || v[0] == '+') {
+		v = v[1:]
+	}
+
+	if len(v) == 0 {
+		return false
+	}
+
+	for i := 0; i < len(v); i++ {
+		if v[i] < '0' || v[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mapBoundedBigInteger maps an integer lexical form and enforces the minInclusive/maxInclusive facets of a derived
+// type, which are all expressed in terms of the sign of the value.
+func mapBoundedBigInteger(lexicalForm string, name string, validSign func(sign int) bool) (*big.Int, error) {
+	v, err := MapBigInteger(lexicalForm)
+	if err != nil {
+		return nil, err
+	}
+
+	if !validSign(v.Int.Sign()) {
+		return nil, fmt.Errorf("%w: invalid %s: %q", rdf.ErrLiteralLexicalFormNotValid, name, xsdutil.WhiteSpaceCollapse(lexicalForm))
+	}
+
+	return v.Int, nil
+}
+
+func canonicalBigIntegerLexicalForm(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+
+	return v.String()
+}
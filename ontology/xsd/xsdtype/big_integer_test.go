@@ -0,0 +1,135 @@
+package xsdtype
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestMapBigInteger(t *testing.T) {
+	for _, tc := range []struct {
+		InputString  string
+		OutputString string
+		Error        string
+	}{
+		{
+			InputString:  "123456789012345678901234567890",
+			OutputString: "123456789012345678901234567890",
+		},
+		{
+			InputString:  " +007 ",
+			OutputString: "7",
+		},
+		{
+			InputString:  "-0",
+			OutputString: "0",
+		},
+		{
+			InputString: "1.0",
+			Error:       `literal lexical form not valid: invalid integer: "1.0"`,
+		},
+		{
+			InputString: "0x10",
+			Error:       `literal lexical form not valid: invalid integer: "0x10"`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			v, err := MapBigInteger(tc.InputString)
+			if err != nil {
+				if len(tc.Error) == 0 || err.Error() != tc.Error {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.Error) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputString, v.CanonicalLexicalForm(); _e != _a {
+				t.Fatalf("expected %q, but got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func TestMapBigInteger_Derived(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		Mapper       func(string) (rdf.Literal, error)
+		InputString  string
+		OutputString string
+		Error        string
+	}{
+		{
+			Name:         "nonNegativeInteger zero",
+			Mapper:       mapLiteral(MapNonNegativeInteger),
+			InputString:  "-0",
+			OutputString: "0",
+		},
+		{
+			Name:        "nonNegativeInteger negative",
+			Mapper:      mapLiteral(MapNonNegativeInteger),
+			InputString: "-1",
+			Error:       `literal lexical form not valid: invalid nonNegativeInteger: "-1"`,
+		},
+		{
+			Name:         "positiveInteger",
+			Mapper:       mapLiteral(MapPositiveInteger),
+			InputString:  "+0012345678901234567890",
+			OutputString: "12345678901234567890",
+		},
+		{
+			Name:        "positiveInteger zero",
+			Mapper:      mapLiteral(MapPositiveInteger),
+			InputString: "0",
+			Error:       `literal lexical form not valid: invalid positiveInteger: "0"`,
+		},
+		{
+			Name:         "negativeInteger",
+			Mapper:       mapLiteral(MapNegativeInteger),
+			InputString:  "-01",
+			OutputString: "-1",
+		},
+		{
+			Name:        "negativeInteger zero",
+			Mapper:      mapLiteral(MapNegativeInteger),
+			InputString: "-0",
+			Error:       `literal lexical form not valid: invalid negativeInteger: "-0"`,
+		},
+		{
+			Name:         "nonPositiveInteger zero",
+			Mapper:       mapLiteral(MapNonPositiveInteger),
+			InputString:  "+0",
+			OutputString: "0",
+		},
+		{
+			Name:        "nonPositiveInteger positive",
+			Mapper:      mapLiteral(MapNonPositiveInteger),
+			InputString: "1",
+			Error:       `literal lexical form not valid: invalid nonPositiveInteger: "1"`,
+		},
+		{
+			Name:        "nonPositiveInteger invalid",
+			Mapper:      mapLiteral(MapNonPositiveInteger),
+			InputString: "-1.0",
+			Error:       `literal lexical form not valid: invalid integer: "-1.0"`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			v, err := tc.Mapper(tc.InputString)
+			if err != nil {
+				if len(tc.Error) == 0 || err.Error() != tc.Error {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.Error) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputString, v.LexicalForm; _e != _a {
+				t.Fatalf("expected %q, but got %q", _e, _a)
+			}
+		})
+	}
+}
@@ -0,0 +1,286 @@
+package xsdtype
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+var floatingPointValidRE = regexp.MustCompile(`^([+-]?([0-9]+(\.[0-9]*)?|\.[0-9]+)([Ee][+-]?[0-9]+)?|[+-]?INF|NaN)$`)
+
+// CanonicalLiteral returns the canonical representation of a literal so that literals of equal values from different
+// sources become identical terms. For example, `"+01"^^xsd:integer` becomes `"1"^^xsd:integer` and `"1E2"^^xsd:double`
+// becomes `"1.0E2"^^xsd:double`. Language tags of rdf:langString and rdf:dirLangString are compared
+// case-insensitively, so their canonical form is entirely lower case (for example, "en-us"), as in canonical N-Triples.
+// This differs from the mixed case of [github.com/dpb587/rdfkit-go/rdf/langtags.Normalize] (for example, "en-US"),
+// which is intended for display.
+//
+// The datatype is never changed. Literals of unsupported datatypes or with invalid lexical forms are returned as-is.
+func CanonicalLiteral(l rdf.Literal) rdf.Literal {
+	canonical, ok := canonicalLiteral(l)
+	if !ok {
+		return l
+	}
+
+	return canonical
+}
+
+func canonicalLiteral(l rdf.Literal) (rdf.Literal, bool) {
+	var lexicalForm string
+
+	switch l.Datatype {
+	case xsdiri.Integer_Datatype:
+		return canonicalValueLiteral(MapBigInteger(l.LexicalForm))
+	case xsdiri.NonNegativeInteger_Datatype:
+		return canonicalValueLiteral(MapNonNegativeInteger(l.LexicalForm))
+	case xsdiri.PositiveInteger_Datatype:
+		return canonicalValueLiteral(MapPositiveInteger(l.LexicalForm))
+	case xsdiri.NegativeInteger_Datatype:
+		return canonicalValueLiteral(MapNegativeInteger(l.LexicalForm))
+	case xsdiri.NonPositiveInteger_Datatype:
+		return canonicalValueLiteral(MapNonPositiveInteger(l.LexicalForm))
+	case xsdiri.Long_Datatype:
+		return canonicalValueLiteral(MapLong(l.LexicalForm))
+	case xsdiri.Int_Datatype:
+		return canonicalValueLiteral(MapInt(l.LexicalForm))
+	case xsdiri.Short_Datatype:
+		return canonicalValueLiteral(MapShort(l.LexicalForm))
+	case xsdiri.Byte_Datatype:
+		return canonicalValueLiteral(MapByte(l.LexicalForm))
+	case xsdiri.UnsignedLong_Datatype:
+		return canonicalValueLiteral(MapUnsignedLong(l.LexicalForm))
+	case xsdiri.UnsignedInt_Datatype:
+		return canonicalValueLiteral(MapUnsignedInt(l.LexicalForm))
+	case xsdiri.UnsignedShort_Datatype:
+		return canonicalValueLiteral(MapUnsignedShort(l.LexicalForm))
+	case xsdiri.UnsignedByte_Datatype:
+		return canonicalValueLiteral(MapUnsignedByte(l.LexicalForm))
+	case xsdiri.Decimal_Datatype:
+		return canonicalValueLiteral(MapBigDecimal(l.LexicalForm))
+	case xsdiri.Boolean_Datatype:
+		return canonicalValueLiteral(MapBoolean(l.LexicalForm))
+	case xsdiri.String_Datatype:
+		return canonicalValueLiteral(MapString(l.LexicalForm))
+	case xsdiri.NormalizedString_Datatype:
+		return canonicalValueLiteral(MapNormalizedString(l.LexicalForm))
+	case xsdiri.Token_Datatype:
+		return canonicalValueLiteral(MapToken(l.LexicalForm))
+	case xsdiri.Language_Datatype:
+		return canonicalValueLiteral(MapLanguage(l.LexicalForm))
+	case xsdiri.Name_Datatype:
+		return canonicalValueLiteral(MapName(l.LexicalForm))
+	case xsdiri.NCName_Datatype:
+		return canonicalValueLiteral(MapNCName(l.LexicalForm))
+	case xsdiri.NMTOKEN_Datatype:
+		return canonicalValueLiteral(MapNMTOKEN(l.LexicalForm))
+	case xsdiri.AnyURI_Datatype:
+		return canonicalValueLiteral(MapAnyURI(l.LexicalForm))
+	case xsdiri.DayTimeDuration_Datatype:
+		return canonicalValueLiteral(MapDayTimeDuration(l.LexicalForm))
+	case xsdiri.YearMonthDuration_Datatype:
+		return canonicalValueLiteral(MapYearMonthDuration(l.LexicalForm))
+	case xsdiri.GYear_Datatype:
+		return canonicalValueLiteral(MapGYear(l.LexicalForm))
+	case xsdiri.GYearMonth_Datatype:
+		return canonicalValueLiteral(MapGYearMonth(l.LexicalForm))
+	case xsdiri.GMonth_Datatype:
+		return canonicalValueLiteral(MapGMonth(l.LexicalForm))
+	case xsdiri.GMonthDay_Datatype:
+		return canonicalValueLiteral(MapGMonthDay(l.LexicalForm))
+	case xsdiri.GDay_Datatype:
+		return canonicalValueLiteral(MapGDay(l.LexicalForm))
+	case xsdiri.Double_Datatype, xsdiri.Float_Datatype:
+		bitSize := 64
+		if l.Datatype == xsdiri.Float_Datatype {
+			bitSize = 32
+		}
+
+		v, ok := mapFloatingPoint(l.LexicalForm, bitSize)
+		if !ok {
+			return l, false
+		}
+
+		lexicalForm = canonicalFloatingPointLexicalForm(v, bitSize)
+	case xsdiri.DateTime_Datatype:
+		v, err := MapDateTime(l.LexicalForm)
+		if err != nil {
+			return l, false
+		}
+
+		lexicalForm = canonicalDateTimeLexicalForm(v.Time, v.Layout, "2006-01-02T15:04:05.999999999")
+	case xsdiri.DateTimeStamp_Datatype:
+		v, err := MapDateTimeStamp(l.LexicalForm)
+		if err != nil {
+			return l, false
+		}
+
+		lexicalForm = canonicalDateTimeLexicalForm(v.Time, v.Layout, "2006-01-02T15:04:05.999999999")
+	case xsdiri.Date_Datatype:
+		v, err := MapDate(l.LexicalForm)
+		if err != nil {
+			return l, false
+		}
+
+		lexicalForm = canonicalDateTimeLexicalForm(v.Time, v.Layout, "2006-01-02")
+	case xsdiri.Time_Datatype:
+		v, err := MapTime(l.LexicalForm)
+		if err != nil {
+			return l, false
+		}
+
+		lexicalForm = canonicalDateTimeLexicalForm(v.Time, v.Layout, "15:04:05.999999999")
+	case xsdiri.Duration_Datatype:
+		v, err := MapDuration(l.LexicalForm)
+		if err != nil {
+			return l, false
+		}
+
+		var ok bool
+
+		lexicalForm, ok = canonicalDurationLexicalForm(v)
+		if !ok {
+			return l, false
+		}
+	case xsdiri.HexBinary_Datatype:
+		v, err := hex.DecodeString(xsdutil.WhiteSpaceCollapse(l.LexicalForm))
+		if err != nil {
+			return l, false
+		}
+
+		lexicalForm = strings.ToUpper(hex.EncodeToString(v))
+	case xsdiri.Base64Binary_Datatype:
+		v, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(xsdutil.WhiteSpaceCollapse(l.LexicalForm), " ", ""))
+		if err != nil {
+			return l, false
+		}
+
+		lexicalForm = base64.StdEncoding.EncodeToString(v)
+	case rdfiri.LangString_Datatype:
+		tag, ok := l.Tag.(rdf.LanguageLiteralTag)
+		if !ok {
+			return l, false
+		}
+
+		tag.Language = strings.ToLower(tag.Language)
+		l.Tag = tag
+
+		return l, true
+	case rdfiri.DirLangString_Datatype:
+		tag, ok := l.Tag.(rdf.DirectionalLanguageLiteralTag)
+		if !ok {
+			return l, false
+		}
+
+		tag.Language = strings.ToLower(tag.Language)
+		l.Tag = tag
+
+		return l, true
+	default:
+		return l, false
+	}
+
+	return rdf.Literal{
+		Datatype:    l.Datatype,
+		LexicalForm: lexicalForm,
+	}, true
+}
+
+func canonicalValueLiteral[T objecttypes.Value](v T, err error) (rdf.Literal, bool) {
+	if err != nil {
+		return rdf.Literal{}, false
+	}
+
+	return v.AsObjectValue().(rdf.Literal), true
+}
+
+func mapFloatingPoint(lexicalForm string, bitSize int) (float64, bool) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	if !floatingPointValidRE.MatchString(lexicalForm) {
+		return 0, false
+	}
+
+	// values out of range are rounded to an infinity, which is acceptable for XSD
+	v, err := strconv.ParseFloat(lexicalForm, bitSize)
+	if err != nil && !math.IsInf(v, 0) {
+		return 0, false
+	}
+
+	return v, true
+}
+
+// canonicalFloatingPointLexicalForm uses the scientific notation of XSD 1.1, such as 1.0E0 or -1.25E-3.
+func canonicalFloatingPointLexicalForm(v float64, bitSize int) string {
+	switch {
+	case math.IsNaN(v):
+		return "NaN"
+	case math.IsInf(v, 1):
+		return "INF"
+	case math.IsInf(v, -1):
+		return "-INF"
+	case v == 0:
+		if math.Signbit(v) {
+			return "-0.0E0"
+		}
+
+		return "0.0E0"
+	}
+
+	mantissa, exponent, _ := strings.Cut(strconv.FormatFloat(v, 'E', -1, bitSize), "E")
+	if !strings.Contains(mantissa, ".") {
+		mantissa += ".0"
+	}
+
+	exponentInt, _ := strconv.Atoi(exponent)
+
+	return mantissa + "E" + strconv.Itoa(exponentInt)
+}
+
+// canonicalDateTimeLexicalForm uses the minimum fractional seconds and represents a zero timezone offset as Z.
+func canonicalDateTimeLexicalForm(t time.Time, layout string, canonicalLayout string) string {
+	if strings.Contains(layout, "Z") {
+		canonicalLayout += "Z07:00"
+	}
+
+	return t.Format(canonicalLayout)
+}
+
+// canonicalDurationLexicalForm normalizes the duration into years and months and days and time. Durations with
+// fractional components other than seconds are not supported.
+func canonicalDurationLexicalForm(v Duration) (string, bool) {
+	for _, f := range []float64{v.Years, v.Months, v.Days, v.Hours, v.Minutes} {
+		if f != math.Trunc(f) {
+			return "", false
+		}
+	}
+
+	years, months := normalizeYearMonthDuration(int64(v.Years), int64(v.Months))
+	days, hours, minutes, seconds := normalizeDayTimeDuration(int64(v.Days), int64(v.Hours), int64(v.Minutes), v.Seconds)
+
+	if years == 0 && months == 0 && days == 0 && hours == 0 && minutes == 0 && seconds == 0 {
+		return "PT0S", true
+	}
+
+	out := &strings.Builder{}
+
+	if v.Negative {
+		out.WriteByte('-')
+	}
+
+	out.WriteByte('P')
+
+	writeYearMonthDurationFragment(out, years, months)
+	writeDayTimeDurationFragment(out, days, hours, minutes, seconds)
+
+	return out.String(), true
+}
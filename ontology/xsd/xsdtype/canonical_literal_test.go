@@ -0,0 +1,127 @@
+package xsdtype
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestCanonicalLiteral(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		InputLiteral  rdf.Literal
+		OutputLiteral rdf.Literal
+	}{
+		{
+			Name:          "integer",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: " +0012 "},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "12"},
+		},
+		{
+			Name:          "integer invalid",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "1.0"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "1.0"},
+		},
+		{
+			Name:          "unsignedShort",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.UnsignedShort_Datatype, LexicalForm: "007"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.UnsignedShort_Datatype, LexicalForm: "7"},
+		},
+		{
+			Name:          "positiveInteger out of range",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.PositiveInteger_Datatype, LexicalForm: "0"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.PositiveInteger_Datatype, LexicalForm: "0"},
+		},
+		{
+			Name:          "decimal",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Decimal_Datatype, LexicalForm: "01.500"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Decimal_Datatype, LexicalForm: "1.5"},
+		},
+		{
+			Name:          "double",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "100"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "1.0E2"},
+		},
+		{
+			Name:          "double fraction",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "-0.00125"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "-1.25E-3"},
+		},
+		{
+			Name:          "double special",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "+INF"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "INF"},
+		},
+		{
+			Name:          "double invalid",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "0x1p-2"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "0x1p-2"},
+		},
+		{
+			Name:          "float",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Float_Datatype, LexicalForm: "0.1"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Float_Datatype, LexicalForm: "1.0E-1"},
+		},
+		{
+			Name:          "boolean",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Boolean_Datatype, LexicalForm: "1"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Boolean_Datatype, LexicalForm: "true"},
+		},
+		{
+			Name:          "dateTime",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T12:00:00.500+00:00"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T12:00:00.5Z"},
+		},
+		{
+			Name:          "dateTime without timezone",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T12:00:00.000"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T12:00:00"},
+		},
+		{
+			Name:          "duration",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P13MT90M"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P1Y1MT1H30M"},
+		},
+		{
+			Name:          "dayTimeDuration",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.DayTimeDuration_Datatype, LexicalForm: "PT24H"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.DayTimeDuration_Datatype, LexicalForm: "P1D"},
+		},
+		{
+			Name:          "hexBinary",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.HexBinary_Datatype, LexicalForm: "0fb7"},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.HexBinary_Datatype, LexicalForm: "0FB7"},
+		},
+		{
+			Name:          "token",
+			InputLiteral:  rdf.Literal{Datatype: xsdiri.Token_Datatype, LexicalForm: " a  b "},
+			OutputLiteral: rdf.Literal{Datatype: xsdiri.Token_Datatype, LexicalForm: "a b"},
+		},
+		{
+			Name: "langString",
+			InputLiteral: rdf.Literal{
+				Datatype:    rdfiri.LangString_Datatype,
+				LexicalForm: "Hello",
+				Tag:         rdf.LanguageLiteralTag{Language: "en-US"},
+			},
+			OutputLiteral: rdf.Literal{
+				Datatype:    rdfiri.LangString_Datatype,
+				LexicalForm: "Hello",
+				Tag:         rdf.LanguageLiteralTag{Language: "en-us"},
+			},
+		},
+		{
+			Name:          "unknown",
+			InputLiteral:  rdf.Literal{Datatype: "http://example.com/datatype", LexicalForm: " 01 "},
+			OutputLiteral: rdf.Literal{Datatype: "http://example.com/datatype", LexicalForm: " 01 "},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _e, _a := tc.OutputLiteral, CanonicalLiteral(tc.InputLiteral); !_e.TermEquals(_a) {
+				t.Fatalf("expected %#+v, but got %#+v", _e, _a)
+			}
+		})
+	}
+}
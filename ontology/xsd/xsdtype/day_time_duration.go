@@ -0,0 +1,159 @@
+package xsdtype
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+var dayTimeDurationValidRE = regexp.MustCompile(`^(-)?P(?:(\d+)D)?(T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d*)?|\.\d+)S)?)?$`)
+
+// DayTimeDuration is derived from duration and restricted to the day and time components.
+type DayTimeDuration struct {
+	Days     int64
+	Hours    int64
+	Minutes  int64
+	Seconds  float64
+	Negative bool
+}
+
+var _ objecttypes.Value = DayTimeDuration{}
+
+func MapDayTimeDuration(lexicalForm string) (DayTimeDuration, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	vMatch := dayTimeDurationValidRE.FindStringSubmatch(lexicalForm)
+	if vMatch == nil {
+		return DayTimeDuration{}, fmt.Errorf("%w: invalid dayTimeDuration: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	} else if len(vMatch[2]) == 0 && len(vMatch[3]) == 0 {
+		return DayTimeDuration{}, fmt.Errorf("%w: invalid dayTimeDuration: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	} else if vMatch[3] == "T" {
+		return DayTimeDuration{}, fmt.Errorf("%w: invalid dayTimeDuration: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	var err error
+	var l DayTimeDuration
+
+	if vMatch[1] == "-" {
+		l.Negative = true
+	}
+
+	if len(vMatch[2]) > 0 {
+		l.Days, err = strconv.ParseInt(vMatch[2], 10, 64)
+		if err != nil {
+			return DayTimeDuration{}, fmt.Errorf("%w: day: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+		}
+	}
+
+	if len(vMatch[4]) > 0 {
+		l.Hours, err = strconv.ParseInt(vMatch[4], 10, 64)
+		if err != nil {
+			return DayTimeDuration{}, fmt.Errorf("%w: hour: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+		}
+	}
+
+	if len(vMatch[5]) > 0 {
+		l.Minutes, err = strconv.ParseInt(vMatch[5], 10, 64)
+		if err != nil {
+			return DayTimeDuration{}, fmt.Errorf("%w: minute: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+		}
+	}
+
+	if len(vMatch[6]) > 0 {
+		l.Seconds, err = strconv.ParseFloat(vMatch[6], 64)
+		if err != nil {
+			return DayTimeDuration{}, fmt.Errorf("%w: second: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+		}
+	}
+
+	return l, nil
+}
+
+// CanonicalLexicalForm returns the form with seconds and minutes less than 60 and hours less than 24. A zero
+// duration is represented as PT0S.
+func (v DayTimeDuration) CanonicalLexicalForm() string {
+	days, hours, minutes, seconds := normalizeDayTimeDuration(v.Days, v.Hours, v.Minutes, v.Seconds)
+	if days == 0 && hours == 0 && minutes == 0 && seconds == 0 {
+		return "PT0S"
+	}
+
+	out := &strings.Builder{}
+
+	if v.Negative {
+		out.WriteByte('-')
+	}
+
+	out.WriteByte('P')
+
+	writeDayTimeDurationFragment(out, days, hours, minutes, seconds)
+
+	return out.String()
+}
+
+func normalizeDayTimeDuration(days, hours, minutes int64, seconds float64) (int64, int64, int64, float64) {
+	minutes += int64(math.Floor(seconds / 60))
+	seconds = math.Mod(seconds, 60)
+
+	hours += minutes / 60
+	minutes = minutes % 60
+
+	days += hours / 24
+	hours = hours % 24
+
+	return days, hours, minutes, seconds
+}
+
+func writeDayTimeDurationFragment(out *strings.Builder, days, hours, minutes int64, seconds float64) {
+	if days > 0 {
+		out.WriteString(strconv.FormatInt(days, 10))
+		out.WriteByte('D')
+	}
+
+	if hours > 0 || minutes > 0 || seconds > 0 {
+		out.WriteByte('T')
+
+		if hours > 0 {
+			out.WriteString(strconv.FormatInt(hours, 10))
+			out.WriteByte('H')
+		}
+
+		if minutes > 0 {
+			out.WriteString(strconv.FormatInt(minutes, 10))
+			out.WriteByte('M')
+		}
+
+		if seconds > 0 {
+			out.WriteString(strconv.FormatFloat(seconds, 'f', -1, 64))
+			out.WriteByte('S')
+		}
+	}
+}
+
+func (v DayTimeDuration) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.DayTimeDuration_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (DayTimeDuration) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v DayTimeDuration) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.DayTimeDuration_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
@@ -0,0 +1,65 @@
+package xsdtype
+
+import "testing"
+
+func TestMapDayTimeDuration(t *testing.T) {
+	for _, tc := range []struct {
+		InputString  string
+		OutputString string
+		Error        string
+	}{
+		{
+			InputString:  "P1DT2H3M4.5S",
+			OutputString: "P1DT2H3M4.5S",
+		},
+		{
+			InputString:  "PT36H",
+			OutputString: "P1DT12H",
+		},
+		{
+			InputString:  "-PT90M",
+			OutputString: "-PT1H30M",
+		},
+		{
+			InputString:  "PT3661S",
+			OutputString: "PT1H1M1S",
+		},
+		{
+			InputString:  "-P0D",
+			OutputString: "PT0S",
+		},
+		{
+			InputString:  "PT.5S",
+			OutputString: "PT0.5S",
+		},
+		{
+			InputString: "P1Y",
+			Error:       `literal lexical form not valid: invalid dayTimeDuration: "P1Y"`,
+		},
+		{
+			InputString: "P1DT",
+			Error:       `literal lexical form not valid: invalid dayTimeDuration: "P1DT"`,
+		},
+		{
+			InputString: "P",
+			Error:       `literal lexical form not valid: invalid dayTimeDuration: "P"`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			v, err := MapDayTimeDuration(tc.InputString)
+			if err != nil {
+				if len(tc.Error) == 0 || err.Error() != tc.Error {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.Error) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputString, v.CanonicalLexicalForm(); _e != _a {
+				t.Fatalf("expected %q, but got %q", _e, _a)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package xsdtype
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestMapGDayMonth(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		Mapper       func(string) (rdf.Literal, error)
+		InputString  string
+		OutputString string
+		Error        string
+	}{
+		{
+			Name:         "gDay",
+			Mapper:       mapLiteral(MapGDay),
+			InputString:  " ---05 ",
+			OutputString: "---05",
+		},
+		{
+			Name:         "gDay timezone",
+			Mapper:       mapLiteral(MapGDay),
+			InputString:  "---31-05:00",
+			OutputString: "---31-05:00",
+		},
+		{
+			Name:         "gDay UTC",
+			Mapper:       mapLiteral(MapGDay),
+			InputString:  "---01Z",
+			OutputString: "---01Z",
+		},
+		{
+			Name:        "gDay without prefix",
+			Mapper:      mapLiteral(MapGDay),
+			InputString: "05",
+			Error:       `literal lexical form not valid`,
+		},
+		{
+			Name:         "gMonth",
+			Mapper:       mapLiteral(MapGMonth),
+			InputString:  "--12",
+			OutputString: "--12",
+		},
+		{
+			Name:         "gMonth timezone",
+			Mapper:       mapLiteral(MapGMonth),
+			InputString:  "--01+14:00",
+			OutputString: "--01+14:00",
+		},
+		{
+			Name:        "gMonth without prefix",
+			Mapper:      mapLiteral(MapGMonth),
+			InputString: "12",
+			Error:       `literal lexical form not valid`,
+		},
+		{
+			Name:        "gMonth out of range",
+			Mapper:      mapLiteral(MapGMonth),
+			InputString: "--13",
+			Error:       `literal lexical form not valid`,
+		},
+		{
+			Name:         "gMonthDay",
+			Mapper:       mapLiteral(MapGMonthDay),
+			InputString:  "--02-29",
+			OutputString: "--02-29",
+		},
+		{
+			Name:         "gMonthDay UTC",
+			Mapper:       mapLiteral(MapGMonthDay),
+			InputString:  "--12-25Z",
+			OutputString: "--12-25Z",
+		},
+		{
+			Name:        "gMonthDay without prefix",
+			Mapper:      mapLiteral(MapGMonthDay),
+			InputString: "12-25",
+			Error:       `literal lexical form not valid`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			v, err := tc.Mapper(tc.InputString)
+			if err != nil {
+				if len(tc.Error) == 0 || err.Error() != tc.Error {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.Error) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputString, v.LexicalForm; _e != _a {
+				t.Fatalf("expected %q, but got %q", _e, _a)
+			}
+		})
+	}
+}
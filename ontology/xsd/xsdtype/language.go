@@ -0,0 +1,50 @@
+package xsdtype
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+var languageValidRE = regexp.MustCompile(`^[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*$`)
+
+// Language is derived from token and matches `[a-zA-Z]{1,8}(-[a-zA-Z0-9]{1,8})*`.
+type Language string
+
+var _ objecttypes.Value = Language("")
+
+func MapLanguage(lexicalForm string) (Language, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	if !languageValidRE.MatchString(lexicalForm) {
+		return "", fmt.Errorf("%w: invalid language: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	return Language(lexicalForm), nil
+}
+
+func (v Language) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.Language_Datatype,
+		LexicalForm: string(v),
+	}
+}
+
+func (Language) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v Language) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.Language_Datatype {
+		return false
+	}
+
+	return tLiteral.LexicalForm == string(v)
+}
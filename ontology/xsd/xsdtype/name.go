@@ -0,0 +1,114 @@
+package xsdtype
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// Name is derived from token and matches the XML Name production.
+type Name string
+
+var _ objecttypes.Value = Name("")
+
+func MapName(lexicalForm string) (Name, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	if !isName(lexicalForm) {
+		return "", fmt.Errorf("%w: invalid Name: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	return Name(lexicalForm), nil
+}
+
+func (v Name) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.Name_Datatype,
+		LexicalForm: string(v),
+	}
+}
+
+func (Name) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v Name) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.Name_Datatype {
+		return false
+	}
+
+	return tLiteral.LexicalForm == string(v)
+}
+
+// isNameStartChar matches the XML NameStartChar production.
+func isNameStartChar(r rune) bool {
+	switch {
+	case r == ':', r == '_',
+		'A' <= r && r <= 'Z',
+		'a' <= r && r <= 'z',
+		0xC0 <= r && r <= 0xD6,
+		0xD8 <= r && r <= 0xF6,
+		0xF8 <= r && r <= 0x2FF,
+		0x370 <= r && r <= 0x37D,
+		0x37F <= r && r <= 0x1FFF,
+		0x200C <= r && r <= 0x200D,
+		0x2070 <= r && r <= 0x218F,
+		0x2C00 <= r && r <= 0x2FEF,
+		0x3001 <= r && r <= 0xD7FF,
+		0xF900 <= r && r <= 0xFDCF,
+		0xFDF0 <= r && r <= 0xFFFD,
+		0x10000 <= r && r <= 0xEFFFF:
+		return true
+	}
+
+	return false
+}
+
+// isNameChar matches the XML NameChar production.
+func isNameChar(r rune) bool {
+	switch {
+	case isNameStartChar(r),
+		r == '-', r == '.', r == 0xB7,
+		'0' <= r && r <= '9',
+		0x300 <= r && r <= 0x36F,
+		0x203F <= r && r <= 0x2040:
+		return true
+	}
+
+	return false
+}
+
+func isName(v string) bool {
+	for i, r := range v {
+		if i == 0 {
+			if !isNameStartChar(r) {
+				return false
+			}
+		} else if !isNameChar(r) {
+			return false
+		}
+	}
+
+	return len(v) > 0
+}
+
+func isNCName(v string) bool {
+	return isName(v) && !strings.ContainsRune(v, ':')
+}
+
+func isNmtoken(v string) bool {
+	for _, r := range v {
+		if !isNameChar(r) {
+			return false
+		}
+	}
+
+	return len(v) > 0
+}
@@ -0,0 +1,112 @@
+package xsdtype
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestMapName(t *testing.T) {
+	for _, tc := range []struct {
+		Name         string
+		Mapper       func(string) (rdf.Literal, error)
+		InputString  string
+		OutputString string
+		Error        string
+	}{
+		{
+			Name:         "Name",
+			Mapper:       mapLiteral(MapName),
+			InputString:  " xml:lang ",
+			OutputString: "xml:lang",
+		},
+		{
+			Name:        "Name leading digit",
+			Mapper:      mapLiteral(MapName),
+			InputString: "1abc",
+			Error:       `literal lexical form not valid: invalid Name: "1abc"`,
+		},
+		{
+			Name:        "Name space",
+			Mapper:      mapLiteral(MapName),
+			InputString: "a b",
+			Error:       `literal lexical form not valid: invalid Name: "a b"`,
+		},
+		{
+			Name:         "NCName",
+			Mapper:       mapLiteral(MapNCName),
+			InputString:  "_élan-1.0",
+			OutputString: "_élan-1.0",
+		},
+		{
+			Name:        "NCName colon",
+			Mapper:      mapLiteral(MapNCName),
+			InputString: "xml:lang",
+			Error:       `literal lexical form not valid: invalid NCName: "xml:lang"`,
+		},
+		{
+			Name:         "NMTOKEN",
+			Mapper:       mapLiteral(MapNMTOKEN),
+			InputString:  "1.0-rc",
+			OutputString: "1.0-rc",
+		},
+		{
+			Name:        "NMTOKEN empty",
+			Mapper:      mapLiteral(MapNMTOKEN),
+			InputString: " ",
+			Error:       `literal lexical form not valid: invalid NMTOKEN: ""`,
+		},
+		{
+			Name:         "language",
+			Mapper:       mapLiteral(MapLanguage),
+			InputString:  "en-US",
+			OutputString: "en-US",
+		},
+		{
+			Name:        "language subtag too long",
+			Mapper:      mapLiteral(MapLanguage),
+			InputString: "en-abcdefghi",
+			Error:       `literal lexical form not valid: invalid language: "en-abcdefghi"`,
+		},
+		{
+			Name:         "token",
+			Mapper:       mapLiteral(MapToken),
+			InputString:  "\ta  b\n",
+			OutputString: "a b",
+		},
+		{
+			Name:         "normalizedString",
+			Mapper:       mapLiteral(MapNormalizedString),
+			InputString:  "\ta  b\n",
+			OutputString: " a  b ",
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			v, err := tc.Mapper(tc.InputString)
+			if err != nil {
+				if len(tc.Error) == 0 || err.Error() != tc.Error {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.Error) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputString, v.LexicalForm; _e != _a {
+				t.Fatalf("expected %q, but got %q", _e, _a)
+			}
+		})
+	}
+}
+
+func mapLiteral[T interface{ AsObjectValue() rdf.ObjectValue }](mapper func(string) (T, error)) func(string) (rdf.Literal, error) {
+	return func(v string) (rdf.Literal, error) {
+		mapped, err := mapper(v)
+		if err != nil {
+			return rdf.Literal{}, err
+		}
+
+		return mapped.AsObjectValue().(rdf.Literal), nil
+	}
+}
@@ -0,0 +1,47 @@
+package xsdtype
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// NCName is derived from Name and matches the XML NCName production, which is a Name without any colons.
+type NCName string
+
+var _ objecttypes.Value = NCName("")
+
+func MapNCName(lexicalForm string) (NCName, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	if !isNCName(lexicalForm) {
+		return "", fmt.Errorf("%w: invalid NCName: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	return NCName(lexicalForm), nil
+}
+
+func (v NCName) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.NCName_Datatype,
+		LexicalForm: string(v),
+	}
+}
+
+func (NCName) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v NCName) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.NCName_Datatype {
+		return false
+	}
+
+	return tLiteral.LexicalForm == string(v)
+}
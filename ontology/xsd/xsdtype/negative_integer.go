@@ -0,0 +1,56 @@
+package xsdtype
+
+import (
+	"math/big"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// NegativeInteger is derived from integer with a maxInclusive -1 facet. A nil Int is equivalent to zero.
+type NegativeInteger struct {
+	Int *big.Int
+}
+
+var _ objecttypes.Value = NegativeInteger{}
+
+func MapNegativeInteger(lexicalForm string) (NegativeInteger, error) {
+	vInt, err := mapBoundedBigInteger(lexicalForm, "negativeInteger", func(sign int) bool {
+		return sign < 0
+	})
+	if err != nil {
+		return NegativeInteger{}, err
+	}
+
+	return NegativeInteger{
+		Int: vInt,
+	}, nil
+}
+
+// CanonicalLexicalForm returns the form without leading zeros or a positive sign.
+func (v NegativeInteger) CanonicalLexicalForm() string {
+	return canonicalBigIntegerLexicalForm(v.Int)
+}
+
+func (v NegativeInteger) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.NegativeInteger_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (NegativeInteger) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v NegativeInteger) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.NegativeInteger_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
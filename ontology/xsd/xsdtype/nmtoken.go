@@ -0,0 +1,47 @@
+package xsdtype
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// NMTOKEN is derived from token and matches the XML Nmtoken production.
+type NMTOKEN string
+
+var _ objecttypes.Value = NMTOKEN("")
+
+func MapNMTOKEN(lexicalForm string) (NMTOKEN, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	if !isNmtoken(lexicalForm) {
+		return "", fmt.Errorf("%w: invalid NMTOKEN: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	return NMTOKEN(lexicalForm), nil
+}
+
+func (v NMTOKEN) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.NMTOKEN_Datatype,
+		LexicalForm: string(v),
+	}
+}
+
+func (NMTOKEN) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v NMTOKEN) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.NMTOKEN_Datatype {
+		return false
+	}
+
+	return tLiteral.LexicalForm == string(v)
+}
@@ -0,0 +1,56 @@
+package xsdtype
+
+import (
+	"math/big"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// NonNegativeInteger is derived from integer with a minInclusive 0 facet. A nil Int is equivalent to zero.
+type NonNegativeInteger struct {
+	Int *big.Int
+}
+
+var _ objecttypes.Value = NonNegativeInteger{}
+
+func MapNonNegativeInteger(lexicalForm string) (NonNegativeInteger, error) {
+	vInt, err := mapBoundedBigInteger(lexicalForm, "nonNegativeInteger", func(sign int) bool {
+		return sign >= 0
+	})
+	if err != nil {
+		return NonNegativeInteger{}, err
+	}
+
+	return NonNegativeInteger{
+		Int: vInt,
+	}, nil
+}
+
+// CanonicalLexicalForm returns the form without leading zeros or a positive sign.
+func (v NonNegativeInteger) CanonicalLexicalForm() string {
+	return canonicalBigIntegerLexicalForm(v.Int)
+}
+
+func (v NonNegativeInteger) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.NonNegativeInteger_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (NonNegativeInteger) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v NonNegativeInteger) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.NonNegativeInteger_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
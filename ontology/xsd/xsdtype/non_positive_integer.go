@@ -0,0 +1,56 @@
+package xsdtype
+
+import (
+	"math/big"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// NonPositiveInteger is derived from integer with a maxInclusive 0 facet. A nil Int is equivalent to zero.
+type NonPositiveInteger struct {
+	Int *big.Int
+}
+
+var _ objecttypes.Value = NonPositiveInteger{}
+
+func MapNonPositiveInteger(lexicalForm string) (NonPositiveInteger, error) {
+	vInt, err := mapBoundedBigInteger(lexicalForm, "nonPositiveInteger", func(sign int) bool {
+		return sign <= 0
+	})
+	if err != nil {
+		return NonPositiveInteger{}, err
+	}
+
+	return NonPositiveInteger{
+		Int: vInt,
+	}, nil
+}
+
+// CanonicalLexicalForm returns the form without leading zeros or a positive sign.
+func (v NonPositiveInteger) CanonicalLexicalForm() string {
+	return canonicalBigIntegerLexicalForm(v.Int)
+}
+
+func (v NonPositiveInteger) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.NonPositiveInteger_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (NonPositiveInteger) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v NonPositiveInteger) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.NonPositiveInteger_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
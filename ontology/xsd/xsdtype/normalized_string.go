@@ -0,0 +1,40 @@
+package xsdtype
+
+import (
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// NormalizedString is derived from string with a whiteSpace facet of replace; it contains no carriage return, line
+// feed or tab characters.
+type NormalizedString string
+
+var _ objecttypes.Value = NormalizedString("")
+
+func MapNormalizedString(lexicalForm string) (NormalizedString, error) {
+	return NormalizedString(xsdutil.WhiteSpaceReplace(lexicalForm)), nil
+}
+
+func (v NormalizedString) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.NormalizedString_Datatype,
+		LexicalForm: string(v),
+	}
+}
+
+func (NormalizedString) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v NormalizedString) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.NormalizedString_Datatype {
+		return false
+	}
+
+	return tLiteral.LexicalForm == string(v)
+}
@@ -0,0 +1,56 @@
+package xsdtype
+
+import (
+	"math/big"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// PositiveInteger is derived from integer with a minInclusive 1 facet. A nil Int is equivalent to zero.
+type PositiveInteger struct {
+	Int *big.Int
+}
+
+var _ objecttypes.Value = PositiveInteger{}
+
+func MapPositiveInteger(lexicalForm string) (PositiveInteger, error) {
+	vInt, err := mapBoundedBigInteger(lexicalForm, "positiveInteger", func(sign int) bool {
+		return sign > 0
+	})
+	if err != nil {
+		return PositiveInteger{}, err
+	}
+
+	return PositiveInteger{
+		Int: vInt,
+	}, nil
+}
+
+// CanonicalLexicalForm returns the form without leading zeros or a positive sign.
+func (v PositiveInteger) CanonicalLexicalForm() string {
+	return canonicalBigIntegerLexicalForm(v.Int)
+}
+
+func (v PositiveInteger) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.PositiveInteger_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (PositiveInteger) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v PositiveInteger) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.PositiveInteger_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
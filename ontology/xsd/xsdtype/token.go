@@ -0,0 +1,40 @@
+package xsdtype
+
+import (
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+// Token is derived from normalizedString with a whiteSpace facet of collapse; it contains no leading, trailing or
+// consecutive spaces.
+type Token string
+
+var _ objecttypes.Value = Token("")
+
+func MapToken(lexicalForm string) (Token, error) {
+	return Token(xsdutil.WhiteSpaceCollapse(lexicalForm)), nil
+}
+
+func (v Token) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.Token_Datatype,
+		LexicalForm: string(v),
+	}
+}
+
+func (Token) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v Token) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.Token_Datatype {
+		return false
+	}
+
+	return tLiteral.LexicalForm == string(v)
+}
@@ -0,0 +1,474 @@
+package xsdtype
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"math"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// valueSpace identifies a group of datatypes whose values may be compared with each other. Derived datatypes share
+// the value space of their primitive datatype.
+type valueSpace int
+
+const (
+	valueSpaceUnknown valueSpace = iota
+	valueSpaceBase64Binary
+	valueSpaceBoolean
+	valueSpaceDate
+	valueSpaceDateTime
+	valueSpaceDecimal
+	valueSpaceDouble
+	valueSpaceDuration
+	valueSpaceFloat
+	valueSpaceGDay
+	valueSpaceGMonth
+	valueSpaceGMonthDay
+	valueSpaceGYear
+	valueSpaceGYearMonth
+	valueSpaceHexBinary
+	valueSpaceLangString
+	valueSpaceString
+	valueSpaceTime
+	valueSpaceAnyURI
+)
+
+type dateTimeValue struct {
+	Time     time.Time
+	Timezone bool
+}
+
+type durationValue struct {
+	Months  float64
+	Seconds float64
+}
+
+type langStringValue struct {
+	Language      string
+	BaseDirection string
+	Text          string
+}
+
+// mapValue maps a literal into its value space. It returns false for unsupported datatypes and invalid lexical forms.
+func mapValue(l rdf.Literal) (valueSpace, any, bool) {
+	switch l.Datatype {
+	case xsdiri.Decimal_Datatype:
+		v, err := MapBigDecimal(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDecimal, v.Rat, true
+	case xsdiri.Integer_Datatype,
+		xsdiri.NonNegativeInteger_Datatype,
+		xsdiri.PositiveInteger_Datatype,
+		xsdiri.NegativeInteger_Datatype,
+		xsdiri.NonPositiveInteger_Datatype,
+		xsdiri.Long_Datatype,
+		xsdiri.Int_Datatype,
+		xsdiri.Short_Datatype,
+		xsdiri.Byte_Datatype,
+		xsdiri.UnsignedLong_Datatype,
+		xsdiri.UnsignedInt_Datatype,
+		xsdiri.UnsignedShort_Datatype,
+		xsdiri.UnsignedByte_Datatype:
+		canonical, ok := canonicalLiteral(l)
+		if !ok {
+			return valueSpaceUnknown, nil, false
+		}
+
+		vInt, ok := new(big.Int).SetString(canonical.LexicalForm, 10)
+		if !ok {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDecimal, new(big.Rat).SetInt(vInt), true
+	case xsdiri.Double_Datatype:
+		v, ok := mapFloatingPoint(l.LexicalForm, 64)
+		if !ok {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDouble, v, true
+	case xsdiri.Float_Datatype:
+		v, ok := mapFloatingPoint(l.LexicalForm, 32)
+		if !ok {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceFloat, v, true
+	case xsdiri.Boolean_Datatype:
+		v, err := MapBoolean(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceBoolean, bool(v), true
+	case xsdiri.String_Datatype,
+		xsdiri.NormalizedString_Datatype,
+		xsdiri.Token_Datatype,
+		xsdiri.Language_Datatype,
+		xsdiri.Name_Datatype,
+		xsdiri.NCName_Datatype,
+		xsdiri.NMTOKEN_Datatype:
+		canonical, ok := canonicalLiteral(l)
+		if !ok {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceString, canonical.LexicalForm, true
+	case xsdiri.AnyURI_Datatype:
+		return valueSpaceAnyURI, xsdutil.WhiteSpaceCollapse(l.LexicalForm), true
+	case xsdiri.HexBinary_Datatype:
+		v, err := hex.DecodeString(xsdutil.WhiteSpaceCollapse(l.LexicalForm))
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceHexBinary, v, true
+	case xsdiri.Base64Binary_Datatype:
+		v, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(xsdutil.WhiteSpaceCollapse(l.LexicalForm), " ", ""))
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceBase64Binary, v, true
+	case xsdiri.DateTime_Datatype:
+		v, err := MapDateTime(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDateTime, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.DateTimeStamp_Datatype:
+		v, err := MapDateTimeStamp(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDateTime, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.Date_Datatype:
+		v, err := MapDate(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDate, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.Time_Datatype:
+		v, err := MapTime(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceTime, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.GYear_Datatype:
+		v, err := MapGYear(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceGYear, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.GYearMonth_Datatype:
+		v, err := MapGYearMonth(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceGYearMonth, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.GMonth_Datatype:
+		v, err := MapGMonth(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceGMonth, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.GMonthDay_Datatype:
+		v, err := MapGMonthDay(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceGMonthDay, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.GDay_Datatype:
+		v, err := MapGDay(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceGDay, newDateTimeValue(v.Time, v.Layout), true
+	case xsdiri.Duration_Datatype:
+		v, err := MapDuration(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDuration, newDurationValue(v), true
+	case xsdiri.DayTimeDuration_Datatype:
+		v, err := MapDayTimeDuration(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDuration, newDurationValue(Duration{
+			Days:     float64(v.Days),
+			Hours:    float64(v.Hours),
+			Minutes:  float64(v.Minutes),
+			Seconds:  v.Seconds,
+			Negative: v.Negative,
+		}), true
+	case xsdiri.YearMonthDuration_Datatype:
+		v, err := MapYearMonthDuration(l.LexicalForm)
+		if err != nil {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceDuration, newDurationValue(Duration{
+			Years:    float64(v.Years),
+			Months:   float64(v.Months),
+			Negative: v.Negative,
+		}), true
+	case rdfiri.LangString_Datatype:
+		tag, ok := l.Tag.(rdf.LanguageLiteralTag)
+		if !ok {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceLangString, langStringValue{
+			Language: strings.ToLower(tag.Language),
+			Text:     l.LexicalForm,
+		}, true
+	case rdfiri.DirLangString_Datatype:
+		tag, ok := l.Tag.(rdf.DirectionalLanguageLiteralTag)
+		if !ok {
+			return valueSpaceUnknown, nil, false
+		}
+
+		return valueSpaceLangString, langStringValue{
+			Language:      strings.ToLower(tag.Language),
+			BaseDirection: tag.BaseDirection,
+			Text:          l.LexicalForm,
+		}, true
+	}
+
+	return valueSpaceUnknown, nil, false
+}
+
+func newDateTimeValue(t time.Time, layout string) dateTimeValue {
+	return dateTimeValue{
+		Time:     t,
+		Timezone: strings.Contains(layout, "Z"),
+	}
+}
+
+func newDurationValue(v Duration) durationValue {
+	dv := durationValue{
+		Months:  v.Years*12 + v.Months,
+		Seconds: v.Days*86400 + v.Hours*3600 + v.Minutes*60 + v.Seconds,
+	}
+
+	if v.Negative {
+		dv.Months = -dv.Months
+		dv.Seconds = -dv.Seconds
+	}
+
+	return dv
+}
+
+// ValueEquals reports whether two literals represent the same value, such as `"01"^^xsd:integer` and
+// `"1"^^xsd:integer`. Derived datatypes are equal to their primitive datatype when their values are. Literals of
+// unsupported datatypes or with invalid lexical forms fall back to [rdf.Literal.TermEquals]. Language tags are compared
+// case-insensitively.
+func ValueEquals(a, b rdf.Literal) bool {
+	aSpace, aValue, aOK := mapValue(a)
+	bSpace, bValue, bOK := mapValue(b)
+
+	if !aOK || !bOK {
+		return a.TermEquals(b)
+	} else if aSpace != bSpace {
+		return false
+	}
+
+	switch aSpace {
+	case valueSpaceHexBinary, valueSpaceBase64Binary:
+		return bytes.Equal(aValue.([]byte), bValue.([]byte))
+	case valueSpaceLangString:
+		return aValue.(langStringValue) == bValue.(langStringValue)
+	}
+
+	c, ok := compareValue(aSpace, aValue, bValue)
+
+	return ok && c == 0
+}
+
+// Compare returns -1, 0, or +1 depending on whether a is less than, equal to, or greater than b in value space.
+//
+// The ordering is partial, so the second result is false when the literals are not comparable. This includes values
+// of different primitive datatypes, unordered datatypes (such as binary values) which are not equal, NaN, and
+// indeterminate comparisons of date/time values with and without a timezone or of durations such as P1M and P30D.
+func Compare(a, b rdf.Literal) (int, bool) {
+	aSpace, aValue, aOK := mapValue(a)
+	bSpace, bValue, bOK := mapValue(b)
+
+	if !aOK || !bOK || aSpace != bSpace {
+		return 0, false
+	}
+
+	return compareValue(aSpace, aValue, bValue)
+}
+
+func compareValue(space valueSpace, a, b any) (int, bool) {
+	switch space {
+	case valueSpaceDecimal:
+		return a.(*big.Rat).Cmp(b.(*big.Rat)), true
+	case valueSpaceDouble, valueSpaceFloat:
+		aFloat, bFloat := a.(float64), b.(float64)
+		if math.IsNaN(aFloat) || math.IsNaN(bFloat) {
+			return 0, false
+		} else if aFloat < bFloat {
+			return -1, true
+		} else if aFloat > bFloat {
+			return 1, true
+		}
+
+		return 0, true
+	case valueSpaceBoolean:
+		aBool, bBool := a.(bool), b.(bool)
+		if aBool == bBool {
+			return 0, true
+		} else if bBool {
+			return -1, true
+		}
+
+		return 1, true
+	case valueSpaceString, valueSpaceAnyURI:
+		return strings.Compare(a.(string), b.(string)), true
+	case valueSpaceLangString:
+		aLang, bLang := a.(langStringValue), b.(langStringValue)
+		if aLang.Language != bLang.Language || aLang.BaseDirection != bLang.BaseDirection {
+			return 0, false
+		}
+
+		return strings.Compare(aLang.Text, bLang.Text), true
+	case valueSpaceHexBinary, valueSpaceBase64Binary:
+		if bytes.Equal(a.([]byte), b.([]byte)) {
+			return 0, true
+		}
+
+		return 0, false
+	case valueSpaceDuration:
+		return compareDurationValue(a.(durationValue), b.(durationValue))
+	case valueSpaceDateTime,
+		valueSpaceDate,
+		valueSpaceTime,
+		valueSpaceGYear,
+		valueSpaceGYearMonth,
+		valueSpaceGMonth,
+		valueSpaceGMonthDay,
+		valueSpaceGDay:
+		return compareDateTimeValue(a.(dateTimeValue), b.(dateTimeValue))
+	}
+
+	return 0, false
+}
+
+// dateTimeTimezoneRange is the maximum timezone offset which an untimezoned value may be interpreted with.
+const dateTimeTimezoneRange = 14 * time.Hour
+
+// compareDateTimeValue follows the order relation of XSD 1.1 where a value without a timezone is only ordered
+// relative to a value with a timezone when the order is the same for every possible timezone.
+func compareDateTimeValue(a, b dateTimeValue) (int, bool) {
+	if a.Timezone == b.Timezone {
+		return a.Time.Compare(b.Time), true
+	} else if !a.Timezone {
+		c, ok := compareDateTimeValue(b, a)
+
+		return -c, ok
+	}
+
+	if a.Time.Before(b.Time.Add(-dateTimeTimezoneRange)) {
+		return -1, true
+	} else if a.Time.After(b.Time.Add(dateTimeTimezoneRange)) {
+		return 1, true
+	}
+
+	return 0, false
+}
+
+// durationReferenceDateTimes are used by XSD 1.1 to determine the order of durations with both months and seconds.
+var durationReferenceDateTimes = []time.Time{
+	time.Date(1696, 9, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1697, 2, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1903, 3, 1, 0, 0, 0, 0, time.UTC),
+	time.Date(1903, 7, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func compareDurationValue(a, b durationValue) (int, bool) {
+	if a.Months == b.Months {
+		return compareFloat(a.Seconds, b.Seconds), true
+	} else if a.Seconds == b.Seconds {
+		return compareFloat(a.Months, b.Months), true
+	}
+
+	var c int
+
+	for i, ref := range durationReferenceDateTimes {
+		refA, ok := addDurationValue(ref, a)
+		if !ok {
+			return 0, false
+		}
+
+		refB, ok := addDurationValue(ref, b)
+		if !ok {
+			return 0, false
+		}
+
+		refC := refA.Compare(refB)
+		if i == 0 {
+			c = refC
+		} else if refC != c {
+			return 0, false
+		}
+	}
+
+	if c == 0 {
+		// equal instants at every reference but different properties, so they are not the same value
+		return 0, false
+	}
+
+	return c, true
+}
+
+// durationValueMaxUnits is the magnitude of months or days beyond which a duration is not added to a date.
+const durationValueMaxUnits = 1e12
+
+// addDurationValue adds whole days through the calendar and only the remaining seconds as a [time.Duration], which
+// would otherwise overflow after about 292 years. It returns false if the duration is too large to add.
+func addDurationValue(t time.Time, v durationValue) (time.Time, bool) {
+	days := math.Floor(v.Seconds / 86400)
+
+	if math.Abs(v.Months) > durationValueMaxUnits || math.Abs(days) > durationValueMaxUnits {
+		return time.Time{}, false
+	}
+
+	remainder := v.Seconds - days*86400
+
+	return t.AddDate(0, int(v.Months), int(days)).Add(time.Duration(remainder * float64(time.Second))), true
+}
+
+func compareFloat(a, b float64) int {
+	if a < b {
+		return -1
+	} else if a > b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,233 @@
+package xsdtype
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestCompare(t *testing.T) {
+	for _, tc := range []struct {
+		Name          string
+		InputA        rdf.Literal
+		InputB        rdf.Literal
+		OutputCompare int
+		OutputOK      bool
+	}{
+		{
+			Name:     "integer",
+			InputA:   rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "01"},
+			InputB:   rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "1"},
+			OutputOK: true,
+		},
+		{
+			Name:          "integer and decimal",
+			InputA:        rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "2"},
+			InputB:        rdf.Literal{Datatype: xsdiri.Decimal_Datatype, LexicalForm: "1.5"},
+			OutputCompare: 1,
+			OutputOK:      true,
+		},
+		{
+			Name:          "short and unsignedLong",
+			InputA:        rdf.Literal{Datatype: xsdiri.Short_Datatype, LexicalForm: "-1"},
+			InputB:        rdf.Literal{Datatype: xsdiri.UnsignedLong_Datatype, LexicalForm: "18446744073709551615"},
+			OutputCompare: -1,
+			OutputOK:      true,
+		},
+		{
+			Name:   "decimal and double",
+			InputA: rdf.Literal{Datatype: xsdiri.Decimal_Datatype, LexicalForm: "1"},
+			InputB: rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "1"},
+		},
+		{
+			Name:   "double NaN",
+			InputA: rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "NaN"},
+			InputB: rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "NaN"},
+		},
+		{
+			Name:          "double",
+			InputA:        rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "-INF"},
+			InputB:        rdf.Literal{Datatype: xsdiri.Double_Datatype, LexicalForm: "-1E308"},
+			OutputCompare: -1,
+			OutputOK:      true,
+		},
+		{
+			Name:          "boolean",
+			InputA:        rdf.Literal{Datatype: xsdiri.Boolean_Datatype, LexicalForm: "1"},
+			InputB:        rdf.Literal{Datatype: xsdiri.Boolean_Datatype, LexicalForm: "false"},
+			OutputCompare: 1,
+			OutputOK:      true,
+		},
+		{
+			Name:          "string and token",
+			InputA:        rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "a"},
+			InputB:        rdf.Literal{Datatype: xsdiri.Token_Datatype, LexicalForm: " b "},
+			OutputCompare: -1,
+			OutputOK:      true,
+		},
+		{
+			Name:     "dateTime timezones",
+			InputA:   rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T12:00:00-05:00"},
+			InputB:   rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T17:00:00Z"},
+			OutputOK: true,
+		},
+		{
+			Name:          "dateTime and dateTimeStamp",
+			InputA:        rdf.Literal{Datatype: xsdiri.DateTimeStamp_Datatype, LexicalForm: "2002-10-10T12:00:00+01:00"},
+			InputB:        rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T12:00:00Z"},
+			OutputCompare: -1,
+			OutputOK:      true,
+		},
+		{
+			Name:   "dateTime indeterminate",
+			InputA: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2000-01-15T12:00:00"},
+			InputB: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2000-01-16T00:00:00Z"},
+		},
+		{
+			Name:          "dateTime without timezone",
+			InputA:        rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2000-01-15T12:00:00"},
+			InputB:        rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2000-01-16T02:00:01Z"},
+			OutputCompare: -1,
+			OutputOK:      true,
+		},
+		{
+			Name:          "dateTime with timezone",
+			InputA:        rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2000-01-16T02:00:01Z"},
+			InputB:        rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2000-01-15T12:00:00"},
+			OutputCompare: 1,
+			OutputOK:      true,
+		},
+		{
+			Name:   "date and dateTime",
+			InputA: rdf.Literal{Datatype: xsdiri.Date_Datatype, LexicalForm: "2000-01-15"},
+			InputB: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2000-01-15T00:00:00"},
+		},
+		{
+			Name:     "duration and dayTimeDuration",
+			InputA:   rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "PT24H"},
+			InputB:   rdf.Literal{Datatype: xsdiri.DayTimeDuration_Datatype, LexicalForm: "P1D"},
+			OutputOK: true,
+		},
+		{
+			Name:   "duration indeterminate",
+			InputA: rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P1M"},
+			InputB: rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P30D"},
+		},
+		{
+			Name:          "duration determinate",
+			InputA:        rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P1M"},
+			InputB:        rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P32D"},
+			OutputCompare: -1,
+			OutputOK:      true,
+		},
+		{
+			Name:          "duration large",
+			InputA:        rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P1M"},
+			InputB:        rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P110000D"},
+			OutputCompare: -1,
+			OutputOK:      true,
+		},
+		{
+			Name:          "duration large negative",
+			InputA:        rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "-P400Y"},
+			InputB:        rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "-P146100DT1S"},
+			OutputCompare: 1,
+			OutputOK:      true,
+		},
+		{
+			Name:   "duration too large",
+			InputA: rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P1M"},
+			InputB: rdf.Literal{Datatype: xsdiri.Duration_Datatype, LexicalForm: "P10000000000000D"},
+		},
+		{
+			Name:          "yearMonthDuration",
+			InputA:        rdf.Literal{Datatype: xsdiri.YearMonthDuration_Datatype, LexicalForm: "P1Y"},
+			InputB:        rdf.Literal{Datatype: xsdiri.YearMonthDuration_Datatype, LexicalForm: "-P13M"},
+			OutputCompare: 1,
+			OutputOK:      true,
+		},
+		{
+			Name:   "langString different languages",
+			InputA: rdf.Literal{Datatype: rdfiri.LangString_Datatype, LexicalForm: "a", Tag: rdf.LanguageLiteralTag{Language: "en"}},
+			InputB: rdf.Literal{Datatype: rdfiri.LangString_Datatype, LexicalForm: "b", Tag: rdf.LanguageLiteralTag{Language: "de"}},
+		},
+		{
+			Name:   "unknown",
+			InputA: rdf.Literal{Datatype: "http://example.com/datatype", LexicalForm: "1"},
+			InputB: rdf.Literal{Datatype: "http://example.com/datatype", LexicalForm: "1"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			c, ok := Compare(tc.InputA, tc.InputB)
+			if _e, _a := tc.OutputOK, ok; _e != _a {
+				t.Fatalf("expected ok %v, but got %v", _e, _a)
+			} else if _e, _a := tc.OutputCompare, c; _e != _a {
+				t.Fatalf("expected %d, but got %d", _e, _a)
+			}
+		})
+	}
+}
+
+func TestValueEquals(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		InputA rdf.Literal
+		InputB rdf.Literal
+		Output bool
+	}{
+		{
+			Name:   "integer",
+			InputA: rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "01"},
+			InputB: rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "1"},
+			Output: true,
+		},
+		{
+			Name:   "integer and decimal",
+			InputA: rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "1"},
+			InputB: rdf.Literal{Datatype: xsdiri.Decimal_Datatype, LexicalForm: "1.0"},
+			Output: true,
+		},
+		{
+			Name:   "dateTime timezones",
+			InputA: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T12:00:00-05:00"},
+			InputB: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T17:00:00Z"},
+			Output: true,
+		},
+		{
+			Name:   "dateTime with and without timezone",
+			InputA: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T17:00:00"},
+			InputB: rdf.Literal{Datatype: xsdiri.DateTime_Datatype, LexicalForm: "2002-10-10T17:00:00Z"},
+		},
+		{
+			Name:   "hexBinary",
+			InputA: rdf.Literal{Datatype: xsdiri.HexBinary_Datatype, LexicalForm: "0fb7"},
+			InputB: rdf.Literal{Datatype: xsdiri.HexBinary_Datatype, LexicalForm: "0FB7"},
+			Output: true,
+		},
+		{
+			Name:   "langString case",
+			InputA: rdf.Literal{Datatype: rdfiri.LangString_Datatype, LexicalForm: "a", Tag: rdf.LanguageLiteralTag{Language: "en-US"}},
+			InputB: rdf.Literal{Datatype: rdfiri.LangString_Datatype, LexicalForm: "a", Tag: rdf.LanguageLiteralTag{Language: "en-us"}},
+			Output: true,
+		},
+		{
+			Name:   "unknown",
+			InputA: rdf.Literal{Datatype: "http://example.com/datatype", LexicalForm: "1"},
+			InputB: rdf.Literal{Datatype: "http://example.com/datatype", LexicalForm: "1"},
+			Output: true,
+		},
+		{
+			Name:   "invalid",
+			InputA: rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "one"},
+			InputB: rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "1"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _e, _a := tc.Output, ValueEquals(tc.InputA, tc.InputB); _e != _a {
+				t.Fatalf("expected %v, but got %v", _e, _a)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package xsdtype
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/objecttypes"
+)
+
+var yearMonthDurationValidRE = regexp.MustCompile(`^(-)?P(?:(\d+)Y)?(?:(\d+)M)?$`)
+
+// YearMonthDuration is derived from duration and restricted to the year and month components.
+type YearMonthDuration struct {
+	Years    int64
+	Months   int64
+	Negative bool
+}
+
+var _ objecttypes.Value = YearMonthDuration{}
+
+func MapYearMonthDuration(lexicalForm string) (YearMonthDuration, error) {
+	lexicalForm = xsdutil.WhiteSpaceCollapse(lexicalForm)
+
+	vMatch := yearMonthDurationValidRE.FindStringSubmatch(lexicalForm)
+	if vMatch == nil || (len(vMatch[2]) == 0 && len(vMatch[3]) == 0) {
+		return YearMonthDuration{}, fmt.Errorf("%w: invalid yearMonthDuration: %q", rdf.ErrLiteralLexicalFormNotValid, lexicalForm)
+	}
+
+	var err error
+	var l YearMonthDuration
+
+	if vMatch[1] == "-" {
+		l.Negative = true
+	}
+
+	if len(vMatch[2]) > 0 {
+		l.Years, err = strconv.ParseInt(vMatch[2], 10, 64)
+		if err != nil {
+			return YearMonthDuration{}, fmt.Errorf("%w: year: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+		}
+	}
+
+	if len(vMatch[3]) > 0 {
+		l.Months, err = strconv.ParseInt(vMatch[3], 10, 64)
+		if err != nil {
+			return YearMonthDuration{}, fmt.Errorf("%w: month: %v", rdf.ErrLiteralLexicalFormNotValid, err)
+		}
+	}
+
+	return l, nil
+}
+
+// CanonicalLexicalForm returns the form with months less than 12. A zero duration is represented as P0M.
+func (v YearMonthDuration) CanonicalLexicalForm() string {
+	years, months := normalizeYearMonthDuration(v.Years, v.Months)
+	if years == 0 && months == 0 {
+		return "P0M"
+	}
+
+	out := &strings.Builder{}
+
+	if v.Negative {
+		out.WriteByte('-')
+	}
+
+	out.WriteByte('P')
+
+	writeYearMonthDurationFragment(out, years, months)
+
+	return out.String()
+}
+
+func normalizeYearMonthDuration(years, months int64) (int64, int64) {
+	return years + months/12, months % 12
+}
+
+func writeYearMonthDurationFragment(out *strings.Builder, years, months int64) {
+	if years > 0 {
+		out.WriteString(strconv.FormatInt(years, 10))
+		out.WriteByte('Y')
+	}
+
+	if months > 0 {
+		out.WriteString(strconv.FormatInt(months, 10))
+		out.WriteByte('M')
+	}
+}
+
+func (v YearMonthDuration) AsObjectValue() rdf.ObjectValue {
+	return rdf.Literal{
+		Datatype:    xsdiri.YearMonthDuration_Datatype,
+		LexicalForm: v.CanonicalLexicalForm(),
+	}
+}
+
+func (YearMonthDuration) TermKind() rdf.TermKind {
+	return rdf.TermKindLiteral
+}
+
+func (v YearMonthDuration) TermEquals(t rdf.Term) bool {
+	tLiteral, ok := t.(rdf.Literal)
+	if !ok {
+		return false
+	} else if tLiteral.Datatype != xsdiri.YearMonthDuration_Datatype {
+		return false
+	}
+
+	return v.CanonicalLexicalForm() == tLiteral.LexicalForm
+}
@@ -0,0 +1,53 @@
+package xsdtype
+
+import "testing"
+
+func TestMapYearMonthDuration(t *testing.T) {
+	for _, tc := range []struct {
+		InputString  string
+		OutputString string
+		Error        string
+	}{
+		{
+			InputString:  "P1Y2M",
+			OutputString: "P1Y2M",
+		},
+		{
+			InputString:  "P14M",
+			OutputString: "P1Y2M",
+		},
+		{
+			InputString:  "-P24M",
+			OutputString: "-P2Y",
+		},
+		{
+			InputString:  "P0Y",
+			OutputString: "P0M",
+		},
+		{
+			InputString: "P1D",
+			Error:       `literal lexical form not valid: invalid yearMonthDuration: "P1D"`,
+		},
+		{
+			InputString: "P1.5Y",
+			Error:       `literal lexical form not valid: invalid yearMonthDuration: "P1.5Y"`,
+		},
+	} {
+		t.Run(tc.InputString, func(t *testing.T) {
+			v, err := MapYearMonthDuration(tc.InputString)
+			if err != nil {
+				if len(tc.Error) == 0 || err.Error() != tc.Error {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				return
+			} else if len(tc.Error) > 0 {
+				t.Fatalf("expected error, but got nil")
+			}
+
+			if _e, _a := tc.OutputString, v.CanonicalLexicalForm(); _e != _a {
+				t.Fatalf("expected %q, but got %q", _e, _a)
+			}
+		})
+	}
+}
@@ -4,6 +4,14 @@ type BlankNodeIdentifier interface {
 	EqualsBlankNodeIdentifier(bni BlankNodeIdentifier) bool
 }
 
+// BlankNodeIdentifierComparer may be implemented by a [BlankNodeIdentifier] to provide a consistent ordering among
+// identifiers of the same type. Identifiers from different scopes must still be ordered, such as by the order their
+// scopes were created.
+type BlankNodeIdentifierComparer interface {
+	// CompareBlankNodeIdentifier returns -1, 0, or +1. If the other identifier is of a different type, 0 is returned.
+	CompareBlankNodeIdentifier(bni BlankNodeIdentifier) int
+}
+
 // BlankNode does not identify a specific resource and is disjoint from an [IRI] and a [Literal]. Two BlankNode values
 // are equal if both of their Identifier fields are non-nil and equivalent.
 //
@@ -1,6 +1,7 @@
 package rdf
 
 import (
+	"cmp"
 	"sync/atomic"
 )
 
@@ -31,6 +32,7 @@ type bn struct {
 }
 
 var _ BlankNodeIdentifier = bn{}
+var _ BlankNodeIdentifierComparer = bn{}
 
 func (bni bn) EqualsBlankNodeIdentifier(other BlankNodeIdentifier) bool {
 	otherT, ok := other.(bn)
@@ -41,10 +43,25 @@ func (bni bn) EqualsBlankNodeIdentifier(other BlankNodeIdentifier) bool {
 	return otherT.s == bni.s && otherT.v == bni.v
 }
 
+func (bni bn) CompareBlankNodeIdentifier(other BlankNodeIdentifier) int {
+	otherT, ok := other.(bn)
+	if !ok {
+		return 0
+	} else if otherT.s != bni.s {
+		return cmp.Compare(bni.s.scope, otherT.s.scope)
+	}
+
+	return cmp.Compare(bni.v, otherT.v)
+}
+
 //
 
+// bnFScopes orders the identifiers of different factories by when the factory was created.
+var bnFScopes = &atomic.Int64{}
+
 type bnF struct {
-	a *atomic.Int64
+	a     *atomic.Int64
+	scope int64
 }
 
 var _ BlankNodeFactory = &bnF{}
@@ -52,7 +69,8 @@ var _ BlankNodeFactory = &bnF{}
 // NewBlankNodeFactory creates a new [BlankNodeFactory].
 func NewBlankNodeFactory() BlankNodeFactory {
 	return &bnF{
-		a: &atomic.Int64{},
+		a:     &atomic.Int64{},
+		scope: bnFScopes.Add(1),
 	}
 }
 
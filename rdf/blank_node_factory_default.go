@@ -1,6 +1,7 @@
 package rdf
 
 import (
+	"cmp"
 	"sync/atomic"
 )
 
@@ -11,6 +12,7 @@ type bnDefault struct {
 }
 
 var _ BlankNodeIdentifier = bnDefault{}
+var _ BlankNodeIdentifierComparer = bnDefault{}
 
 func (bni bnDefault) EqualsBlankNodeIdentifier(other BlankNodeIdentifier) bool {
 	otherT, ok := other.(bnDefault)
@@ -21,6 +23,15 @@ func (bni bnDefault) EqualsBlankNodeIdentifier(other BlankNodeIdentifier) bool {
 	return otherT.v == bni.v
 }
 
+func (bni bnDefault) CompareBlankNodeIdentifier(other BlankNodeIdentifier) int {
+	otherT, ok := other.(bnDefault)
+	if !ok {
+		return 0
+	}
+
+	return cmp.Compare(bni.v, otherT.v)
+}
+
 //
 
 type defaultBlankNodeFactory struct {
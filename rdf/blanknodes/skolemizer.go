@@ -0,0 +1,268 @@
+package blanknodes
+
+import (
+	"strings"
+	"sync"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// WellKnownGenIDPath is the path prefix of skolem IRIs as described by RDF 1.1 Concepts, Section 3.5.
+const WellKnownGenIDPath = "/.well-known/genid/"
+
+// NewSkolemIRIPrefix returns the prefix of skolem IRIs for an authority, such as "https://example.com".
+func NewSkolemIRIPrefix(authority rdf.IRI) rdf.IRI {
+	return rdf.IRI(strings.TrimSuffix(string(authority), "/")) + WellKnownGenIDPath
+}
+
+//
+
+// Skolemizer replaces blank nodes with skolem IRIs of an authority.
+type Skolemizer struct {
+	prefix         rdf.IRI
+	stringProvider StringProvider
+}
+
+// NewSkolemizer creates a Skolemizer for the authority. The string provider determines the suffix of each IRI, so the
+// same blank node is always mapped to the same IRI; by default, [NewUUIDStringProvider] is used.
+func NewSkolemizer(authority rdf.IRI, stringProvider StringProvider) *Skolemizer {
+	if stringProvider == nil {
+		stringProvider = NewUUIDStringProvider("", nil)
+	}
+
+	return &Skolemizer{
+		prefix:         NewSkolemIRIPrefix(authority),
+		stringProvider: stringProvider,
+	}
+}
+
+func (s *Skolemizer) SkolemizeBlankNode(bn rdf.BlankNode) rdf.IRI {
+	return s.prefix + rdf.IRI(s.stringProvider.GetBlankNodeString(bn))
+}
+
+// SkolemizeTerm replaces a blank node, including any within a triple term. Other terms are returned as-is.
+func (s *Skolemizer) SkolemizeTerm(t rdf.Term) rdf.Term {
+	switch t := t.(type) {
+	case rdf.BlankNode:
+		return s.SkolemizeBlankNode(t)
+	case rdf.TripleTerm:
+		return s.skolemizeTripleTerm(t)
+	}
+
+	return t
+}
+
+func (s *Skolemizer) SkolemizeQuad(q rdf.Quad) rdf.Quad {
+	if bn, ok := q.Triple.Subject.(rdf.BlankNode); ok {
+		q.Triple.Subject = s.SkolemizeBlankNode(bn)
+	}
+
+	q.Triple.Object = s.skolemizeObject(q.Triple.Object)
+
+	if bn, ok := q.GraphName.(rdf.BlankNode); ok {
+		q.GraphName = s.SkolemizeBlankNode(bn)
+	}
+
+	return q
+}
+
+func (s *Skolemizer) skolemizeObject(o rdf.ObjectValue) rdf.ObjectValue {
+	switch o := o.(type) {
+	case rdf.BlankNode:
+		return s.SkolemizeBlankNode(o)
+	case rdf.TripleTerm:
+		return s.skolemizeTripleTerm(o)
+	}
+
+	return o
+}
+
+func (s *Skolemizer) skolemizeTripleTerm(t rdf.TripleTerm) rdf.TripleTerm {
+	if bn, ok := t.Subject.(rdf.BlankNode); ok {
+		t.Subject = s.SkolemizeBlankNode(bn)
+	}
+
+	t.Object = s.skolemizeObject(t.Object)
+
+	return t
+}
+
+//
+
+// Deskolemizer replaces skolem IRIs of an authority with blank nodes.
+type Deskolemizer struct {
+	prefix        rdf.IRI
+	factory       rdf.BlankNodeFactory
+	stringFactory StringFactory
+
+	mutex sync.Mutex
+	known map[rdf.IRI]rdf.BlankNode
+}
+
+// NewDeskolemizer creates a Deskolemizer for the authority. If authority is empty, any IRI with a path starting with
+// [WellKnownGenIDPath] is replaced.
+//
+// New blank nodes are created by factory, or [rdf.DefaultBlankNodeFactory] by default. If factory is a
+// [StringFactory], the suffix of the IRI is used as the string identifier of the blank node.
+func NewDeskolemizer(authority rdf.IRI, factory rdf.BlankNodeFactory) *Deskolemizer {
+	d := &Deskolemizer{
+		factory: factory,
+		known:   map[rdf.IRI]rdf.BlankNode{},
+	}
+
+	if len(authority) > 0 {
+		d.prefix = NewSkolemIRIPrefix(authority)
+	}
+
+	if d.factory == nil {
+		d.factory = rdf.DefaultBlankNodeFactory
+	}
+
+	d.stringFactory, _ = d.factory.(StringFactory)
+
+	return d
+}
+
+// DeskolemizeIRI returns the blank node of a skolem IRI. The same IRI is always mapped to the same blank node. If the
+// IRI is not a skolem IRI of the authority, false is returned.
+func (d *Deskolemizer) DeskolemizeIRI(t rdf.IRI) (rdf.BlankNode, bool) {
+	suffix, ok := d.cutSkolemIRI(t)
+	if !ok {
+		return rdf.BlankNode{}, false
+	}
+
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if bn, known := d.known[t]; known {
+		return bn, true
+	}
+
+	var bn rdf.BlankNode
+
+	if d.stringFactory != nil {
+		bn = d.stringFactory.NewStringBlankNode(suffix)
+	} else {
+		bn = d.factory.NewBlankNode()
+	}
+
+	d.known[t] = bn
+
+	return bn, true
+}
+
+// DeskolemizeTerm replaces a skolem IRI, including any within a triple term. Other terms are returned as-is.
+func (d *Deskolemizer) DeskolemizeTerm(t rdf.Term) rdf.Term {
+	switch t := t.(type) {
+	case rdf.IRI:
+		if bn, ok := d.DeskolemizeIRI(t); ok {
+			return bn
+		}
+	case rdf.TripleTerm:
+		return d.deskolemizeTripleTerm(t)
+	}
+
+	return t
+}
+
+// DeskolemizeQuad replaces skolem IRIs of the subject, object, and graph name. Predicates are never replaced since
+// they cannot be blank nodes.
+func (d *Deskolemizer) DeskolemizeQuad(q rdf.Quad) rdf.Quad {
+	if iri, ok := q.Triple.Subject.(rdf.IRI); ok {
+		if bn, ok := d.DeskolemizeIRI(iri); ok {
+			q.Triple.Subject = bn
+		}
+	}
+
+	q.Triple.Object = d.deskolemizeObject(q.Triple.Object)
+
+	if iri, ok := q.GraphName.(rdf.IRI); ok {
+		if bn, ok := d.DeskolemizeIRI(iri); ok {
+			q.GraphName = bn
+		}
+	}
+
+	return q
+}
+
+func (d *Deskolemizer) deskolemizeObject(o rdf.ObjectValue) rdf.ObjectValue {
+	switch o := o.(type) {
+	case rdf.IRI:
+		if bn, ok := d.DeskolemizeIRI(o); ok {
+			return bn
+		}
+	case rdf.TripleTerm:
+		return d.deskolemizeTripleTerm(o)
+	}
+
+	return o
+}
+
+func (d *Deskolemizer) deskolemizeTripleTerm(t rdf.TripleTerm) rdf.TripleTerm {
+	if iri, ok := t.Subject.(rdf.IRI); ok {
+		if bn, ok := d.DeskolemizeIRI(iri); ok {
+			t.Subject = bn
+		}
+	}
+
+	t.Object = d.deskolemizeObject(t.Object)
+
+	return t
+}
+
+func (d *Deskolemizer) cutSkolemIRI(t rdf.IRI) (string, bool) {
+	if len(d.prefix) > 0 {
+		suffix, ok := strings.CutPrefix(string(t), string(d.prefix))
+
+		return suffix, ok && len(suffix) > 0
+	}
+
+	// any authority; the path must immediately follow it
+	schemeEnd := strings.Index(string(t), "://")
+	if schemeEnd < 0 {
+		return "", false
+	}
+
+	rest := string(t)[schemeEnd+3:]
+
+	pathStart := strings.IndexByte(rest, '/')
+	if pathStart < 0 {
+		return "", false
+	}
+
+	suffix, ok := strings.CutPrefix(rest[pathStart:], WellKnownGenIDPath)
+
+	return suffix, ok && len(suffix) > 0
+}
+
+//
+
+type mappedQuadIterator struct {
+	rdf.QuadIterator
+
+	mapper func(q rdf.Quad) rdf.Quad
+}
+
+func (i mappedQuadIterator) Quad() rdf.Quad {
+	return i.mapper(i.QuadIterator.Quad())
+}
+
+func (i mappedQuadIterator) Statement() rdf.Statement {
+	return i.Quad()
+}
+
+// NewSkolemizedQuadIterator wraps an iterator to replace its blank nodes with skolem IRIs.
+func NewSkolemizedQuadIterator(it rdf.QuadIterator, s *Skolemizer) rdf.QuadIterator {
+	return mappedQuadIterator{
+		QuadIterator: it,
+		mapper:       s.SkolemizeQuad,
+	}
+}
+
+// NewDeskolemizedQuadIterator wraps an iterator to replace its skolem IRIs with blank nodes.
+func NewDeskolemizedQuadIterator(it rdf.QuadIterator, d *Deskolemizer) rdf.QuadIterator {
+	return mappedQuadIterator{
+		QuadIterator: it,
+		mapper:       d.DeskolemizeQuad,
+	}
+}
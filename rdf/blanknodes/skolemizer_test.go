@@ -0,0 +1,103 @@
+package blanknodes
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestSkolemizer(t *testing.T) {
+	subject := NewSkolemizer("https://example.com/", NewInt64StringProvider(""))
+
+	bn1 := rdf.NewBlankNode()
+	bn2 := rdf.NewBlankNode()
+
+	q := subject.SkolemizeQuad(rdf.Quad{
+		Triple: rdf.Triple{
+			Subject:   bn1,
+			Predicate: rdf.IRI("http://example.com/p"),
+			Object: rdf.TripleTerm{
+				Subject:   bn2,
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    bn1,
+			},
+		},
+		GraphName: bn2,
+	})
+
+	if _a, _e := q.Triple.Subject, rdf.IRI("https://example.com/.well-known/genid/b0"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := q.Triple.Object.(rdf.TripleTerm).Subject, rdf.IRI("https://example.com/.well-known/genid/b1"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := q.Triple.Object.(rdf.TripleTerm).Object, rdf.IRI("https://example.com/.well-known/genid/b0"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := q.GraphName, rdf.IRI("https://example.com/.well-known/genid/b1"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestDeskolemizer(t *testing.T) {
+	bnFactory := NewStringFactory()
+	subject := NewDeskolemizer("https://example.com", bnFactory)
+
+	q := subject.DeskolemizeQuad(rdf.Quad{
+		Triple: rdf.Triple{
+			Subject:   rdf.IRI("https://example.com/.well-known/genid/b0"),
+			Predicate: rdf.IRI("https://example.com/.well-known/genid/b0"),
+			Object:    rdf.IRI("https://example.org/.well-known/genid/b0"),
+		},
+		GraphName: rdf.IRI("https://example.com/.well-known/genid/b0"),
+	})
+
+	bn, ok := q.Triple.Subject.(rdf.BlankNode)
+	if !ok {
+		t.Fatalf("expected blank node, got %v", q.Triple.Subject)
+	} else if _a, _e := bnFactory.(StringProviderProvider).GetStringProvider(nil).GetBlankNodeString(bn), "b0"; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if !bn.TermEquals(q.GraphName) {
+		t.Fatalf("expected %v, got %v", bn, q.GraphName)
+	} else if _a, _e := q.Triple.Predicate, rdf.IRI("https://example.com/.well-known/genid/b0"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := q.Triple.Object, rdf.IRI("https://example.org/.well-known/genid/b0"); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestDeskolemizer_AnyAuthority(t *testing.T) {
+	subject := NewDeskolemizer("", nil)
+
+	for _, tc := range []struct {
+		IRI      rdf.IRI
+		Expected bool
+	}{
+		{"https://example.com/.well-known/genid/b0", true},
+		{"https://example.org/.well-known/genid/b0", true},
+		{"https://example.com/.well-known/genid/", false},
+		{"https://example.com/path/.well-known/genid/b0", false},
+		{"urn:example:.well-known/genid/b0", false},
+	} {
+		if _, _a := subject.DeskolemizeIRI(tc.IRI); _a != tc.Expected {
+			t.Fatalf("%s: expected %v, got %v", tc.IRI, tc.Expected, _a)
+		}
+	}
+}
+
+func TestSkolemizer_RoundTrip(t *testing.T) {
+	bn := rdf.NewBlankNode()
+
+	skolemizer := NewSkolemizer("https://example.com", nil)
+	deskolemizer := NewDeskolemizer("https://example.com", nil)
+
+	iri := skolemizer.SkolemizeBlankNode(bn)
+
+	if _a, _e := skolemizer.SkolemizeBlankNode(bn), iri; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	restored, ok := deskolemizer.DeskolemizeIRI(iri)
+	if !ok {
+		t.Fatalf("expected skolem IRI: %v", iri)
+	} else if restored2, _ := deskolemizer.DeskolemizeIRI(iri); !restored.TermEquals(restored2) {
+		t.Fatalf("expected %v, got %v", restored, restored2)
+	}
+}
@@ -1,6 +1,12 @@
 package blanknodes
 
-import "github.com/dpb587/rdfkit-go/rdf"
+import (
+	"cmp"
+	"strings"
+	"sync/atomic"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
 
 // StringFactory expands supports for the creation of BlankNode values based on string identifiers.
 type StringFactory interface {
@@ -19,6 +25,7 @@ type bnString struct {
 }
 
 var _ rdf.BlankNodeIdentifier = bnString{}
+var _ rdf.BlankNodeIdentifierComparer = bnString{}
 
 func (bni bnString) EqualsBlankNodeIdentifier(other rdf.BlankNodeIdentifier) bool {
 	otherT, ok := other.(bnString)
@@ -29,10 +36,25 @@ func (bni bnString) EqualsBlankNodeIdentifier(other rdf.BlankNodeIdentifier) boo
 	return otherT.s == bni.s && otherT.v == bni.v
 }
 
+func (bni bnString) CompareBlankNodeIdentifier(other rdf.BlankNodeIdentifier) int {
+	otherT, ok := other.(bnString)
+	if !ok {
+		return 0
+	} else if otherT.s != bni.s {
+		return cmp.Compare(bni.s.scope, otherT.s.scope)
+	}
+
+	return strings.Compare(bni.v, otherT.v)
+}
+
 //
 
+// bnStringFScopes orders the identifiers of different factories by when the factory was created.
+var bnStringFScopes = &atomic.Int64{}
+
 type bnStringF struct {
-	anon rdf.BlankNodeFactory
+	anon  rdf.BlankNodeFactory
+	scope int64
 }
 
 var _ StringFactory = &bnStringF{}
@@ -40,7 +62,8 @@ var _ StringProviderProvider = &bnStringF{}
 
 func NewStringFactory() StringFactory {
 	return &bnStringF{
-		anon: rdf.NewBlankNodeFactory(),
+		anon:  rdf.NewBlankNodeFactory(),
+		scope: bnStringFScopes.Add(1),
 	}
 }
 
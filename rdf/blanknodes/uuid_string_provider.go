@@ -31,9 +31,13 @@ func NewUUIDStringProvider(format string, reader io.Reader) StringProvider {
 		format = "%s"
 	}
 
+	if reader == nil {
+		reader = rand.Reader
+	}
+
 	return &uuidStringProvider{
 		format: format,
-		reader: rand.Reader,
+		reader: reader,
 		known:  make(map[rdf.BlankNodeIdentifier]uuid.UUID),
 	}
 }
@@ -48,7 +52,8 @@ func (sp *uuidStringProvider) GetBlankNodeString(bn rdf.BlankNode) string {
 			panic(fmt.Errorf("%T: %v", sp, err))
 		}
 
-		sp.known[bn.Identifier] = value
+		index = value
+		sp.known[bn.Identifier] = index
 	}
 
 	sp.mutex.Unlock()
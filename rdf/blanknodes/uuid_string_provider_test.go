@@ -0,0 +1,47 @@
+package blanknodes
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestUUIDStringProvider(t *testing.T) {
+	subject := NewUUIDStringProvider("urn:uuid:%s", nil)
+
+	bn1 := rdf.NewBlankNode()
+	bn2 := rdf.NewBlankNode()
+
+	s1 := subject.GetBlankNodeString(bn1)
+	s2 := subject.GetBlankNodeString(bn2)
+
+	if !strings.HasPrefix(s1, "urn:uuid:") {
+		t.Fatalf("expected urn:uuid: prefix, got %s", s1)
+	} else if _a, _e := s1, "urn:uuid:00000000-0000-0000-0000-000000000000"; _a == _e {
+		t.Fatalf("expected non-zero UUID, got %s", _a)
+	} else if s1 == s2 {
+		t.Fatalf("expected distinct strings, got %s", s1)
+	} else if _a, _e := subject.GetBlankNodeString(bn1), s1; _a != _e {
+		t.Fatalf("expected %s, got %s", _e, _a)
+	}
+}
+
+func TestUUIDStringProvider_Reader(t *testing.T) {
+	subject := NewUUIDStringProvider("", errorReader{})
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected panic, got nil")
+		}
+	}()
+
+	subject.GetBlankNodeString(rdf.NewBlankNode())
+}
+
+type errorReader struct{}
+
+func (errorReader) Read([]byte) (int, error) {
+	return 0, errors.New("fake error")
+}
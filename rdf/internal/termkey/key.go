@@ -0,0 +1,73 @@
+// Package termkey encodes terms into binary keys. It is shared by packages which need to compare or intern terms
+// without depending on each other.
+package termkey
+
+import (
+	"encoding/binary"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+const (
+	KindBlankNode byte = 0x01
+	KindIRI       byte = 0x02
+	KindLiteral   byte = 0x03
+	KindTriple    byte = 0x04
+)
+
+const (
+	LiteralTagNone        byte = 0x00
+	LiteralTagLanguage    byte = 0x01
+	LiteralTagDirLanguage byte = 0x02
+)
+
+// AppendIRI appends the kind byte followed by the IRI.
+func AppendIRI(dst []byte, t rdf.IRI) []byte {
+	dst = append(dst, KindIRI)
+
+	return append(dst, t...)
+}
+
+// AppendLiteral appends the kind byte, the length-prefixed datatype, a tag byte with its length-prefixed fields, and
+// then the lexical form.
+func AppendLiteral(dst []byte, t rdf.Literal) []byte {
+	dst = append(dst, KindLiteral)
+	dst = AppendString(dst, string(t.Datatype))
+
+	switch tag := t.Tag.(type) {
+	case rdf.LanguageLiteralTag:
+		dst = append(dst, LiteralTagLanguage)
+		dst = AppendString(dst, tag.Language)
+	case rdf.DirectionalLanguageLiteralTag:
+		dst = append(dst, LiteralTagDirLanguage)
+		dst = AppendString(dst, tag.Language)
+		dst = AppendString(dst, tag.BaseDirection)
+	default:
+		dst = append(dst, LiteralTagNone)
+	}
+
+	return append(dst, t.LexicalForm...)
+}
+
+// AppendBlankNode appends the kind byte followed by an ID which was allocated for the blank node.
+func AppendBlankNode(dst []byte, id uint64) []byte {
+	dst = append(dst, KindBlankNode)
+
+	return binary.AppendUvarint(dst, id)
+}
+
+// AppendTripleTerm appends the kind byte followed by IDs which were allocated for the subject, predicate, and object.
+func AppendTripleTerm(dst []byte, s, p, o uint64) []byte {
+	dst = append(dst, KindTriple)
+	dst = binary.AppendUvarint(dst, s)
+	dst = binary.AppendUvarint(dst, p)
+
+	return binary.AppendUvarint(dst, o)
+}
+
+// AppendString appends the length-prefixed value.
+func AppendString(dst []byte, v string) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(v)))
+
+	return append(dst, v...)
+}
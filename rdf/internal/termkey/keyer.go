@@ -0,0 +1,93 @@
+package termkey
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// Keyer creates keys which are equal for statements of equal terms. Blank nodes are keyed by IDs which are allocated
+// as they are first seen, so a Keyer retains every blank node identifier it is given.
+//
+// A Keyer is not safe for concurrent use.
+type Keyer struct {
+	blankNodeIDs    map[rdf.BlankNodeIdentifier]uint64
+	lastBlankNodeID uint64
+	buf             []byte
+}
+
+func NewKeyer() *Keyer {
+	return &Keyer{
+		blankNodeIDs: map[rdf.BlankNodeIdentifier]uint64{},
+	}
+}
+
+// QuadKey returns the key of a quad. The default graph is keyed as an empty term.
+func (k *Keyer) QuadKey(q rdf.Quad) string {
+	k.buf = k.buf[:0]
+	k.buf = k.appendTerm(k.buf, q.Triple.Subject)
+	k.buf = k.appendTerm(k.buf, q.Triple.Predicate)
+	k.buf = k.appendTerm(k.buf, q.Triple.Object)
+
+	if q.GraphName != nil {
+		k.buf = k.appendTerm(k.buf, q.GraphName.(rdf.Term))
+	} else {
+		k.buf = AppendString(k.buf, "")
+	}
+
+	return string(k.buf)
+}
+
+// TripleKey returns the key of a triple.
+func (k *Keyer) TripleKey(t rdf.Triple) string {
+	k.buf = k.buf[:0]
+	k.buf = k.appendTerm(k.buf, t.Subject)
+	k.buf = k.appendTerm(k.buf, t.Predicate)
+	k.buf = k.appendTerm(k.buf, t.Object)
+
+	return string(k.buf)
+}
+
+// appendTerm appends the length-prefixed key of a term. The components of a triple term are nested in the same way.
+func (k *Keyer) appendTerm(dst []byte, t rdf.Term) []byte {
+	var key []byte
+
+	switch t := t.(type) {
+	case rdf.IRI:
+		key = AppendIRI(nil, t)
+	case rdf.Literal:
+		key = AppendLiteral(nil, t)
+	case rdf.BlankNode:
+		key = AppendBlankNode(nil, k.blankNodeID(t))
+	case rdf.TripleTerm:
+		key = append(key, KindTriple)
+		key = k.appendTerm(key, t.Subject)
+		key = k.appendTerm(key, t.Predicate)
+		key = k.appendTerm(key, t.Object)
+	default:
+		panic(fmt.Errorf("unsupported term type: %T", t))
+	}
+
+	return AppendString(dst, string(key))
+}
+
+func (k *Keyer) blankNodeID(t rdf.BlankNode) uint64 {
+	if t.Identifier == nil {
+		// never equal to another blank node
+		return k.nextBlankNodeID()
+	}
+
+	id, ok := k.blankNodeIDs[t.Identifier]
+	if !ok {
+		id = k.nextBlankNodeID()
+		k.blankNodeIDs[t.Identifier] = id
+	}
+
+	return id
+}
+
+func (k *Keyer) nextBlankNodeID() uint64 {
+	k.lastBlankNodeID++
+
+	return k.lastBlankNodeID
+}
@@ -0,0 +1,64 @@
+package langtags
+
+import (
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// MapperFunc checks or rewrites a language tag. It is used by decoders which accept language tags from documents;
+// [Validated] rejects malformed tags and [Normalize] additionally normalizes their case.
+type MapperFunc func(tag string) (string, error)
+
+var _ MapperFunc = Validated
+var _ MapperFunc = Normalize
+
+// Validated returns the language tag as-is if it is well-formed.
+func Validated(tag string) (string, error) {
+	if err := Validate(tag); err != nil {
+		return "", err
+	}
+
+	return tag, nil
+}
+
+// MapLiteralTag applies the mapper to the language of a [rdf.LanguageLiteralTag] or
+// [rdf.DirectionalLanguageLiteralTag]. Any other tag is returned as-is.
+func MapLiteralTag(t rdf.LiteralTag, mapper MapperFunc) (rdf.LiteralTag, error) {
+	switch t := t.(type) {
+	case rdf.LanguageLiteralTag:
+		language, err := mapper(t.Language)
+		if err != nil {
+			return nil, err
+		}
+
+		t.Language = language
+
+		return t, nil
+	case rdf.DirectionalLanguageLiteralTag:
+		language, err := mapper(t.Language)
+		if err != nil {
+			return nil, err
+		}
+
+		t.Language = language
+
+		return t, nil
+	}
+
+	return t, nil
+}
+
+// NormalizeLiteral normalizes the language tag of a literal, if any.
+func NormalizeLiteral(l rdf.Literal) (rdf.Literal, error) {
+	if l.Tag == nil {
+		return l, nil
+	}
+
+	tag, err := MapLiteralTag(l.Tag, Normalize)
+	if err != nil {
+		return rdf.Literal{}, err
+	}
+
+	l.Tag = tag
+
+	return l, nil
+}
@@ -0,0 +1,58 @@
+package langtags
+
+import (
+	"strings"
+)
+
+// MatchBasic reports whether the language tag matches a language range using the basic filtering of RFC 4647, Section
+// 3.3.1. This is the behavior of the SPARQL langMatches function, where the "*" range matches any non-empty tag.
+//
+// Comparisons are case-insensitive and the range must match whole subtags; for example, "de" matches "de-CH" but not
+// "den".
+func MatchBasic(tag, languageRange string) bool {
+	if languageRange == "*" {
+		return len(tag) > 0
+	} else if len(tag) < len(languageRange) {
+		return false
+	} else if !strings.EqualFold(tag[:len(languageRange)], languageRange) {
+		return false
+	}
+
+	return len(tag) == len(languageRange) || tag[len(languageRange)] == '-'
+}
+
+// MatchExtended reports whether the language tag matches an extended language range using the extended filtering of
+// RFC 4647, Section 3.3.2. Wildcard subtags of the range match any number of subtags of the tag, so "de-*-DE" matches
+// "de-Latn-DE" and "de-DE".
+func MatchExtended(tag, languageRange string) bool {
+	if len(tag) == 0 {
+		return false
+	}
+
+	tagSubtags := strings.Split(strings.ToLower(tag), "-")
+	rangeSubtags := strings.Split(strings.ToLower(languageRange), "-")
+
+	if rangeSubtags[0] != "*" && rangeSubtags[0] != tagSubtags[0] {
+		return false
+	}
+
+	tagIdx, rangeIdx := 1, 1
+
+	for rangeIdx < len(rangeSubtags) {
+		switch {
+		case rangeSubtags[rangeIdx] == "*":
+			rangeIdx++
+		case tagIdx >= len(tagSubtags):
+			return false
+		case rangeSubtags[rangeIdx] == tagSubtags[tagIdx]:
+			rangeIdx++
+			tagIdx++
+		case len(tagSubtags[tagIdx]) == 1:
+			return false
+		default:
+			tagIdx++
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,45 @@
+package langtags
+
+import "testing"
+
+func TestMatchBasic(t *testing.T) {
+	for _, tc := range []struct {
+		Tag      string
+		Range    string
+		Expected bool
+	}{
+		{"en", "en", true},
+		{"en-US", "en", true},
+		{"EN-us", "en-US", true},
+		{"en", "en-US", false},
+		{"english", "en", false},
+		{"en", "*", true},
+		{"", "*", false},
+		{"fr", "en", false},
+	} {
+		if _a := MatchBasic(tc.Tag, tc.Range); _a != tc.Expected {
+			t.Fatalf("%s ~ %s: expected %v, got %v", tc.Tag, tc.Range, tc.Expected, _a)
+		}
+	}
+}
+
+func TestMatchExtended(t *testing.T) {
+	// examples of RFC 4647, Section 3.3.2
+	for _, tag := range []string{"de-DE", "de-de", "de-Latn-DE", "de-Latf-DE", "de-DE-x-goethe", "de-Latn-DE-1996", "de-Deva-DE"} {
+		if !MatchExtended(tag, "de-*-DE") {
+			t.Fatalf("%s: expected match", tag)
+		}
+	}
+
+	for _, tag := range []string{"de", "de-x-DE", "de-Deva"} {
+		if MatchExtended(tag, "de-*-DE") {
+			t.Fatalf("%s: expected no match", tag)
+		}
+	}
+
+	if !MatchExtended("fr-CA", "*") {
+		t.Fatalf("expected wildcard match")
+	} else if !MatchExtended("de-DE", "*-DE") {
+		t.Fatalf("expected wildcard primary match")
+	}
+}
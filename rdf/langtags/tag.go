@@ -0,0 +1,276 @@
+package langtags
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrNotWellFormed = errors.New("language tag not well-formed")
+
+// Tag is a parsed [BCP47] language tag. Subtags retain their original case; use [Tag.String] for the normalized form.
+//
+// [BCP47]: https://www.rfc-editor.org/rfc/rfc5646
+type Tag struct {
+	// Grandfathered is the full tag when it is one of the irregular or regular grandfathered tags. All other fields are
+	// empty.
+	Grandfathered string
+
+	Language   string
+	ExtLangs   []string
+	Script     string
+	Region     string
+	Variants   []string
+	Extensions []string
+
+	// PrivateUse includes the leading "x" singleton. It is the only field of a private use tag, such as "x-whatever".
+	PrivateUse string
+}
+
+// String returns the tag using the case conventions of RFC 5646, Section 2.1.1.
+func (t Tag) String() string {
+	var subtags []string
+
+	if len(t.Grandfathered) > 0 {
+		subtags = append(subtags, t.Grandfathered)
+	} else {
+		if len(t.Language) > 0 {
+			subtags = append(subtags, t.Language)
+			subtags = append(subtags, t.ExtLangs...)
+		}
+
+		if len(t.Script) > 0 {
+			subtags = append(subtags, t.Script)
+		}
+
+		if len(t.Region) > 0 {
+			subtags = append(subtags, t.Region)
+		}
+
+		subtags = append(subtags, t.Variants...)
+		subtags = append(subtags, t.Extensions...)
+
+		if len(t.PrivateUse) > 0 {
+			subtags = append(subtags, t.PrivateUse)
+		}
+	}
+
+	return normalizeCase(strings.Join(subtags, "-"))
+}
+
+//
+
+var grandfatheredTags = func() map[string]struct{} {
+	m := map[string]struct{}{}
+
+	for _, v := range []string{
+		// irregular
+		"en-GB-oed", "i-ami", "i-bnn", "i-default", "i-enochian", "i-hak", "i-klingon", "i-lux", "i-mingo", "i-navajo",
+		"i-pwn", "i-tao", "i-tay", "i-tsu", "sgn-BE-FR", "sgn-BE-NL", "sgn-CH-DE",
+		// regular
+		"art-lojban", "cel-gaulish", "no-bok", "no-nyn", "zh-guoyu", "zh-hakka", "zh-min", "zh-min-nan", "zh-xiang",
+	} {
+		m[strings.ToLower(v)] = struct{}{}
+	}
+
+	return m
+}()
+
+// Parse parses a well-formed language tag according to the syntax of RFC 5646, Section 2.1. Subtags are not checked
+// against the IANA registry.
+func Parse(s string) (Tag, error) {
+	if _, ok := grandfatheredTags[strings.ToLower(s)]; ok {
+		return Tag{
+			Grandfathered: s,
+		}, nil
+	}
+
+	subtags := strings.Split(s, "-")
+
+	for _, subtag := range subtags {
+		if len(subtag) == 0 {
+			return Tag{}, fmt.Errorf("%w: empty subtag: %q", ErrNotWellFormed, s)
+		} else if len(subtag) > 8 {
+			return Tag{}, fmt.Errorf("%w: subtag too long: %q", ErrNotWellFormed, subtag)
+		} else if !isAlphanum(subtag) {
+			return Tag{}, fmt.Errorf("%w: invalid subtag: %q", ErrNotWellFormed, subtag)
+		}
+	}
+
+	var t Tag
+
+	if isPrivateUseSingleton(subtags[0]) {
+		return parsePrivateUse(t, subtags)
+	}
+
+	// language = 2*3ALPHA ["-" extlang] / 4ALPHA / 5*8ALPHA
+	if len(subtags[0]) < 2 || !isAlpha(subtags[0]) {
+		return Tag{}, fmt.Errorf("%w: invalid primary language subtag: %q", ErrNotWellFormed, subtags[0])
+	}
+
+	t.Language = subtags[0]
+	subtags = subtags[1:]
+
+	// extlang = 3ALPHA *2("-" 3ALPHA)
+	if len(t.Language) <= 3 {
+		for len(subtags) > 0 && len(t.ExtLangs) < 3 && len(subtags[0]) == 3 && isAlpha(subtags[0]) {
+			t.ExtLangs = append(t.ExtLangs, subtags[0])
+			subtags = subtags[1:]
+		}
+	}
+
+	// script = 4ALPHA
+	if len(subtags) > 0 && len(subtags[0]) == 4 && isAlpha(subtags[0]) {
+		t.Script = subtags[0]
+		subtags = subtags[1:]
+	}
+
+	// region = 2ALPHA / 3DIGIT
+	if len(subtags) > 0 && (len(subtags[0]) == 2 && isAlpha(subtags[0]) || len(subtags[0]) == 3 && isDigit(subtags[0])) {
+		t.Region = subtags[0]
+		subtags = subtags[1:]
+	}
+
+	// variant = 5*8alphanum / (DIGIT 3alphanum)
+	for len(subtags) > 0 && (len(subtags[0]) >= 5 || len(subtags[0]) == 4 && isDigit(subtags[0][:1])) {
+		t.Variants = append(t.Variants, subtags[0])
+		subtags = subtags[1:]
+	}
+
+	// extension = singleton 1*("-" (2*8alphanum))
+	for len(subtags) > 0 && len(subtags[0]) == 1 && !isPrivateUseSingleton(subtags[0]) {
+		extension := []string{subtags[0]}
+		subtags = subtags[1:]
+
+		for len(subtags) > 0 && len(subtags[0]) >= 2 {
+			extension = append(extension, subtags[0])
+			subtags = subtags[1:]
+		}
+
+		if len(extension) == 1 {
+			return Tag{}, fmt.Errorf("%w: empty extension: %q", ErrNotWellFormed, extension[0])
+		}
+
+		t.Extensions = append(t.Extensions, strings.Join(extension, "-"))
+	}
+
+	if len(subtags) > 0 && isPrivateUseSingleton(subtags[0]) {
+		return parsePrivateUse(t, subtags)
+	} else if len(subtags) > 0 {
+		return Tag{}, fmt.Errorf("%w: unexpected subtag: %q", ErrNotWellFormed, subtags[0])
+	}
+
+	return t, nil
+}
+
+// privateuse = "x" 1*("-" (1*8alphanum))
+func parsePrivateUse(t Tag, subtags []string) (Tag, error) {
+	if len(subtags) < 2 {
+		return Tag{}, fmt.Errorf("%w: empty private use", ErrNotWellFormed)
+	}
+
+	t.PrivateUse = strings.Join(subtags, "-")
+
+	return t, nil
+}
+
+// Validate returns an error if the language tag is not well-formed.
+func Validate(s string) error {
+	_, err := Parse(s)
+
+	return err
+}
+
+// IsWellFormed reports whether the language tag is well-formed.
+func IsWellFormed(s string) bool {
+	return Validate(s) == nil
+}
+
+// Normalize returns a well-formed language tag using the case conventions of RFC 5646, Section 2.1.1. For example,
+// "en-us" is normalized to "en-US" and "ZH-HANT-tw" to "zh-Hant-TW".
+//
+// Tags which differ only by case are equal, so this is a presentation form rather than a canonical one. The canonical
+// form of [github.com/dpb587/rdfkit-go/ontology/xsd/xsdtype.CanonicalLiteral] is lower case instead.
+func Normalize(s string) (string, error) {
+	if err := Validate(s); err != nil {
+		return "", err
+	}
+
+	return normalizeCase(s), nil
+}
+
+// normalizeCase lowercases all subtags, except those which follow the primary subtag and precede any singleton:
+// two-letter subtags are uppercased and four-letter subtags are titlecased.
+func normalizeCase(s string) string {
+	buf := []byte(strings.ToLower(s))
+
+	var subtagIdx int
+	var singleton bool
+
+	for start := 0; start < len(buf); {
+		end := start
+
+		for end < len(buf) && buf[end] != '-' {
+			end++
+		}
+
+		switch l := end - start; {
+		case l == 1:
+			singleton = true
+		case singleton || subtagIdx == 0:
+			// lowercase
+		case l == 2:
+			buf[start] = toUpper(buf[start])
+			buf[start+1] = toUpper(buf[start+1])
+		case l == 4:
+			buf[start] = toUpper(buf[start])
+		}
+
+		subtagIdx++
+		start = end + 1
+	}
+
+	return string(buf)
+}
+
+func toUpper(b byte) byte {
+	if 'a' <= b && b <= 'z' {
+		return b - ('a' - 'A')
+	}
+
+	return b
+}
+
+func isAlpha(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !('a' <= s[i] && s[i] <= 'z' || 'A' <= s[i] && s[i] <= 'Z') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isDigit(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !('0' <= s[i] && s[i] <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isAlphanum(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !('a' <= s[i] && s[i] <= 'z' || 'A' <= s[i] && s[i] <= 'Z' || '0' <= s[i] && s[i] <= '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func isPrivateUseSingleton(s string) bool {
+	return s == "x" || s == "X"
+}
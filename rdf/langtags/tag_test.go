@@ -0,0 +1,125 @@
+package langtags
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	for _, tc := range []struct {
+		Input    string
+		Expected Tag
+	}{
+		{
+			Input:    "en",
+			Expected: Tag{Language: "en"},
+		},
+		{
+			Input:    "zh-yue-Hant-HK",
+			Expected: Tag{Language: "zh", ExtLangs: []string{"yue"}, Script: "Hant", Region: "HK"},
+		},
+		{
+			Input:    "es-419",
+			Expected: Tag{Language: "es", Region: "419"},
+		},
+		{
+			Input:    "de-CH-1901-x-phonebk",
+			Expected: Tag{Language: "de", Region: "CH", Variants: []string{"1901"}, PrivateUse: "x-phonebk"},
+		},
+		{
+			Input:    "sl-rozaj-biske",
+			Expected: Tag{Language: "sl", Variants: []string{"rozaj", "biske"}},
+		},
+		{
+			Input:    "en-a-bbb-u-co-phonebk",
+			Expected: Tag{Language: "en", Extensions: []string{"a-bbb", "u-co-phonebk"}},
+		},
+		{
+			Input:    "x-whatever",
+			Expected: Tag{PrivateUse: "x-whatever"},
+		},
+		{
+			Input:    "i-klingon",
+			Expected: Tag{Grandfathered: "i-klingon"},
+		},
+	} {
+		t.Run(tc.Input, func(t *testing.T) {
+			_a, err := Parse(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_e := tc.Expected
+
+			if _a.Grandfathered != _e.Grandfathered ||
+				_a.Language != _e.Language ||
+				!slices.Equal(_a.ExtLangs, _e.ExtLangs) ||
+				_a.Script != _e.Script ||
+				_a.Region != _e.Region ||
+				!slices.Equal(_a.Variants, _e.Variants) ||
+				!slices.Equal(_a.Extensions, _e.Extensions) ||
+				_a.PrivateUse != _e.PrivateUse {
+				t.Fatalf("expected %#v, got %#v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestValidate_NotWellFormed(t *testing.T) {
+	for _, input := range []string{
+		"",
+		"e",
+		"en-",
+		"en--US",
+		"en-toolongsubtag",
+		"en-a",
+		"en-a-b",
+		"en-x",
+		"x",
+		"1en",
+		"en_US",
+		"en-Ü",
+		"de-419-DE",
+		"a-DE",
+	} {
+		t.Run(input, func(t *testing.T) {
+			if err := Validate(input); !errors.Is(err, ErrNotWellFormed) {
+				t.Fatalf("expected error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tc := range []struct {
+		Input    string
+		Expected string
+	}{
+		{"EN", "en"},
+		{"en-us", "en-US"},
+		{"ZH-HANT-tw", "zh-Hant-TW"},
+		{"sgn-be-fr", "sgn-BE-FR"},
+		{"en-CA-X-CA", "en-CA-x-ca"},
+		{"az-latn-x-latn", "az-Latn-x-latn"},
+		{"de-ch-1996", "de-CH-1996"},
+		{"en-a-bb-cccc", "en-a-bb-cccc"},
+		{"X-PRIVATE", "x-private"},
+	} {
+		t.Run(tc.Input, func(t *testing.T) {
+			_a, err := Normalize(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if _a != tc.Expected {
+				t.Fatalf("expected %v, got %v", tc.Expected, _a)
+			}
+
+			parsed, err := Parse(tc.Input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if _a := parsed.String(); _a != tc.Expected {
+				t.Fatalf("expected %v, got %v", tc.Expected, _a)
+			}
+		})
+	}
+}
@@ -12,6 +12,8 @@ type Literal struct {
 	//
 	// For `http://www.w3.org/1999/02/22-rdf-syntax-ns#langString`, [LanguageLiteralTag] is expected.
 	//
+	// For `http://www.w3.org/1999/02/22-rdf-syntax-ns#dirLangString`, [DirectionalLanguageLiteralTag] is expected.
+	//
 	// For all other datatypes, the value should be nil.
 	Tag LiteralTag
 }
@@ -35,8 +35,8 @@ func (t LanguageLiteralTag) Equals(other LiteralTag) bool {
 
 //
 
-// DirectionalLanguageLiteralTag behavior is still undefined for this Go project and pending RDF 1.2. It is currently
-// used internally for testing and potential future use.
+// DirectionalLanguageLiteralTag is used for a language-tagged literal with an initial text direction. Introduced by
+// RDF 1.2, it is used with the `http://www.w3.org/1999/02/22-rdf-syntax-ns#dirLangString` datatype.
 type DirectionalLanguageLiteralTag struct {
 	// Language is a well-formed [BCP47] language tag.
 	//
@@ -2,7 +2,7 @@ package rdf
 
 // ObjectValue represents any value that can be used for an object property.
 //
-// This is a closed interface. See [BlankNode], [IRI], and [Literal].
+// This is a closed interface. See [BlankNode], [IRI], [Literal], and [TripleTerm].
 type ObjectValue interface {
 	Term
 
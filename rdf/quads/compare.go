@@ -0,0 +1,17 @@
+package quads
+
+import (
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/terms"
+	"github.com/dpb587/rdfkit-go/rdf/triples"
+)
+
+// Compare orders quads by their triple using [triples.Compare] and then by their graph name using [terms.Compare].
+// Quads of the default graph are ordered first. This matches the code point ordering of their N-Quads representation.
+func Compare(a, b rdf.Quad) int {
+	if c := triples.Compare(a.Triple, b.Triple); c != 0 {
+		return c
+	}
+
+	return terms.Compare(a.GraphName, b.GraphName)
+}
@@ -0,0 +1,244 @@
+package quadssort
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"os"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/termdict"
+)
+
+const (
+	runTermNil byte = iota
+	runTermBlankNode
+	runTermIRI
+	runTermLiteral
+	runTermTriple
+)
+
+// runBlankNodes retains blank nodes by index since their identifiers cannot be written.
+type runBlankNodes struct {
+	indices map[rdf.BlankNodeIdentifier]uint64
+	values  []rdf.BlankNode
+}
+
+func newRunBlankNodes() *runBlankNodes {
+	return &runBlankNodes{
+		indices: map[rdf.BlankNodeIdentifier]uint64{},
+	}
+}
+
+func (bns *runBlankNodes) index(t rdf.BlankNode) uint64 {
+	if t.Identifier != nil {
+		if idx, ok := bns.indices[t.Identifier]; ok {
+			return idx
+		}
+	}
+
+	idx := uint64(len(bns.values))
+	bns.values = append(bns.values, t)
+
+	if t.Identifier != nil {
+		bns.indices[t.Identifier] = idx
+	}
+
+	return idx
+}
+
+//
+
+func writeRun(path string, ql rdf.QuadList, bns *runBlankNodes) error {
+	fh, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	w := bufio.NewWriter(fh)
+
+	var buf []byte
+
+	for _, q := range ql {
+		buf = buf[:0]
+		buf = appendRunTerm(buf, q.Triple.Subject, bns)
+		buf = appendRunTerm(buf, q.Triple.Predicate, bns)
+		buf = appendRunTerm(buf, q.Triple.Object, bns)
+		buf = appendRunTerm(buf, q.GraphName, bns)
+
+		if _, err := w.Write(buf); err != nil {
+			fh.Close()
+
+			return err
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		fh.Close()
+
+		return err
+	}
+
+	return fh.Close()
+}
+
+func appendRunTerm(dst []byte, t rdf.Term, bns *runBlankNodes) []byte {
+	switch t := t.(type) {
+	case nil:
+		return append(dst, runTermNil)
+	case rdf.BlankNode:
+		dst = append(dst, runTermBlankNode)
+
+		return binary.AppendUvarint(dst, bns.index(t))
+	case rdf.IRI:
+		dst = append(dst, runTermIRI)
+		dst = binary.AppendUvarint(dst, uint64(len(t)))
+
+		return append(dst, t...)
+	case rdf.Literal:
+		key := termdict.AppendLiteralKey(nil, t)
+
+		dst = append(dst, runTermLiteral)
+		dst = binary.AppendUvarint(dst, uint64(len(key)))
+
+		return append(dst, key...)
+	case rdf.TripleTerm:
+		dst = append(dst, runTermTriple)
+		dst = appendRunTerm(dst, t.Subject, bns)
+		dst = appendRunTerm(dst, t.Predicate, bns)
+
+		return appendRunTerm(dst, t.Object, bns)
+	}
+
+	panic(fmt.Errorf("unsupported term type: %T", t))
+}
+
+//
+
+func readRun(path string, bns *runBlankNodes) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		fh, err := os.Open(path)
+		if err != nil {
+			yield(rdf.Quad{}, err)
+
+			return
+		}
+
+		defer fh.Close()
+
+		r := bufio.NewReader(fh)
+
+		for {
+			if _, err := r.Peek(1); errors.Is(err, io.EOF) {
+				return
+			}
+
+			q, err := readRunQuad(r, bns)
+			if err != nil {
+				yield(rdf.Quad{}, fmt.Errorf("read %s: %v", path, err))
+
+				return
+			}
+
+			if !yield(q, nil) {
+				return
+			}
+		}
+	}
+}
+
+func readRunQuad(r *bufio.Reader, bns *runBlankNodes) (rdf.Quad, error) {
+	var terms [4]rdf.Term
+
+	for i := range terms {
+		t, err := readRunTerm(r, bns)
+		if err != nil {
+			return rdf.Quad{}, err
+		}
+
+		terms[i] = t
+	}
+
+	var q rdf.Quad
+
+	q.Triple.Subject, _ = terms[0].(rdf.SubjectValue)
+	q.Triple.Predicate, _ = terms[1].(rdf.PredicateValue)
+	q.Triple.Object, _ = terms[2].(rdf.ObjectValue)
+	q.GraphName, _ = terms[3].(rdf.GraphNameValue)
+
+	return q, nil
+}
+
+func readRunTerm(r *bufio.Reader, bns *runBlankNodes) (rdf.Term, error) {
+	kind, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+
+	switch kind {
+	case runTermNil:
+		return nil, nil
+	case runTermBlankNode:
+		idx, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		} else if idx >= uint64(len(bns.values)) {
+			return nil, fmt.Errorf("unknown blank node: %d", idx)
+		}
+
+		return bns.values[idx], nil
+	case runTermIRI:
+		buf, err := readRunBytes(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return rdf.IRI(buf), nil
+	case runTermLiteral:
+		buf, err := readRunBytes(r)
+		if err != nil {
+			return nil, err
+		}
+
+		return termdict.DecodeKey(buf)
+	case runTermTriple:
+		var terms [3]rdf.Term
+
+		for i := range terms {
+			t, err := readRunTerm(r, bns)
+			if err != nil {
+				return nil, err
+			}
+
+			terms[i] = t
+		}
+
+		var t rdf.TripleTerm
+
+		t.Subject, _ = terms[0].(rdf.SubjectValue)
+		t.Predicate, _ = terms[1].(rdf.PredicateValue)
+		t.Object, _ = terms[2].(rdf.ObjectValue)
+
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("unknown term kind: 0x%02x", kind)
+}
+
+func readRunBytes(r *bufio.Reader) ([]byte, error) {
+	l, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, l)
+
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
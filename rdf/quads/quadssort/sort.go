@@ -0,0 +1,227 @@
+package quadssort
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+)
+
+type SortOption interface {
+	apply(s *SortConfig)
+}
+
+// Sort reads all quads of the input and returns an iterator of them in order. When there are more quads than may be
+// buffered in memory, sorted runs are written to temporary files and merged while iterating. The temporary files are
+// removed when the returned iterator is closed.
+//
+// Blank nodes are retained in memory so that they are returned as the same values which were read.
+func Sort(ctx context.Context, input rdf.QuadIterator, opts ...SortOption) (rdf.QuadIterator, error) {
+	c := SortConfig{}
+
+	for _, opt := range opts {
+		opt.apply(&c)
+	}
+
+	s := c.newSorter()
+
+	err := s.load(ctx, input)
+	if err != nil {
+		s.cleanup()
+
+		return nil, err
+	}
+
+	return s.newIterator(), nil
+}
+
+//
+
+type sorter struct {
+	compare          func(a, b rdf.Quad) int
+	unique           bool
+	maxBufferedQuads int
+	tempDir          string
+
+	buffered   rdf.QuadList
+	dir        string
+	runs       []string
+	blankNodes *runBlankNodes
+}
+
+func (s *sorter) load(ctx context.Context, input rdf.QuadIterator) error {
+	for input.Next() {
+		s.buffered = append(s.buffered, input.Quad())
+
+		if len(s.buffered) < s.maxBufferedQuads {
+			continue
+		}
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := s.spill(); err != nil {
+			return fmt.Errorf("spill: %v", err)
+		}
+	}
+
+	if err := input.Err(); err != nil {
+		return err
+	}
+
+	s.sortBuffered()
+
+	return nil
+}
+
+func (s *sorter) sortBuffered() {
+	slices.SortStableFunc(s.buffered, s.compare)
+
+	if !s.unique {
+		return
+	}
+
+	var deduped rdf.QuadList
+
+	for q := range uniqueSeq(quads.All(quads.NewIterator(s.buffered)), s.compare) {
+		deduped = append(deduped, q)
+	}
+
+	s.buffered = deduped
+}
+
+func (s *sorter) spill() error {
+	if len(s.dir) == 0 {
+		dir, err := os.MkdirTemp(s.tempDir, "rdfkit-quadssort-")
+		if err != nil {
+			return err
+		}
+
+		s.dir = dir
+	}
+
+	s.sortBuffered()
+
+	path := filepath.Join(s.dir, strconv.Itoa(len(s.runs)))
+
+	err := writeRun(path, s.buffered, s.blankNodes)
+	if err != nil {
+		return err
+	}
+
+	s.runs = append(s.runs, path)
+
+	clear(s.buffered)
+	s.buffered = s.buffered[:0]
+
+	return nil
+}
+
+func (s *sorter) newIterator() rdf.QuadIterator {
+	seqs := []iter.Seq2[rdf.Quad, error]{
+		quads.All(quads.NewIterator(s.buffered)),
+	}
+
+	for _, path := range s.runs {
+		seqs = append(seqs, readRun(path, s.blankNodes))
+	}
+
+	var seq iter.Seq2[rdf.Quad, error]
+
+	if len(seqs) == 1 {
+		seq = seqs[0]
+	} else {
+		seq = quads.Merge(s.compare, seqs...)
+
+		if s.unique {
+			seq = uniqueSeq(seq, s.compare)
+		}
+	}
+
+	return &iterator{
+		QuadIterator: quads.NewSeqIterator(seq),
+		s:            s,
+	}
+}
+
+func (s *sorter) cleanup() error {
+	s.buffered = nil
+
+	if len(s.dir) == 0 {
+		return nil
+	}
+
+	return os.RemoveAll(s.dir)
+}
+
+//
+
+type iterator struct {
+	rdf.QuadIterator
+
+	s *sorter
+}
+
+func (it *iterator) Close() error {
+	it.QuadIterator.Close()
+
+	return it.s.cleanup()
+}
+
+//
+
+// uniqueSeq drops duplicates from a sorted sequence. A compare function from SetCompare may order distinct quads as
+// equivalent, so every quad of the current equivalent group is checked.
+func uniqueSeq(seq iter.Seq2[rdf.Quad, error], compare func(a, b rdf.Quad) int) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		var group rdf.QuadList
+
+	QUADS:
+		for q, err := range seq {
+			if err != nil {
+				yield(q, err)
+
+				return
+			}
+
+			if len(group) > 0 && compare(group[0], q) == 0 {
+				for _, g := range group {
+					if quadEquals(g, q) {
+						continue QUADS
+					}
+				}
+			} else {
+				clear(group)
+				group = group[:0]
+			}
+
+			group = append(group, q)
+
+			if !yield(q, nil) {
+				return
+			}
+		}
+	}
+}
+
+func quadEquals(a, b rdf.Quad) bool {
+	return termEquals(a.Triple.Subject, b.Triple.Subject) &&
+		termEquals(a.Triple.Predicate, b.Triple.Predicate) &&
+		termEquals(a.Triple.Object, b.Triple.Object) &&
+		termEquals(a.GraphName, b.GraphName)
+}
+
+func termEquals(a, b rdf.Term) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.TermEquals(b)
+}
@@ -0,0 +1,91 @@
+package quadssort
+
+import (
+	"os"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+)
+
+type SortConfig struct {
+	compare          func(a, b rdf.Quad) int
+	unique           *bool
+	maxBufferedQuads *int
+	tempDir          *string
+}
+
+var _ SortOption = SortConfig{}
+
+// SetCompare overrides the ordering of quads. By default, [quads.Compare] is used.
+func (c SortConfig) SetCompare(v func(a, b rdf.Quad) int) SortConfig {
+	c.compare = v
+
+	return c
+}
+
+// SetUnique drops duplicate quads, similar to `sort -u`.
+func (c SortConfig) SetUnique(v bool) SortConfig {
+	c.unique = &v
+
+	return c
+}
+
+// SetMaxBufferedQuads is the number of quads which are sorted in memory before they are written to a temporary file.
+// By default, 262144 quads are buffered.
+func (c SortConfig) SetMaxBufferedQuads(v int) SortConfig {
+	c.maxBufferedQuads = &v
+
+	return c
+}
+
+// SetTempDir is the directory where temporary files are created. By default, [os.TempDir] is used.
+func (c SortConfig) SetTempDir(v string) SortConfig {
+	c.tempDir = &v
+
+	return c
+}
+
+func (c SortConfig) apply(s *SortConfig) {
+	if c.compare != nil {
+		s.compare = c.compare
+	}
+
+	if c.unique != nil {
+		s.unique = c.unique
+	}
+
+	if c.maxBufferedQuads != nil {
+		s.maxBufferedQuads = c.maxBufferedQuads
+	}
+
+	if c.tempDir != nil {
+		s.tempDir = c.tempDir
+	}
+}
+
+func (c SortConfig) newSorter() *sorter {
+	s := &sorter{
+		compare:          quads.Compare,
+		maxBufferedQuads: 262144,
+		tempDir:          os.TempDir(),
+		blankNodes:       newRunBlankNodes(),
+	}
+
+	if c.compare != nil {
+		s.compare = c.compare
+	}
+
+	if c.unique != nil {
+		s.unique = *c.unique
+	}
+
+	if c.maxBufferedQuads != nil && *c.maxBufferedQuads > 0 {
+		s.maxBufferedQuads = *c.maxBufferedQuads
+	}
+
+	if c.tempDir != nil {
+		s.tempDir = *c.tempDir
+	}
+
+	return s
+}
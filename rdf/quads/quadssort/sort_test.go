@@ -0,0 +1,94 @@
+package quadssort
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+)
+
+func TestSort(t *testing.T) {
+	bn := rdf.NewBlankNode()
+
+	var input rdf.QuadList
+
+	for i := 0; i < 50; i++ {
+		for _, v := range []int{(i * 7) % 25, i % 3} {
+			input = append(input, rdf.Quad{
+				Triple: rdf.Triple{
+					Subject:   rdf.IRI(fmt.Sprintf("http://example.com/s%02d", v)),
+					Predicate: rdf.IRI("http://example.com/p"),
+					Object: rdf.Literal{
+						Datatype:    xsdiri.String_Datatype,
+						LexicalForm: fmt.Sprintf("%d", v%4),
+					},
+				},
+			})
+		}
+
+		input = append(input, rdf.Quad{
+			Triple: rdf.Triple{
+				Subject:   bn,
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object: rdf.TripleTerm{
+					Subject:   bn,
+					Predicate: rdf.IRI("http://example.com/p"),
+					Object:    rdf.IRI(fmt.Sprintf("http://example.com/o%d", i%2)),
+				},
+			},
+			GraphName: rdf.IRI("http://example.com/g"),
+		})
+	}
+
+	for _, unique := range []bool{false, true} {
+		t.Run(fmt.Sprintf("unique=%v", unique), func(t *testing.T) {
+			tempDir := t.TempDir()
+
+			iter, err := Sort(context.Background(), quads.NewIterator(input), SortConfig{}.
+				SetUnique(unique).
+				SetMaxBufferedQuads(16).
+				SetTempDir(tempDir),
+			)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if entries, _ := os.ReadDir(tempDir); len(entries) != 1 {
+				t.Fatalf("expected spilled runs")
+			}
+
+			actual, err := quads.Collect(iter)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			} else if err := iter.Close(); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if entries, _ := os.ReadDir(tempDir); len(entries) != 0 {
+				t.Fatalf("expected temporary files to be removed")
+			}
+
+			expected := slices.Clone(input)
+			slices.SortStableFunc(expected, quads.Compare)
+
+			if unique {
+				expected = slices.CompactFunc(expected, quadEquals)
+			}
+
+			if _a, _e := len(actual), len(expected); _a != _e {
+				t.Fatalf("expected %d quads, got %d", _e, _a)
+			}
+
+			for i := range expected {
+				if !quadEquals(actual[i], expected[i]) {
+					t.Fatalf("quad %d: expected %v, got %v", i, expected[i], actual[i])
+				}
+			}
+		})
+	}
+}
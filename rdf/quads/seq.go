@@ -0,0 +1,99 @@
+package quads
+
+import (
+	"iter"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// All returns a sequence of every quad of the iterator. If the iterator fails, the error is yielded last with a zero
+// quad. The iterator is closed once the sequence stops, including when the loop breaks early.
+func All(it rdf.QuadIterator) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		defer it.Close()
+
+		for it.Next() {
+			if !yield(it.Quad(), nil) {
+				return
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			yield(rdf.Quad{}, err)
+		}
+	}
+}
+
+// CollectSeq returns all quads of the sequence, or the first error.
+func CollectSeq(seq iter.Seq2[rdf.Quad, error]) (rdf.QuadList, error) {
+	var all rdf.QuadList
+
+	for q, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, q)
+	}
+
+	return all, nil
+}
+
+//
+
+type seqIterator struct {
+	next func() (rdf.Quad, error, bool)
+	stop func()
+	quad rdf.Quad
+	err  error
+}
+
+var _ rdf.QuadIterator = &seqIterator{}
+
+// NewSeqIterator returns an iterator for a sequence. Close must be called if the iterator is not exhausted.
+func NewSeqIterator(seq iter.Seq2[rdf.Quad, error]) rdf.QuadIterator {
+	next, stop := iter.Pull2(seq)
+
+	return &seqIterator{
+		next: next,
+		stop: stop,
+	}
+}
+
+func (it *seqIterator) Close() error {
+	it.stop()
+
+	return nil
+}
+
+func (it *seqIterator) Err() error {
+	return it.err
+}
+
+func (it *seqIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	quad, err, ok := it.next()
+	if !ok {
+		return false
+	} else if err != nil {
+		it.err = err
+		it.stop()
+
+		return false
+	}
+
+	it.quad = quad
+
+	return true
+}
+
+func (it *seqIterator) Quad() rdf.Quad {
+	return it.quad
+}
+
+func (it *seqIterator) Statement() rdf.Statement {
+	return it.Quad()
+}
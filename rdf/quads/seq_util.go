@@ -0,0 +1,470 @@
+package quads
+
+import (
+	"container/heap"
+	"iter"
+	"math"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/internal/termkey"
+)
+
+// Filter yields the quads which match all of the matchers.
+func Filter(seq iter.Seq2[rdf.Quad, error], matchers ...rdf.QuadMatcher) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+	QUADS:
+		for q, err := range seq {
+			if err != nil {
+				yield(q, err)
+
+				return
+			}
+
+			for _, m := range matchers {
+				if !m.MatchQuad(q) {
+					continue QUADS
+				}
+			}
+
+			if !yield(q, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Map yields the result of fn for every quad.
+func Map(seq iter.Seq2[rdf.Quad, error], fn func(q rdf.Quad) rdf.Quad) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		for q, err := range seq {
+			if err != nil {
+				yield(q, err)
+
+				return
+			}
+
+			if !yield(fn(q), nil) {
+				return
+			}
+		}
+	}
+}
+
+// Distinct yields the first occurrence of every quad. Quads are compared by their terms, and the key of each distinct
+// quad is retained until the sequence stops.
+func Distinct(seq iter.Seq2[rdf.Quad, error]) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		keyer := termkey.NewKeyer()
+		seen := map[string]struct{}{}
+
+		for q, err := range seq {
+			if err != nil {
+				yield(q, err)
+
+				return
+			}
+
+			key := keyer.QuadKey(q)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+
+			seen[key] = struct{}{}
+
+			if !yield(q, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Limit yields at most n quads.
+func Limit(seq iter.Seq2[rdf.Quad, error], n int) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		if n <= 0 {
+			return
+		}
+
+		var count int
+
+		for q, err := range seq {
+			if !yield(q, err) || err != nil {
+				return
+			}
+
+			count++
+
+			if count >= n {
+				return
+			}
+		}
+	}
+}
+
+// Offset skips the first n quads. Errors are always yielded.
+func Offset(seq iter.Seq2[rdf.Quad, error], n int) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		var count int
+
+		for q, err := range seq {
+			if err != nil {
+				yield(q, err)
+
+				return
+			}
+
+			if count < n {
+				count++
+
+				continue
+			}
+
+			if !yield(q, nil) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk yields lists of up to size quads. Only the last list may be smaller. If size is not positive, nothing is
+// yielded.
+func Chunk(seq iter.Seq2[rdf.Quad, error], size int) iter.Seq2[rdf.QuadList, error] {
+	return func(yield func(rdf.QuadList, error) bool) {
+		if size < 1 {
+			return
+		}
+
+		var chunk rdf.QuadList
+
+		for q, err := range seq {
+			if err != nil {
+				yield(nil, err)
+
+				return
+			}
+
+			chunk = append(chunk, q)
+
+			if len(chunk) == size {
+				if !yield(chunk, nil) {
+					return
+				}
+
+				chunk = nil
+			}
+		}
+
+		if len(chunk) > 0 {
+			yield(chunk, nil)
+		}
+	}
+}
+
+//
+
+// Tee returns n sequences which each yield the quads of seq. Quads are buffered until every sequence has consumed
+// them, so sequences which are consumed unevenly may require unbounded memory. The sequences are not safe for
+// concurrent use, and each may only be used once. The underlying sequence is stopped once every sequence has been
+// ranged over.
+func Tee(seq iter.Seq2[rdf.Quad, error], n int) []iter.Seq2[rdf.Quad, error] {
+	next, stop := iter.Pull2(seq)
+
+	b := &teeBuffer{
+		next:      next,
+		stop:      stop,
+		positions: make([]int, n),
+		active:    n,
+	}
+
+	seqs := make([]iter.Seq2[rdf.Quad, error], n)
+
+	for i := range seqs {
+		seqs[i] = func(yield func(rdf.Quad, error) bool) {
+			defer b.release(i)
+
+			for {
+				item, ok := b.get(i)
+				if !ok {
+					return
+				} else if !yield(item.quad, item.err) || item.err != nil {
+					return
+				}
+			}
+		}
+	}
+
+	return seqs
+}
+
+type teeItem struct {
+	quad rdf.Quad
+	err  error
+}
+
+type teeBuffer struct {
+	next func() (rdf.Quad, error, bool)
+	stop func()
+	done bool
+
+	items  []teeItem
+	offset int
+
+	positions []int
+	active    int
+}
+
+func (b *teeBuffer) get(i int) (teeItem, bool) {
+	pos := b.positions[i]
+	if pos == math.MaxInt {
+		return teeItem{}, false
+	}
+
+	for pos-b.offset >= len(b.items) {
+		if b.done {
+			return teeItem{}, false
+		}
+
+		q, err, ok := b.next()
+		if !ok {
+			b.done = true
+
+			return teeItem{}, false
+		} else if err != nil {
+			b.done = true
+		}
+
+		b.items = append(b.items, teeItem{
+			quad: q,
+			err:  err,
+		})
+	}
+
+	item := b.items[pos-b.offset]
+
+	b.positions[i]++
+	b.trim()
+
+	return item, true
+}
+
+func (b *teeBuffer) release(i int) {
+	if b.positions[i] == math.MaxInt {
+		return
+	}
+
+	b.positions[i] = math.MaxInt
+	b.active--
+
+	if b.active == 0 {
+		b.stop()
+		b.items = nil
+
+		return
+	}
+
+	b.trim()
+}
+
+func (b *teeBuffer) trim() {
+	drop := min(b.positions[0], len(b.items)+b.offset)
+
+	for _, pos := range b.positions[1:] {
+		drop = min(drop, pos)
+	}
+
+	drop -= b.offset
+
+	if drop <= 0 {
+		return
+	}
+
+	clear(b.items[:drop])
+	b.items = b.items[drop:]
+	b.offset += drop
+}
+
+//
+
+// Merge yields the quads of sequences which are each sorted by cmp, maintaining the order across all sequences.
+func Merge(cmp func(a, b rdf.Quad) int, seqs ...iter.Seq2[rdf.Quad, error]) iter.Seq2[rdf.Quad, error] {
+	return func(yield func(rdf.Quad, error) bool) {
+		h := &mergeHeap{
+			cmp: cmp,
+		}
+
+		defer func() {
+			for _, s := range h.sources {
+				s.stop()
+			}
+		}()
+
+		for _, seq := range seqs {
+			next, stop := iter.Pull2(seq)
+
+			q, err, ok := next()
+			if !ok {
+				stop()
+
+				continue
+			} else if err != nil {
+				stop()
+				yield(q, err)
+
+				return
+			}
+
+			h.sources = append(h.sources, &mergeSource{
+				next: next,
+				stop: stop,
+				quad: q,
+			})
+		}
+
+		heap.Init(h)
+
+		for h.Len() > 0 {
+			s := h.sources[0]
+
+			if !yield(s.quad, nil) {
+				return
+			}
+
+			q, err, ok := s.next()
+			if !ok {
+				s.stop()
+				heap.Pop(h)
+
+				continue
+			} else if err != nil {
+				yield(q, err)
+
+				return
+			}
+
+			s.quad = q
+			heap.Fix(h, 0)
+		}
+	}
+}
+
+type mergeSource struct {
+	next func() (rdf.Quad, error, bool)
+	stop func()
+	quad rdf.Quad
+}
+
+type mergeHeap struct {
+	cmp     func(a, b rdf.Quad) int
+	sources []*mergeSource
+}
+
+func (h *mergeHeap) Len() int {
+	return len(h.sources)
+}
+
+func (h *mergeHeap) Less(i, j int) bool {
+	return h.cmp(h.sources[i].quad, h.sources[j].quad) < 0
+}
+
+func (h *mergeHeap) Swap(i, j int) {
+	h.sources[i], h.sources[j] = h.sources[j], h.sources[i]
+}
+
+func (h *mergeHeap) Push(x any) {
+	h.sources = append(h.sources, x.(*mergeSource))
+}
+
+func (h *mergeHeap) Pop() any {
+	last := h.sources[len(h.sources)-1]
+	h.sources = h.sources[:len(h.sources)-1]
+
+	return last
+}
+
+//
+
+// JoinedQuads is a pair of quads which were matched by [MergeJoin].
+type JoinedQuads struct {
+	Left  rdf.Quad
+	Right rdf.Quad
+}
+
+// MergeJoin yields every pair of left and right quads where cmp returns 0. Both sequences must be sorted by the
+// compared key, such that cmp(l, r) < 0 means l is before r.
+func MergeJoin(left, right iter.Seq2[rdf.Quad, error], cmp func(l, r rdf.Quad) int) iter.Seq2[JoinedQuads, error] {
+	return func(yield func(JoinedQuads, error) bool) {
+		leftNext, leftStop := iter.Pull2(left)
+		defer leftStop()
+
+		rightNext, rightStop := iter.Pull2(right)
+		defer rightStop()
+
+		l, err, lok := leftNext()
+		if err != nil {
+			yield(JoinedQuads{}, err)
+
+			return
+		}
+
+		r, err, rok := rightNext()
+		if err != nil {
+			yield(JoinedQuads{}, err)
+
+			return
+		}
+
+		for lok && rok {
+			c := cmp(l, r)
+
+			if c < 0 {
+				l, err, lok = leftNext()
+				if err != nil {
+					yield(JoinedQuads{}, err)
+
+					return
+				}
+
+				continue
+			} else if c > 0 {
+				r, err, rok = rightNext()
+				if err != nil {
+					yield(JoinedQuads{}, err)
+
+					return
+				}
+
+				continue
+			}
+
+			group := rdf.QuadList{r}
+
+			for {
+				r, err, rok = rightNext()
+				if err != nil {
+					yield(JoinedQuads{}, err)
+
+					return
+				} else if !rok || cmp(l, r) != 0 {
+					break
+				}
+
+				group = append(group, r)
+			}
+
+			for lok && cmp(l, group[0]) == 0 {
+				for _, gr := range group {
+					if !yield(JoinedQuads{Left: l, Right: gr}, nil) {
+						return
+					}
+				}
+
+				l, err, lok = leftNext()
+				if err != nil {
+					yield(JoinedQuads{}, err)
+
+					return
+				}
+			}
+		}
+	}
+}
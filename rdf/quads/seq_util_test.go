@@ -0,0 +1,200 @@
+package quads
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func newTestQuad(s string) rdf.Quad {
+	return rdf.Quad{
+		Triple: rdf.Triple{
+			Subject:   rdf.IRI("http://example.com/" + s),
+			Predicate: rdf.IRI("http://example.com/p"),
+			Object:    rdf.IRI("http://example.com/o"),
+		},
+	}
+}
+
+func newTestSeq(subjects ...string) rdf.QuadList {
+	var ql rdf.QuadList
+
+	for _, s := range subjects {
+		ql = append(ql, newTestQuad(s))
+	}
+
+	return ql
+}
+
+func testSubjects(t *testing.T, seq iter.Seq2[rdf.Quad, error]) []string {
+	t.Helper()
+
+	ql, err := CollectSeq(seq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var subjects []string
+
+	for _, q := range ql {
+		subjects = append(subjects, string(q.Triple.Subject.(rdf.IRI))[len("http://example.com/"):])
+	}
+
+	return subjects
+}
+
+func compareTestSubjects(a, b rdf.Quad) int {
+	return cmp.Compare(a.Triple.Subject.(rdf.IRI), b.Triple.Subject.(rdf.IRI))
+}
+
+func TestAll_Error(t *testing.T) {
+	expectedErr := errors.New("fake")
+
+	ql, err := CollectSeq(All(NewSeqIterator(func(yield func(rdf.Quad, error) bool) {
+		if !yield(newTestQuad("a"), nil) {
+			return
+		}
+
+		yield(rdf.Quad{}, expectedErr)
+	})))
+	if !errors.Is(err, expectedErr) {
+		t.Fatalf("expected error, got %v", err)
+	} else if ql != nil {
+		t.Fatalf("expected no quads, got %v", ql)
+	}
+}
+
+func TestSeqUtil(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Seq      iter.Seq2[rdf.Quad, error]
+		Expected []string
+	}{
+		{
+			Name: "Filter",
+			Seq: Filter(All(NewIterator(newTestSeq("a", "b", "c"))), SubjectMatcher{
+				Matcher: rdf.TermMatcherFunc(func(t rdf.Term) bool {
+					return t != rdf.IRI("http://example.com/b")
+				}),
+			}),
+			Expected: []string{"a", "c"},
+		},
+		{
+			Name: "Map",
+			Seq: Map(All(NewIterator(newTestSeq("a", "b"))), func(q rdf.Quad) rdf.Quad {
+				q.Triple.Subject = q.Triple.Subject.(rdf.IRI) + "x"
+
+				return q
+			}),
+			Expected: []string{"ax", "bx"},
+		},
+		{
+			Name:     "Distinct",
+			Seq:      Distinct(All(NewIterator(newTestSeq("a", "b", "a", "c", "b")))),
+			Expected: []string{"a", "b", "c"},
+		},
+		{
+			Name:     "LimitOffset",
+			Seq:      Limit(Offset(All(NewIterator(newTestSeq("a", "b", "c", "d"))), 1), 2),
+			Expected: []string{"b", "c"},
+		},
+		{
+			Name: "Merge",
+			Seq: Merge(
+				compareTestSubjects,
+				All(NewIterator(newTestSeq("a", "d", "e"))),
+				All(NewIterator(newTestSeq())),
+				All(NewIterator(newTestSeq("b", "c", "f"))),
+			),
+			Expected: []string{"a", "b", "c", "d", "e", "f"},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _a, _e := testSubjects(t, tc.Seq), tc.Expected; !slices.Equal(_a, _e) {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Size     int
+		Expected []int
+	}{
+		{
+			Name:     "Partial",
+			Size:     2,
+			Expected: []int{2, 2, 1},
+		},
+		{
+			Name:     "Exceeds",
+			Size:     10,
+			Expected: []int{5},
+		},
+		{
+			Name:     "Zero",
+			Size:     0,
+			Expected: nil,
+		},
+		{
+			Name:     "Negative",
+			Size:     -1,
+			Expected: nil,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var sizes []int
+
+			for chunk, err := range Chunk(All(NewIterator(newTestSeq("a", "b", "c", "d", "e"))), tc.Size) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				sizes = append(sizes, len(chunk))
+			}
+
+			if _a, _e := sizes, tc.Expected; !slices.Equal(_a, _e) {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestTee(t *testing.T) {
+	seqs := Tee(All(NewIterator(newTestSeq("a", "b", "c"))), 2)
+
+	// consume unevenly to exercise buffering
+	first := Limit(seqs[0], 2)
+
+	if _a, _e := testSubjects(t, first), []string{"a", "b"}; !slices.Equal(_a, _e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := testSubjects(t, seqs[1]), []string{"a", "b", "c"}; !slices.Equal(_a, _e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestMergeJoin(t *testing.T) {
+	var pairs []string
+
+	for jq, err := range MergeJoin(
+		All(NewIterator(newTestSeq("a", "b", "b", "d"))),
+		All(NewIterator(newTestSeq("b", "b", "c", "d"))),
+		compareTestSubjects,
+	) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		pairs = append(pairs, string(jq.Left.Triple.Subject.(rdf.IRI))[len("http://example.com/"):])
+	}
+
+	if _a, _e := pairs, []string{"b", "b", "b", "b", "d"}; !slices.Equal(_a, _e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
@@ -6,13 +6,14 @@ const (
 	TermKindBlankNode TermKind = iota
 	TermKindIRI
 	TermKindLiteral
+	TermKindTripleTerm
 )
 
 //
 
 // Term represents a value that may be used in some position of a statement.
 //
-// This is a closed interface. See [BlankNode], [IRI], and [Literal].
+// This is a closed interface. See [BlankNode], [IRI], [Literal], and [TripleTerm].
 type Term interface {
 	TermKind() TermKind
 	TermEquals(a Term) bool
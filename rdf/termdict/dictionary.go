@@ -0,0 +1,184 @@
+package termdict
+
+import (
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// ID is a dense identifier of a term within a [Dictionary]. IDs are allocated sequentially starting at 1.
+type ID uint64
+
+// NoID is never allocated. It represents the absence of a term, such as the default graph of a quad.
+const NoID ID = 0
+
+// Dictionary interns terms into IDs. Each distinct term is stored once as its binary key, and the same key is shared
+// by the forward and reverse lookups. IRIs and literals are reconstructed from their key, so the original values are
+// not retained.
+//
+// A Dictionary is not safe for concurrent use.
+type Dictionary struct {
+	ids  map[string]ID
+	keys []string
+
+	blankNodeIDs   map[rdf.BlankNodeIdentifier]ID
+	blankNodesByID map[ID]rdf.BlankNode
+
+	buf []byte
+}
+
+func NewDictionary() *Dictionary {
+	return &Dictionary{
+		ids:            map[string]ID{},
+		blankNodeIDs:   map[rdf.BlankNodeIdentifier]ID{},
+		blankNodesByID: map[ID]rdf.BlankNode{},
+	}
+}
+
+// Len returns the number of interned terms.
+func (d *Dictionary) Len() int {
+	return len(d.keys)
+}
+
+// Intern returns the ID of a term, allocating one if the term is new. A nil term returns [NoID]. The components of a
+// triple term are interned as well.
+func (d *Dictionary) Intern(t rdf.Term) ID {
+	id, _ := d.bind(t, true)
+
+	return id
+}
+
+// Lookup returns the ID of a term if it was previously interned.
+func (d *Dictionary) Lookup(t rdf.Term) (ID, bool) {
+	return d.bind(t, false)
+}
+
+// Key returns the binary key of an interned term.
+func (d *Dictionary) Key(id ID) (string, bool) {
+	if id == NoID || uint64(id) > uint64(len(d.keys)) {
+		return "", false
+	}
+
+	return d.keys[id-1], true
+}
+
+// Term returns the term of an ID. The reverse lookup of [NoID] or an unknown ID is false.
+func (d *Dictionary) Term(id ID) (rdf.Term, bool) {
+	key, ok := d.Key(id)
+	if !ok {
+		return nil, false
+	}
+
+	switch key[0] {
+	case keyKindBlankNode:
+		t, ok := d.blankNodesByID[id]
+
+		return t, ok
+	case keyKindTriple:
+		ids, err := decodeKeyIDs([]byte(key[1:]), 3)
+		if err != nil {
+			return nil, false
+		}
+
+		s, ok := d.Term(ids[0])
+		if !ok {
+			return nil, false
+		}
+
+		p, ok := d.Term(ids[1])
+		if !ok {
+			return nil, false
+		}
+
+		o, ok := d.Term(ids[2])
+		if !ok {
+			return nil, false
+		}
+
+		return rdf.TripleTerm{
+			Subject:   s.(rdf.SubjectValue),
+			Predicate: p.(rdf.PredicateValue),
+			Object:    o.(rdf.ObjectValue),
+		}, true
+	}
+
+	t, err := DecodeKey([]byte(key))
+	if err != nil {
+		return nil, false
+	}
+
+	return t, true
+}
+
+func (d *Dictionary) bind(t rdf.Term, write bool) (ID, bool) {
+	switch t := t.(type) {
+	case nil:
+		return NoID, false
+	case rdf.BlankNode:
+		if t.Identifier == nil {
+			// never equal to another blank node, so always new
+			if !write {
+				return NoID, false
+			}
+
+			return d.allocateBlankNode(t), true
+		}
+
+		id, ok := d.blankNodeIDs[t.Identifier]
+		if ok {
+			return id, true
+		} else if !write {
+			return NoID, false
+		}
+
+		id = d.allocateBlankNode(t)
+		d.blankNodeIDs[t.Identifier] = id
+
+		return id, true
+	case rdf.IRI:
+		d.buf = AppendIRIKey(d.buf[:0], t)
+	case rdf.Literal:
+		d.buf = AppendLiteralKey(d.buf[:0], t)
+	case rdf.TripleTerm:
+		s, ok := d.bind(t.Subject, write)
+		if !ok {
+			return NoID, false
+		}
+
+		p, ok := d.bind(t.Predicate, write)
+		if !ok {
+			return NoID, false
+		}
+
+		o, ok := d.bind(t.Object, write)
+		if !ok {
+			return NoID, false
+		}
+
+		d.buf = AppendTripleTermKey(d.buf[:0], s, p, o)
+	default:
+		return NoID, false
+	}
+
+	id, ok := d.ids[string(d.buf)]
+	if ok {
+		return id, true
+	} else if !write {
+		return NoID, false
+	}
+
+	key := string(d.buf)
+
+	d.keys = append(d.keys, key)
+	id = ID(len(d.keys))
+	d.ids[key] = id
+
+	return id, true
+}
+
+func (d *Dictionary) allocateBlankNode(t rdf.BlankNode) ID {
+	id := ID(len(d.keys) + 1)
+
+	d.keys = append(d.keys, string(AppendBlankNodeKey(nil, id)))
+	d.blankNodesByID[id] = t
+
+	return id
+}
@@ -0,0 +1,207 @@
+package termdict
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+)
+
+func TestDecodeKey(t *testing.T) {
+	for _, tc := range []rdf.Term{
+		rdf.IRI("http://example.com/"),
+		rdf.IRI(""),
+		rdf.Literal{
+			Datatype:    xsdiri.String_Datatype,
+			LexicalForm: "hello",
+		},
+		rdf.Literal{
+			Datatype: xsdiri.String_Datatype,
+		},
+		rdf.Literal{
+			Datatype:    rdfiri.LangString_Datatype,
+			LexicalForm: "hello",
+			Tag: rdf.LanguageLiteralTag{
+				Language: "en",
+			},
+		},
+		rdf.Literal{
+			Datatype:    rdfiri.DirLangString_Datatype,
+			LexicalForm: "hello",
+			Tag: rdf.DirectionalLanguageLiteralTag{
+				Language:      "en",
+				BaseDirection: "ltr",
+			},
+		},
+	} {
+		var key []byte
+
+		switch tc := tc.(type) {
+		case rdf.IRI:
+			key = AppendIRIKey(nil, tc)
+		case rdf.Literal:
+			key = AppendLiteralKey(nil, tc)
+		}
+
+		decoded, err := DecodeKey(key)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		} else if !decoded.TermEquals(tc) {
+			t.Fatalf("expected %v, got %v", tc, decoded)
+		}
+	}
+}
+
+func TestDecodeKey_Error(t *testing.T) {
+	for _, tc := range [][]byte{
+		nil,
+		{0xff},
+		{keyKindLiteral, 0x05, 'a'},
+		{keyKindLiteral, 0x00, 0x09},
+		AppendBlankNodeKey(nil, 1),
+	} {
+		_, err := DecodeKey(tc)
+		if !errors.Is(err, ErrKeyNotValid) {
+			t.Fatalf("expected ErrKeyNotValid for %x, got %v", tc, err)
+		}
+	}
+}
+
+func TestAppendLiteralKey_Distinct(t *testing.T) {
+	// fields with a shared concatenation must not collide
+	a := AppendLiteralKey(nil, rdf.Literal{
+		Datatype:    "http://example.com/a",
+		LexicalForm: "b",
+	})
+	b := AppendLiteralKey(nil, rdf.Literal{
+		Datatype:    "http://example.com/",
+		LexicalForm: "ab",
+	})
+
+	if string(a) == string(b) {
+		t.Fatalf("expected distinct keys")
+	}
+}
+
+func TestDictionary(t *testing.T) {
+	d := NewDictionary()
+
+	bn1 := rdf.NewBlankNode()
+	bn2 := rdf.NewBlankNode()
+	iri := rdf.IRI("http://example.com/p")
+	literal := rdf.Literal{
+		Datatype:    xsdiri.Integer_Datatype,
+		LexicalForm: "1",
+	}
+	tripleTerm := rdf.TripleTerm{
+		Subject:   bn1,
+		Predicate: iri,
+		Object:    literal,
+	}
+
+	idBN1 := d.Intern(bn1)
+	idIRI := d.Intern(iri)
+	idLiteral := d.Intern(literal)
+	idTripleTerm := d.Intern(tripleTerm)
+
+	if _a, _e := []ID{idBN1, idIRI, idLiteral, idTripleTerm}, []ID{1, 2, 3, 4}; !slices.Equal(_a, _e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a := d.Intern(rdf.IRI("http://example.com/p")); _a != idIRI {
+		t.Fatalf("expected %d, got %d", idIRI, _a)
+	} else if _a := d.Intern(literal); _a != idLiteral {
+		t.Fatalf("expected %d, got %d", idLiteral, _a)
+	} else if _a := d.Intern(tripleTerm); _a != idTripleTerm {
+		t.Fatalf("expected %d, got %d", idTripleTerm, _a)
+	} else if _, ok := d.Lookup(bn2); ok {
+		t.Fatalf("expected unknown blank node")
+	} else if _, ok := d.Lookup(rdf.IRI("http://example.com/o")); ok {
+		t.Fatalf("expected unknown iri")
+	} else if _a, _e := d.Len(), 4; _a != _e {
+		t.Fatalf("expected %d, got %d", _e, _a)
+	}
+
+	for _, tc := range []rdf.Term{bn1, iri, literal, tripleTerm} {
+		id, ok := d.Lookup(tc)
+		if !ok {
+			t.Fatalf("expected known term: %v", tc)
+		}
+
+		term, ok := d.Term(id)
+		if !ok {
+			t.Fatalf("expected known id: %d", id)
+		} else if !term.TermEquals(tc) {
+			t.Fatalf("expected %v, got %v", tc, term)
+		}
+	}
+
+	if _, ok := d.Term(NoID); ok {
+		t.Fatalf("expected unknown NoID")
+	} else if _, ok := d.Term(5); ok {
+		t.Fatalf("expected unknown id")
+	}
+}
+
+func TestQuadIterator(t *testing.T) {
+	d := NewDictionary()
+
+	input := rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object:    rdf.IRI("http://example.com/s"),
+			},
+		},
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://example.com/s"),
+				Predicate: rdf.IRI("http://example.com/p"),
+				Object: rdf.Literal{
+					Datatype:    xsdiri.String_Datatype,
+					LexicalForm: "o",
+				},
+			},
+			GraphName: rdf.IRI("http://example.com/g"),
+		},
+	}
+
+	var ids QuadList
+
+	iter := NewInternQuadIterator(d, quads.NewIterator(input))
+
+	for iter.Next() {
+		ids = append(ids, iter.Quad())
+	}
+
+	if err := iter.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := ids[0], (Quad{Subject: 1, Predicate: 2, Object: 1}); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := ids[1], (Quad{Subject: 1, Predicate: 2, Object: 4, GraphName: 3}); _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	output, err := quads.Collect(NewResolveQuadIterator(d, NewQuadIterator(ids)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(output), len(input); _a != _e {
+		t.Fatalf("expected %d, got %d", _e, _a)
+	}
+
+	for i := range input {
+		if !output[i].Triple.Object.TermEquals(input[i].Triple.Object) {
+			t.Fatalf("expected %v, got %v", input[i], output[i])
+		} else if output[i].GraphName != input[i].GraphName {
+			t.Fatalf("expected %v, got %v", input[i], output[i])
+		}
+	}
+
+	_, err = quads.Collect(NewResolveQuadIterator(d, NewQuadIterator(QuadList{{Subject: 1, Predicate: 2, Object: 9}})))
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
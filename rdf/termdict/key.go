@@ -0,0 +1,151 @@
+package termdict
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/internal/termkey"
+)
+
+var ErrKeyNotValid = errors.New("key not valid")
+
+const (
+	keyKindBlankNode = termkey.KindBlankNode
+	keyKindIRI       = termkey.KindIRI
+	keyKindLiteral   = termkey.KindLiteral
+	keyKindTriple    = termkey.KindTriple
+)
+
+const (
+	keyLiteralTagNone        = termkey.LiteralTagNone
+	keyLiteralTagLanguage    = termkey.LiteralTagLanguage
+	keyLiteralTagDirLanguage = termkey.LiteralTagDirLanguage
+)
+
+// AppendIRIKey appends the binary key of an IRI.
+//
+// The key is the kind byte followed by the IRI.
+func AppendIRIKey(dst []byte, t rdf.IRI) []byte {
+	return termkey.AppendIRI(dst, t)
+}
+
+// AppendLiteralKey appends the binary key of a literal.
+//
+// The key is the kind byte, the length-prefixed datatype, a tag byte with its length-prefixed fields, and then the
+// lexical form.
+func AppendLiteralKey(dst []byte, t rdf.Literal) []byte {
+	return termkey.AppendLiteral(dst, t)
+}
+
+// AppendBlankNodeKey appends the binary key of a blank node. Blank nodes have no portable representation, so the key
+// refers to the ID which was allocated by a [Dictionary].
+func AppendBlankNodeKey(dst []byte, id ID) []byte {
+	return termkey.AppendBlankNode(dst, uint64(id))
+}
+
+// AppendTripleTermKey appends the binary key of a triple term using the IDs of its subject, predicate, and object.
+func AppendTripleTermKey(dst []byte, s, p, o ID) []byte {
+	return termkey.AppendTripleTerm(dst, uint64(s), uint64(p), uint64(o))
+}
+
+// DecodeKey returns the term of an IRI or literal key. The keys of blank nodes and triple terms refer to IDs, so they
+// must be resolved with the [Dictionary] which created them.
+func DecodeKey(key []byte) (rdf.Term, error) {
+	if len(key) == 0 {
+		return nil, fmt.Errorf("%w: empty", ErrKeyNotValid)
+	}
+
+	switch key[0] {
+	case keyKindIRI:
+		return rdf.IRI(key[1:]), nil
+	case keyKindLiteral:
+		return decodeLiteralKey(key[1:])
+	case keyKindBlankNode, keyKindTriple:
+		return nil, fmt.Errorf("%w: kind requires dictionary: 0x%02x", ErrKeyNotValid, key[0])
+	}
+
+	return nil, fmt.Errorf("%w: unknown kind: 0x%02x", ErrKeyNotValid, key[0])
+}
+
+func decodeLiteralKey(key []byte) (rdf.Literal, error) {
+	datatype, key, err := decodeKeyString(key)
+	if err != nil {
+		return rdf.Literal{}, fmt.Errorf("datatype: %w", err)
+	} else if len(key) == 0 {
+		return rdf.Literal{}, fmt.Errorf("%w: missing tag", ErrKeyNotValid)
+	}
+
+	t := rdf.Literal{
+		Datatype: rdf.IRI(datatype),
+	}
+
+	tagKind := key[0]
+	key = key[1:]
+
+	switch tagKind {
+	case keyLiteralTagNone:
+		// nothing
+	case keyLiteralTagLanguage:
+		var tag rdf.LanguageLiteralTag
+
+		tag.Language, key, err = decodeKeyString(key)
+		if err != nil {
+			return rdf.Literal{}, fmt.Errorf("language: %w", err)
+		}
+
+		t.Tag = tag
+	case keyLiteralTagDirLanguage:
+		var tag rdf.DirectionalLanguageLiteralTag
+
+		tag.Language, key, err = decodeKeyString(key)
+		if err != nil {
+			return rdf.Literal{}, fmt.Errorf("language: %w", err)
+		}
+
+		tag.BaseDirection, key, err = decodeKeyString(key)
+		if err != nil {
+			return rdf.Literal{}, fmt.Errorf("base direction: %w", err)
+		}
+
+		t.Tag = tag
+	default:
+		return rdf.Literal{}, fmt.Errorf("%w: unknown tag: 0x%02x", ErrKeyNotValid, tagKind)
+	}
+
+	t.LexicalForm = string(key)
+
+	return t, nil
+}
+
+func decodeKeyIDs(key []byte, n int) ([]ID, error) {
+	ids := make([]ID, n)
+
+	for i := range ids {
+		v, l := binary.Uvarint(key)
+		if l <= 0 {
+			return nil, fmt.Errorf("%w: truncated id", ErrKeyNotValid)
+		}
+
+		ids[i] = ID(v)
+		key = key[l:]
+	}
+
+	if len(key) > 0 {
+		return nil, fmt.Errorf("%w: trailing bytes", ErrKeyNotValid)
+	}
+
+	return ids, nil
+}
+
+func decodeKeyString(key []byte) (string, []byte, error) {
+	l, n := binary.Uvarint(key)
+	if n <= 0 {
+		return "", nil, fmt.Errorf("%w: truncated length", ErrKeyNotValid)
+	} else if uint64(len(key)-n) < l {
+		return "", nil, fmt.Errorf("%w: truncated string", ErrKeyNotValid)
+	}
+
+	return string(key[n : n+int(l)]), key[n+int(l):], nil
+}
@@ -0,0 +1,226 @@
+package termdict
+
+import (
+	"fmt"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// Quad is a quad of term IDs. The GraphName of the default graph is [NoID].
+type Quad struct {
+	Subject   ID
+	Predicate ID
+	Object    ID
+	GraphName ID
+}
+
+type QuadList []Quad
+
+// InternQuad returns the IDs of a quad, allocating any new terms.
+func (d *Dictionary) InternQuad(q rdf.Quad) Quad {
+	var graphName ID
+
+	if q.GraphName != nil {
+		graphName = d.Intern(q.GraphName.(rdf.Term))
+	}
+
+	return Quad{
+		Subject:   d.Intern(q.Triple.Subject),
+		Predicate: d.Intern(q.Triple.Predicate),
+		Object:    d.Intern(q.Triple.Object),
+		GraphName: graphName,
+	}
+}
+
+// LookupQuad returns the IDs of a quad if all of its terms were previously interned.
+func (d *Dictionary) LookupQuad(q rdf.Quad) (Quad, bool) {
+	var res Quad
+	var ok bool
+
+	if res.Subject, ok = d.Lookup(q.Triple.Subject); !ok {
+		return Quad{}, false
+	} else if res.Predicate, ok = d.Lookup(q.Triple.Predicate); !ok {
+		return Quad{}, false
+	} else if res.Object, ok = d.Lookup(q.Triple.Object); !ok {
+		return Quad{}, false
+	}
+
+	if q.GraphName != nil {
+		if res.GraphName, ok = d.Lookup(q.GraphName.(rdf.Term)); !ok {
+			return Quad{}, false
+		}
+	}
+
+	return res, true
+}
+
+// ResolveQuad returns the terms of a quad of IDs.
+func (d *Dictionary) ResolveQuad(q Quad) (rdf.Quad, error) {
+	s, ok := d.Term(q.Subject)
+	if !ok {
+		return rdf.Quad{}, fmt.Errorf("subject: unknown id: %d", q.Subject)
+	}
+
+	p, ok := d.Term(q.Predicate)
+	if !ok {
+		return rdf.Quad{}, fmt.Errorf("predicate: unknown id: %d", q.Predicate)
+	}
+
+	o, ok := d.Term(q.Object)
+	if !ok {
+		return rdf.Quad{}, fmt.Errorf("object: unknown id: %d", q.Object)
+	}
+
+	res := rdf.Quad{
+		Triple: rdf.Triple{
+			Subject:   s.(rdf.SubjectValue),
+			Predicate: p.(rdf.PredicateValue),
+			Object:    o.(rdf.ObjectValue),
+		},
+	}
+
+	if q.GraphName != NoID {
+		g, ok := d.Term(q.GraphName)
+		if !ok {
+			return rdf.Quad{}, fmt.Errorf("graph name: unknown id: %d", q.GraphName)
+		}
+
+		res.GraphName = g.(rdf.GraphNameValue)
+	}
+
+	return res, nil
+}
+
+//
+
+// QuadIterator iterates over quads of IDs.
+type QuadIterator interface {
+	Next() bool
+	Err() error
+	Close() error
+
+	Quad() Quad
+}
+
+//
+
+type quadListIterator struct {
+	quads QuadList
+	idx   int
+}
+
+var _ QuadIterator = &quadListIterator{}
+
+func NewQuadIterator(quads QuadList) QuadIterator {
+	return &quadListIterator{
+		quads: quads,
+		idx:   -1,
+	}
+}
+
+func (it *quadListIterator) Close() error {
+	return nil
+}
+
+func (it *quadListIterator) Err() error {
+	return nil
+}
+
+func (it *quadListIterator) Next() bool {
+	it.idx++
+
+	return it.idx < len(it.quads)
+}
+
+func (it *quadListIterator) Quad() Quad {
+	return it.quads[it.idx]
+}
+
+//
+
+type internQuadIterator struct {
+	d    *Dictionary
+	iter rdf.QuadIterator
+	quad Quad
+}
+
+var _ QuadIterator = &internQuadIterator{}
+
+// NewInternQuadIterator interns every quad of the iterator into the dictionary.
+func NewInternQuadIterator(d *Dictionary, iter rdf.QuadIterator) QuadIterator {
+	return &internQuadIterator{
+		d:    d,
+		iter: iter,
+	}
+}
+
+func (it *internQuadIterator) Close() error {
+	return it.iter.Close()
+}
+
+func (it *internQuadIterator) Err() error {
+	return it.iter.Err()
+}
+
+func (it *internQuadIterator) Next() bool {
+	if !it.iter.Next() {
+		return false
+	}
+
+	it.quad = it.d.InternQuad(it.iter.Quad())
+
+	return true
+}
+
+func (it *internQuadIterator) Quad() Quad {
+	return it.quad
+}
+
+//
+
+type resolveQuadIterator struct {
+	d    *Dictionary
+	iter QuadIterator
+	quad rdf.Quad
+	err  error
+}
+
+var _ rdf.QuadIterator = &resolveQuadIterator{}
+
+// NewResolveQuadIterator resolves every quad of IDs into terms. An unknown ID stops iteration with an error.
+func NewResolveQuadIterator(d *Dictionary, iter QuadIterator) rdf.QuadIterator {
+	return &resolveQuadIterator{
+		d:    d,
+		iter: iter,
+	}
+}
+
+func (it *resolveQuadIterator) Close() error {
+	return it.iter.Close()
+}
+
+func (it *resolveQuadIterator) Err() error {
+	if it.err != nil {
+		return it.err
+	}
+
+	return it.iter.Err()
+}
+
+func (it *resolveQuadIterator) Next() bool {
+	if it.err != nil || !it.iter.Next() {
+		return false
+	}
+
+	it.quad, it.err = it.d.ResolveQuad(it.iter.Quad())
+
+	return it.err == nil
+}
+
+func (it *resolveQuadIterator) Quad() rdf.Quad {
+	return it.quad
+}
+
+func (it *resolveQuadIterator) Statement() rdf.Statement {
+	return it.Quad()
+}
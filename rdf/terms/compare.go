@@ -0,0 +1,253 @@
+package terms
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// Compare returns a total ordering of terms which matches the code point ordering of their N-Quads representation. A
+// nil term is ordered first. Literals are ordered before triple terms, then IRIs, then blank nodes.
+//
+// Blank nodes have no portable label, so they are ordered by [rdf.BlankNodeIdentifierComparer] when their identifiers
+// are of the same type and support it; otherwise, they are ordered by the type and then the Go representation of their
+// identifiers. The order of blank nodes is stable within a process, but not across processes.
+func Compare(a, b rdf.Term) int {
+	if a == nil || b == nil {
+		switch {
+		case a == nil && b == nil:
+			return 0
+		case a == nil:
+			return -1
+		}
+
+		return 1
+	}
+
+	if c := cmp.Compare(compareRank(a), compareRank(b)); c != 0 {
+		return c
+	}
+
+	switch aT := a.(type) {
+	case rdf.Literal:
+		return compareLiteral(aT, b.(rdf.Literal))
+	case rdf.IRI:
+		return compareIRI(aT, b.(rdf.IRI))
+	case rdf.TripleTerm:
+		bT := b.(rdf.TripleTerm)
+
+		if c := Compare(aT.Subject, bT.Subject); c != 0 {
+			return c
+		} else if c := Compare(aT.Predicate, bT.Predicate); c != 0 {
+			return c
+		}
+
+		return Compare(aT.Object, bT.Object)
+	case rdf.BlankNode:
+		return compareBlankNode(aT, b.(rdf.BlankNode))
+	}
+
+	panic(fmt.Errorf("unsupported term type: %T", a))
+}
+
+// compareRank orders the leading bytes of the N-Quads representation: `"` for literals, `<<(` for triple terms, `<`
+// for IRIs, and `_:` for blank nodes.
+func compareRank(t rdf.Term) int {
+	switch t := t.(type) {
+	case rdf.Literal:
+		return 0
+	case rdf.TripleTerm:
+		return 2
+	case rdf.IRI:
+		// an IRI only sorts before a triple term when its first byte is before '<', which is never true of an absolute
+		// IRI but is handled for completeness
+		if len(t) > 0 && t[0] < '<' && !iriMustEscapeByte(t[0]) {
+			return 1
+		}
+
+		return 3
+	case rdf.BlankNode:
+		return 4
+	}
+
+	panic(fmt.Errorf("unsupported term type: %T", t))
+}
+
+func compareIRI(a, b rdf.IRI) int {
+	return compareNQuadsBytes(newIRIBytes(a), newIRIBytes(b))
+}
+
+func compareLiteral(a, b rdf.Literal) int {
+	return compareNQuadsBytes(newLiteralBytes(a), newLiteralBytes(b))
+}
+
+func compareBlankNode(a, b rdf.BlankNode) int {
+	if a.TermEquals(b) {
+		return 0
+	} else if a.Identifier == nil || b.Identifier == nil {
+		switch {
+		case a.Identifier == nil && b.Identifier == nil:
+			return 0
+		case a.Identifier == nil:
+			return -1
+		}
+
+		return 1
+	}
+
+	if ac, ok := a.Identifier.(rdf.BlankNodeIdentifierComparer); ok {
+		if c := ac.CompareBlankNodeIdentifier(b.Identifier); c != 0 {
+			return c
+		}
+	}
+
+	// identifiers of different types, or which cannot otherwise be ordered; pointers are represented by their address
+	if c := strings.Compare(fmt.Sprintf("%T", a.Identifier), fmt.Sprintf("%T", b.Identifier)); c != 0 {
+		return c
+	}
+
+	return strings.Compare(fmt.Sprintf("%#v", a.Identifier), fmt.Sprintf("%#v", b.Identifier))
+}
+
+//
+
+// nquadsBytes produces the bytes of a term's N-Quads representation followed by the space separator of a statement.
+// Only the value part is escaped; all escaped characters are ASCII, so escaping is decided per byte.
+type nquadsBytes struct {
+	parts      [7]string
+	escapePart int
+	escapeFunc func(b byte, pending []byte) []byte
+
+	part    int
+	idx     int
+	pending []byte
+	buf     [6]byte
+}
+
+func newIRIBytes(t rdf.IRI) *nquadsBytes {
+	s := &nquadsBytes{
+		escapePart: 1,
+		escapeFunc: escapeIRIByte,
+	}
+
+	s.parts[0] = "<"
+	s.parts[1] = string(t)
+	s.parts[2] = ">"
+
+	return s
+}
+
+func newLiteralBytes(t rdf.Literal) *nquadsBytes {
+	s := &nquadsBytes{
+		escapePart: 1,
+		escapeFunc: escapeLiteralByte,
+	}
+
+	s.parts[0] = `"`
+	s.parts[1] = t.LexicalForm
+	s.parts[2] = `"`
+
+	switch t.Datatype {
+	case xsdiri.String_Datatype:
+		// implicit
+	case rdfiri.LangString_Datatype:
+		if tag, ok := t.Tag.(rdf.LanguageLiteralTag); ok {
+			s.parts[3] = "@"
+			s.parts[4] = tag.Language
+		}
+	case rdfiri.DirLangString_Datatype:
+		if tag, ok := t.Tag.(rdf.DirectionalLanguageLiteralTag); ok {
+			s.parts[3] = "@"
+			s.parts[4] = tag.Language
+			s.parts[5] = "--"
+			s.parts[6] = tag.BaseDirection
+		}
+	default:
+		// datatype IRIs are always absolute, so they are not expected to need escaping
+		s.parts[3] = "^^<"
+		s.parts[4] = string(t.Datatype)
+		s.parts[5] = ">"
+	}
+
+	return s
+}
+
+func (s *nquadsBytes) next() (byte, bool) {
+	if len(s.pending) > 0 {
+		b := s.pending[0]
+		s.pending = s.pending[1:]
+
+		return b, true
+	}
+
+	for s.part < len(s.parts) && s.idx >= len(s.parts[s.part]) {
+		s.part++
+		s.idx = 0
+	}
+
+	if s.part >= len(s.parts) {
+		return ' ', false
+	}
+
+	b := s.parts[s.part][s.idx]
+	s.idx++
+
+	if s.part == s.escapePart {
+		if escaped := s.escapeFunc(b, s.buf[:0]); len(escaped) > 0 {
+			s.pending = escaped[1:]
+
+			return escaped[0], true
+		}
+	}
+
+	return b, true
+}
+
+func compareNQuadsBytes(a, b *nquadsBytes) int {
+	for {
+		ab, aok := a.next()
+		bb, bok := b.next()
+
+		if !aok && !bok {
+			return 0
+		} else if ab != bb {
+			return cmp.Compare(ab, bb)
+		}
+	}
+}
+
+const hexUpper = "0123456789ABCDEF"
+
+func iriMustEscapeByte(b byte) bool {
+	switch b {
+	case '<', '>', '"', '{', '}', '|', '^', '`', '\\':
+		return true
+	}
+
+	return b <= 0x20
+}
+
+func escapeIRIByte(b byte, buf []byte) []byte {
+	if !iriMustEscapeByte(b) {
+		return nil
+	}
+
+	return append(buf, '\\', 'u', '0', '0', hexUpper[b>>4], hexUpper[b&0x0f])
+}
+
+func escapeLiteralByte(b byte, buf []byte) []byte {
+	switch b {
+	case '"', '\\':
+		return append(buf, '\\', b)
+	case '\n':
+		return append(buf, '\\', 'n')
+	case '\r':
+		return append(buf, '\\', 'r')
+	}
+
+	return nil
+}
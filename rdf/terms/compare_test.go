@@ -0,0 +1,144 @@
+package terms_test
+
+import (
+	"bytes"
+	"cmp"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding/nquads"
+	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/terms"
+)
+
+func TestCompare_NQuadsOrdering(t *testing.T) {
+	list := rdf.TermList{
+		rdf.IRI("http://example.com/"),
+		rdf.IRI("http://example.com/a"),
+		rdf.IRI("http://example.com/a!"),
+		rdf.IRI("http://example.com/a>b"),
+		rdf.IRI("http://example.com/ä"),
+		rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "a"},
+		rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "a b"},
+		rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "a\"b"},
+		rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "a\nb"},
+		rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "a\\b"},
+		rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "ab"},
+		rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: ""},
+		rdf.Literal{Datatype: xsdiri.Integer_Datatype, LexicalForm: "a"},
+		rdf.Literal{Datatype: rdfiri.LangString_Datatype, LexicalForm: "a", Tag: rdf.LanguageLiteralTag{Language: "en"}},
+		rdf.Literal{Datatype: rdfiri.LangString_Datatype, LexicalForm: "a", Tag: rdf.LanguageLiteralTag{Language: "en-us"}},
+		rdf.Literal{Datatype: rdfiri.DirLangString_Datatype, LexicalForm: "a", Tag: rdf.DirectionalLanguageLiteralTag{Language: "en", BaseDirection: "rtl"}},
+		rdf.TripleTerm{
+			Subject:   rdf.IRI("http://example.com/s"),
+			Predicate: rdf.IRI("http://example.com/p"),
+			Object:    rdf.IRI("http://example.com/o"),
+		},
+		rdf.TripleTerm{
+			Subject:   rdf.IRI("http://example.com/s"),
+			Predicate: rdf.IRI("http://example.com/p"),
+			Object:    rdf.Literal{Datatype: xsdiri.String_Datatype, LexicalForm: "o"},
+		},
+	}
+
+	encode := func(t rdf.Term) []byte {
+		buf := &bytes.Buffer{}
+
+		switch t := t.(type) {
+		case rdf.IRI:
+			nquads.WriteIRI(buf, t, false)
+		case rdf.Literal:
+			nquads.WriteLiteral(buf, t, false)
+		case rdf.TripleTerm:
+			nquads.WriteTripleTerm(buf, t, blanknodes.NewInt64StringProvider(""), false)
+		}
+
+		// statements separate terms with a space
+		buf.WriteByte(' ')
+
+		return buf.Bytes()
+	}
+
+	for _, a := range list {
+		for _, b := range list {
+			if _a, _e := terms.Compare(a, b), cmp.Compare(0, bytes.Compare(encode(b), encode(a))); _a != _e {
+				t.Fatalf("compare %s with %s: expected %d, got %d", encode(a), encode(b), _e, _a)
+			}
+		}
+	}
+}
+
+func TestCompare_BlankNode(t *testing.T) {
+	bnf := blanknodes.NewStringFactory()
+
+	b1 := bnf.NewStringBlankNode("b1")
+	b2 := bnf.NewStringBlankNode("b2")
+
+	if _a := terms.Compare(b1, b2); _a != -1 {
+		t.Fatalf("expected -1, got %d", _a)
+	} else if _a := terms.Compare(b2, b1); _a != 1 {
+		t.Fatalf("expected 1, got %d", _a)
+	} else if _a := terms.Compare(b1, bnf.NewStringBlankNode("b1")); _a != 0 {
+		t.Fatalf("expected 0, got %d", _a)
+	} else if _a := terms.Compare(rdf.IRI("http://example.com/"), b1); _a != -1 {
+		t.Fatalf("expected -1, got %d", _a)
+	} else if _a := terms.Compare(nil, b1); _a != -1 {
+		t.Fatalf("expected -1, got %d", _a)
+	}
+}
+
+func TestCompare_BlankNodeMixedScopes(t *testing.T) {
+	bnf1 := blanknodes.NewStringFactory()
+	bnf2 := blanknodes.NewStringFactory()
+	anonf := rdf.NewBlankNodeFactory()
+
+	list := rdf.TermList{
+		bnf1.NewStringBlankNode("b0"),
+		bnf1.NewStringBlankNode("b1"),
+		bnf2.NewStringBlankNode("b0"),
+		bnf2.NewStringBlankNode("b1"),
+		bnf1.NewBlankNode(),
+		bnf2.NewBlankNode(),
+		anonf.NewBlankNode(),
+		rdf.NewBlankNode(),
+		rdf.NewBlankNode(),
+		rdf.BlankNode{Identifier: testBlankNodeIdentifier{v: "b0"}},
+		rdf.BlankNode{Identifier: testBlankNodeIdentifier{v: "b1"}},
+	}
+
+	for i, a := range list {
+		for j, b := range list {
+			c := terms.Compare(a, b)
+
+			if i == j {
+				if c != 0 {
+					t.Fatalf("[%d, %d]: expected 0, got %d", i, j, c)
+				}
+
+				continue
+			} else if c == 0 {
+				t.Fatalf("[%d, %d]: expected non-zero for distinct blank nodes", i, j)
+			} else if _a, _e := terms.Compare(b, a), -c; _a != _e {
+				t.Fatalf("[%d, %d]: expected %d, got %d", i, j, _e, _a)
+			}
+
+			for k, z := range list {
+				if c < 0 && terms.Compare(b, z) < 0 && terms.Compare(a, z) >= 0 {
+					t.Fatalf("[%d, %d, %d]: expected transitive ordering", i, j, k)
+				}
+			}
+		}
+	}
+}
+
+type testBlankNodeIdentifier struct {
+	v string
+}
+
+func (bni testBlankNodeIdentifier) EqualsBlankNodeIdentifier(other rdf.BlankNodeIdentifier) bool {
+	otherT, ok := other.(testBlankNodeIdentifier)
+
+	return ok && otherT.v == bni.v
+}
@@ -38,6 +38,18 @@ var IsLiteral = isLiteral{}
 
 //
 
+type isTripleTerm struct{}
+
+func (m isTripleTerm) MatchTerm(t rdf.Term) bool {
+	_, ok := t.(rdf.TripleTerm)
+
+	return ok
+}
+
+var IsTripleTerm = isTripleTerm{}
+
+//
+
 type IsLiteralDatatype struct {
 	Datatype rdf.TermMatcher
 }
@@ -67,6 +79,7 @@ type EqualsOneOfCompiled struct {
 	mappedIRIs         map[rdf.IRI]struct{}
 	mappedBlankNodes   map[rdf.BlankNodeIdentifier]struct{}
 	literalsByDatatype map[rdf.IRI][]rdf.Literal
+	tripleTerms        []rdf.TripleTerm
 }
 
 func (m EqualsOneOfCompiled) AsLogicalOrMatcher() LogicalOrMatcher {
@@ -94,6 +107,12 @@ func (m EqualsOneOfCompiled) AsLogicalOrMatcher() LogicalOrMatcher {
 		}
 	}
 
+	for _, tripleTerm := range m.tripleTerms {
+		as = append(as, Equals{
+			Expected: tripleTerm,
+		})
+	}
+
 	return as
 }
 
@@ -117,6 +136,14 @@ func (m EqualsOneOfCompiled) MatchTerm(t rdf.Term) bool {
 			}
 		}
 
+		return false
+	case rdf.TripleTerm:
+		for _, tt := range m.tripleTerms {
+			if tt.TermEquals(t) {
+				return true
+			}
+		}
+
 		return false
 	}
 
@@ -140,11 +167,13 @@ func EqualsOneOf(expected ...rdf.Term) rdf.TermMatcher {
 			}
 		case rdf.Literal:
 			compiled.literalsByDatatype[t.Datatype] = append(compiled.literalsByDatatype[t.Datatype], t)
+		case rdf.TripleTerm:
+			compiled.tripleTerms = append(compiled.tripleTerms, t)
 		}
 	}
 
 	// simplification shortcut
-	if len(compiled.mappedIRIs) == 1 && len(compiled.mappedBlankNodes) == 0 && len(compiled.literalsByDatatype) == 0 {
+	if len(compiled.mappedIRIs) == 1 && len(compiled.mappedBlankNodes) == 0 && len(compiled.literalsByDatatype) == 0 && len(compiled.tripleTerms) == 0 {
 		for iri := range compiled.mappedIRIs {
 			return Equals{
 				Expected: iri,
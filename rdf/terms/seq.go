@@ -0,0 +1,25 @@
+package terms
+
+import (
+	"iter"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// All returns a sequence of every term of the iterator. If the iterator fails, the error is yielded last with a nil
+// term. The iterator is closed once the sequence stops, including when the loop breaks early.
+func All(it rdf.TermIterator) iter.Seq2[rdf.Term, error] {
+	return func(yield func(rdf.Term, error) bool) {
+		defer it.Close()
+
+		for it.Next() {
+			if !yield(it.Term(), nil) {
+				return
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package rdf
+
+// TripleTerm is a [Triple] used as a term, allowing statements to be made about a statement. Introduced by RDF 1.2,
+// it may only be used as the object of a triple. Its fields follow the same restrictions as a Triple, so the object of
+// a TripleTerm may itself be a TripleTerm.
+//
+// A TripleTerm does not assert its triple; it only refers to it.
+type TripleTerm Triple
+
+var _ Term = TripleTerm{}
+var _ ObjectValue = TripleTerm{}
+
+func (TripleTerm) isTermBuiltin()        {}
+func (TripleTerm) isObjectValueBuiltin() {}
+
+func (TripleTerm) TermKind() TermKind {
+	return TermKindTripleTerm
+}
+
+func (t TripleTerm) TermEquals(a Term) bool {
+	aTripleTerm, ok := a.(TripleTerm)
+	if !ok {
+		return false
+	}
+
+	return termEqualsNillable(t.Subject, aTripleTerm.Subject) &&
+		termEqualsNillable(t.Predicate, aTripleTerm.Predicate) &&
+		termEqualsNillable(t.Object, aTripleTerm.Object)
+}
+
+// AsTriple returns the triple which is referred to by the term.
+func (t TripleTerm) AsTriple() Triple {
+	return Triple(t)
+}
+
+func termEqualsNillable(a, b Term) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+
+	return a.TermEquals(b)
+}
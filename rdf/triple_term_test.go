@@ -0,0 +1,77 @@
+package rdf
+
+import (
+	"testing"
+)
+
+func TestTripleTerm_TermEquals(t *testing.T) {
+	v := TripleTerm{
+		Subject:   IRI("http://example.com/s"),
+		Predicate: IRI("http://example.com/p"),
+		Object: Literal{
+			Datatype:    "String",
+			LexicalForm: "hello",
+		},
+	}.TermEquals(TripleTerm{
+		Subject:   IRI("http://example.com/s"),
+		Predicate: IRI("http://example.com/p"),
+		Object: Literal{
+			Datatype:    "String",
+			LexicalForm: "hello",
+		},
+	})
+	if _e, _a := true, v; _e != _a {
+		t.Errorf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestTripleTerm_TermEquals_Nested(t *testing.T) {
+	v := TripleTerm{
+		Subject:   IRI("http://example.com/s"),
+		Predicate: IRI("http://example.com/p"),
+		Object: TripleTerm{
+			Subject:   IRI("http://example.com/s"),
+			Predicate: IRI("http://example.com/p"),
+			Object:    IRI("http://example.com/o"),
+		},
+	}.TermEquals(TripleTerm{
+		Subject:   IRI("http://example.com/s"),
+		Predicate: IRI("http://example.com/p"),
+		Object: TripleTerm{
+			Subject:   IRI("http://example.com/s"),
+			Predicate: IRI("http://example.com/p"),
+			Object:    IRI("http://example.com/o2"),
+		},
+	})
+	if _e, _a := false, v; _e != _a {
+		t.Errorf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestTripleTerm_TermEquals_NotType(t *testing.T) {
+	v := TripleTerm{
+		Subject:   IRI("http://example.com/s"),
+		Predicate: IRI("http://example.com/p"),
+		Object:    IRI("http://example.com/o"),
+	}.TermEquals(IRI("http://example.com/o"))
+	if _e, _a := false, v; _e != _a {
+		t.Errorf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestTripleTerm_TermEquals_BlankNode(t *testing.T) {
+	bn := NewBlankNode()
+
+	v := TripleTerm{
+		Subject:   bn,
+		Predicate: IRI("http://example.com/p"),
+		Object:    IRI("http://example.com/o"),
+	}.TermEquals(TripleTerm{
+		Subject:   NewBlankNode(),
+		Predicate: IRI("http://example.com/p"),
+		Object:    IRI("http://example.com/o"),
+	})
+	if _e, _a := false, v; _e != _a {
+		t.Errorf("expected %v, got %v", _e, _a)
+	}
+}
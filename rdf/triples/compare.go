@@ -0,0 +1,18 @@
+package triples
+
+import (
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/terms"
+)
+
+// Compare orders triples by their subject, predicate, and object using [terms.Compare]. This matches the code point
+// ordering of their N-Triples representation.
+func Compare(a, b rdf.Triple) int {
+	if c := terms.Compare(a.Subject, b.Subject); c != 0 {
+		return c
+	} else if c := terms.Compare(a.Predicate, b.Predicate); c != 0 {
+		return c
+	}
+
+	return terms.Compare(a.Object, b.Object)
+}
@@ -0,0 +1,99 @@
+package triples
+
+import (
+	"iter"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+// All returns a sequence of every triple of the iterator. If the iterator fails, the error is yielded last with a zero
+// triple. The iterator is closed once the sequence stops, including when the loop breaks early.
+func All(it rdf.TripleIterator) iter.Seq2[rdf.Triple, error] {
+	return func(yield func(rdf.Triple, error) bool) {
+		defer it.Close()
+
+		for it.Next() {
+			if !yield(it.Triple(), nil) {
+				return
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			yield(rdf.Triple{}, err)
+		}
+	}
+}
+
+// CollectSeq returns all triples of the sequence, or the first error.
+func CollectSeq(seq iter.Seq2[rdf.Triple, error]) (rdf.TripleList, error) {
+	var all rdf.TripleList
+
+	for q, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, q)
+	}
+
+	return all, nil
+}
+
+//
+
+type seqIterator struct {
+	next   func() (rdf.Triple, error, bool)
+	stop   func()
+	triple rdf.Triple
+	err    error
+}
+
+var _ rdf.TripleIterator = &seqIterator{}
+
+// NewSeqIterator returns an iterator for a sequence. Close must be called if the iterator is not exhausted.
+func NewSeqIterator(seq iter.Seq2[rdf.Triple, error]) rdf.TripleIterator {
+	next, stop := iter.Pull2(seq)
+
+	return &seqIterator{
+		next: next,
+		stop: stop,
+	}
+}
+
+func (it *seqIterator) Close() error {
+	it.stop()
+
+	return nil
+}
+
+func (it *seqIterator) Err() error {
+	return it.err
+}
+
+func (it *seqIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	triple, err, ok := it.next()
+	if !ok {
+		return false
+	} else if err != nil {
+		it.err = err
+		it.stop()
+
+		return false
+	}
+
+	it.triple = triple
+
+	return true
+}
+
+func (it *seqIterator) Triple() rdf.Triple {
+	return it.triple
+}
+
+func (it *seqIterator) Statement() rdf.Statement {
+	return it.Triple()
+}
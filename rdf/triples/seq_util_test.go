@@ -0,0 +1,389 @@
+package triples
+
+import (
+	"cmp"
+	"errors"
+	"iter"
+	"slices"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func newTestTriple(s string) rdf.Triple {
+	return rdf.Triple{
+		Subject:   rdf.IRI("http://example.com/" + s),
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object:    rdf.IRI("http://example.com/o"),
+	}
+}
+
+func newTestSeq(subjects ...string) rdf.TripleList {
+	var tl rdf.TripleList
+
+	for _, s := range subjects {
+		tl = append(tl, newTestTriple(s))
+	}
+
+	return tl
+}
+
+func newTestErrorSeq(err error, subjects ...string) iter.Seq2[rdf.Triple, error] {
+	return func(yield func(rdf.Triple, error) bool) {
+		for _, s := range subjects {
+			if !yield(newTestTriple(s), nil) {
+				return
+			}
+		}
+
+		yield(rdf.Triple{}, err)
+	}
+}
+
+func testSubjects(t *testing.T, seq iter.Seq2[rdf.Triple, error]) []string {
+	t.Helper()
+
+	tl, err := CollectSeq(seq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var subjects []string
+
+	for _, tr := range tl {
+		subjects = append(subjects, string(tr.Subject.(rdf.IRI))[len("http://example.com/"):])
+	}
+
+	return subjects
+}
+
+func compareTestSubjects(a, b rdf.Triple) int {
+	return cmp.Compare(a.Subject.(rdf.IRI), b.Subject.(rdf.IRI))
+}
+
+func TestSeqUtil(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Seq      iter.Seq2[rdf.Triple, error]
+		Expected []string
+	}{
+		{
+			Name: "Filter",
+			Seq: Filter(All(NewIterator(newTestSeq("a", "b", "c"))), SubjectMatcher{
+				Matcher: rdf.TermMatcherFunc(func(t rdf.Term) bool {
+					return t != rdf.IRI("http://example.com/b")
+				}),
+			}),
+			Expected: []string{"a", "c"},
+		},
+		{
+			Name:     "FilterNoMatchers",
+			Seq:      Filter(All(NewIterator(newTestSeq("a", "b")))),
+			Expected: []string{"a", "b"},
+		},
+		{
+			Name: "Map",
+			Seq: Map(All(NewIterator(newTestSeq("a", "b"))), func(t rdf.Triple) rdf.Triple {
+				t.Subject = t.Subject.(rdf.IRI) + "x"
+
+				return t
+			}),
+			Expected: []string{"ax", "bx"},
+		},
+		{
+			Name:     "Distinct",
+			Seq:      Distinct(All(NewIterator(newTestSeq("a", "b", "a", "c", "b")))),
+			Expected: []string{"a", "b", "c"},
+		},
+		{
+			Name:     "Limit",
+			Seq:      Limit(All(NewIterator(newTestSeq("a", "b", "c"))), 2),
+			Expected: []string{"a", "b"},
+		},
+		{
+			Name:     "LimitZero",
+			Seq:      Limit(All(NewIterator(newTestSeq("a", "b", "c"))), 0),
+			Expected: nil,
+		},
+		{
+			Name:     "Offset",
+			Seq:      Offset(All(NewIterator(newTestSeq("a", "b", "c"))), 1),
+			Expected: []string{"b", "c"},
+		},
+		{
+			Name:     "OffsetBeyond",
+			Seq:      Offset(All(NewIterator(newTestSeq("a", "b", "c"))), 5),
+			Expected: nil,
+		},
+		{
+			Name:     "LimitOffset",
+			Seq:      Limit(Offset(All(NewIterator(newTestSeq("a", "b", "c", "d"))), 1), 2),
+			Expected: []string{"b", "c"},
+		},
+		{
+			Name: "Merge",
+			Seq: Merge(
+				compareTestSubjects,
+				All(NewIterator(newTestSeq("a", "d", "e"))),
+				All(NewIterator(newTestSeq())),
+				All(NewIterator(newTestSeq("b", "c", "f"))),
+			),
+			Expected: []string{"a", "b", "c", "d", "e", "f"},
+		},
+		{
+			Name:     "MergeNone",
+			Seq:      Merge(compareTestSubjects),
+			Expected: nil,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			if _a, _e := testSubjects(t, tc.Seq), tc.Expected; !slices.Equal(_a, _e) {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestSeqUtil_Error(t *testing.T) {
+	expectedErr := errors.New("fake")
+
+	for _, tc := range []struct {
+		Name string
+		Seq  iter.Seq2[rdf.Triple, error]
+	}{
+		{
+			Name: "Filter",
+			Seq:  Filter(newTestErrorSeq(expectedErr, "a")),
+		},
+		{
+			Name: "Map",
+			Seq: Map(newTestErrorSeq(expectedErr, "a"), func(t rdf.Triple) rdf.Triple {
+				return t
+			}),
+		},
+		{
+			Name: "Distinct",
+			Seq:  Distinct(newTestErrorSeq(expectedErr, "a")),
+		},
+		{
+			Name: "Limit",
+			Seq:  Limit(newTestErrorSeq(expectedErr, "a"), 2),
+		},
+		{
+			Name: "Offset",
+			Seq:  Offset(newTestErrorSeq(expectedErr, "a"), 1),
+		},
+		{
+			Name: "Merge",
+			Seq:  Merge(compareTestSubjects, All(NewIterator(newTestSeq("b"))), newTestErrorSeq(expectedErr, "a")),
+		},
+		{
+			Name: "Tee",
+			Seq:  Tee(newTestErrorSeq(expectedErr, "a"), 2)[1],
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := CollectSeq(tc.Seq)
+			if !errors.Is(err, expectedErr) {
+				t.Fatalf("expected error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestDistinct_Terms(t *testing.T) {
+	bn1 := rdf.NewBlankNode()
+	bn2 := rdf.NewBlankNode()
+
+	tt := rdf.TripleTerm{
+		Subject:   bn1,
+		Predicate: rdf.IRI("http://example.com/p"),
+		Object:    rdf.Literal{Datatype: "http://www.w3.org/2001/XMLSchema#string", LexicalForm: "o"},
+	}
+
+	tl, err := CollectSeq(Distinct(All(NewIterator(rdf.TripleList{
+		{Subject: bn1, Predicate: rdf.IRI("http://example.com/p"), Object: tt},
+		{Subject: bn2, Predicate: rdf.IRI("http://example.com/p"), Object: tt},
+		{Subject: bn1, Predicate: rdf.IRI("http://example.com/p"), Object: tt},
+		{Subject: bn1, Predicate: rdf.IRI("http://example.com/p"), Object: rdf.TripleTerm{
+			Subject:   bn2,
+			Predicate: tt.Predicate,
+			Object:    tt.Object,
+		}},
+	}))))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := len(tl), 3; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestChunk(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Size     int
+		Expected []int
+	}{
+		{
+			Name:     "Partial",
+			Size:     2,
+			Expected: []int{2, 2, 1},
+		},
+		{
+			Name:     "Single",
+			Size:     1,
+			Expected: []int{1, 1, 1, 1, 1},
+		},
+		{
+			Name:     "Exceeds",
+			Size:     10,
+			Expected: []int{5},
+		},
+		{
+			Name:     "Zero",
+			Size:     0,
+			Expected: nil,
+		},
+		{
+			Name:     "Negative",
+			Size:     -1,
+			Expected: nil,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var sizes []int
+
+			for chunk, err := range Chunk(All(NewIterator(newTestSeq("a", "b", "c", "d", "e"))), tc.Size) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				sizes = append(sizes, len(chunk))
+			}
+
+			if _a, _e := sizes, tc.Expected; !slices.Equal(_a, _e) {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestChunk_Error(t *testing.T) {
+	expectedErr := errors.New("fake")
+
+	var sizes []int
+
+	for chunk, err := range Chunk(newTestErrorSeq(expectedErr, "a", "b", "c"), 2) {
+		if err != nil {
+			if !errors.Is(err, expectedErr) {
+				t.Fatalf("expected error, got %v", err)
+			}
+
+			break
+		}
+
+		sizes = append(sizes, len(chunk))
+	}
+
+	if _a, _e := sizes, []int{2}; !slices.Equal(_a, _e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestTee(t *testing.T) {
+	seqs := Tee(All(NewIterator(newTestSeq("a", "b", "c"))), 2)
+
+	// consume unevenly to exercise buffering
+	first := Limit(seqs[0], 2)
+
+	if _a, _e := testSubjects(t, first), []string{"a", "b"}; !slices.Equal(_a, _e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := testSubjects(t, seqs[1]), []string{"a", "b", "c"}; !slices.Equal(_a, _e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}
+
+func TestMergeJoin(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Left     []string
+		Right    []string
+		Expected []string
+	}{
+		{
+			Name:     "Duplicates",
+			Left:     []string{"a", "b", "b", "d"},
+			Right:    []string{"b", "b", "c", "d"},
+			Expected: []string{"b/b", "b/b", "b/b", "b/b", "d/d"},
+		},
+		{
+			Name:     "Disjoint",
+			Left:     []string{"a", "c"},
+			Right:    []string{"b", "d"},
+			Expected: nil,
+		},
+		{
+			Name:     "EmptyLeft",
+			Left:     nil,
+			Right:    []string{"a"},
+			Expected: nil,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var pairs []string
+
+			for jt, err := range MergeJoin(
+				All(NewIterator(newTestSeq(tc.Left...))),
+				All(NewIterator(newTestSeq(tc.Right...))),
+				compareTestSubjects,
+			) {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				pairs = append(pairs, string(jt.Left.Subject.(rdf.IRI))[len("http://example.com/"):]+"/"+string(jt.Right.Subject.(rdf.IRI))[len("http://example.com/"):])
+			}
+
+			if _a, _e := pairs, tc.Expected; !slices.Equal(_a, _e) {
+				t.Fatalf("expected %v, got %v", _e, _a)
+			}
+		})
+	}
+}
+
+func TestMergeJoin_Error(t *testing.T) {
+	expectedErr := errors.New("fake")
+
+	for _, tc := range []struct {
+		Name  string
+		Left  iter.Seq2[rdf.Triple, error]
+		Right iter.Seq2[rdf.Triple, error]
+	}{
+		{
+			Name:  "Left",
+			Left:  newTestErrorSeq(expectedErr, "a"),
+			Right: All(NewIterator(newTestSeq("b"))),
+		},
+		{
+			Name:  "Right",
+			Left:  All(NewIterator(newTestSeq("b"))),
+			Right: newTestErrorSeq(expectedErr, "a"),
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			var lastErr error
+
+			for _, err := range MergeJoin(tc.Left, tc.Right, compareTestSubjects) {
+				if err != nil {
+					lastErr = err
+
+					break
+				}
+			}
+
+			if !errors.Is(lastErr, expectedErr) {
+				t.Fatalf("expected error, got %v", lastErr)
+			}
+		})
+	}
+}
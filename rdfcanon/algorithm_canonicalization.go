@@ -103,6 +103,19 @@ func (a algorithmCanonicalization) Call() (*Canonicalization, error) {
 			b.Reset()
 			nquads.WriteLiteral(b, o, false)
 
+			q.ObjectEncoded = b.String()
+		case rdf.TripleTerm:
+			// [dpb] blank nodes within triple terms would need to be hashed as components of the quad
+			if tripleTermHasBlankNode(o) {
+				return nil, ErrTripleTermBlankNode
+			}
+
+			b.Reset()
+
+			if err := nquads.WriteTripleTerm(b, o, nil, false); err != nil {
+				return nil, fmt.Errorf("object: %v", err)
+			}
+
 			q.ObjectEncoded = b.String()
 		default:
 			panic(fmt.Errorf("invalid object type: %T", o))
@@ -321,3 +334,18 @@ func (a algorithmCanonicalization) Call() (*Canonicalization, error) {
 
 	return cres, nil
 }
+
+func tripleTermHasBlankNode(t rdf.TripleTerm) bool {
+	if _, ok := t.Subject.(rdf.BlankNode); ok {
+		return true
+	}
+
+	switch o := t.Object.(type) {
+	case rdf.BlankNode:
+		return true
+	case rdf.TripleTerm:
+		return tripleTermHasBlankNode(o)
+	}
+
+	return false
+}
@@ -3,6 +3,7 @@ package rdfcanon_test
 import (
 	"context"
 	"errors"
+	"strings"
 	"testing"
 	"time"
 
@@ -53,3 +54,53 @@ func TestCanonicalize_ContextCancellation(t *testing.T) {
 		}
 	})
 }
+
+func TestCanonicalize_TripleTerm(t *testing.T) {
+	c, err := rdfcanon.Canonicalize(context.Background(), quads.NewIterator(rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.NewBlankNode(),
+				Predicate: rdf.IRI("http://ex/p"),
+				Object: rdf.TripleTerm{
+					Subject:   rdf.IRI("http://ex/s"),
+					Predicate: rdf.IRI("http://ex/p"),
+					Object:    rdf.IRI("http://ex/o"),
+				},
+			},
+		},
+	}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	buf := &strings.Builder{}
+
+	if _, err := c.WriteTo(buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := buf.String(), "_:c14n0 <http://ex/p> <<( <http://ex/s> <http://ex/p> <http://ex/o> )>> .\n"; _a != _e {
+		t.Fatalf("expected %q, got %q", _e, _a)
+	}
+}
+
+func TestCanonicalize_TripleTermBlankNode(t *testing.T) {
+	_, err := rdfcanon.Canonicalize(context.Background(), quads.NewIterator(rdf.QuadList{
+		{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://ex/s"),
+				Predicate: rdf.IRI("http://ex/p"),
+				Object: rdf.TripleTerm{
+					Subject:   rdf.IRI("http://ex/s"),
+					Predicate: rdf.IRI("http://ex/p"),
+					Object: rdf.TripleTerm{
+						Subject:   rdf.NewBlankNode(),
+						Predicate: rdf.IRI("http://ex/p"),
+						Object:    rdf.IRI("http://ex/o"),
+					},
+				},
+			},
+		},
+	}))
+	if _a, _e := err, rdfcanon.ErrTripleTermBlankNode; !errors.Is(_a, _e) {
+		t.Fatalf("expected %v error, got: %v", _e, _a)
+	}
+}
@@ -4,3 +4,7 @@ import "errors"
 
 var ErrMaxIterationsReached = errors.New("maximum iterations reached")
 var ErrMaxRecursionDepthReached = errors.New("maximum recursion depth reached")
+
+// ErrTripleTermBlankNode is returned for a dataset with a triple term which contains a blank node. Triple terms without
+// blank nodes are supported.
+var ErrTripleTermBlankNode = errors.New("triple term with blank node is not supported")
@@ -2,7 +2,6 @@ package rdfdescription
 
 import (
 	"context"
-	"maps"
 	"slices"
 
 	"github.com/dpb587/rdfkit-go/rdf"
@@ -11,6 +10,7 @@ import (
 
 type DatasetResourceListBuilder struct {
 	builderByGraphName map[rdf.GraphNameValue]*ResourceListBuilder
+	graphNames         rdf.GraphNameValueList
 }
 
 var _ quads.DatasetWriter = &DatasetResourceListBuilder{}
@@ -22,8 +22,9 @@ func NewDatasetResourceListBuilder() *DatasetResourceListBuilder {
 	}
 }
 
+// GetGraphNames returns the graph names in the order they were first added.
 func (e *DatasetResourceListBuilder) GetGraphNames() rdf.GraphNameValueList {
-	return slices.Collect(maps.Keys(e.builderByGraphName))
+	return slices.Clone(e.graphNames)
 }
 
 func (e *DatasetResourceListBuilder) GetResourceListBuilder(graphName rdf.GraphNameValue) *ResourceListBuilder {
@@ -48,25 +49,29 @@ func (e *DatasetResourceListBuilder) AddQuad(ctx context.Context, quad rdf.Quad)
 
 func (e *DatasetResourceListBuilder) Add(quads ...rdf.Quad) {
 	for _, quad := range quads {
-		if e.builderByGraphName[quad.GraphName] == nil {
-			e.builderByGraphName[quad.GraphName] = NewResourceListBuilder()
-		}
-
-		e.builderByGraphName[quad.GraphName].Add(quad.Triple)
+		e.getOrCreateResourceListBuilder(quad.GraphName).Add(quad.Triple)
 	}
 }
 
 func (e *DatasetResourceListBuilder) AddDatasetResource(ctx context.Context, resource DatasetResource) error {
-	if e.builderByGraphName[resource.GraphName] == nil {
-		e.builderByGraphName[resource.GraphName] = NewResourceListBuilder()
+	return e.getOrCreateResourceListBuilder(resource.GraphName).AddResource(ctx, resource.Resource)
+}
+
+func (e *DatasetResourceListBuilder) getOrCreateResourceListBuilder(graphName rdf.GraphNameValue) *ResourceListBuilder {
+	builder, known := e.builderByGraphName[graphName]
+	if !known {
+		builder = NewResourceListBuilder()
+
+		e.builderByGraphName[graphName] = builder
+		e.graphNames = append(e.graphNames, graphName)
 	}
 
-	return e.builderByGraphName[resource.GraphName].AddResource(ctx, resource.Resource)
+	return builder
 }
 
 func (e *DatasetResourceListBuilder) ToDatasetResourceWriter(ctx context.Context, z DatasetResourceWriter, opts ExportResourceOptions) error {
-	for graphName, builder := range e.builderByGraphName {
-		err := builder.ToDatasetResourceWriter(ctx, z, graphName, opts)
+	for _, graphName := range e.graphNames {
+		err := e.builderByGraphName[graphName].ToDatasetResourceWriter(ctx, z, graphName, opts)
 		if err != nil {
 			return err
 		}
@@ -12,6 +12,8 @@ import (
 type ResourceListBuilder struct {
 	resourceBySubject   map[rdf.SubjectValue]ObjectStatementList
 	blankNodeReferences map[rdf.BlankNodeIdentifier]int
+
+	externalBlankNodes map[rdf.BlankNodeIdentifier]struct{}
 }
 
 var _ triples.GraphWriter = &ResourceListBuilder{}
@@ -21,6 +23,7 @@ func NewResourceListBuilder() *ResourceListBuilder {
 	return &ResourceListBuilder{
 		resourceBySubject:   map[rdf.SubjectValue]ObjectStatementList{},
 		blankNodeReferences: map[rdf.BlankNodeIdentifier]int{},
+		externalBlankNodes:  map[rdf.BlankNodeIdentifier]struct{}{},
 	}
 }
 
@@ -53,10 +56,32 @@ func (rb *ResourceListBuilder) AddResource(ctx context.Context, resource Resourc
 	return nil
 }
 
+// AddExternalReference records that a blank node is referenced from outside of the builder, such as from another graph
+// of a dataset or as the name of a graph. An externally-referenced blank node is never inlined or exported as an
+// AnonResource.
+func (rb *ResourceListBuilder) AddExternalReference(bn rdf.BlankNode) {
+	rb.externalBlankNodes[bn.Identifier] = struct{}{}
+}
+
+// GetBlankNodeReferences returns the number of objects which reference the blank node, including one for any external
+// references.
 func (rb *ResourceListBuilder) GetBlankNodeReferences(bn rdf.BlankNode) int {
+	if _, ok := rb.externalBlankNodes[bn.Identifier]; ok {
+		return rb.blankNodeReferences[bn.Identifier] + 1
+	}
+
 	return rb.blankNodeReferences[bn.Identifier]
 }
 
+// isInlineable checks whether a blank node is only referenced by a single object known to the builder.
+func (rb *ResourceListBuilder) isInlineable(bn rdf.BlankNode) bool {
+	if _, ok := rb.externalBlankNodes[bn.Identifier]; ok {
+		return false
+	}
+
+	return rb.blankNodeReferences[bn.Identifier] == 1
+}
+
 func (rb *ResourceListBuilder) Subjects() iter.Seq[rdf.SubjectValue] {
 	return maps.Keys(rb.resourceBySubject)
 }
@@ -86,7 +111,7 @@ func (rb *ResourceListBuilder) ExportResources(opts ExportResourceOptions) iter.
 	return func(yield func(Resource) bool) {
 		for subject := range rb.resourceBySubject {
 			if opts.Inline {
-				if bn, ok := subject.(rdf.BlankNode); ok && rb.blankNodeReferences[bn.Identifier] == 1 {
+				if bn, ok := subject.(rdf.BlankNode); ok && rb.isInlineable(bn) {
 					continue
 				}
 			}
@@ -95,6 +120,71 @@ func (rb *ResourceListBuilder) ExportResources(opts ExportResourceOptions) iter.
 				return
 			}
 		}
+
+		if !opts.Inline {
+			return
+		}
+
+		// blank nodes which are only referenced from within a cycle of inlined blank nodes (including themselves) are
+		// never reached from a root-level resource, so each cycle is exported from one of its blank nodes instead
+		referencedBy := map[rdf.BlankNodeIdentifier]rdf.SubjectValue{}
+
+		for subject, statements := range rb.resourceBySubject {
+			for _, statement := range statements {
+				if bn, ok := statement.Object.(rdf.BlankNode); ok && rb.isInlineable(bn) {
+					referencedBy[bn.Identifier] = subject
+				}
+			}
+		}
+
+		exported := map[rdf.BlankNodeIdentifier]struct{}{}
+
+		for subject := range rb.resourceBySubject {
+			bn, ok := subject.(rdf.BlankNode)
+			if !ok || !rb.isInlineable(bn) {
+				continue
+			}
+
+			cycleBlankNode, ok := rb.findInlineCycle(bn, referencedBy)
+			if !ok {
+				continue
+			} else if _, known := exported[cycleBlankNode.Identifier]; known {
+				continue
+			}
+
+			exported[cycleBlankNode.Identifier] = struct{}{}
+
+			if !yield(SubjectResource{
+				Subject:    cycleBlankNode,
+				Statements: rb.exportResourceStatements(cycleBlankNode, opts, exported),
+			}) {
+				return
+			}
+		}
+	}
+}
+
+// findInlineCycle follows the single references of inlined blank nodes and returns the blank node where they loop back
+// on themselves. False is returned if they lead to a resource which will be exported as a root.
+func (rb *ResourceListBuilder) findInlineCycle(bn rdf.BlankNode, referencedBy map[rdf.BlankNodeIdentifier]rdf.SubjectValue) (rdf.BlankNode, bool) {
+	visited := map[rdf.BlankNodeIdentifier]struct{}{}
+
+	var current rdf.SubjectValue = bn
+
+	for {
+		currentBlankNode, ok := current.(rdf.BlankNode)
+		if !ok || !rb.isInlineable(currentBlankNode) {
+			return rdf.BlankNode{}, false
+		} else if _, known := visited[currentBlankNode.Identifier]; known {
+			return currentBlankNode, true
+		}
+
+		visited[currentBlankNode.Identifier] = struct{}{}
+
+		current, ok = referencedBy[currentBlankNode.Identifier]
+		if !ok {
+			return rdf.BlankNode{}, false
+		}
 	}
 }
 
@@ -116,15 +206,29 @@ func (rb *ResourceListBuilder) ExportResource(s rdf.SubjectValue, opts ExportRes
 }
 
 func (rb *ResourceListBuilder) ExportResourceStatements(subject rdf.SubjectValue, opts ExportResourceOptions) StatementList {
+	return rb.exportResourceStatements(subject, opts, map[rdf.BlankNodeIdentifier]struct{}{})
+}
+
+// exportResourceStatements tracks the blank nodes which have already been exported so that a cycle is referenced
+// rather than inlined again.
+func (rb *ResourceListBuilder) exportResourceStatements(subject rdf.SubjectValue, opts ExportResourceOptions, exported map[rdf.BlankNodeIdentifier]struct{}) StatementList {
 	var statements StatementList
 
 	for _, statement := range rb.resourceBySubject[subject] {
 		if opts.Inline {
-			if bn, ok := statement.Object.(rdf.BlankNode); ok && rb.blankNodeReferences[bn.Identifier] == 1 {
+			if bn, ok := statement.Object.(rdf.BlankNode); ok && rb.isInlineable(bn) {
+				if _, known := exported[bn.Identifier]; known {
+					statements = append(statements, statement)
+
+					continue
+				}
+
+				exported[bn.Identifier] = struct{}{}
+
 				statements = append(statements, AnonResourceStatement{
 					Predicate: statement.Predicate,
 					AnonResource: AnonResource{
-						Statements: rb.ExportResourceStatements(bn, opts),
+						Statements: rb.exportResourceStatements(bn, opts, exported),
 					},
 				})
 
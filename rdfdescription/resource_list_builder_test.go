@@ -0,0 +1,63 @@
+package rdfdescription
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/testing/testingassert"
+)
+
+func TestResourceListBuilder_ExportResources_InlineCycles(t *testing.T) {
+	bn1 := rdf.NewBlankNode()
+	bn2 := rdf.NewBlankNode()
+	bn3 := rdf.NewBlankNode()
+
+	for _, tc := range []struct {
+		Name    string
+		Triples rdf.TripleList
+	}{
+		{
+			Name: "self reference",
+			Triples: rdf.TripleList{
+				{Subject: bn1, Predicate: rdf.IRI("http://example.com/p"), Object: bn1},
+			},
+		},
+		{
+			Name: "pair",
+			Triples: rdf.TripleList{
+				{Subject: bn1, Predicate: rdf.IRI("http://example.com/p"), Object: bn2},
+				{Subject: bn2, Predicate: rdf.IRI("http://example.com/p"), Object: bn1},
+			},
+		},
+		{
+			Name: "pair with branch",
+			Triples: rdf.TripleList{
+				{Subject: bn1, Predicate: rdf.IRI("http://example.com/p"), Object: bn2},
+				{Subject: bn2, Predicate: rdf.IRI("http://example.com/p"), Object: bn1},
+				{Subject: bn2, Predicate: rdf.IRI("http://example.com/q"), Object: bn3},
+				{Subject: bn3, Predicate: rdf.IRI("http://example.com/q"), Object: rdf.IRI("http://example.com/o")},
+			},
+		},
+		{
+			Name: "referenced from root",
+			Triples: rdf.TripleList{
+				{Subject: rdf.IRI("http://example.com/s"), Predicate: rdf.IRI("http://example.com/p"), Object: bn1},
+				{Subject: bn1, Predicate: rdf.IRI("http://example.com/p"), Object: bn2},
+				{Subject: bn2, Predicate: rdf.IRI("http://example.com/p"), Object: bn3},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			rb := NewResourceListBuilder()
+			rb.Add(tc.Triples...)
+
+			var actual rdf.TripleList
+
+			for resource := range rb.ExportResources(ExportResourceOptions{Inline: true}) {
+				actual = append(actual, resource.NewTriples()...)
+			}
+
+			testingassert.IsomorphicGraphs(t.Context(), t, tc.Triples, actual)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"net/http"
 
 	"github.com/dpb587/rdfkit-go/encoding"
+	"github.com/dpb587/rdfkit-go/encoding/csvw/csvwcontent"
+	"github.com/dpb587/rdfkit-go/encoding/csvw/csvwrdfio"
 	"github.com/dpb587/rdfkit-go/encoding/encodingtest"
 	"github.com/dpb587/rdfkit-go/encoding/html/htmlcontent"
 	"github.com/dpb587/rdfkit-go/encoding/html/htmldefaults/htmldefaultsrdfio"
@@ -21,6 +23,8 @@ import (
 	"github.com/dpb587/rdfkit-go/encoding/trig/trigrdfio"
 	"github.com/dpb587/rdfkit-go/encoding/turtle/turtlecontent"
 	"github.com/dpb587/rdfkit-go/encoding/turtle/turtlerdfio"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld/yamlldcontent"
+	"github.com/dpb587/rdfkit-go/encoding/yamlld/yamlldrdfio"
 	"github.com/dpb587/rdfkit-go/rdfio/fileresource"
 	"github.com/dpb587/rdfkit-go/rdfio/httpresource"
 	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
@@ -29,6 +33,8 @@ import (
 func NewRegistry() rdfiotypes.Registry {
 	return rdfiotypes.Registry{
 		Aliases: map[string]encoding.ContentTypeIdentifier{
+			"csv":         csvwcontent.TypeIdentifier,
+			"csvw":        csvwcontent.TypeIdentifier,
 			"dev/null":    encodingtest.DiscardEncoderContentTypeIdentifier,
 			"dev/quads":   encodingtest.QuadsEncoderContentTypeIdentifier,
 			"dev/triples": encodingtest.TriplesEncoderContentTypeIdentifier,
@@ -47,24 +53,30 @@ func NewRegistry() rdfiotypes.Registry {
 			"rdfxml":      rdfxmlcontent.TypeIdentifier,
 			"rj":          rdfjsoncontent.TypeIdentifier,
 			"trig":        trigcontent.TypeIdentifier,
+			"tsv":         csvwcontent.TypeIdentifier,
 			"ttl":         turtlecontent.TypeIdentifier,
 			"turtle":      turtlecontent.TypeIdentifier,
 			"xhtml":       htmlcontent.TypeIdentifier,
 			"xml":         rdfxmlcontent.TypeIdentifier,
+			"yamlld":      yamlldcontent.TypeIdentifier,
 		},
 		MediaTypes: map[string]encoding.ContentTypeIdentifier{
-			"application/ld+json":   jsonldcontent.TypeIdentifier,
-			"application/n-quads":   nquadscontent.TypeIdentifier,
-			"application/n-triples": ntriplescontent.TypeIdentifier,
-			"application/rdf+json":  rdfjsoncontent.TypeIdentifier,
-			"application/rdf+xml":   rdfxmlcontent.TypeIdentifier,
-			"application/trig":      trigcontent.TypeIdentifier,
-			"application/xhtml+xml": htmlcontent.TypeIdentifier,
-			"text/html":             htmlcontent.TypeIdentifier,
-			"text/turtle":           turtlecontent.TypeIdentifier,
-			"text/xhtml+xml":        htmlcontent.TypeIdentifier,
+			"application/ld+json":       jsonldcontent.TypeIdentifier,
+			"application/ld+yaml":       yamlldcontent.TypeIdentifier,
+			"application/n-quads":       nquadscontent.TypeIdentifier,
+			"application/n-triples":     ntriplescontent.TypeIdentifier,
+			"application/rdf+json":      rdfjsoncontent.TypeIdentifier,
+			"application/rdf+xml":       rdfxmlcontent.TypeIdentifier,
+			"application/trig":          trigcontent.TypeIdentifier,
+			"application/xhtml+xml":     htmlcontent.TypeIdentifier,
+			"text/csv":                  csvwcontent.TypeIdentifier,
+			"text/html":                 htmlcontent.TypeIdentifier,
+			"text/tab-separated-values": csvwcontent.TypeIdentifier,
+			"text/turtle":               turtlecontent.TypeIdentifier,
+			"text/xhtml+xml":            htmlcontent.TypeIdentifier,
 		},
 		FileExts: map[string]encoding.ContentTypeIdentifier{
+			".csv":    csvwcontent.TypeIdentifier,
 			".htm":    htmlcontent.TypeIdentifier,
 			".html":   htmlcontent.TypeIdentifier,
 			".jsonld": jsonldcontent.TypeIdentifier,
@@ -73,8 +85,10 @@ func NewRegistry() rdfiotypes.Registry {
 			".rdf":    rdfxmlcontent.TypeIdentifier,
 			".rj":     rdfjsoncontent.TypeIdentifier,
 			".trig":   trigcontent.TypeIdentifier,
+			".tsv":    csvwcontent.TypeIdentifier,
 			".ttl":    turtlecontent.TypeIdentifier,
 			".xhtml":  htmlcontent.TypeIdentifier,
+			".yamlld": yamlldcontent.TypeIdentifier,
 		},
 		MagicBytesResolvers: []rdfiotypes.MagicBytesResolver{
 			rdfiotypes.MagicBytesResolverFunc(func(buf []byte) (encoding.ContentTypeIdentifier, bool) {
@@ -91,6 +105,13 @@ func NewRegistry() rdfiotypes.Registry {
 
 				return "", false
 			}),
+			rdfiotypes.MagicBytesResolverFunc(func(buf []byte) (encoding.ContentTypeIdentifier, bool) {
+				if yamlldcontent.MatchBytes(buf) {
+					return yamlldcontent.TypeIdentifier, true
+				}
+
+				return "", false
+			}),
 			rdfiotypes.MagicBytesResolverFunc(func(buf []byte) (encoding.ContentTypeIdentifier, bool) {
 				if htmlcontent.MatchBytes(buf) {
 					return htmlcontent.TypeIdentifier, true
@@ -118,6 +139,7 @@ func NewRegistry() rdfiotypes.Registry {
 			fileresource.NewManager(),
 		},
 		DecoderManagers: map[encoding.ContentTypeIdentifier]rdfiotypes.DecoderManager{
+			csvwcontent.TypeIdentifier:     csvwrdfio.NewDecoder(),
 			htmlcontent.TypeIdentifier:     htmldefaultsrdfio.NewDecoder(),
 			jsonldcontent.TypeIdentifier:   jsonldrdfio.NewDecoder(),
 			ntriplescontent.TypeIdentifier: ntriplesrdfio.NewDecoder(),
@@ -126,13 +148,17 @@ func NewRegistry() rdfiotypes.Registry {
 			rdfjsoncontent.TypeIdentifier:  rdfjsonrdfio.NewDecoder(),
 			trigcontent.TypeIdentifier:     trigrdfio.NewDecoder(),
 			turtlecontent.TypeIdentifier:   turtlerdfio.NewDecoder(),
+			yamlldcontent.TypeIdentifier:   yamlldrdfio.NewDecoder(),
 		},
 		EncoderManagers: map[encoding.ContentTypeIdentifier]rdfiotypes.EncoderManager{
 			jsonldcontent.TypeIdentifier:                     jsonldrdfio.NewEncoder(),
 			ntriplescontent.TypeIdentifier:                   ntriplesrdfio.NewEncoder(),
 			nquadscontent.TypeIdentifier:                     nquadsrdfio.NewEncoder(),
 			rdfjsoncontent.TypeIdentifier:                    rdfjsonrdfio.NewEncoder(),
+			rdfxmlcontent.TypeIdentifier:                     rdfxmlrdfio.NewEncoder(),
+			trigcontent.TypeIdentifier:                       trigrdfio.NewEncoder(),
 			turtlecontent.TypeIdentifier:                     turtlerdfio.NewEncoder(),
+			yamlldcontent.TypeIdentifier:                     yamlldrdfio.NewEncoder(),
 			ctiDevHtmlInspector:                              encodingDevHtmlInspector{},
 			encodingtest.DiscardEncoderContentTypeIdentifier: encodingDevDiscard{},
 			encodingtest.QuadsEncoderContentTypeIdentifier:   encodingDevQuads{},
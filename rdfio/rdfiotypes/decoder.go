@@ -13,6 +13,9 @@ type DecoderOptions struct {
 	BaseIRI rdf.IRI
 	Params  []string
 	Patcher GenericOptionsPatcherFunc
+
+	// MessageWriter receives messages from decoders and any wrapping stages which support them.
+	MessageWriter encoding.DecoderMessageWriter
 }
 
 func (next DecoderOptions) ApplyOptions(r Registry, rr Reader, base *DecoderOptions) error {
@@ -26,6 +29,10 @@ func (next DecoderOptions) ApplyOptions(r Registry, rr Reader, base *DecoderOpti
 
 	base.Params = append(base.Params, next.Params...)
 
+	if next.MessageWriter != nil {
+		base.MessageWriter = next.MessageWriter
+	}
+
 	if next.Patcher != nil {
 		if base.Patcher != nil {
 			originalPatcher := base.Patcher
@@ -0,0 +1,83 @@
+package rdfioutil
+
+import (
+	"fmt"
+
+	"github.com/dpb587/kvstrings-go/kvstrings"
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/internal/ptr"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type IRIValidation struct {
+	Mode *string
+}
+
+func (f *IRIValidation) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base: kvref.StringPtr(&f.Mode, rdfiotypes.ParamMeta{
+			Usage:      "Validate the RFC 3987 syntax of IRIs (default none)",
+			ValueEnums: []string{"none", "warn", "error"},
+		}),
+	}
+}
+
+func (f *IRIValidation) ApplyDefaults() {
+	if f.Mode == nil {
+		f.Mode = ptr.Value("none")
+	}
+}
+
+// ResolveDecoderHandle wraps the decoder of the handle when validation is enabled. Invalid IRIs are sent to the
+// message writer of the options for warn, or stop decoding for error.
+func (f *IRIValidation) ResolveDecoderHandle(h *rdfiotypes.DecoderHandle, opts rdfiotypes.DecoderOptions) error {
+	switch *f.Mode {
+	case "none":
+		return nil
+	case "warn", "error":
+		h.Decoder = encodingutil.NewValidatingQuadsDecoder(h.GetQuadsDecoder(), encodingutil.ValidatingQuadsDecoderOptions{
+			SkipLiterals:  true,
+			IRIValidator:  encodingutil.ValidateIRI,
+			MessageWriter: opts.MessageWriter,
+			Strict:        *f.Mode == "error",
+		})
+
+		return nil
+	}
+
+	return fmt.Errorf("unknown iri validation %q", *f.Mode)
+}
+
+//
+
+type IRINormalization struct {
+	Enabled *bool
+}
+
+func (f *IRINormalization) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base: kvref.BoolPtr(&f.Enabled, rdfiotypes.ParamMeta{
+			Usage: "Apply RFC 3987 syntax-based normalization to IRIs, such as decoding %7E to ~",
+		}),
+	}
+}
+
+func (f *IRINormalization) ApplyDefaults() {
+	if f.Enabled == nil {
+		f.Enabled = ptr.Value(false)
+	}
+}
+
+// ResolveDecoderHandle wraps the decoder of the handle when normalization is enabled. IRIs which are not valid are
+// left as-is.
+func (f *IRINormalization) ResolveDecoderHandle(h *rdfiotypes.DecoderHandle) {
+	if !*f.Enabled {
+		return
+	}
+
+	h.Decoder = encodingutil.NewQuadMapperQuadsDecoder(h.GetQuadsDecoder(), func(q rdf.Quad) rdf.Quad {
+		return encodingutil.MapQuadIRIs(q, encodingutil.NormalizeIRI)
+	})
+}
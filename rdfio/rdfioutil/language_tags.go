@@ -0,0 +1,44 @@
+package rdfioutil
+
+import (
+	"fmt"
+
+	"github.com/dpb587/kvstrings-go/kvstrings"
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/internal/ptr"
+	"github.com/dpb587/rdfkit-go/rdf/langtags"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type LanguageTags struct {
+	Mode *string
+}
+
+func (f *LanguageTags) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base: kvref.StringPtr(&f.Mode, rdfiotypes.ParamMeta{
+			Usage:      "Check the BCP 47 syntax of language tags (default preserve)",
+			ValueEnums: []string{"preserve", "validate", "normalize"},
+		}),
+	}
+}
+
+func (f *LanguageTags) ApplyDefaults() {
+	if f.Mode == nil {
+		f.Mode = ptr.Value("preserve")
+	}
+}
+
+// ResolveMapper returns the mapper for decoders which support one, or nil if tags should be used as-is.
+func (f *LanguageTags) ResolveMapper() (langtags.MapperFunc, error) {
+	switch *f.Mode {
+	case "preserve":
+		return nil, nil
+	case "validate":
+		return langtags.Validated, nil
+	case "normalize":
+		return langtags.Normalize, nil
+	}
+
+	return nil, fmt.Errorf("unknown language tags mode %q", *f.Mode)
+}
@@ -0,0 +1,79 @@
+package rdfioutil
+
+import (
+	"fmt"
+
+	"github.com/dpb587/kvstrings-go/kvstrings"
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/internal/ptr"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdtype"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type LiteralValidation struct {
+	Mode *string
+}
+
+func (f *LiteralValidation) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base: kvref.StringPtr(&f.Mode, rdfiotypes.ParamMeta{
+			Usage:      "Validate the lexical forms of literals (default none)",
+			ValueEnums: []string{"none", "warn", "error"},
+		}),
+	}
+}
+
+func (f *LiteralValidation) ApplyDefaults() {
+	if f.Mode == nil {
+		f.Mode = ptr.Value("none")
+	}
+}
+
+// ResolveDecoderHandle wraps the decoder of the handle when validation is enabled. Invalid literals are sent to the
+// message writer of the options for warn, or stop decoding for error.
+func (f *LiteralValidation) ResolveDecoderHandle(h *rdfiotypes.DecoderHandle, opts rdfiotypes.DecoderOptions) error {
+	switch *f.Mode {
+	case "none":
+		return nil
+	case "warn", "error":
+		h.Decoder = encodingutil.NewValidatingQuadsDecoder(h.GetQuadsDecoder(), encodingutil.ValidatingQuadsDecoderOptions{
+			MessageWriter: opts.MessageWriter,
+			Strict:        *f.Mode == "error",
+		})
+
+		return nil
+	}
+
+	return fmt.Errorf("unknown literal validation %q", *f.Mode)
+}
+
+//
+
+type LiteralCanonicalization struct {
+	Enabled *bool
+}
+
+func (f *LiteralCanonicalization) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base: kvref.BoolPtr(&f.Enabled, rdfiotypes.ParamMeta{
+			Usage: "Rewrite literals of XSD datatypes to their canonical lexical form, such as +01 to 1",
+		}),
+	}
+}
+
+func (f *LiteralCanonicalization) ApplyDefaults() {
+	if f.Enabled == nil {
+		f.Enabled = ptr.Value(false)
+	}
+}
+
+// ResolveDecoderHandle wraps the decoder of the handle when canonicalization is enabled. Literals of unsupported
+// datatypes or with invalid lexical forms are left as-is.
+func (f *LiteralCanonicalization) ResolveDecoderHandle(h *rdfiotypes.DecoderHandle) {
+	if !*f.Enabled {
+		return
+	}
+
+	h.Decoder = encodingutil.NewLiteralMapperQuadsDecoder(h.GetQuadsDecoder(), xsdtype.CanonicalLiteral)
+}
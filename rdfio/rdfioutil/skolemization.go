@@ -0,0 +1,75 @@
+package rdfioutil
+
+import (
+	"github.com/dpb587/kvstrings-go/kvstrings"
+	"github.com/dpb587/kvstrings-go/kvstrings/kvref"
+	"github.com/dpb587/rdfkit-go/encoding/encodingutil"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+type Skolemization struct {
+	Authority *string
+}
+
+func (f *Skolemization) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base: kvref.StringPtr(&f.Authority, rdfiotypes.ParamMeta{
+			Usage: "Replace blank nodes with /.well-known/genid/ IRIs of an authority, such as https://example.com",
+		}),
+	}
+}
+
+func (f *Skolemization) ApplyDefaults() {}
+
+// ResolveDecoderHandle wraps the decoder of the handle when an authority is configured. Blank node labels are only
+// unique within a single document, so new UUIDs are always used for the IRIs.
+func (f *Skolemization) ResolveDecoderHandle(h *rdfiotypes.DecoderHandle) {
+	if f.Authority == nil || len(*f.Authority) == 0 {
+		return
+	}
+
+	h.Decoder = encodingutil.NewQuadMapperQuadsDecoder(
+		h.GetQuadsDecoder(),
+		blanknodes.NewSkolemizer(rdf.IRI(*f.Authority), blanknodes.NewUUIDStringProvider("", nil)).SkolemizeQuad,
+	)
+}
+
+//
+
+type Deskolemization struct {
+	Authority *string
+}
+
+func (f *Deskolemization) NewParamsCollection(base kvstrings.KeyName) rdfiotypes.ParamsCollection {
+	return rdfiotypes.ParamsCollection{
+		base: kvref.StringPtr(&f.Authority, rdfiotypes.ParamMeta{
+			Usage: "Replace /.well-known/genid/ IRIs of an authority with blank nodes, or \"*\" for any authority",
+		}),
+	}
+}
+
+func (f *Deskolemization) ApplyDefaults() {}
+
+// ResolveDecoderHandle wraps the decoder of the handle when an authority is configured. The suffix of each IRI is used
+// as the blank node label, and the blank node factory of the handle is replaced so encoders may reuse them.
+func (f *Deskolemization) ResolveDecoderHandle(h *rdfiotypes.DecoderHandle) {
+	if f.Authority == nil || len(*f.Authority) == 0 {
+		return
+	}
+
+	var authority rdf.IRI
+
+	if *f.Authority != "*" {
+		authority = rdf.IRI(*f.Authority)
+	}
+
+	bnFactory := blanknodes.NewStringFactory()
+
+	h.Decoder = encodingutil.NewQuadMapperQuadsDecoder(
+		h.GetQuadsDecoder(),
+		blanknodes.NewDeskolemizer(authority, bnFactory).DeskolemizeQuad,
+	)
+	h.DecoderBlankNodes = bnFactory
+}
@@ -0,0 +1,61 @@
+package rdfioutil
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/encoding/ntriples"
+	"github.com/dpb587/rdfkit-go/rdf"
+	"github.com/dpb587/rdfkit-go/rdf/blanknodes"
+	"github.com/dpb587/rdfkit-go/rdf/quads"
+	"github.com/dpb587/rdfkit-go/rdfio/rdfiotypes"
+)
+
+func TestSkolemization_MultipleDocuments(t *testing.T) {
+	authority := "https://example.com"
+	skolemization := &Skolemization{
+		Authority: &authority,
+	}
+
+	var skolemIRIs []rdf.IRI
+
+	// past bug (document-local labels were reused, so both documents resulted in the same IRI)
+	for range 2 {
+		bnFactory := blanknodes.NewStringFactory()
+
+		decoder, err := ntriples.NewDecoder(
+			strings.NewReader("_:b0 <http://example.com/p> <http://example.com/o> .\n"),
+			ntriples.DecoderConfig{}.SetBlankNodeStringFactory(bnFactory),
+		)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		h := &rdfiotypes.DecoderHandle{
+			Decoder:           decoder,
+			DecoderBlankNodes: bnFactory,
+		}
+
+		skolemization.ResolveDecoderHandle(h)
+
+		statements, err := quads.Collect(h.GetQuadsDecoder())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		} else if _e, _a := 1, len(statements); _e != _a {
+			t.Fatalf("expected %v, got %v", _e, _a)
+		}
+
+		subject, ok := statements[0].Triple.Subject.(rdf.IRI)
+		if !ok {
+			t.Fatalf("expected IRI, got %T", statements[0].Triple.Subject)
+		} else if !strings.HasPrefix(string(subject), "https://example.com/.well-known/genid/") {
+			t.Fatalf("expected skolem IRI, got %v", subject)
+		}
+
+		skolemIRIs = append(skolemIRIs, subject)
+	}
+
+	if skolemIRIs[0] == skolemIRIs[1] {
+		t.Fatalf("expected distinct IRIs, got %v", skolemIRIs[0])
+	}
+}
@@ -2,6 +2,7 @@ package rdfdescriptionstruct
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 
@@ -185,8 +186,8 @@ func marshalObjectValue(subject rdf.SubjectValue, predicate rdf.IRI, fieldValue
 	if fieldValue.Kind() == reflect.Struct {
 		// Check if it's one of the known RDF types that should NOT be recursively marshaled
 		fieldType := fieldValue.Type()
-		if fieldType == reflect.TypeOf(rdf.Literal{}) {
-			// It's an rdf.Literal, handle as ObjectValue
+		if fieldType == reflect.TypeOf(rdf.Literal{}) || fieldType == reflect.TypeOf(big.Int{}) || fieldType == reflect.TypeOf(big.Rat{}) {
+			// It's an rdf.Literal or arbitrary-precision number, handle as ObjectValue
 			objectValue, err := marshalToObjectValue(fieldValue)
 			if err != nil {
 				return nil, nil, err
@@ -253,6 +254,10 @@ func marshalToObjectValue(fieldValue reflect.Value) (rdf.ObjectValue, error) {
 		return v, nil
 	case rdf.Literal:
 		return v, nil
+	case big.Int:
+		return xsdtype.BigInteger{Int: &v}.AsObjectValue(), nil
+	case big.Rat:
+		return xsdtype.BigDecimal{Rat: &v}.AsObjectValue(), nil
 	}
 
 	// Handle builtin types - convert to Literal
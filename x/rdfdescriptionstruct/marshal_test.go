@@ -1,6 +1,7 @@
 package rdfdescriptionstruct_test
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdobject"
@@ -153,6 +154,47 @@ func TestMarshal_NumericTypes(t *testing.T) {
 	}
 }
 
+func TestMarshal_BigNumbers(t *testing.T) {
+	type TestStruct struct {
+		Subject rdf.IRI  `rdf:"s"`
+		ID      *big.Int `rdf:"o,p=https://example.com/id"`
+		Amount  big.Rat  `rdf:"o,p=https://example.com/amount"`
+	}
+
+	id, _ := new(big.Int).SetString("123456789012345678901234567890", 10)
+	amount, _ := new(big.Rat).SetString("12345678901234567890.01")
+
+	resources, err := rdfdescriptionstruct.Marshal(TestStruct{
+		Subject: "https://example.com/test",
+		ID:      id,
+		Amount:  *amount,
+	})
+	if err != nil {
+		t.Fatalf("Marshal error: %v", err)
+	}
+
+	if len(resources) != 1 {
+		t.Fatalf("Expected 1 resource, got %d", len(resources))
+	}
+
+	stmts := resources[0].GetResourceStatements()
+	if len(stmts) != 2 {
+		t.Fatalf("Expected 2 statements, got %d", len(stmts))
+	}
+
+	if _e, _a := xsdobject.Integer(0), stmts[0].(rdfdescription.ObjectStatement).Object; _a.(rdf.Literal).Datatype != _e.(rdf.Literal).Datatype {
+		t.Errorf("ID datatype = %v, want %v", _a, _e)
+	} else if _e, _a := "123456789012345678901234567890", _a.(rdf.Literal).LexicalForm; _e != _a {
+		t.Errorf("ID = %s, want %s", _a, _e)
+	}
+
+	if _e, _a := xsdobject.Decimal(0), stmts[1].(rdfdescription.ObjectStatement).Object; _a.(rdf.Literal).Datatype != _e.(rdf.Literal).Datatype {
+		t.Errorf("Amount datatype = %v, want %v", _a, _e)
+	} else if _e, _a := "12345678901234567890.01", _a.(rdf.Literal).LexicalForm; _e != _a {
+		t.Errorf("Amount = %s, want %s", _a, _e)
+	}
+}
+
 func TestMarshal_Pointer(t *testing.T) {
 	type TestStruct struct {
 		Subject rdf.IRI `rdf:"s"`
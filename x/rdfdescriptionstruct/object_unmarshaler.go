@@ -2,11 +2,13 @@ package rdfdescriptionstruct
 
 import (
 	"fmt"
+	"math/big"
 	"reflect"
 	"strconv"
 
 	"github.com/dpb587/rdfkit-go/ontology/rdf/rdfiri"
 	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdiri"
+	"github.com/dpb587/rdfkit-go/ontology/xsd/xsdtype"
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdfdescription"
 )
@@ -195,6 +197,34 @@ func (u *Unmarshaler) unmarshalObjectValue(builder *rdfdescription.ResourceListB
 		}
 		fieldValue.Set(reflect.ValueOf(lit))
 		return nil
+
+	case reflect.TypeOf(big.Int{}):
+		lit, ok := object.(rdf.Literal)
+		if !ok {
+			return &TypeMismatchError{Expected: "rdf.Literal", Got: reflect.TypeOf(object).String()}
+		} else if !isIntegerDatatype(lit.Datatype) {
+			return &TypeMismatchError{Expected: "xsd:integer", Got: string(lit.Datatype)}
+		}
+		val, err := xsdtype.MapBigInteger(lit.LexicalForm)
+		if err != nil {
+			return fmt.Errorf("parse big.Int: %w", err)
+		}
+		fieldValue.Set(reflect.ValueOf(val.Int).Elem())
+		return nil
+
+	case reflect.TypeOf(big.Rat{}):
+		lit, ok := object.(rdf.Literal)
+		if !ok {
+			return &TypeMismatchError{Expected: "rdf.Literal", Got: reflect.TypeOf(object).String()}
+		} else if lit.Datatype != xsdiri.Decimal_Datatype && !isIntegerDatatype(lit.Datatype) {
+			return &TypeMismatchError{Expected: "xsd:decimal", Got: string(lit.Datatype)}
+		}
+		val, err := xsdtype.MapBigDecimal(lit.LexicalForm)
+		if err != nil {
+			return fmt.Errorf("parse big.Rat: %w", err)
+		}
+		fieldValue.Set(reflect.ValueOf(val.Rat).Elem())
+		return nil
 	}
 
 	// Handle builtin Go types (must be from Literal)
@@ -366,6 +396,28 @@ func unmarshalLiteralToBuiltin(lit rdf.Literal, fieldValue reflect.Value, fieldT
 	}
 }
 
+// isIntegerDatatype checks if a datatype is xsd:integer or one of its derived datatypes.
+func isIntegerDatatype(datatype rdf.IRI) bool {
+	switch datatype {
+	case xsdiri.Integer_Datatype,
+		xsdiri.Long_Datatype,
+		xsdiri.Int_Datatype,
+		xsdiri.Short_Datatype,
+		xsdiri.Byte_Datatype,
+		xsdiri.NonNegativeInteger_Datatype,
+		xsdiri.PositiveInteger_Datatype,
+		xsdiri.NonPositiveInteger_Datatype,
+		xsdiri.NegativeInteger_Datatype,
+		xsdiri.UnsignedLong_Datatype,
+		xsdiri.UnsignedInt_Datatype,
+		xsdiri.UnsignedShort_Datatype,
+		xsdiri.UnsignedByte_Datatype:
+		return true
+	}
+
+	return false
+}
+
 // expandRDFList attempts to expand an rdf:List into a slice of values.
 // Returns nil if the object is not an rdf:List or is rdf:nil.
 func (u *Unmarshaler) expandRDFList(builder *rdfdescription.ResourceListBuilder, object rdf.ObjectValue, elemType reflect.Type) (reflect.Value, error) {
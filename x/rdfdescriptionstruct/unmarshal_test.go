@@ -1,6 +1,7 @@
 package rdfdescriptionstruct
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 
@@ -574,6 +575,66 @@ func TestUnmarshal_AllNumericTypes(t *testing.T) {
 	}
 }
 
+func TestUnmarshal_BigNumbers(t *testing.T) {
+	resource := &rdfdescription.SubjectResource{
+		Subject: rdf.IRI("http://example.com/test"),
+		Statements: rdfdescription.StatementList{
+			rdfdescription.ObjectStatement{
+				Predicate: rdf.IRI("http://example.com/id"),
+				Object: rdf.Literal{
+					Datatype:    rdf.IRI("http://www.w3.org/2001/XMLSchema#integer"),
+					LexicalForm: "123456789012345678901234567890",
+				},
+			},
+			rdfdescription.ObjectStatement{
+				Predicate: rdf.IRI("http://example.com/amount"),
+				Object: rdf.Literal{
+					Datatype:    rdf.IRI("http://www.w3.org/2001/XMLSchema#decimal"),
+					LexicalForm: "12345678901234567890.01",
+				},
+			},
+		},
+	}
+
+	type TestStruct struct {
+		ID       big.Int  `rdf:"o,p=http://example.com/id"`
+		Amount   *big.Rat `rdf:"o,p=http://example.com/amount"`
+		Optional *big.Int `rdf:"o,p=http://example.com/optional"`
+	}
+
+	var result TestStruct
+	err := UnmarshalResource(resource, &result)
+	if err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	if _e, _a := "123456789012345678901234567890", result.ID.String(); _e != _a {
+		t.Errorf("ID = %s, want %s", _a, _e)
+	}
+
+	if result.Amount == nil {
+		t.Fatalf("Amount = nil")
+	} else if _e, _a := "12345678901234567890.01", result.Amount.FloatString(2); _e != _a {
+		t.Errorf("Amount = %s, want %s", _a, _e)
+	}
+
+	if result.Optional != nil {
+		t.Errorf("Optional = %v, want nil", result.Optional)
+	}
+
+	t.Run("datatype mismatch", func(t *testing.T) {
+		type TestStruct struct {
+			Amount big.Int `rdf:"o,p=http://example.com/amount"`
+		}
+
+		var result TestStruct
+		err := UnmarshalResource(resource, &result)
+		if err == nil {
+			t.Fatalf("expected error, got nil")
+		}
+	})
+}
+
 func TestUnmarshal_JellyExample(t *testing.T) {
 	// Build resources from the Turtle example in design.md
 	// First, create the list nodes
@@ -2,11 +2,11 @@ package inmemory
 
 import (
 	"context"
-	"crypto/sha256"
 	"fmt"
 
 	"github.com/dpb587/rdfkit-go/rdf"
 	"github.com/dpb587/rdfkit-go/rdf/quads"
+	"github.com/dpb587/rdfkit-go/rdf/termdict"
 	"github.com/dpb587/rdfkit-go/rdf/triples"
 )
 
@@ -37,7 +37,8 @@ type Dataset struct {
 
 	nodesByIRI          map[rdf.IRI]*Node
 	nodesByBlankNodeRef map[rdf.BlankNode]*Node
-	nodesByLiteral      map[[12]byte]*Node
+	nodesByLiteral      map[string]*Node
+	nodesByTripleTerm   map[tripleTermNodeKey]*Node
 
 	graphs map[rdf.GraphNameValue]*Graph
 
@@ -50,7 +51,8 @@ func NewDataset(opts ...DatasetOption) *Dataset {
 	d := &Dataset{
 		nodesByIRI:          map[rdf.IRI]*Node{},
 		nodesByBlankNodeRef: map[rdf.BlankNode]*Node{},
-		nodesByLiteral:      map[[12]byte]*Node{},
+		nodesByLiteral:      map[string]*Node{},
+		nodesByTripleTerm:   map[tripleTermNodeKey]*Node{},
 		graphs:              map[rdf.GraphNameValue]*Graph{},
 	}
 
@@ -220,6 +222,10 @@ func (d *Dataset) NewQuadIterator(ctx context.Context, matchers ...rdf.QuadMatch
 	}, nil
 }
 
+type tripleTermNodeKey struct {
+	s, p, o *Node
+}
+
 func (d *Dataset) bindNode(t rdf.Term, write bool) (*Node, bool) {
 	switch t := t.(type) {
 	case rdf.IRI:
@@ -263,24 +269,42 @@ func (d *Dataset) bindNode(t rdf.Term, write bool) (*Node, bool) {
 
 		return nb, true
 	case rdf.Literal:
-		h := sha256.New()
+		key := string(termdict.AppendLiteralKey(nil, t))
 
-		h.Write([]byte(t.Datatype + "\n"))
+		nb, ok := d.nodesByLiteral[key]
+		if ok {
+			return nb, true
+		} else if !write {
+			return nil, false
+		}
 
-		if t.Tag != nil {
-			switch tag := t.Tag.(type) {
-			case rdf.LanguageLiteralTag:
-				fmt.Fprintf(h, "lang=%q\n", tag.Language)
-			case rdf.DirectionalLanguageLiteralTag:
-				fmt.Fprintf(h, "lang=%q; dir=%q\n", tag.Language, tag.BaseDirection)
-			}
+		nb = &Node{
+			d: d,
+			t: t,
 		}
 
-		h.Write([]byte(t.LexicalForm))
+		if d.hooks.InitNode != nil {
+			d.hooks.InitNode(nb)
+		}
 
-		key := [12]byte(h.Sum(nil)[0:12])
+		d.nodesByLiteral[key] = nb
 
-		nb, ok := d.nodesByLiteral[key]
+		return nb, true
+	case rdf.TripleTerm:
+		var key tripleTermNodeKey
+		var ok bool
+
+		// triple terms are equal when their components are, so their key is the nodes of the components
+
+		if key.s, ok = d.bindNode(t.Subject, write); !ok {
+			return nil, false
+		} else if key.p, ok = d.bindNode(t.Predicate, write); !ok {
+			return nil, false
+		} else if key.o, ok = d.bindNode(t.Object, write); !ok {
+			return nil, false
+		}
+
+		nb, ok := d.nodesByTripleTerm[key]
 		if ok {
 			return nb, true
 		} else if !write {
@@ -296,7 +320,7 @@ func (d *Dataset) bindNode(t rdf.Term, write bool) (*Node, bool) {
 			d.hooks.InitNode(nb)
 		}
 
-		d.nodesByLiteral[key] = nb
+		d.nodesByTripleTerm[key] = nb
 
 		return nb, true
 	}
@@ -0,0 +1,63 @@
+package inmemory
+
+import (
+	"testing"
+
+	"github.com/dpb587/rdfkit-go/rdf"
+)
+
+func TestDataset_TripleTerm(t *testing.T) {
+	bn := rdf.NewBlankNode()
+
+	newQuad := func() rdf.Quad {
+		return rdf.Quad{
+			Triple: rdf.Triple{
+				Subject:   rdf.IRI("http://ex/s"),
+				Predicate: rdf.IRI("http://ex/p"),
+				Object: rdf.TripleTerm{
+					Subject:   bn,
+					Predicate: rdf.IRI("http://ex/p"),
+					Object: rdf.TripleTerm{
+						Subject:   rdf.IRI("http://ex/s"),
+						Predicate: rdf.IRI("http://ex/p"),
+						Object:    rdf.Literal{Datatype: "http://www.w3.org/2001/XMLSchema#string", LexicalForm: "o"},
+					},
+				},
+			},
+		}
+	}
+
+	d := NewDataset()
+
+	for range 2 {
+		if err := d.AddQuad(t.Context(), newQuad()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	statement, err := d.GetQuadStatement(t.Context(), newQuad())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	} else if _a, _e := statement.GetObjectNode().GetTerm(), rdf.Term(newQuad().Triple.Object); !_a.TermEquals(_e) {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+
+	iter, err := d.NewQuadIterator(t.Context())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	defer iter.Close()
+
+	var count int
+
+	for iter.Next() {
+		count++
+	}
+
+	if _a, _e := count, 1; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	} else if _a, _e := len(d.nodesByTripleTerm), 2; _a != _e {
+		t.Fatalf("expected %v, got %v", _e, _a)
+	}
+}